@@ -1,14 +1,23 @@
 // Command wandb-core provides the W&B SDK core service and the "leet" terminal UI
 // in a single binary. The default mode runs the core service; the `leet` subcommand
-// launches the local TUI for inspecting a run.
+// launches the local TUI for inspecting a run, the `tensorboard-export`
+// subcommand writes runs as TensorBoard tfevents files, the
+// `mlflow-import` subcommand converts MLflow runs into W&B runs, and the
+// `symon-agent` subcommand serves system metrics to multi-host symon.
 //
 // Usage:
 //
 //	wandb-core [service flags]
 //	wandb-core leet [<wandb-directory>] [leet flags]
+//	wandb-core tensorboard-export [flags] <run-file-or-directory>...
+//	wandb-core mlflow-import [flags] [<mlruns-directory>]
+//	wandb-core symon-agent [flags]
 //
 // Service flags: see `wandb-core -h`.
 // Leet flags:    see `wandb-core leet -h`.
+// Export flags:  see `wandb-core tensorboard-export -h`.
+// Import flags:  see `wandb-core mlflow-import -h`.
+// Agent flags:   see `wandb-core symon-agent -h`.
 package main
 
 import (
@@ -31,6 +40,7 @@ import (
 	"github.com/wandb/wandb/core/internal/observability"
 	"github.com/wandb/wandb/core/internal/pprof"
 	"github.com/wandb/wandb/core/internal/processlib"
+	"github.com/wandb/wandb/core/internal/remoterun"
 	"github.com/wandb/wandb/core/internal/version"
 	"github.com/wandb/wandb/core/pkg/server"
 )
@@ -59,8 +69,17 @@ func main() {
 }
 
 func run(args []string) int {
-	if len(args) > 0 && args[0] == "leet" {
-		return leetMain(args[1:])
+	if len(args) > 0 {
+		switch args[0] {
+		case "leet":
+			return leetMain(args[1:])
+		case "tensorboard-export":
+			return tensorboardExportMain(args[1:])
+		case "mlflow-import":
+			return mlflowImportMain(args[1:])
+		case "symon-agent":
+			return symonAgentMain(args[1:])
+		}
 	}
 	return serviceMain()
 }
@@ -102,6 +121,11 @@ func serviceMain() int {
 		"Whether to listen on a localhost socket. This is less secure than"+
 			" Unix sockets, but some clients do not support them"+
 			" (in particular, Python on Windows).")
+	grpcAddr := flag.String("grpc-addr", "",
+		"If set, also serve the WandbCoreService gRPC API on this TCP address,"+
+			" such as 127.0.0.1:0 to pick a free localhost port. The port is"+
+			" written to the port file. Connections are not authenticated,"+
+			" so avoid addresses reachable from other machines.")
 
 	flag.Usage = func() {
 		fmt.Fprintf(os.Stderr, "wandb-core - W&B SDK core service\n\n")
@@ -172,6 +196,7 @@ func serviceMain() int {
 			"disable-analytics", *disableAnalytics,
 			"shutdown-on-parent-exit", shutdownOnParentExitEnabled,
 			"enable-dcgm-profiling", *enableDCGMProfiling,
+			"grpc-addr", *grpcAddr,
 		)
 		loggerPath = file.Name()
 		defer func() { _ = file.Close() }()
@@ -183,11 +208,19 @@ func serviceMain() int {
 	signalCh := make(chan os.Signal, 1)
 	signal.Notify(signalCh, syscall.SIGINT, syscall.SIGTERM)
 
+	// Capture performance profiles of active runs on request, e.g. through
+	// `kill -USR1 <pid>`.
+	profileCh := make(chan os.Signal, 1)
+	if signals := profileSignals(); len(signals) > 0 {
+		signal.Notify(profileCh, signals...)
+	}
+
 	srv := server.NewServer(
 		server.ServerParams{
 			Commit:              commit,
 			EnableDCGMProfiling: *enableDCGMProfiling,
 			ListenOnLocalhost:   *listenOnLocalhost,
+			GRPCAddr:            *grpcAddr,
 			LoggerPath:          loggerPath,
 			LogLevel:            slog.Level(*logLevel),
 			ParentPID:           *pid,
@@ -208,6 +241,9 @@ func serviceMain() int {
 			default:
 				return exitCodeSuccess
 			}
+		case sig := <-profileCh:
+			slog.Info("main: received profile signal", "signal", sig)
+			srv.CaptureProfiles()
 		case sig := <-signalCh:
 			slog.Info("main: received shutdown signal", "signal", sig)
 			srv.ForceStop()
@@ -260,13 +296,25 @@ type leetOptions struct {
 	symonInterval    time.Duration
 	wandbDir         string
 
+	// symonRecordFile is the .wandb file to record symon samples to.
+	symonRecordFile string
+
+	// symonReplayFile is the .wandb file with samples to show in symon.
+	symonReplayFile string
+
+	// symonHostList is the comma-separated list of agents to poll.
+	symonHostList string
+
+	// symonHosts is the parsed symonHostList. Set during validation.
+	symonHosts []leet.SymonHost
+
 	// remoteURL is the W&B URL of the run to open
 	// (e.g. https://api.wandb.ai/<entity>/<project>/runs/<run-id>).
 	// Non-empty means we are in remote mode.
 	remoteURL string
 
 	// remoteRun is the parsed remoteURL. Set during validation.
-	remoteRun *leet.RemoteRunParams
+	remoteRun *remoterun.Params
 }
 
 func parseLeetOptions(args []string) (leetOptions, error) {
@@ -322,6 +370,26 @@ func bindLeetFlags(fs *flag.FlagSet, opts *leetOptions) {
 		leet.DefaultSymonSamplingInterval,
 		"Sampling interval for standalone system metrics (e.g. 500ms, 2s, 1m).",
 	)
+	fs.StringVar(
+		&opts.symonRecordFile,
+		"record",
+		"",
+		"With --symon, save the samples to this new .wandb file,"+
+			" which can be uploaded as a run with \"wandb sync\".",
+	)
+	fs.StringVar(
+		&opts.symonReplayFile,
+		"replay",
+		"",
+		"With --symon, show the samples in this .wandb file instead of live ones.",
+	)
+	fs.StringVar(
+		&opts.symonHostList,
+		"hosts",
+		"",
+		"With --symon, chart the machines running \"wandb-core symon-agent\""+
+			" at these comma-separated [name=]host:port addresses.",
+	)
 	fs.StringVar(
 		&opts.remoteURL,
 		"remote-url",
@@ -341,6 +409,9 @@ Usage:
   wandb-core leet --remote-url <wandb-run-url>
   wandb-core leet --config
   wandb-core leet --symon [flags]
+  wandb-core leet --symon --record <wandb-file>
+  wandb-core leet --symon --replay <wandb-file>
+  wandb-core leet --symon --hosts <host:port>,<host:port>,...
 
 Arguments:
   <wandb-directory>  Path to the wandb directory containing run folders.
@@ -355,7 +426,7 @@ Flags:
 
 func validateLeetOptions(fs *flag.FlagSet, opts *leetOptions) error {
 	if opts.remoteURL != "" {
-		remote, err := leet.ParseRemoteURL(opts.remoteURL)
+		remote, err := remoterun.ParseURL(opts.remoteURL)
 		if err != nil {
 			fmt.Fprintln(os.Stderr, "Error:", err)
 			fs.Usage()
@@ -364,6 +435,16 @@ func validateLeetOptions(fs *flag.FlagSet, opts *leetOptions) error {
 		opts.remoteRun = remote
 	}
 
+	if opts.symonHostList != "" {
+		hosts, err := leet.ParseSymonHosts(opts.symonHostList)
+		if err != nil {
+			fmt.Fprintln(os.Stderr, "Error: --hosts:", err)
+			fs.Usage()
+			return err
+		}
+		opts.symonHosts = hosts
+	}
+
 	switch {
 	case opts.symonInterval <= 0:
 		fmt.Fprintln(os.Stderr, "Error: --interval must be > 0")
@@ -377,6 +458,19 @@ func validateLeetOptions(fs *flag.FlagSet, opts *leetOptions) error {
 		fmt.Fprintln(os.Stderr, "Error: --remote-url does not take a wandb directory")
 		fs.Usage()
 		return fmt.Errorf("unexpected wandb directory %q in remote mode", fs.Arg(0))
+	case !opts.symonMode &&
+		(opts.symonRecordFile != "" || opts.symonReplayFile != "" || opts.symonHosts != nil):
+		fmt.Fprintln(os.Stderr, "Error: --record, --replay and --hosts require --symon")
+		fs.Usage()
+		return fmt.Errorf("--record, --replay and --hosts require --symon")
+	case opts.symonRecordFile != "" && opts.symonReplayFile != "":
+		fmt.Fprintln(os.Stderr, "Error: --record cannot be used with --replay")
+		fs.Usage()
+		return fmt.Errorf("--record cannot be used with --replay")
+	case opts.symonHosts != nil && (opts.symonRecordFile != "" || opts.symonReplayFile != ""):
+		fmt.Fprintln(os.Stderr, "Error: --hosts cannot be used with --record or --replay")
+		fs.Usage()
+		return fmt.Errorf("--hosts cannot be used with --record or --replay")
 	case opts.symonMode && fs.NArg() != 0:
 		fmt.Fprintln(os.Stderr, "Error: --symon does not take a wandb directory")
 		fs.Usage()
@@ -487,10 +581,42 @@ func runLeetConfigEditor(logger *observability.CoreLogger) int {
 }
 
 func runSymon(opts *leetOptions, logger *observability.CoreLogger) int {
+	var replay []leet.StatsMsg
+	if opts.symonReplayFile != "" {
+		samples, err := leet.ReadSymonRecording(opts.symonReplayFile, logger)
+		if err != nil {
+			fmt.Fprintln(os.Stderr, "Error: failed to read recording:", err)
+			return exitCodeErrorArgs
+		}
+		if len(samples) == 0 {
+			fmt.Fprintln(os.Stderr, "Error: no system metrics in", opts.symonReplayFile)
+			return exitCodeErrorArgs
+		}
+		replay = samples
+	}
+
+	var recorder *leet.SymonRecorder
+	if opts.symonRecordFile != "" {
+		var err error
+		recorder, err = leet.NewSymonRecorder(opts.symonRecordFile)
+		if err != nil {
+			fmt.Fprintln(os.Stderr, "Error: failed to start recording:", err)
+			return exitCodeErrorArgs
+		}
+		defer func() {
+			if err := recorder.Close(); err != nil {
+				fmt.Fprintln(os.Stderr, "Error: failed to finish recording:", err)
+			}
+		}()
+	}
+
 	for {
 		m := leet.NewSymon(leet.SymonParams{
 			Logger:           logger,
 			SamplingInterval: opts.symonInterval,
+			Recorder:         recorder,
+			Replay:           replay,
+			Hosts:            opts.symonHosts,
 		})
 		program := tea.NewProgram(m)
 
@@ -0,0 +1,260 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"log/slog"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"time"
+
+	"google.golang.org/protobuf/types/known/wrapperspb"
+
+	"github.com/wandb/wandb/core/internal/analytics"
+	"github.com/wandb/wandb/core/internal/clisettings"
+	"github.com/wandb/wandb/core/internal/mlflowimport"
+	"github.com/wandb/wandb/core/internal/observability"
+	"github.com/wandb/wandb/core/internal/runsync"
+	spb "github.com/wandb/wandb/core/pkg/service_go_proto"
+)
+
+// syncStatusInterval is how often to print progress while syncing.
+const syncStatusInterval = 2 * time.Second
+
+type mlflowImportOptions struct {
+	wandbDir    string
+	entity      string
+	project     string
+	experiment  string
+	sync        bool
+	parallelism int
+	logLevel    int
+
+	// storeDir is the MLflow file store to import from.
+	storeDir string
+}
+
+// mlflowImportMain runs the mlflow-import subcommand.
+func mlflowImportMain(args []string) int {
+	var opts mlflowImportOptions
+
+	fs := flag.NewFlagSet("mlflow-import", flag.ContinueOnError)
+	fs.SetOutput(os.Stderr)
+	fs.StringVar(&opts.wandbDir, "wandb-dir", "wandb",
+		"Directory in which to write the imported runs as offline runs.")
+	fs.StringVar(&opts.entity, "entity", "",
+		"W&B entity to import runs into. Defaults to the API key's default entity.")
+	fs.StringVar(&opts.project, "project", "",
+		"W&B project to import runs into. Defaults to each run's experiment name.")
+	fs.StringVar(&opts.experiment, "experiment", "",
+		"Only import the experiment with this name or ID.")
+	fs.BoolVar(&opts.sync, "sync", false,
+		"Upload the imported runs to W&B, using the server and API key"+
+			" from the environment, settings files or netrc, like \"wandb sync\".")
+	fs.IntVar(&opts.parallelism, "parallelism", 4,
+		"Number of runs to upload in parallel with --sync.")
+	fs.IntVar(&opts.logLevel, "log-level", int(slog.LevelWarn),
+		"Specifies the log level to use for logging. -4: debug, 0: info, 4: warn, 8: error.")
+	fs.Usage = func() {
+		fmt.Fprintf(os.Stderr, `wandb-core mlflow-import - Import MLflow runs into W&B
+Converts each run in an MLflow file store into an offline W&B run: params
+become config, metrics become history, tags become tags and notes, and
+artifacts become run files. Use --sync to upload the runs, or upload them
+later with "wandb sync".
+
+Usage:
+  wandb-core mlflow-import [flags] [<mlruns-directory>]
+
+Arguments:
+  <mlruns-directory>  The MLflow file store. Defaults to "mlruns".
+
+Flags:
+`)
+		fs.PrintDefaults()
+	}
+
+	if err := fs.Parse(args); err != nil {
+		if errors.Is(err, flag.ErrHelp) {
+			return exitCodeSuccess
+		}
+		return exitCodeErrorArgs
+	}
+
+	switch fs.NArg() {
+	case 0:
+		opts.storeDir = "mlruns"
+	case 1:
+		opts.storeDir = fs.Arg(0)
+	default:
+		fmt.Fprintln(os.Stderr, "Error: expected at most one MLflow directory")
+		fs.Usage()
+		return exitCodeErrorArgs
+	}
+
+	var creds clisettings.Credentials
+	if opts.sync {
+		var err error
+		if creds, err = clisettings.Resolve(opts.wandbDir); err != nil {
+			fmt.Fprintln(os.Stderr, "Error:", err)
+			return exitCodeErrorArgs
+		}
+	}
+
+	ctx, cancel := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer cancel()
+
+	logger := observability.NewCoreLogger(
+		slog.New(slog.NewTextHandler(
+			os.Stderr,
+			&slog.HandlerOptions{Level: slog.Level(opts.logLevel)},
+		)),
+		nil,
+		analytics.NewTelemetryRecorder(nil, analytics.NewTelemetryContext()),
+	)
+
+	wandbFiles, exitCode := importMLflowRuns(opts, logger)
+	if !opts.sync || len(wandbFiles) == 0 {
+		return exitCode
+	}
+
+	if syncCode := syncImportedRuns(ctx, opts, creds, wandbFiles); syncCode != exitCodeSuccess {
+		return syncCode
+	}
+	return exitCode
+}
+
+// importMLflowRuns converts the runs in the file store into offline runs,
+// returning the paths to their .wandb files.
+func importMLflowRuns(
+	opts mlflowImportOptions,
+	logger *observability.CoreLogger,
+) ([]string, int) {
+	experiments, err := mlflowimport.ReadExperiments(opts.storeDir)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "Error: failed to read MLflow experiments:", err)
+		return nil, exitCodeErrorInternal
+	}
+
+	params := mlflowimport.Params{
+		WandbDir: opts.wandbDir,
+		Entity:   opts.entity,
+		Project:  opts.project,
+		Logger:   logger,
+	}
+
+	var wandbFiles []string
+	exitCode := exitCodeSuccess
+	matched := false
+	for _, experiment := range experiments {
+		if opts.experiment != "" &&
+			opts.experiment != experiment.Name &&
+			opts.experiment != experiment.ID {
+			continue
+		}
+		matched = true
+
+		runs, err := mlflowimport.ReadRuns(experiment)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Failed to read experiment %q: %v\n", experiment.Name, err)
+			exitCode = exitCodeErrorInternal
+			continue
+		}
+
+		for _, run := range runs {
+			result, err := mlflowimport.ImportRun(run, params)
+			switch {
+			case errors.Is(err, os.ErrExist):
+				fmt.Printf("Skipped run %s of %q: already imported\n", run.ID, experiment.Name)
+			case err != nil:
+				fmt.Fprintf(os.Stderr, "Failed to import run %s of %q: %v\n",
+					run.ID, experiment.Name, err)
+				exitCode = exitCodeErrorInternal
+			default:
+				fmt.Printf("Imported run %s of %q to %s (%d steps, %d files)\n",
+					run.ID, experiment.Name, result.RunDir, result.Steps, result.Files)
+				wandbFiles = append(wandbFiles, result.WandbFile)
+			}
+		}
+	}
+
+	if opts.experiment != "" && !matched {
+		fmt.Fprintf(os.Stderr, "Error: no experiment named %q\n", opts.experiment)
+		exitCode = exitCodeErrorArgs
+	}
+
+	return wandbFiles, exitCode
+}
+
+// syncImportedRuns uploads offline runs, printing their progress.
+func syncImportedRuns(
+	ctx context.Context,
+	opts mlflowImportOptions,
+	creds clisettings.Credentials,
+	wandbFiles []string,
+) int {
+	wandbDir, err := filepath.Abs(opts.wandbDir)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "Error:", err)
+		return exitCodeErrorInternal
+	}
+	cwd, _ := os.Getwd()
+
+	settings := creds.Settings()
+	settings.WandbDir = wrapperspb.String(wandbDir)
+
+	manager := runsync.NewRunSyncManager()
+	id := manager.InitSync(&spb.ServerInitSyncRequest{
+		Path:       wandbFiles,
+		Cwd:        cwd,
+		Settings:   settings,
+		NewEntity:  opts.entity,
+		NewProject: opts.project,
+	}).Id
+
+	done := make(chan *spb.ServerSyncResponse, 1)
+	go func() {
+		done <- manager.DoSync(ctx, &spb.ServerSyncRequest{
+			Id:          id,
+			Parallelism: uint32(opts.parallelism),
+		})
+	}()
+
+	ticker := time.NewTicker(syncStatusInterval)
+	defer ticker.Stop()
+
+	exitCode := exitCodeSuccess
+	printMessages := func(messages []*spb.ServerSyncMessage) {
+		for _, message := range messages {
+			if message.Severity >= spb.ServerSyncMessage_SEVERITY_ERROR {
+				exitCode = exitCodeErrorInternal
+				fmt.Fprintln(os.Stderr, message.Content)
+			} else {
+				fmt.Println(message.Content)
+			}
+		}
+	}
+
+	for {
+		select {
+		case response := <-done:
+			printMessages(response.Messages)
+			return exitCode
+
+		case <-ticker.C:
+			status := manager.SyncStatus(&spb.ServerSyncStatusRequest{Id: id})
+			printMessages(status.NewMessages)
+			for _, stats := range status.Stats {
+				for _, operation := range stats.Operations {
+					fmt.Printf("%s: (%.0fs) %s %s\n",
+						stats.Label,
+						operation.RuntimeSeconds,
+						operation.Desc,
+						operation.Progress)
+				}
+			}
+		}
+	}
+}
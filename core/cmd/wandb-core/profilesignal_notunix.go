@@ -0,0 +1,14 @@
+//go:build !unix
+
+package main
+
+import "os"
+
+// profileSignals are the signals that make wandb-core capture a
+// performance profile of every active run.
+//
+// There are none on this platform; profiles can only be requested by
+// the SDK.
+func profileSignals() []os.Signal {
+	return nil
+}
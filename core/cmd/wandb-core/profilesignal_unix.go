@@ -0,0 +1,14 @@
+//go:build unix
+
+package main
+
+import (
+	"os"
+	"syscall"
+)
+
+// profileSignals are the signals that make wandb-core capture a
+// performance profile of every active run.
+func profileSignals() []os.Signal {
+	return []os.Signal{syscall.SIGUSR1}
+}
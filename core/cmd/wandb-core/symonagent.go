@@ -0,0 +1,94 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"log/slog"
+	"net"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"github.com/wandb/wandb/core/internal/analytics"
+	"github.com/wandb/wandb/core/internal/leet"
+	"github.com/wandb/wandb/core/internal/observability"
+)
+
+// symonAgentMain runs the symon-agent subcommand.
+func symonAgentMain(args []string) int {
+	var addr string
+	var interval = leet.DefaultSymonSamplingInterval
+	var logLevel int
+
+	fs := flag.NewFlagSet("symon-agent", flag.ContinueOnError)
+	fs.SetOutput(os.Stderr)
+	fs.StringVar(&addr, "listen", leet.DefaultSymonAgentAddr,
+		"Address to serve samples on. Use e.g. :7734 to accept connections"+
+			" from other machines; connections are not authenticated.")
+	fs.DurationVar(&interval, "interval", interval,
+		"Sampling interval (e.g. 500ms, 2s, 1m).")
+	fs.IntVar(&logLevel, "log-level", int(slog.LevelWarn),
+		"Specifies the log level to use for logging. -4: debug, 0: info, 4: warn, 8: error.")
+	fs.Usage = func() {
+		fmt.Fprintf(os.Stderr, `wandb-core symon-agent - Serve system metrics to multi-host symon
+Samples this machine's system metrics like "leet --symon" and serves them
+over HTTP, so that a dashboard started with
+"wandb-core leet --symon --hosts node0:7734,node1:7734" can chart several
+machines together. No W&B server is needed.
+
+Usage:
+  wandb-core symon-agent [flags]
+
+Flags:
+`)
+		fs.PrintDefaults()
+	}
+
+	if err := fs.Parse(args); err != nil {
+		if errors.Is(err, flag.ErrHelp) {
+			return exitCodeSuccess
+		}
+		return exitCodeErrorArgs
+	}
+	if fs.NArg() != 0 {
+		fmt.Fprintln(os.Stderr, "Error: unexpected arguments")
+		fs.Usage()
+		return exitCodeErrorArgs
+	}
+	if interval <= 0 {
+		fmt.Fprintln(os.Stderr, "Error: --interval must be > 0")
+		return exitCodeErrorArgs
+	}
+
+	logger := observability.NewCoreLogger(
+		slog.New(slog.NewTextHandler(
+			os.Stderr,
+			&slog.HandlerOptions{Level: slog.Level(logLevel)},
+		)),
+		nil,
+		analytics.NewTelemetryRecorder(nil, analytics.NewTelemetryContext()),
+	)
+
+	listener, err := net.Listen("tcp", addr)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "Error:", err)
+		return exitCodeErrorInternal
+	}
+	fmt.Fprintf(os.Stderr, "Serving system metrics on %s\n", listener.Addr())
+
+	ctx, cancel := signal.NotifyContext(context.Background(),
+		os.Interrupt, syscall.SIGTERM)
+	defer cancel()
+
+	agent := leet.NewSymonAgent(leet.SymonAgentParams{
+		Interval: interval,
+		Logger:   logger,
+	})
+	if err := agent.Serve(ctx, listener); err != nil {
+		fmt.Fprintln(os.Stderr, "Error:", err)
+		return exitCodeErrorInternal
+	}
+	return exitCodeSuccess
+}
@@ -0,0 +1,262 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"log/slog"
+	"os"
+	"os/signal"
+	"path/filepath"
+
+	"github.com/wandb/simplejsonext"
+
+	"github.com/wandb/wandb/core/internal/analytics"
+	"github.com/wandb/wandb/core/internal/gql"
+	"github.com/wandb/wandb/core/internal/observability"
+	"github.com/wandb/wandb/core/internal/remoterun"
+	"github.com/wandb/wandb/core/internal/runhistoryreader"
+	"github.com/wandb/wandb/core/internal/runhistoryreader/parquet/ffi"
+	"github.com/wandb/wandb/core/internal/tensorboardexport"
+)
+
+type tensorboardExportOptions struct {
+	logDir    string
+	logLevel  int
+	remoteURL string
+
+	// sources are the .wandb files and run directories to export.
+	sources []string
+}
+
+// tensorboardExportMain runs the tensorboard-export subcommand.
+func tensorboardExportMain(args []string) int {
+	var opts tensorboardExportOptions
+
+	fs := flag.NewFlagSet("tensorboard-export", flag.ContinueOnError)
+	fs.SetOutput(os.Stderr)
+	fs.StringVar(&opts.logDir, "logdir", "tensorboard",
+		"TensorBoard log directory to write to. Each run is written to a"+
+			" subdirectory named after the run.")
+	fs.IntVar(&opts.logLevel, "log-level", int(slog.LevelWarn),
+		"Specifies the log level to use for logging. -4: debug, 0: info, 4: warn, 8: error.")
+	fs.StringVar(&opts.remoteURL, "remote-url", "",
+		"URL of a W&B run whose history to export"+
+			" (e.g. https://api.wandb.ai/<entity>/<project>/runs/<run-id>)."+
+			" Requires WANDB_API_KEY.")
+	fs.Usage = func() {
+		fmt.Fprintf(os.Stderr, `wandb-core tensorboard-export - Export W&B runs to TensorBoard
+Writes tfevents files with each run's scalars, histograms and images,
+and its config and notes as text.
+
+Usage:
+  wandb-core tensorboard-export [flags] <run-file-or-directory>...
+  wandb-core tensorboard-export [flags] --remote-url <wandb-run-url>
+
+Arguments:
+  <run-file-or-directory>  A .wandb file, or a run directory containing one.
+
+Flags:
+`)
+		fs.PrintDefaults()
+	}
+
+	if err := fs.Parse(args); err != nil {
+		if errors.Is(err, flag.ErrHelp) {
+			return exitCodeSuccess
+		}
+		return exitCodeErrorArgs
+	}
+	opts.sources = fs.Args()
+
+	if len(opts.sources) == 0 && opts.remoteURL == "" {
+		fmt.Fprintln(os.Stderr, "Error: a run file, run directory or --remote-url is required")
+		fs.Usage()
+		return exitCodeErrorArgs
+	}
+
+	var remote *remoterun.Params
+	if opts.remoteURL != "" {
+		var err error
+		if remote, err = remoterun.ParseURL(opts.remoteURL); err != nil {
+			fmt.Fprintln(os.Stderr, "Error:", err)
+			return exitCodeErrorArgs
+		}
+	}
+
+	ctx, cancel := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer cancel()
+
+	logger := observability.NewCoreLogger(
+		slog.New(slog.NewTextHandler(
+			os.Stderr,
+			&slog.HandlerOptions{Level: slog.Level(opts.logLevel)},
+		)),
+		nil,
+		analytics.NewTelemetryRecorder(nil, analytics.NewTelemetryContext()),
+	)
+	hostname, err := os.Hostname()
+	if err != nil {
+		hostname = "localhost"
+	}
+	params := tensorboardexport.Params{
+		LogDirs:  tensorboardexport.NewLogDirs(opts.logDir),
+		Hostname: hostname,
+		Logger:   logger,
+	}
+
+	exitCode := exitCodeSuccess
+	report := func(source string, result *tensorboardexport.Result, err error) {
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Failed to export %s: %v\n", source, err)
+			exitCode = exitCodeErrorInternal
+			return
+		}
+
+		fmt.Printf("Exported %s to %s (%d steps)\n", source, result.Dir, result.Steps)
+		if result.SkippedMedia > 0 {
+			fmt.Fprintf(os.Stderr,
+				"Skipped %d media values that couldn't be read\n", result.SkippedMedia)
+		}
+	}
+
+	for _, source := range opts.sources {
+		path, err := findTransactionLog(source)
+		if err != nil {
+			report(source, nil, err)
+			continue
+		}
+
+		result, err := tensorboardexport.ExportTransactionLog(path, params)
+		report(source, result, err)
+	}
+
+	if remote != nil {
+		result, err := exportRemoteRun(ctx, remote, params, logger)
+		report(opts.remoteURL, result, err)
+	}
+
+	return exitCode
+}
+
+// findTransactionLog returns the .wandb file for a run file or directory.
+func findTransactionLog(source string) (string, error) {
+	info, err := os.Stat(source)
+	if err != nil {
+		return "", err
+	}
+	if !info.IsDir() {
+		return source, nil
+	}
+
+	matches, err := filepath.Glob(filepath.Join(source, "*.wandb"))
+	switch {
+	case err != nil:
+		return "", err
+	case len(matches) == 0:
+		return "", fmt.Errorf("no .wandb file in %s", source)
+	case len(matches) > 1:
+		return "", fmt.Errorf("multiple .wandb files in %s", source)
+	default:
+		return matches[0], nil
+	}
+}
+
+// exportRemoteRun exports a run's history, config and notes from
+// the W&B backend.
+func exportRemoteRun(
+	ctx context.Context,
+	remote *remoterun.Params,
+	params tensorboardexport.Params,
+	logger *observability.CoreLogger,
+) (*tensorboardexport.Result, error) {
+	clients, err := remoterun.NewClients(remote, logger)
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := gql.RunResumeStatus(
+		ctx, clients.GraphQL, &remote.Project, &remote.Entity, remote.RunID)
+	if err != nil {
+		return nil, err
+	}
+	data := response.GetModel().GetBucket()
+	if data == nil {
+		return nil, fmt.Errorf("run %s/%s/%s not found",
+			remote.Entity, remote.Project, remote.RunID)
+	}
+
+	run := tensorboardexport.RemoteRun{ID: remote.RunID, LastStep: -1}
+	if name := data.GetDisplayName(); name != nil {
+		run.DisplayName = *name
+	}
+	if notes := data.GetNotes(); notes != nil {
+		run.Notes = *notes
+	}
+	if configJSON := data.GetConfig(); configJSON != nil {
+		run.Config = remoteConfig(*configJSON, logger)
+	}
+	if summaryJSON := data.GetSummaryMetrics(); summaryJSON != nil {
+		summary, err := simplejsonext.UnmarshalObjectString(*summaryJSON)
+		if err == nil {
+			switch step := summary["_step"].(type) {
+			case int64:
+				run.LastStep = step
+			case float64:
+				run.LastStep = int64(step)
+			}
+		}
+	}
+
+	rustArrowWrapper, err := ffi.NewRustArrowWrapper()
+	if err != nil {
+		return nil, err
+	}
+
+	reader, err := runhistoryreader.New(
+		ctx,
+		remote.Entity,
+		remote.Project,
+		remote.RunID,
+		clients.GraphQL,
+		clients.HTTP,
+		[]string{}, // keys
+		false,      // useCache
+		rustArrowWrapper,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer reader.Release()
+
+	return tensorboardexport.ExportRemoteHistory(ctx, reader, run, params)
+}
+
+// remoteConfig unwraps a run config returned by the W&B backend, in which
+// each value is stored under a "value" key.
+//
+// Returns nil if the config can't be parsed.
+func remoteConfig(
+	configJSON string,
+	logger *observability.CoreLogger,
+) map[string]any {
+	config, err := simplejsonext.UnmarshalObjectString(configJSON)
+	if err != nil {
+		logger.Warn(
+			"tensorboard-export: failed to parse run config",
+			"error", err)
+		return nil
+	}
+
+	values := make(map[string]any, len(config))
+	for key, item := range config {
+		if item, ok := item.(map[string]any); ok {
+			if value, ok := item["value"]; ok {
+				values[key] = value
+			}
+		}
+	}
+
+	return values
+}
@@ -113,6 +113,10 @@ type ClientOptions struct {
 	// PreRetryLayers specifies additional functionality to the HTTP client
 	// that runs on every retry.
 	PreRetryLayers httplayers.HTTPWrapper
+
+	// OnRetry, if not nil, is called whenever a failed request is about
+	// to be retried.
+	OnRetry func()
 }
 
 // NewClient creates a new [RetryableClient].
@@ -139,6 +143,7 @@ func NewClient(opts ClientOptions) RetryableClient {
 	retryableHTTP.CheckRetry = withRetryObservation(
 		opts.RetryPolicy,
 		opts.Logger,
+		opts.OnRetry,
 	)
 
 	// Let the client log debug messages.
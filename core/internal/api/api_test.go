@@ -92,3 +92,31 @@ func TestRetryTimeout(t *testing.T) {
 	assert.ErrorIs(t, retryError.Inner, context.DeadlineExceeded)
 	assert.Equal(t, "HTTP 500: test error", retryError.LastStatus)
 }
+
+func TestOnRetry(t *testing.T) {
+	attempts := 0
+	server := apitest.NewRecordingServer(
+		apitest.WithHandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			attempts++
+			if attempts < 3 {
+				w.WriteHeader(http.StatusInternalServerError)
+			}
+		}),
+	)
+	defer server.Close()
+	retries := 0
+	client := api.NewClient(api.ClientOptions{
+		RetryMax:     5,
+		RetryWaitMin: time.Millisecond,
+		RetryWaitMax: time.Millisecond,
+		OnRetry:      func() { retries++ },
+	})
+	request, err := retryablehttp.NewRequest(http.MethodGet, server.URL, http.NoBody)
+	require.NoError(t, err)
+
+	response, err := client.Do(request)
+
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, response.StatusCode)
+	assert.Equal(t, 2, retries)
+}
@@ -22,6 +22,7 @@ func NewGQLClient(
 	peeker Peeker,
 	s *settings.Settings,
 	extraHeaders http.Header,
+	extraLayers httplayers.HTTPWrapper,
 ) graphql.Client {
 	// TODO: This is used for the service account feature to associate the run
 	// with the specified user. Note that we are using environment variables
@@ -50,6 +51,7 @@ func NewGQLClient(
 		InsecureDisableSSL: s.IsInsecureDisableSSL(),
 		Logger:             logger,
 		PreRetryLayers: httplayers.Concat(
+			extraLayers,
 			NetworkPeeker(peeker),
 			httplayers.DefaultHeaders(graphqlHeaders),
 			httplayers.LimitTo(baseURL, credentialProvider),
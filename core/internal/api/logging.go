@@ -35,10 +35,12 @@ func (client *clientImpl) logFinalResponseOnError(
 // withRetryObservation wraps a retry policy to log retries.
 //
 // The resulting CheckRetry function always invokes the given policy
-// and acts based on its outcome.
+// and acts based on its outcome. If onRetry is not nil, it is called
+// before each retry.
 func withRetryObservation(
 	policy retryablehttp.CheckRetry,
 	logger *slog.Logger,
+	onRetry func(),
 ) retryablehttp.CheckRetry {
 	if policy == nil {
 		panic("api: withRetryLogging: nil policy")
@@ -57,6 +59,10 @@ func withRetryObservation(
 			return false, err
 		}
 
+		if onRetry != nil {
+			onRetry()
+		}
+
 		switch {
 		case resp == nil && err == nil:
 			logger.Error("api: retrying HTTP request, no error or response")
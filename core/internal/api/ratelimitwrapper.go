@@ -6,6 +6,7 @@ import (
 
 	"golang.org/x/time/rate"
 
+	"github.com/wandb/wandb/core/internal/coremetrics"
 	"github.com/wandb/wandb/core/internal/httplayers"
 )
 
@@ -16,12 +17,18 @@ type rateLimitWrapper struct {
 
 	// rlTracker optimizes the rate limit based on response headers.
 	rlTracker *RateLimitTracker
+
+	// waitTimer measures how long requests are held back by the rate limit.
+	waitTimer *coremetrics.Timer
 }
 
 // ResponseBasedRateLimiter rate limits outgoing requests based on RateLimit
 // headers in responses.
-func ResponseBasedRateLimiter() httplayers.HTTPWrapper {
-	return rateLimitWrapper{
+//
+// The current rate limit and the time requests wait for it are reported
+// to the registry, which may be nil.
+func ResponseBasedRateLimiter(metrics *coremetrics.Registry) httplayers.HTTPWrapper {
+	wrapper := rateLimitWrapper{
 		rateLimiter: rate.NewLimiter(maxRequestsPerSecond, maxBurst),
 		rlTracker: NewRateLimitTracker(RateLimitTrackerParams{
 			MinPerSecond: minRequestsPerSecond,
@@ -31,7 +38,14 @@ func ResponseBasedRateLimiter() httplayers.HTTPWrapper {
 			Smoothing:              0.2,
 			MinRequestsForEstimate: 5,
 		}),
+		waitTimer: metrics.Timer("rate_limit.wait"),
 	}
+
+	metrics.Gauge("rate_limit.requests_per_sec", func() float64 {
+		return float64(wrapper.rateLimiter.Limit())
+	})
+
+	return wrapper
 }
 
 // WrapHTTP implements HTTPWrapper.WrapHTTP.
@@ -39,12 +53,14 @@ func (transport rateLimitWrapper) WrapHTTP(
 	send httplayers.HTTPDoFunc,
 ) httplayers.HTTPDoFunc {
 	return func(req *http.Request) (*http.Response, error) {
+		waitStart := time.Now()
 		if err := transport.rateLimiter.Wait(req.Context()); err != nil {
 			// Errors happen if:
 			//   - The request is canceled
 			//   - The rate limit exceeds the request deadline
 			return nil, httplayers.URLError(req, err)
 		}
+		transport.waitTimer.Observe(time.Since(waitStart))
 
 		transport.rlTracker.TrackRequest()
 		resp, err := send(req)
@@ -0,0 +1,336 @@
+// Package circuitbreaker detects when the W&B server has been unreachable
+// for a long time so that a run can switch to offline mode.
+//
+// A Breaker is shared by the HTTP clients of a run. It observes the outcome
+// of their requests and opens once they've failed for long enough without
+// any success in between. While open, the run writes only its transaction
+// log, and the breaker periodically probes the server. The breaker closes
+// as soon as a probe or any other request succeeds.
+package circuitbreaker
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/wandb/wandb/core/internal/httplayers"
+	"github.com/wandb/wandb/core/internal/observability"
+	"github.com/wandb/wandb/core/internal/wboperation"
+)
+
+// DefaultProbeInterval is how often the server is probed by default while
+// the breaker is open.
+const DefaultProbeInterval = 30 * time.Second
+
+// Params are used to create a Breaker.
+type Params struct {
+	// OpenAfter is how long requests must fail without any success
+	// for the breaker to open.
+	//
+	// If not positive, New returns nil.
+	OpenAfter time.Duration
+
+	// ProbeInterval is how long to wait between probes while open.
+	//
+	// Defaults to DefaultProbeInterval.
+	ProbeInterval time.Duration
+
+	// Probe checks whether the server can be reached.
+	//
+	// It returns nil if so. If nil, the breaker only closes
+	// when a request made through its Layer succeeds.
+	Probe func(ctx context.Context) error
+
+	Logger     *observability.CoreLogger
+	Operations *wboperation.WandbOperations
+	Printer    *observability.Printer
+
+	// GetNow returns the current time.
+	//
+	// Defaults to time.Now.
+	GetNow func() time.Time
+}
+
+// Breaker tracks whether the W&B server is reachable.
+//
+// A nil Breaker is valid and never opens.
+type Breaker struct {
+	openAfter     time.Duration
+	probeInterval time.Duration
+	probe         func(ctx context.Context) error
+	logger        *observability.CoreLogger
+	operations    *wboperation.WandbOperations
+	printer       *observability.Printer
+	getNow        func() time.Time
+
+	mu sync.Mutex
+
+	// failingSince is when requests started failing, or the zero time
+	// if the last request succeeded.
+	failingSince time.Time
+
+	// closedCh is closed while the breaker is closed, and replaced
+	// with an open channel when the breaker opens.
+	closedCh chan struct{}
+
+	// isOpen is whether the breaker is open.
+	isOpen bool
+
+	// isStopped is whether Close was called.
+	isStopped bool
+
+	// operation shows that the run is offline while the breaker is open.
+	operation *wboperation.WandbOperation
+
+	// cancelProbing stops the probe goroutine while the breaker is open.
+	cancelProbing context.CancelFunc
+
+	wg sync.WaitGroup
+}
+
+// New returns a Breaker for the given parameters.
+//
+// It returns nil if params.OpenAfter is not positive.
+func New(params Params) *Breaker {
+	if params.OpenAfter <= 0 {
+		return nil
+	}
+
+	closedCh := make(chan struct{})
+	close(closedCh)
+
+	b := &Breaker{
+		openAfter:     params.OpenAfter,
+		probeInterval: params.ProbeInterval,
+		probe:         params.Probe,
+		logger:        params.Logger,
+		operations:    params.Operations,
+		printer:       params.Printer,
+		getNow:        params.GetNow,
+		closedCh:      closedCh,
+	}
+
+	if b.probeInterval <= 0 {
+		b.probeInterval = DefaultProbeInterval
+	}
+	if b.getNow == nil {
+		b.getNow = time.Now
+	}
+
+	return b
+}
+
+// IsOpen reports whether the server is considered unreachable.
+func (b *Breaker) IsOpen() bool {
+	if b == nil {
+		return false
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.isOpen
+}
+
+// WaitClosed blocks until the breaker is closed or the context is done.
+//
+// It returns the context's error if the context finishes first.
+func (b *Breaker) WaitClosed(ctx context.Context) error {
+	if b == nil {
+		return nil
+	}
+
+	b.mu.Lock()
+	if !b.isOpen {
+		b.mu.Unlock()
+		return nil
+	}
+	closedCh := b.closedCh
+	b.mu.Unlock()
+
+	select {
+	case <-closedCh:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// RecordSuccess notes that the server was reached.
+//
+// It closes the breaker if it was open.
+func (b *Breaker) RecordSuccess() {
+	if b == nil {
+		return
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.failingSince = time.Time{}
+
+	if !b.isOpen {
+		return
+	}
+
+	b.isOpen = false
+	close(b.closedCh)
+	b.cancelProbing()
+	b.operation.Finish()
+	b.operation = nil
+
+	b.logger.Info("circuitbreaker: closed")
+	b.printer.Infof(
+		"Reconnected to W&B; uploading data saved while offline.")
+}
+
+// RecordFailure notes that a request failed because the server
+// couldn't be reached.
+//
+// It opens the breaker if requests have been failing for long enough.
+func (b *Breaker) RecordFailure(err error) {
+	if b == nil {
+		return
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	now := b.getNow()
+
+	if b.failingSince.IsZero() {
+		b.failingSince = now
+	}
+
+	if b.isOpen || b.isStopped || now.Sub(b.failingSince) < b.openAfter {
+		return
+	}
+
+	b.isOpen = true
+	b.closedCh = make(chan struct{})
+
+	b.logger.Warn(
+		"circuitbreaker: opened",
+		"failingSince", b.failingSince,
+		"error", err,
+	)
+	b.printer.Warnf(
+		"Unable to reach W&B for %v; switching to offline mode."+
+			" Data is saved locally and will be uploaded once W&B"+
+			" can be reached again.",
+		now.Sub(b.failingSince).Round(time.Second),
+	)
+
+	b.operation = b.operations.New("offline: waiting for W&B to be reachable")
+	b.operation.MarkRetryingError(err)
+
+	probeCtx, cancelProbing := context.WithCancel(context.Background())
+	b.cancelProbing = cancelProbing
+	b.wg.Add(1)
+	go b.probePeriodically(probeCtx, b.operation)
+}
+
+// Close stops probing the server.
+//
+// The breaker stays in its current state and never opens after this.
+func (b *Breaker) Close() {
+	if b == nil {
+		return
+	}
+
+	b.mu.Lock()
+	b.isStopped = true
+	if b.cancelProbing != nil {
+		b.cancelProbing()
+	}
+	b.mu.Unlock()
+
+	b.wg.Wait()
+}
+
+// probePeriodically probes the server until the context is cancelled.
+func (b *Breaker) probePeriodically(
+	ctx context.Context,
+	operation *wboperation.WandbOperation,
+) {
+	defer b.wg.Done()
+
+	if b.probe == nil {
+		return
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(b.probeInterval):
+		}
+
+		err := b.probe(ctx)
+
+		switch {
+		case ctx.Err() != nil:
+			return
+		case err != nil:
+			b.logger.Info("circuitbreaker: probe failed", "error", err)
+			operation.MarkRetryingError(err)
+		default:
+			b.RecordSuccess()
+		}
+	}
+}
+
+// Layer returns an HTTP layer that reports the outcome of requests
+// to the breaker.
+//
+// It doesn't modify requests or responses. Transport errors and
+// 502, 503 and 504 responses count as failures; other responses
+// count as successes. Errors caused by a cancelled request context
+// are ignored.
+//
+// It returns nil if the breaker is nil.
+func (b *Breaker) Layer() httplayers.HTTPWrapper {
+	if b == nil {
+		return nil
+	}
+
+	return breakerLayer{b}
+}
+
+type breakerLayer struct {
+	breaker *Breaker
+}
+
+// WrapHTTP implements HTTPWrapper.WrapHTTP.
+func (l breakerLayer) WrapHTTP(send httplayers.HTTPDoFunc) httplayers.HTTPDoFunc {
+	return func(req *http.Request) (*http.Response, error) {
+		resp, err := send(req)
+
+		switch {
+		case req.Context().Err() != nil:
+		case err != nil && resp == nil:
+			l.breaker.RecordFailure(err)
+		case isGatewayFailure(resp.StatusCode):
+			l.breaker.RecordFailure(
+				fmt.Errorf("circuitbreaker: got status %s", resp.Status))
+		default:
+			l.breaker.RecordSuccess()
+		}
+
+		return resp, err
+	}
+}
+
+// isGatewayFailure reports whether a status code indicates that the
+// server is unavailable rather than that it rejected the request.
+func isGatewayFailure(statusCode int) bool {
+	switch statusCode {
+	case http.StatusBadGateway,
+		http.StatusServiceUnavailable,
+		http.StatusGatewayTimeout:
+		return true
+	default:
+		return false
+	}
+}
@@ -0,0 +1,178 @@
+package circuitbreaker_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/wandb/wandb/core/internal/circuitbreaker"
+	"github.com/wandb/wandb/core/internal/observability"
+	"github.com/wandb/wandb/core/internal/observabilitytest"
+	"github.com/wandb/wandb/core/internal/wboperation"
+)
+
+var errUnreachable = errors.New("dial tcp: connection refused")
+
+type testFixture struct {
+	Breaker    *circuitbreaker.Breaker
+	Operations *wboperation.WandbOperations
+	Printer    *observability.Printer
+
+	// Now is the time returned to the breaker.
+	Now *time.Time
+}
+
+func setup(
+	t *testing.T,
+	probe func(ctx context.Context) error,
+) testFixture {
+	t.Helper()
+
+	now := time.Unix(1000, 0)
+	operations := wboperation.NewOperations()
+	printer := observability.NewPrinter(16)
+	breaker := circuitbreaker.New(circuitbreaker.Params{
+		OpenAfter:     time.Minute,
+		ProbeInterval: time.Millisecond,
+		Probe:         probe,
+		Logger:        observabilitytest.NewTestLogger(t),
+		Operations:    operations,
+		Printer:       printer,
+		GetNow:        func() time.Time { return now },
+	})
+	t.Cleanup(breaker.Close)
+
+	return testFixture{
+		Breaker:    breaker,
+		Operations: operations,
+		Printer:    printer,
+		Now:        &now,
+	}
+}
+
+func neverReachable(ctx context.Context) error {
+	return errUnreachable
+}
+
+func TestNew_NilIfDisabled(t *testing.T) {
+	breaker := circuitbreaker.New(circuitbreaker.Params{OpenAfter: 0})
+
+	assert.Nil(t, breaker)
+	assert.False(t, breaker.IsOpen())
+	assert.NoError(t, breaker.WaitClosed(context.Background()))
+	assert.Nil(t, breaker.Layer())
+	breaker.RecordFailure(errUnreachable)
+	breaker.Close()
+}
+
+func TestOpensAfterFailingForLongEnough(t *testing.T) {
+	x := setup(t, neverReachable)
+
+	x.Breaker.RecordFailure(errUnreachable)
+	*x.Now = x.Now.Add(59 * time.Second)
+	x.Breaker.RecordFailure(errUnreachable)
+	assert.False(t, x.Breaker.IsOpen())
+
+	*x.Now = x.Now.Add(time.Second)
+	x.Breaker.RecordFailure(errUnreachable)
+
+	assert.True(t, x.Breaker.IsOpen())
+	messages := x.Printer.Read()
+	require.Len(t, messages, 1)
+	assert.Contains(t, messages[0].Content, "switching to offline mode")
+	stats := x.Operations.ToProto()
+	require.Len(t, stats.Operations, 1)
+	assert.Equal(t,
+		"offline: waiting for W&B to be reachable",
+		stats.Operations[0].Desc)
+}
+
+func TestSuccessResetsFailureClock(t *testing.T) {
+	x := setup(t, neverReachable)
+
+	x.Breaker.RecordFailure(errUnreachable)
+	*x.Now = x.Now.Add(50 * time.Second)
+	x.Breaker.RecordSuccess()
+	x.Breaker.RecordFailure(errUnreachable)
+	*x.Now = x.Now.Add(50 * time.Second)
+	x.Breaker.RecordFailure(errUnreachable)
+
+	assert.False(t, x.Breaker.IsOpen())
+}
+
+func TestSuccessfulProbe_Closes(t *testing.T) {
+	reachable := make(chan struct{})
+	x := setup(t, func(ctx context.Context) error {
+		select {
+		case <-reachable:
+			return nil
+		default:
+			return errUnreachable
+		}
+	})
+	x.Breaker.RecordFailure(errUnreachable)
+	*x.Now = x.Now.Add(time.Minute)
+	x.Breaker.RecordFailure(errUnreachable)
+	require.True(t, x.Breaker.IsOpen())
+	_ = x.Printer.Read()
+
+	close(reachable)
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	require.NoError(t, x.Breaker.WaitClosed(ctx))
+	assert.False(t, x.Breaker.IsOpen())
+	assert.Empty(t, x.Operations.ToProto().Operations)
+	messages := x.Printer.Read()
+	require.Len(t, messages, 1)
+	assert.Contains(t, messages[0].Content, "Reconnected")
+}
+
+func TestWaitClosed_ReturnsOnCancel(t *testing.T) {
+	x := setup(t, neverReachable)
+	x.Breaker.RecordFailure(errUnreachable)
+	*x.Now = x.Now.Add(time.Minute)
+	x.Breaker.RecordFailure(errUnreachable)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	assert.ErrorIs(t, x.Breaker.WaitClosed(ctx), context.Canceled)
+}
+
+func TestLayer(t *testing.T) {
+	testCases := []struct {
+		name     string
+		resp     *http.Response
+		err      error
+		wantOpen bool
+	}{
+		{"transport error", nil, errUnreachable, true},
+		{"service unavailable", &http.Response{StatusCode: 503}, nil, true},
+		{"bad request", &http.Response{StatusCode: 400}, nil, false},
+		{"ok", &http.Response{StatusCode: 200}, nil, false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			x := setup(t, neverReachable)
+			x.Breaker.RecordFailure(errUnreachable)
+			*x.Now = x.Now.Add(time.Minute)
+
+			send := x.Breaker.Layer().WrapHTTP(
+				func(req *http.Request) (*http.Response, error) {
+					return tc.resp, tc.err
+				})
+			req, err := http.NewRequest(http.MethodGet, "https://x", http.NoBody)
+			require.NoError(t, err)
+			_, _ = send(req)
+
+			assert.Equal(t, tc.wantOpen, x.Breaker.IsOpen())
+		})
+	}
+}
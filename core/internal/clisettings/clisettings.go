@@ -0,0 +1,268 @@
+// Package clisettings resolves the W&B server and API key for wandb-core
+// subcommands that run without the Python SDK.
+//
+// Settings are found in the same places as in the SDK, from lowest to
+// highest priority: the global settings file, the workspace settings file,
+// and environment variables. The API key falls back to the netrc file.
+package clisettings
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"net/url"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+
+	"google.golang.org/protobuf/types/known/wrapperspb"
+
+	spb "github.com/wandb/wandb/core/pkg/service_go_proto"
+)
+
+// DefaultBaseURL is the base URL used when none is configured.
+const DefaultBaseURL = "https://api.wandb.ai"
+
+// settingsSection is the only section read from settings files.
+const settingsSection = "default"
+
+// Credentials are the W&B server to connect to and its API key.
+type Credentials struct {
+	BaseURL string
+	APIKey  string
+}
+
+// Settings returns settings for connecting to the server.
+func (c Credentials) Settings() *spb.Settings {
+	return &spb.Settings{
+		ApiKey:  wrapperspb.String(c.APIKey),
+		BaseUrl: wrapperspb.String(c.BaseURL),
+	}
+}
+
+// Resolve finds the base URL and API key.
+//
+// wandbDir is the workspace's wandb directory, which may contain a
+// "settings" file.
+func Resolve(wandbDir string) (Credentials, error) {
+	values := make(map[string]string)
+
+	globalPath, err := globalSettingsPath()
+	if err != nil {
+		return Credentials{}, err
+	}
+	for _, path := range []string{globalPath, filepath.Join(wandbDir, "settings")} {
+		fileValues, err := readSettingsFile(path)
+		if err != nil {
+			return Credentials{}, err
+		}
+		for key, value := range fileValues {
+			values[key] = value
+		}
+	}
+
+	creds := Credentials{
+		BaseURL: values["base_url"],
+		APIKey:  values["api_key"],
+	}
+	if baseURL := os.Getenv("WANDB_BASE_URL"); baseURL != "" {
+		creds.BaseURL = baseURL
+	}
+	if apiKey := os.Getenv("WANDB_API_KEY"); apiKey != "" {
+		creds.APIKey = apiKey
+	}
+
+	creds.BaseURL = strings.TrimRight(creds.BaseURL, "/")
+	if creds.BaseURL == "" {
+		creds.BaseURL = DefaultBaseURL
+	}
+
+	if creds.APIKey == "" {
+		creds.APIKey, err = readNetrcAPIKey(creds.BaseURL)
+		if err != nil {
+			return Credentials{}, err
+		}
+	}
+
+	if creds.APIKey == "" {
+		return Credentials{}, fmt.Errorf(
+			"no API key for %s: set WANDB_API_KEY or run \"wandb login\"",
+			creds.BaseURL,
+		)
+	}
+
+	return creds, nil
+}
+
+// globalSettingsPath returns the path to the global settings file.
+func globalSettingsPath() (string, error) {
+	if configDir := os.Getenv("WANDB_CONFIG_DIR"); configDir != "" {
+		return filepath.Join(expandHome(configDir), "settings"), nil
+	}
+
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return "", fmt.Errorf("clisettings: failed to find home directory: %v", err)
+	}
+	return filepath.Join(home, ".config", "wandb", "settings"), nil
+}
+
+// readSettingsFile returns the values in the default section of an INI
+// settings file, or nil if the file doesn't exist.
+func readSettingsFile(path string) (map[string]string, error) {
+	file, err := os.Open(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("clisettings: failed to read settings: %v", err)
+	}
+	defer func() { _ = file.Close() }()
+
+	values := make(map[string]string)
+	section := ""
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+
+		switch {
+		case line == "" || line[0] == '#' || line[0] == ';':
+			continue
+		case line[0] == '[' && line[len(line)-1] == ']':
+			section = strings.TrimSpace(line[1 : len(line)-1])
+			continue
+		case section != settingsSection:
+			continue
+		}
+
+		key, value, ok := strings.Cut(line, "=")
+		if !ok {
+			key, value, ok = strings.Cut(line, ":")
+		}
+		if ok {
+			values[strings.ToLower(strings.TrimSpace(key))] = strings.TrimSpace(value)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("clisettings: failed to read settings: %v", err)
+	}
+
+	return values, nil
+}
+
+// readNetrcAPIKey returns the password of the netrc entry for the base URL's
+// host, or an empty string if there is none.
+func readNetrcAPIKey(baseURL string) (string, error) {
+	u, err := url.Parse(baseURL)
+	if err != nil || u.Host == "" {
+		return "", fmt.Errorf("clisettings: invalid base URL %q", baseURL)
+	}
+
+	path, err := netrcPath()
+	if err != nil {
+		return "", err
+	}
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return "", nil
+	}
+	if err != nil {
+		return "", fmt.Errorf("clisettings: failed to read netrc: %v", err)
+	}
+
+	return netrcPassword(string(data), u.Host), nil
+}
+
+// netrcPath returns the path to the netrc file, which may not exist.
+func netrcPath() (string, error) {
+	if path := os.Getenv("NETRC"); path != "" {
+		return expandHome(path), nil
+	}
+
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return "", fmt.Errorf("clisettings: failed to find home directory: %v", err)
+	}
+
+	unixPath := filepath.Join(home, ".netrc")
+	windowsPath := filepath.Join(home, "_netrc")
+	for _, path := range []string{unixPath, windowsPath} {
+		if _, err := os.Stat(path); err == nil {
+			return path, nil
+		}
+	}
+
+	if runtime.GOOS == "windows" {
+		return windowsPath, nil
+	}
+	return unixPath, nil
+}
+
+// netrcPassword returns the password for a machine in a netrc file.
+//
+// Like Python's netrc module, the "default" entry is used if there is
+// no entry for the machine, and later entries override earlier ones.
+func netrcPassword(data, machine string) string {
+	var tokens []string
+	lines := strings.Split(data, "\n")
+	for i := 0; i < len(lines); i++ {
+		fields := strings.Fields(lines[i])
+		if len(fields) > 0 && fields[0] == "macdef" {
+			// Skip the macro body, which ends at a blank line.
+			for i+1 < len(lines) && strings.TrimSpace(lines[i+1]) != "" {
+				i++
+			}
+			continue
+		}
+		tokens = append(tokens, fields...)
+	}
+
+	// current is the machine of the entry being read, and isDefault is
+	// whether it is the default entry instead.
+	var current string
+	var isDefault bool
+	var password, defaultPassword string
+	for i := 0; i < len(tokens); i++ {
+		switch tokens[i] {
+		case "machine":
+			current, isDefault = "", false
+			if i+1 < len(tokens) {
+				current = tokens[i+1]
+				i++
+			}
+		case "default":
+			current, isDefault = "", true
+		case "login", "account":
+			i++
+		case "password":
+			if i+1 >= len(tokens) {
+				break
+			}
+			i++
+			switch {
+			case isDefault:
+				defaultPassword = tokens[i]
+			case current == machine:
+				password = tokens[i]
+			}
+		}
+	}
+
+	if password != "" {
+		return password
+	}
+	return defaultPassword
+}
+
+// expandHome replaces a leading "~" in a path with the home directory.
+func expandHome(path string) string {
+	if path != "~" && !strings.HasPrefix(path, "~/") {
+		return path
+	}
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return path
+	}
+	return filepath.Join(home, path[1:])
+}
@@ -0,0 +1,111 @@
+package clisettings_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/wandb/wandb/core/internal/clisettings"
+)
+
+// setup isolates the test from the user's settings and credentials,
+// returning the global config directory and the workspace wandb directory.
+func setup(t *testing.T) (configDir, wandbDir string) {
+	t.Helper()
+
+	home := t.TempDir()
+	configDir = filepath.Join(home, "config")
+	wandbDir = filepath.Join(home, "workspace", "wandb")
+	require.NoError(t, os.MkdirAll(configDir, 0o755))
+	require.NoError(t, os.MkdirAll(wandbDir, 0o755))
+
+	t.Setenv("HOME", home)
+	t.Setenv("USERPROFILE", home)
+	t.Setenv("WANDB_CONFIG_DIR", configDir)
+	t.Setenv("NETRC", filepath.Join(home, "netrc"))
+	t.Setenv("WANDB_BASE_URL", "")
+	t.Setenv("WANDB_API_KEY", "")
+
+	return configDir, wandbDir
+}
+
+func writeFile(t *testing.T, path, content string) {
+	t.Helper()
+	require.NoError(t, os.WriteFile(path, []byte(content), 0o600))
+}
+
+func TestResolve_Environment(t *testing.T) {
+	configDir, wandbDir := setup(t)
+	writeFile(t, filepath.Join(configDir, "settings"),
+		"[default]\nbase_url = https://global.example.com\napi_key = global-key\n")
+	t.Setenv("WANDB_BASE_URL", "https://env.example.com/")
+	t.Setenv("WANDB_API_KEY", "env-key")
+
+	creds, err := clisettings.Resolve(wandbDir)
+
+	require.NoError(t, err)
+	assert.Equal(t,
+		clisettings.Credentials{BaseURL: "https://env.example.com", APIKey: "env-key"},
+		creds)
+}
+
+func TestResolve_SettingsFiles(t *testing.T) {
+	configDir, wandbDir := setup(t)
+	writeFile(t, filepath.Join(configDir, "settings"),
+		"[default]\nbase_url = https://global.example.com\napi_key = global-key\n")
+	writeFile(t, filepath.Join(wandbDir, "settings"),
+		"[other]\napi_key = ignored\n\n[default]\nbase_url: https://local.example.com\n")
+
+	creds, err := clisettings.Resolve(wandbDir)
+
+	require.NoError(t, err)
+	assert.Equal(t,
+		clisettings.Credentials{BaseURL: "https://local.example.com", APIKey: "global-key"},
+		creds)
+}
+
+func TestResolve_Netrc(t *testing.T) {
+	_, wandbDir := setup(t)
+	t.Setenv("WANDB_BASE_URL", "https://wandb.example.com:8443")
+	writeFile(t, os.Getenv("NETRC"), `
+machine api.wandb.ai login user password saas-key
+macdef init
+  machine wandb.example.com:8443 password not-a-key
+
+machine wandb.example.com:8443
+  login user
+  password local-key
+default login user password default-key
+`)
+
+	creds, err := clisettings.Resolve(wandbDir)
+
+	require.NoError(t, err)
+	assert.Equal(t,
+		clisettings.Credentials{BaseURL: "https://wandb.example.com:8443", APIKey: "local-key"},
+		creds)
+}
+
+func TestResolve_NetrcDefaultEntry(t *testing.T) {
+	_, wandbDir := setup(t)
+	writeFile(t, os.Getenv("NETRC"),
+		"machine other.example.com password other-key\ndefault password default-key\n")
+
+	creds, err := clisettings.Resolve(wandbDir)
+
+	require.NoError(t, err)
+	assert.Equal(t,
+		clisettings.Credentials{BaseURL: clisettings.DefaultBaseURL, APIKey: "default-key"},
+		creds)
+}
+
+func TestResolve_NoAPIKey(t *testing.T) {
+	_, wandbDir := setup(t)
+
+	_, err := clisettings.Resolve(wandbDir)
+
+	assert.ErrorContains(t, err, "no API key for https://api.wandb.ai")
+}
@@ -0,0 +1,56 @@
+package codesnapshot
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"google.golang.org/protobuf/proto"
+
+	"github.com/wandb/wandb/core/pkg/artifacts"
+	spb "github.com/wandb/wandb/core/pkg/service_go_proto"
+)
+
+// ArtifactType is the type of artifacts holding source code.
+const ArtifactType = "code"
+
+// metadata is the JSON metadata attached to a snapshot artifact.
+type metadata struct {
+	Root       string            `json:"root"`
+	Git        bool              `json:"git"`
+	Size       int64             `json:"size"`
+	Submodules map[string]string `json:"submodules,omitempty"`
+	Skipped    []string          `json:"skipped,omitempty"`
+}
+
+// Artifact builds a code artifact from the snapshot's staged files.
+//
+// base provides the artifact's identity, such as its name and run; its type,
+// metadata and manifest are set here.
+func (s *Snapshot) Artifact(
+	base *spb.ArtifactRecord,
+	staged []File,
+) (*spb.ArtifactRecord, error) {
+	meta, err := json.Marshal(metadata{
+		Root:       s.Root,
+		Git:        s.IsGitRepo,
+		Size:       s.Size,
+		Submodules: s.Submodules,
+		Skipped:    s.Skipped,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	record := proto.Clone(base).(*spb.ArtifactRecord)
+	record.Type = ArtifactType
+	record.Metadata = string(meta)
+	record.Manifest = nil
+
+	builder := artifacts.NewArtifactBuilder(record)
+	for _, f := range staged {
+		if err := builder.AddFile(f.Path, f.Name); err != nil {
+			return nil, fmt.Errorf("codesnapshot: failed to add %q: %v", f.Name, err)
+		}
+	}
+	return builder.GetArtifact(), nil
+}
@@ -0,0 +1,277 @@
+// Package codesnapshot captures a run's source tree for upload as a code
+// artifact.
+//
+// Unlike the single-file copy made for `save_code` and the patches made
+// against HEAD, a snapshot includes every file a user would see in their
+// working tree, including untracked files that aren't ignored, so that a run
+// can be reproduced even if its code was never committed.
+package codesnapshot
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/go-git/go-git/v5/plumbing/format/gitignore"
+
+	"github.com/wandb/wandb/core/internal/fileutil"
+	"github.com/wandb/wandb/core/internal/gitops"
+	"github.com/wandb/wandb/core/internal/observability"
+)
+
+// DefaultMaxBytes is the snapshot size cap used when none is configured.
+const DefaultMaxBytes = 100 * 1024 * 1024
+
+// File is a single file included in a snapshot.
+type File struct {
+	// Path is the file's absolute path on disk.
+	Path string
+
+	// Name is the file's slash-separated path relative to the snapshot root.
+	Name string
+
+	// Size is the file's size in bytes.
+	Size int64
+}
+
+// Snapshot is the set of files making up a source tree.
+type Snapshot struct {
+	// Root is the directory the snapshot was taken of.
+	Root string
+
+	// IsGitRepo is whether Root is a git repository.
+	IsGitRepo bool
+
+	// Files are the files in the snapshot, ordered by name.
+	Files []File
+
+	// Size is the total size of Files in bytes.
+	Size int64
+
+	// Skipped are the names of files left out to stay under the size cap.
+	Skipped []string
+
+	// Submodules maps submodule paths to their checked out commits.
+	Submodules map[string]string
+}
+
+// Params are the inputs to Capture.
+type Params struct {
+	// GitRoot is the root of the git repository containing the program.
+	//
+	// If empty or not a repository, ProgramDir is snapshotted instead.
+	GitRoot string
+
+	// ProgramDir is the directory of the run's entrypoint.
+	ProgramDir string
+
+	// ExcludeDirs are absolute paths of directories to leave out,
+	// such as the wandb directory holding run files.
+	ExcludeDirs []string
+
+	// MaxBytes is the size cap; DefaultMaxBytes is used if not positive.
+	MaxBytes int64
+
+	Logger *observability.CoreLogger
+}
+
+// Capture lists the files to include in a snapshot.
+//
+// In a git repository, the snapshot contains tracked files and untracked files
+// that aren't ignored, and records the commit checked out in each submodule.
+// Outside of one, ProgramDir is walked while honoring any .gitignore files.
+//
+// Files are added in name order until the size cap is reached; files that
+// would exceed it are skipped, so smaller files later in the order may still
+// be included.
+func Capture(params Params) (*Snapshot, error) {
+	var snapshot *Snapshot
+	var err error
+
+	git := gitops.New(params.GitRoot, params.Logger)
+	if params.GitRoot != "" && git.IsAvailable() {
+		snapshot, err = captureGit(git, params.GitRoot)
+	} else if params.ProgramDir != "" {
+		snapshot, err = captureDir(params.ProgramDir)
+	} else {
+		return nil, errors.New("codesnapshot: no git root or program directory")
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	snapshot.Files = slices.DeleteFunc(snapshot.Files, func(f File) bool {
+		return isExcluded(f.Path, params.ExcludeDirs)
+	})
+
+	maxBytes := params.MaxBytes
+	if maxBytes <= 0 {
+		maxBytes = DefaultMaxBytes
+	}
+	snapshot.applySizeCap(maxBytes)
+	return snapshot, nil
+}
+
+func captureGit(git *gitops.Git, root string) (*Snapshot, error) {
+	submodules, err := git.Submodules()
+	if err != nil {
+		return nil, fmt.Errorf("codesnapshot: failed to list submodules: %v", err)
+	}
+
+	names, err := git.WorkingTreeFiles(submodules)
+	if err != nil {
+		return nil, fmt.Errorf("codesnapshot: failed to list files: %v", err)
+	}
+
+	snapshot := &Snapshot{
+		Root:       root,
+		IsGitRepo:  true,
+		Submodules: submodules,
+	}
+	for _, name := range names {
+		path := filepath.Join(root, filepath.FromSlash(name))
+
+		// Tracked files deleted from the working tree are still listed,
+		// and symlinks and other special files are skipped.
+		info, err := os.Lstat(path)
+		if err != nil || !info.Mode().IsRegular() {
+			continue
+		}
+
+		snapshot.Files = append(snapshot.Files,
+			File{Path: path, Name: name, Size: info.Size()})
+	}
+	return snapshot, nil
+}
+
+func captureDir(root string) (*Snapshot, error) {
+	snapshot := &Snapshot{Root: root}
+
+	var patterns []gitignore.Pattern
+	err := filepath.WalkDir(root, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+
+		rel, err := filepath.Rel(root, path)
+		if err != nil {
+			return err
+		}
+		var parts []string
+		if rel != "." {
+			parts = strings.Split(filepath.ToSlash(rel), "/")
+			if gitignore.NewMatcher(patterns).Match(parts, d.IsDir()) {
+				if d.IsDir() {
+					return filepath.SkipDir
+				}
+				return nil
+			}
+		}
+
+		if d.IsDir() {
+			if d.Name() == ".git" {
+				return filepath.SkipDir
+			}
+			// WalkDir visits a directory before its contents, so patterns
+			// from its .gitignore apply to everything below it.
+			patterns = append(patterns,
+				readGitignore(filepath.Join(path, ".gitignore"), parts)...)
+			return nil
+		}
+
+		if !d.Type().IsRegular() {
+			return nil
+		}
+		info, err := d.Info()
+		if err != nil {
+			return nil
+		}
+		snapshot.Files = append(snapshot.Files,
+			File{Path: path, Name: filepath.ToSlash(rel), Size: info.Size()})
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("codesnapshot: failed to walk %q: %v", root, err)
+	}
+
+	return snapshot, nil
+}
+
+// readGitignore parses the .gitignore file at path, whose patterns are
+// relative to the directory given by domain.
+func readGitignore(path string, domain []string) []gitignore.Pattern {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil
+	}
+	defer func() {
+		_ = f.Close()
+	}()
+
+	var patterns []gitignore.Pattern
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		line := scanner.Text()
+		if strings.HasPrefix(line, "#") || strings.TrimSpace(line) == "" {
+			continue
+		}
+		patterns = append(patterns, gitignore.ParsePattern(line, domain))
+	}
+	return patterns
+}
+
+func isExcluded(path string, excludeDirs []string) bool {
+	for _, dir := range excludeDirs {
+		if dir == "" {
+			continue
+		}
+		rel, err := filepath.Rel(dir, path)
+		if err == nil && rel != ".." &&
+			!strings.HasPrefix(rel, ".."+string(filepath.Separator)) {
+			return true
+		}
+	}
+	return false
+}
+
+func (s *Snapshot) applySizeCap(maxBytes int64) {
+	slices.SortFunc(s.Files, func(a, b File) int {
+		return strings.Compare(a.Name, b.Name)
+	})
+
+	kept := s.Files[:0]
+	s.Size = 0
+	for _, f := range s.Files {
+		if s.Size+f.Size > maxBytes {
+			s.Skipped = append(s.Skipped, f.Name)
+			continue
+		}
+		s.Size += f.Size
+		kept = append(kept, f)
+	}
+	s.Files = kept
+}
+
+// Stage copies the snapshot's files into dir, preserving their layout.
+//
+// The copies are what get uploaded, so later edits to the working tree
+// don't change the contents of the artifact.
+func (s *Snapshot) Stage(dir string) ([]File, error) {
+	staged := make([]File, 0, len(s.Files))
+	for _, f := range s.Files {
+		dst := filepath.Join(dir, filepath.FromSlash(f.Name))
+		if err := os.MkdirAll(filepath.Dir(dst), os.ModePerm); err != nil {
+			return nil, err
+		}
+		if err := fileutil.CopyFile(f.Path, dst); err != nil {
+			return nil, err
+		}
+		staged = append(staged, File{Path: dst, Name: f.Name, Size: f.Size})
+	}
+	return staged, nil
+}
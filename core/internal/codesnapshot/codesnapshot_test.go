@@ -0,0 +1,181 @@
+package codesnapshot_test
+
+import (
+	"encoding/json"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/wandb/wandb/core/internal/codesnapshot"
+	"github.com/wandb/wandb/core/internal/observabilitytest"
+	spb "github.com/wandb/wandb/core/pkg/service_go_proto"
+)
+
+func writeFile(t *testing.T, root, name, content string) {
+	t.Helper()
+	path := filepath.Join(root, filepath.FromSlash(name))
+	require.NoError(t, os.MkdirAll(filepath.Dir(path), 0o755))
+	require.NoError(t, os.WriteFile(path, []byte(content), 0o644))
+}
+
+func runGit(t *testing.T, dir string, args ...string) {
+	t.Helper()
+	cmd := exec.Command("git", args...)
+	cmd.Dir = dir
+	cmd.Env = append(os.Environ(),
+		"GIT_AUTHOR_NAME=Test User",
+		"GIT_AUTHOR_EMAIL=test@example.com",
+		"GIT_COMMITTER_NAME=Test User",
+		"GIT_COMMITTER_EMAIL=test@example.com",
+	)
+	output, err := cmd.CombinedOutput()
+	require.NoError(t, err, string(output))
+}
+
+func names(files []codesnapshot.File) []string {
+	var result []string
+	for _, f := range files {
+		result = append(result, f.Name)
+	}
+	return result
+}
+
+func TestCapture_GitRepo(t *testing.T) {
+	root := t.TempDir()
+	runGit(t, root, "init", "-q")
+	writeFile(t, root, ".gitignore", "*.log\ndata/\n")
+	writeFile(t, root, "train.py", "print('hi')")
+	writeFile(t, root, "deleted.py", "")
+	runGit(t, root, "add", ".")
+	runGit(t, root, "commit", "-q", "-m", "initial")
+	require.NoError(t, os.Remove(filepath.Join(root, "deleted.py")))
+
+	writeFile(t, root, "lib/untracked.py", "x = 1")
+	writeFile(t, root, "debug.log", "ignored")
+	writeFile(t, root, "data/big.bin", "ignored")
+	writeFile(t, root, "wandb/run-1/files/output.log", "excluded")
+	writeFile(t, root, "wandb/run-1/run-1.wandb", "excluded")
+
+	snapshot, err := codesnapshot.Capture(codesnapshot.Params{
+		GitRoot:     root,
+		ExcludeDirs: []string{filepath.Join(root, "wandb")},
+		Logger:      observabilitytest.NewTestLogger(t),
+	})
+
+	require.NoError(t, err)
+	assert.True(t, snapshot.IsGitRepo)
+	assert.Equal(t,
+		[]string{".gitignore", "lib/untracked.py", "train.py"},
+		names(snapshot.Files))
+	assert.Empty(t, snapshot.Submodules)
+}
+
+func TestCapture_GitRepo_RecordsSubmoduleCommits(t *testing.T) {
+	sub := t.TempDir()
+	runGit(t, sub, "init", "-q")
+	writeFile(t, sub, "lib.py", "")
+	runGit(t, sub, "add", ".")
+	runGit(t, sub, "commit", "-q", "-m", "sub")
+
+	root := t.TempDir()
+	runGit(t, root, "init", "-q")
+	writeFile(t, root, "train.py", "")
+	runGit(t, root,
+		"-c", "protocol.file.allow=always",
+		"submodule", "add", "-q", sub, "vendor/sub")
+	runGit(t, root, "commit", "-q", "-m", "initial")
+
+	snapshot, err := codesnapshot.Capture(codesnapshot.Params{
+		GitRoot: root,
+		Logger:  observabilitytest.NewTestLogger(t),
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t,
+		[]string{".gitmodules", "train.py"},
+		names(snapshot.Files))
+	require.Contains(t, snapshot.Submodules, "vendor/sub")
+	assert.Len(t, snapshot.Submodules["vendor/sub"], 40)
+}
+
+func TestCapture_ProgramDir_HonorsNestedGitignore(t *testing.T) {
+	root := t.TempDir()
+	writeFile(t, root, ".gitignore", "__pycache__/\n")
+	writeFile(t, root, "train.py", "")
+	writeFile(t, root, "__pycache__/train.cpython-312.pyc", "")
+	writeFile(t, root, "pkg/.gitignore", "*.tmp\n")
+	writeFile(t, root, "pkg/mod.py", "")
+	writeFile(t, root, "pkg/scratch.tmp", "")
+	writeFile(t, root, "scratch.tmp", "")
+
+	snapshot, err := codesnapshot.Capture(codesnapshot.Params{
+		ProgramDir: root,
+		Logger:     observabilitytest.NewTestLogger(t),
+	})
+
+	require.NoError(t, err)
+	assert.False(t, snapshot.IsGitRepo)
+	assert.Equal(t,
+		[]string{".gitignore", "pkg/.gitignore", "pkg/mod.py", "scratch.tmp", "train.py"},
+		names(snapshot.Files))
+}
+
+func TestCapture_SizeCap(t *testing.T) {
+	root := t.TempDir()
+	writeFile(t, root, "a.py", "12345")
+	writeFile(t, root, "b.bin", "1234567890")
+	writeFile(t, root, "c.py", "123")
+
+	snapshot, err := codesnapshot.Capture(codesnapshot.Params{
+		ProgramDir: root,
+		MaxBytes:   10,
+		Logger:     observabilitytest.NewTestLogger(t),
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, []string{"a.py", "c.py"}, names(snapshot.Files))
+	assert.Equal(t, []string{"b.bin"}, snapshot.Skipped)
+	assert.EqualValues(t, 8, snapshot.Size)
+}
+
+func TestArtifact(t *testing.T) {
+	root := t.TempDir()
+	writeFile(t, root, "train.py", "print('hi')")
+	writeFile(t, root, "lib/util.py", "")
+	snapshot, err := codesnapshot.Capture(codesnapshot.Params{
+		ProgramDir: root,
+		Logger:     observabilitytest.NewTestLogger(t),
+	})
+	require.NoError(t, err)
+
+	stagingDir := t.TempDir()
+	staged, err := snapshot.Stage(stagingDir)
+	require.NoError(t, err)
+	artifact, err := snapshot.Artifact(
+		&spb.ArtifactRecord{Name: "source-project-train.py"},
+		staged,
+	)
+
+	require.NoError(t, err)
+	assert.Equal(t, "source-project-train.py", artifact.Name)
+	assert.Equal(t, "code", artifact.Type)
+	assert.NotEmpty(t, artifact.Digest)
+
+	var entries []string
+	for _, entry := range artifact.Manifest.Contents {
+		entries = append(entries, entry.Path)
+		assert.Equal(t,
+			filepath.Join(stagingDir, filepath.FromSlash(entry.Path)),
+			entry.LocalPath)
+	}
+	assert.Equal(t, []string{"lib/util.py", "train.py"}, entries)
+
+	var metadata map[string]any
+	require.NoError(t, json.Unmarshal([]byte(artifact.Metadata), &metadata))
+	assert.Equal(t, root, metadata["root"])
+	assert.Equal(t, false, metadata["git"])
+}
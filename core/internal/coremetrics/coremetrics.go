@@ -0,0 +1,203 @@
+// Package coremetrics records wandb-core's internal state for a run.
+//
+// Components such as flow control and the filestream report into a
+// Registry, which the system monitor samples as `_wandb/core.*` system
+// metrics when the `x_stats_core_metrics` setting is enabled. This helps
+// tell whether a run that lags behind in the UI is held back by the network,
+// by the backend's rate limiting or by wandb-core itself.
+package coremetrics
+
+import (
+	"runtime"
+	"runtime/metrics"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// KeyPrefix is prepended to metric names to get their system metric keys.
+const KeyPrefix = "_wandb/core."
+
+// heapMetric is the runtime metric for the memory occupied by heap objects.
+const heapMetric = "/memory/classes/heap/objects:bytes"
+
+// Registry collects a stream's internal metrics.
+//
+// A nil Registry is valid and discards everything, so that components can
+// report to it whether or not the metrics are enabled.
+type Registry struct {
+	mu       sync.Mutex
+	gauges   map[string]func() float64
+	counters map[string]*Counter
+	timers   map[string]*Timer
+}
+
+// New returns a registry that reports the process's goroutine count and
+// heap size in addition to the metrics registered by components.
+func New() *Registry {
+	r := &Registry{
+		gauges:   make(map[string]func() float64),
+		counters: make(map[string]*Counter),
+		timers:   make(map[string]*Timer),
+	}
+
+	r.Gauge("go.goroutines", func() float64 {
+		return float64(runtime.NumGoroutine())
+	})
+	r.Gauge("go.heap_mb", func() float64 {
+		sample := []metrics.Sample{{Name: heapMetric}}
+		metrics.Read(sample)
+		if sample[0].Value.Kind() != metrics.KindUint64 {
+			return 0
+		}
+		return float64(sample[0].Value.Uint64()) / 1024 / 1024
+	})
+
+	return r
+}
+
+// Gauge registers a function that returns a metric's current value.
+//
+// The function is called on every sample from the monitor's goroutine.
+// Registering a name again replaces the previous function.
+func (r *Registry) Gauge(name string, value func() float64) {
+	if r == nil {
+		return
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.gauges[name] = value
+}
+
+// Counter returns the counter with the given name, creating it if needed.
+//
+// Returns nil if the registry is nil.
+func (r *Registry) Counter(name string) *Counter {
+	if r == nil {
+		return nil
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	counter, ok := r.counters[name]
+	if !ok {
+		counter = &Counter{}
+		r.counters[name] = counter
+	}
+	return counter
+}
+
+// Timer returns the timer with the given name, creating it if needed.
+//
+// Returns nil if the registry is nil.
+func (r *Registry) Timer(name string) *Timer {
+	if r == nil {
+		return nil
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	timer, ok := r.timers[name]
+	if !ok {
+		timer = &Timer{}
+		r.timers[name] = timer
+	}
+	return timer
+}
+
+// Sample returns the current values of all metrics keyed by their system
+// metric keys.
+//
+// Counters are reported as totals. Timers are reported as the mean and
+// maximum in milliseconds of the durations observed since the previous
+// sample, and are left out if there were none.
+func (r *Registry) Sample() map[string]float64 {
+	if r == nil {
+		return nil
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	values := make(map[string]float64,
+		len(r.gauges)+len(r.counters)+2*len(r.timers))
+
+	for name, value := range r.gauges {
+		values[KeyPrefix+name] = value()
+	}
+	for name, counter := range r.counters {
+		values[KeyPrefix+name] = float64(counter.Load())
+	}
+	for name, timer := range r.timers {
+		mean, maximum, ok := timer.reset()
+		if ok {
+			values[KeyPrefix+name+".mean_ms"] = mean.Seconds() * 1000
+			values[KeyPrefix+name+".max_ms"] = maximum.Seconds() * 1000
+		}
+	}
+
+	return values
+}
+
+// Counter is a running total.
+//
+// A nil Counter is valid and discards everything.
+type Counter struct {
+	total atomic.Int64
+}
+
+// Add increases the counter.
+func (c *Counter) Add(n int64) {
+	if c != nil {
+		c.total.Add(n)
+	}
+}
+
+// Load returns the counter's total.
+func (c *Counter) Load() int64 {
+	if c == nil {
+		return 0
+	}
+	return c.total.Load()
+}
+
+// Timer aggregates durations, such as request latencies.
+//
+// A nil Timer is valid and discards everything.
+type Timer struct {
+	mu    sync.Mutex
+	count int
+	sum   time.Duration
+	max   time.Duration
+}
+
+// Observe records a duration.
+func (t *Timer) Observe(d time.Duration) {
+	if t == nil {
+		return
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.count++
+	t.sum += d
+	t.max = max(t.max, d)
+}
+
+// reset returns the mean and maximum of the durations observed since the
+// last reset, or false if there were none.
+func (t *Timer) reset() (mean, maximum time.Duration, ok bool) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if t.count == 0 {
+		return 0, 0, false
+	}
+
+	mean, maximum = t.sum/time.Duration(t.count), t.max
+	t.count, t.sum, t.max = 0, 0, 0
+	return mean, maximum, true
+}
@@ -0,0 +1,51 @@
+package coremetrics_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/wandb/wandb/core/internal/coremetrics"
+)
+
+func TestSample(t *testing.T) {
+	registry := coremetrics.New()
+	registry.Gauge("queue.length", func() float64 { return 3 })
+	registry.Counter("requests").Add(2)
+	registry.Counter("requests").Add(1)
+	latency := registry.Timer("latency")
+	latency.Observe(10 * time.Millisecond)
+	latency.Observe(30 * time.Millisecond)
+
+	values := registry.Sample()
+
+	assert.Equal(t, 3.0, values["_wandb/core.queue.length"])
+	assert.Equal(t, 3.0, values["_wandb/core.requests"])
+	assert.Equal(t, 20.0, values["_wandb/core.latency.mean_ms"])
+	assert.Equal(t, 30.0, values["_wandb/core.latency.max_ms"])
+	assert.Positive(t, values["_wandb/core.go.goroutines"])
+	assert.Positive(t, values["_wandb/core.go.heap_mb"])
+}
+
+func TestSample_TimerResets(t *testing.T) {
+	registry := coremetrics.New()
+	registry.Timer("latency").Observe(time.Second)
+	registry.Sample()
+
+	values := registry.Sample()
+
+	assert.NotContains(t, values, "_wandb/core.latency.mean_ms")
+	assert.NotContains(t, values, "_wandb/core.latency.max_ms")
+}
+
+func TestNilRegistry(t *testing.T) {
+	var registry *coremetrics.Registry
+
+	registry.Gauge("queue.length", func() float64 { return 1 })
+	registry.Counter("requests").Add(1)
+	registry.Timer("latency").Observe(time.Second)
+
+	assert.Nil(t, registry.Sample())
+	assert.Zero(t, registry.Counter("requests").Load())
+}
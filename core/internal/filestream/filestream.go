@@ -13,6 +13,7 @@ import (
 	"golang.org/x/time/rate"
 
 	"github.com/wandb/wandb/core/internal/api"
+	"github.com/wandb/wandb/core/internal/coremetrics"
 	"github.com/wandb/wandb/core/internal/featurechecker"
 	"github.com/wandb/wandb/core/internal/observability"
 	"github.com/wandb/wandb/core/internal/settings"
@@ -123,6 +124,12 @@ type fileStream struct {
 	apiClient api.RetryableClient
 	baseURL   *url.URL
 
+	// requestCount counts requests made to the backend, excluding retries.
+	requestCount *coremetrics.Counter
+
+	// requestTimer measures how long requests take, including retries.
+	requestTimer *coremetrics.Timer
+
 	// The rate limit for sending data to the backend.
 	transmitRateLimit *rate.Limiter
 
@@ -147,6 +154,7 @@ var FileStreamProviders = wire.NewSet(
 
 type FileStreamFactory struct {
 	BaseURL         api.WBBaseURL
+	CoreMetrics     *coremetrics.Registry
 	FeatureProvider *featurechecker.FeatureProvider
 	Logger          *observability.CoreLogger
 	Operations      *wboperation.WandbOperations
@@ -180,6 +188,8 @@ func (f *FileStreamFactory) New(
 		printer:         f.Printer,
 		apiClient:       apiClient,
 		baseURL:         f.BaseURL,
+		requestCount:    f.CoreMetrics.Counter("filestream.requests"),
+		requestTimer:    f.CoreMetrics.Timer("filestream.request"),
 		processChan:     make(chan Update, BufferSize),
 		feedbackWait:    &sync.WaitGroup{},
 		deadChanOnce:    &sync.Once{},
@@ -196,6 +206,10 @@ func (f *FileStreamFactory) New(
 		fs.transmitRateLimit = rate.NewLimiter(rate.Every(defaultTransmitInterval), 1)
 	}
 
+	f.CoreMetrics.Gauge("filestream.pending_updates", func() float64 {
+		return float64(len(fs.processChan))
+	})
+
 	return fs
 }
 
@@ -9,6 +9,7 @@ import (
 	"net/http"
 	"strings"
 	"sync"
+	"time"
 
 	"github.com/hashicorp/go-retryablehttp"
 
@@ -178,7 +179,10 @@ func (fs *fileStream) send(
 		fs.logRequestSummary(data)
 	}
 
+	fs.requestCount.Add(1)
+	requestStart := time.Now()
 	resp, err := fs.apiClient.Do(req)
+	fs.requestTimer.Observe(time.Since(requestStart))
 
 	switch {
 	case err != nil:
@@ -39,6 +39,14 @@ func runCommandWithOutput(command []string, dir string) ([]byte, error) {
 	return cmd.CombinedOutput()
 }
 
+// runCommandStdout is like runCommandWithOutput, but ignores stderr so that
+// warnings don't end up mixed into parsed output.
+func runCommandStdout(command []string, dir string) ([]byte, error) {
+	cmd := exec.Command(command[0], command[1:]...)
+	cmd.Dir = dir
+	return cmd.Output()
+}
+
 type Git struct {
 	path   string
 	logger *observability.CoreLogger
@@ -204,3 +212,73 @@ func (g *Git) findMostRecentAncestor(branches []string) (string, error) {
 
 	return strings.TrimSpace(string(output)), nil
 }
+
+// WorkingTreeFiles lists the files that make up the working tree, relative
+// to the repository path.
+//
+// This includes tracked files and untracked files that are not excluded by
+// .gitignore or other standard exclude rules. Submodules are not descended
+// into, and the paths of the given submodules as returned by Submodules
+// are left out.
+func (g *Git) WorkingTreeFiles(submodules map[string]string) ([]string, error) {
+	output, err := runCommandStdout(
+		[]string{
+			"git", "ls-files", "-z",
+			"--cached", "--others", "--exclude-standard",
+		},
+		g.path,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	var files []string
+	seen := make(map[string]struct{})
+	for file := range strings.SplitSeq(string(output), "\x00") {
+		if file == "" {
+			continue
+		}
+		if _, isSubmodule := submodules[file]; isSubmodule {
+			continue
+		}
+		// Files with merge conflicts are listed once per stage.
+		if _, ok := seen[file]; ok {
+			continue
+		}
+		seen[file] = struct{}{}
+		files = append(files, file)
+	}
+	return files, nil
+}
+
+// Submodules returns the commit checked out in each submodule, keyed by the
+// submodule's path relative to the repository path.
+//
+// Uninitialized submodules report the commit recorded in the superproject.
+func (g *Git) Submodules() (map[string]string, error) {
+	output, err := runCommandStdout(
+		[]string{"git", "submodule", "status", "--recursive"},
+		g.path,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	submodules := make(map[string]string)
+	for line := range strings.SplitSeq(string(output), "\n") {
+		// Each line is "<state><commit> <path>[ (<describe>)]", where
+		// <state> is a space, '-', '+' or 'U'.
+		if len(line) < 2 {
+			continue
+		}
+		commit, path, ok := strings.Cut(line[1:], " ")
+		if !ok {
+			continue
+		}
+		if i := strings.LastIndex(path, " ("); i >= 0 {
+			path = path[:i]
+		}
+		submodules[path] = commit
+	}
+	return submodules, nil
+}
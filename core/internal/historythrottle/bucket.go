@@ -0,0 +1,108 @@
+package historythrottle
+
+import (
+	"math"
+	"strconv"
+	"time"
+
+	spb "github.com/wandb/wandb/core/pkg/service_go_proto"
+)
+
+// bucket collects the rows logged during one time bucket in Buckets mode.
+type bucket struct {
+	// end is when the bucket closes.
+	end time.Time
+
+	// rows is the bucket's rows in order.
+	rows []bucketRow
+
+	// keys is every key in the bucket, in order of first appearance.
+	keys []string
+
+	// extremes tracks each key's selected values.
+	extremes map[string]*keyExtremes
+}
+
+// bucketRow is a row held in a bucket.
+type bucketRow struct {
+	seq       int64
+	record    *spb.HistoryRecord
+	numValues int
+}
+
+// keyExtremes is the values of a key to upload from a bucket.
+type keyExtremes struct {
+	// min and max are the rows with the smallest and largest numeric
+	// values, if the key has any.
+	min, max *bucketValue
+
+	// last is the key's most recent value.
+	last bucketValue
+}
+
+// bucketValue is one value of a key in a bucket.
+type bucketValue struct {
+	seq    int64
+	item   *spb.HistoryItem
+	number float64
+}
+
+func newBucket(end time.Time) *bucket {
+	return &bucket{
+		end:      end,
+		extremes: make(map[string]*keyExtremes),
+	}
+}
+
+// add puts the next row into the bucket.
+func (b *bucket) add(
+	seq int64,
+	record *spb.HistoryRecord,
+	values []*spb.HistoryItem,
+) {
+	b.rows = append(b.rows, bucketRow{
+		seq:       seq,
+		record:    record,
+		numValues: len(values),
+	})
+
+	for _, item := range values {
+		key := itemKey(item)
+		value := bucketValue{seq: seq, item: item}
+
+		extremes, ok := b.extremes[key]
+		if !ok {
+			extremes = &keyExtremes{}
+			b.extremes[key] = extremes
+			b.keys = append(b.keys, key)
+		}
+		extremes.last = value
+
+		number, err := strconv.ParseFloat(item.GetValueJson(), 64)
+		if err != nil || math.IsNaN(number) {
+			continue
+		}
+		value.number = number
+
+		// Ties keep the earlier row.
+		if extremes.min == nil || number < extremes.min.number {
+			extremes.min = &value
+		}
+		if extremes.max == nil || number > extremes.max.number {
+			extremes.max = &value
+		}
+	}
+}
+
+// selection returns the values of a key to upload.
+//
+// The result may contain the same value more than once.
+func (b *bucket) selection(key string) []bucketValue {
+	extremes := b.extremes[key]
+
+	selected := []bucketValue{extremes.last}
+	if extremes.min != nil {
+		selected = append(selected, *extremes.min, *extremes.max)
+	}
+	return selected
+}
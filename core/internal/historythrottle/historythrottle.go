@@ -0,0 +1,391 @@
+// Package historythrottle thins the run history that is uploaded when a run
+// logs faster than a configured budget.
+//
+// The throttle only affects what is sent to the server: the transaction log
+// always keeps every row.
+package historythrottle
+
+import (
+	"fmt"
+	"math"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	spb "github.com/wandb/wandb/core/pkg/service_go_proto"
+)
+
+// rateWindow is the period over which the logging rate is measured.
+const rateWindow = time.Second
+
+// minBucketWidth is the shortest time bucket in Buckets mode.
+const minBucketWidth = time.Second
+
+// Mode is how history is thinned once the budget is exceeded.
+type Mode int
+
+const (
+	// Stride uploads every Nth value of each key, where N grows with
+	// the ratio of the logging rate to the budget.
+	Stride Mode = iota
+
+	// Buckets groups rows into time buckets and uploads, for each key,
+	// the rows holding its minimum, maximum and last value in the bucket.
+	Buckets
+)
+
+// ParseMode parses the name of a Mode as used in settings.
+//
+// The empty string is parsed as Stride.
+func ParseMode(name string) (Mode, error) {
+	switch name {
+	case "", "stride":
+		return Stride, nil
+	case "buckets":
+		return Buckets, nil
+	default:
+		return 0, fmt.Errorf("historythrottle: unknown mode %q", name)
+	}
+}
+
+func (m Mode) String() string {
+	switch m {
+	case Stride:
+		return "stride"
+	case Buckets:
+		return "buckets"
+	default:
+		return fmt.Sprintf("Mode(%d)", int(m))
+	}
+}
+
+// Policy configures a Throttler.
+type Policy struct {
+	Mode Mode
+
+	// Budget is the number of history values per second above which
+	// history is thinned.
+	//
+	// A value is one key in one row. Keys starting with an underscore,
+	// like "_step" and "_runtime", are not counted and are kept on every
+	// uploaded row.
+	Budget float64
+}
+
+// Stats describes how much history a Throttler has thinned.
+type Stats struct {
+	// ThinnedRows is the number of rows that were dropped or uploaded
+	// with some of their values removed.
+	ThinnedRows int64
+
+	// DroppedValues is the number of values that were not uploaded.
+	DroppedValues int64
+
+	// FirstThinnedStep is the step of the first thinned row.
+	//
+	// It is only meaningful if HasFirstThinnedStep is true.
+	FirstThinnedStep    int64
+	HasFirstThinnedStep bool
+}
+
+// Throttler decides which history rows to upload.
+//
+// It is not safe for concurrent use.
+type Throttler struct {
+	policy Policy
+	getNow func() time.Time
+
+	// windowStart is the start of the current rate window, and
+	// windowValues is the number of values seen in it.
+	windowStart  time.Time
+	windowValues int
+	hasWindow    bool
+
+	// rate is the number of values per second in the last full window.
+	rate float64
+
+	// skipped is the number of consecutive values of each key not uploaded
+	// in Stride mode since thinning started.
+	skipped map[string]int
+
+	// bucket collects rows in Buckets mode, or is nil.
+	bucket *bucket
+
+	// bucketKeys is the number of keys in the previous bucket, used to
+	// size the next one.
+	bucketKeys int
+
+	// nextSeq identifies the next row added to a bucket.
+	nextSeq int64
+
+	stats Stats
+}
+
+// New returns a Throttler for the policy.
+//
+// getNow is used for rows that have no "_timestamp" value.
+func New(policy Policy, getNow func() time.Time) *Throttler {
+	if getNow == nil {
+		getNow = time.Now
+	}
+
+	return &Throttler{
+		policy:  policy,
+		getNow:  getNow,
+		skipped: make(map[string]int),
+	}
+}
+
+// Policy returns the throttler's policy.
+func (t *Throttler) Policy() Policy {
+	return t.policy
+}
+
+// Stats returns how much history has been thinned so far.
+func (t *Throttler) Stats() Stats {
+	return t.stats
+}
+
+// ToRunConfigData returns the policy and stats to record in the run's
+// config, so that it's known whether the uploaded history was thinned.
+func (t *Throttler) ToRunConfigData() map[string]any {
+	data := map[string]any{
+		"mode":           t.policy.Mode.String(),
+		"budget":         t.policy.Budget,
+		"thinned":        t.stats.ThinnedRows > 0,
+		"thinned_rows":   t.stats.ThinnedRows,
+		"dropped_values": t.stats.DroppedValues,
+	}
+
+	if t.stats.HasFirstThinnedStep {
+		data["first_thinned_step"] = t.stats.FirstThinnedStep
+	}
+
+	return data
+}
+
+// Add processes the next history row and returns the rows to upload,
+// in order.
+//
+// Rows may be held back in Buckets mode; Flush returns any that remain.
+func (t *Throttler) Add(record *spb.HistoryRecord) []*spb.HistoryRecord {
+	meta, values := splitItems(record)
+	now := t.rowTime(meta)
+	overBudget := t.updateRate(now, len(values))
+
+	// Rows held in a bucket precede this one, so they're returned first.
+	var records []*spb.HistoryRecord
+	if t.bucket != nil && (!overBudget || !now.Before(t.bucket.end)) {
+		records = t.closeBucket()
+	}
+
+	switch {
+	case !overBudget:
+		clear(t.skipped)
+		return append(records, record)
+
+	case t.policy.Mode == Buckets:
+		if t.bucket == nil {
+			t.bucket = newBucket(now.Add(t.bucketWidth(len(values))))
+		}
+		t.bucket.add(t.nextSeq, record, values)
+		t.nextSeq++
+		return records
+
+	default:
+		return append(records, t.addStride(record, values)...)
+	}
+}
+
+// Flush returns any rows held back by the throttler.
+func (t *Throttler) Flush() []*spb.HistoryRecord {
+	if t.bucket == nil {
+		return nil
+	}
+	return t.closeBucket()
+}
+
+// updateRate counts the row's values and reports whether the logging rate
+// is over budget.
+func (t *Throttler) updateRate(now time.Time, numValues int) bool {
+	if !t.hasWindow {
+		t.windowStart = now
+		t.hasWindow = true
+	}
+
+	if elapsed := now.Sub(t.windowStart); elapsed >= rateWindow {
+		t.rate = float64(t.windowValues) / elapsed.Seconds()
+		t.windowStart = now
+		t.windowValues = 0
+	}
+
+	t.windowValues += numValues
+
+	return t.currentRate() > t.policy.Budget
+}
+
+// currentRate estimates the logging rate in values per second.
+//
+// The current window contributes as soon as it alone exceeds the budget,
+// so that a sudden burst is throttled without waiting for it to end.
+func (t *Throttler) currentRate() float64 {
+	return max(t.rate, float64(t.windowValues)/rateWindow.Seconds())
+}
+
+// rowTime returns the time at which a row was logged.
+//
+// Using the row's own timestamp makes the throttle behave the same when
+// a run is synced from its transaction log long after it was logged.
+func (t *Throttler) rowTime(meta []*spb.HistoryItem) time.Time {
+	for _, item := range meta {
+		if item.GetKey() != "_timestamp" {
+			continue
+		}
+
+		seconds, err := strconv.ParseFloat(item.GetValueJson(), 64)
+		if err != nil || math.IsNaN(seconds) || math.IsInf(seconds, 0) {
+			break
+		}
+
+		return time.Unix(0, int64(seconds*float64(time.Second)))
+	}
+
+	return t.getNow()
+}
+
+// addStride implements Add in Stride mode.
+func (t *Throttler) addStride(
+	record *spb.HistoryRecord,
+	values []*spb.HistoryItem,
+) []*spb.HistoryRecord {
+	stride := int(math.Ceil(t.currentRate() / t.policy.Budget))
+
+	var kept []*spb.HistoryItem
+	for _, item := range values {
+		key := itemKey(item)
+
+		// The first value of a key after thinning starts is always kept.
+		skipped, seen := t.skipped[key]
+		if !seen || skipped+1 >= stride {
+			kept = append(kept, item)
+			t.skipped[key] = 0
+		} else {
+			t.skipped[key]++
+		}
+	}
+
+	if len(kept) == len(values) {
+		return []*spb.HistoryRecord{record}
+	}
+
+	t.recordThinned(record, len(values)-len(kept))
+	if len(kept) == 0 {
+		return nil
+	}
+	return []*spb.HistoryRecord{withValues(record, kept)}
+}
+
+// bucketWidth returns the width of a new bucket starting with a row
+// that has the given number of values.
+//
+// Each key uploads at most three values per bucket, so the bucket is made
+// wide enough for that to fit in the budget.
+func (t *Throttler) bucketWidth(numValues int) time.Duration {
+	keys := max(t.bucketKeys, numValues)
+	seconds := 3 * float64(keys) / t.policy.Budget
+
+	return max(minBucketWidth, time.Duration(seconds*float64(time.Second)))
+}
+
+// closeBucket returns the selected rows of the current bucket and
+// discards it.
+func (t *Throttler) closeBucket() []*spb.HistoryRecord {
+	b := t.bucket
+	t.bucket = nil
+	t.bucketKeys = len(b.keys)
+
+	selected := make(map[int64][]*spb.HistoryItem)
+	for _, key := range b.keys {
+		for _, p := range b.selection(key) {
+			if !slices.Contains(selected[p.seq], p.item) {
+				selected[p.seq] = append(selected[p.seq], p.item)
+			}
+		}
+	}
+
+	var records []*spb.HistoryRecord
+	for _, r := range b.rows {
+		kept := selected[r.seq]
+
+		if len(kept) < r.numValues {
+			t.recordThinned(r.record, r.numValues-len(kept))
+		}
+
+		switch {
+		case len(kept) == 0:
+			continue
+		case len(kept) == r.numValues:
+			records = append(records, r.record)
+		default:
+			records = append(records, withValues(r.record, kept))
+		}
+	}
+
+	return records
+}
+
+// recordThinned updates stats for a row that had values removed.
+func (t *Throttler) recordThinned(record *spb.HistoryRecord, dropped int) {
+	t.stats.ThinnedRows++
+	t.stats.DroppedValues += int64(dropped)
+
+	if !t.stats.HasFirstThinnedStep && record.GetStep() != nil {
+		t.stats.FirstThinnedStep = record.GetStep().GetNum()
+		t.stats.HasFirstThinnedStep = true
+	}
+}
+
+// splitItems separates a row's internal items, whose keys start with
+// an underscore, from its values.
+func splitItems(
+	record *spb.HistoryRecord,
+) (meta []*spb.HistoryItem, values []*spb.HistoryItem) {
+	for _, item := range record.GetItem() {
+		if strings.HasPrefix(itemKey(item), "_") {
+			meta = append(meta, item)
+		} else {
+			values = append(values, item)
+		}
+	}
+	return meta, values
+}
+
+// itemKey returns a string identifying an item's key.
+func itemKey(item *spb.HistoryItem) string {
+	if len(item.GetNestedKey()) > 0 {
+		return strings.Join(item.GetNestedKey(), ".")
+	}
+	return item.GetKey()
+}
+
+// withValues returns a copy of a row that keeps its internal items but
+// only the given values.
+func withValues(
+	record *spb.HistoryRecord,
+	values []*spb.HistoryItem,
+) *spb.HistoryRecord {
+	items := make([]*spb.HistoryItem, 0, len(record.GetItem()))
+	for _, item := range record.GetItem() {
+		if strings.HasPrefix(itemKey(item), "_") ||
+			slices.Contains(values, item) {
+			items = append(items, item)
+		}
+	}
+
+	return &spb.HistoryRecord{
+		Step:  record.GetStep(),
+		Item:  items,
+		XInfo: record.GetXInfo(),
+	}
+}
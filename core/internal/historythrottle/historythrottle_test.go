@@ -0,0 +1,218 @@
+package historythrottle_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/wandb/wandb/core/internal/historythrottle"
+	spb "github.com/wandb/wandb/core/pkg/service_go_proto"
+)
+
+// row returns a history row at a step and time with the given key-value
+// pairs.
+func row(step int64, seconds float64, pairs ...any) *spb.HistoryRecord {
+	record := &spb.HistoryRecord{
+		Step: &spb.HistoryStep{Num: step},
+		Item: []*spb.HistoryItem{
+			{Key: "_step", ValueJson: fmt.Sprint(step)},
+			{Key: "_timestamp", ValueJson: fmt.Sprint(seconds)},
+		},
+	}
+
+	for i := 0; i < len(pairs); i += 2 {
+		record.Item = append(record.Item, &spb.HistoryItem{
+			Key:       pairs[i].(string),
+			ValueJson: fmt.Sprint(pairs[i+1]),
+		})
+	}
+
+	return record
+}
+
+// values returns a row's non-internal key-value pairs.
+func values(record *spb.HistoryRecord) map[string]string {
+	result := make(map[string]string)
+	for _, item := range record.GetItem() {
+		if item.GetKey()[0] != '_' {
+			result[item.GetKey()] = item.GetValueJson()
+		}
+	}
+	return result
+}
+
+func steps(records []*spb.HistoryRecord) []int64 {
+	var result []int64
+	for _, record := range records {
+		result = append(result, record.GetStep().GetNum())
+	}
+	return result
+}
+
+func TestParseMode(t *testing.T) {
+	mode, err := historythrottle.ParseMode("")
+	require.NoError(t, err)
+	assert.Equal(t, historythrottle.Stride, mode)
+
+	mode, err = historythrottle.ParseMode("buckets")
+	require.NoError(t, err)
+	assert.Equal(t, historythrottle.Buckets, mode)
+
+	_, err = historythrottle.ParseMode("other")
+	assert.ErrorContains(t, err, `unknown mode "other"`)
+}
+
+func TestUnderBudget_UploadsEverything(t *testing.T) {
+	throttler := historythrottle.New(
+		historythrottle.Policy{Mode: historythrottle.Stride, Budget: 10},
+		nil,
+	)
+
+	for step := range int64(20) {
+		record := row(step, float64(step), "a", 1, "b", 2)
+
+		assert.Equal(t,
+			[]*spb.HistoryRecord{record},
+			throttler.Add(record))
+	}
+	assert.Zero(t, throttler.Stats())
+}
+
+func TestStride_KeepsEveryNthValuePerKey(t *testing.T) {
+	throttler := historythrottle.New(
+		historythrottle.Policy{Mode: historythrottle.Stride, Budget: 4},
+		nil,
+	)
+
+	// 8 values per second in the first second: 2 keys x 4 rows.
+	var uploaded []*spb.HistoryRecord
+	for step := range int64(8) {
+		uploaded = append(uploaded,
+			throttler.Add(row(step, float64(step)/8, "a", step, "b", step))...)
+	}
+
+	// Thinning starts at step 2, when 6 values have been seen, and the
+	// stride grows with the estimated rate: 2 at steps 2 and 3, 3 at steps
+	// 4 and 5, and 4 at steps 6 and 7.
+	assert.Equal(t, []int64{0, 1, 2, 5}, steps(uploaded))
+	assert.Equal(t, map[string]string{"a": "2", "b": "2"}, values(uploaded[2]))
+
+	stats := throttler.Stats()
+	assert.EqualValues(t, 4, stats.ThinnedRows)
+	assert.EqualValues(t, 8, stats.DroppedValues)
+	assert.True(t, stats.HasFirstThinnedStep)
+	assert.EqualValues(t, 3, stats.FirstThinnedStep)
+}
+
+func TestStride_KeepsInternalKeysOnPartialRows(t *testing.T) {
+	throttler := historythrottle.New(
+		historythrottle.Policy{Mode: historythrottle.Stride, Budget: 1},
+		nil,
+	)
+
+	throttler.Add(row(0, 0, "a", 0))
+	throttler.Add(row(1, 0.1, "a", 1))
+	uploaded := throttler.Add(row(2, 0.2, "a", 2, "b", 2))
+
+	// "b" is new, so its first value is kept.
+	require.Len(t, uploaded, 1)
+	assert.Equal(t, map[string]string{"b": "2"}, values(uploaded[0]))
+	assert.Equal(t, "_step", uploaded[0].GetItem()[0].GetKey())
+	assert.Equal(t, "_timestamp", uploaded[0].GetItem()[1].GetKey())
+}
+
+func TestBuckets_KeepsMinMaxAndLast(t *testing.T) {
+	throttler := historythrottle.New(
+		historythrottle.Policy{Mode: historythrottle.Buckets, Budget: 1},
+		nil,
+	)
+
+	// The first row is under budget; the rest fill a 3-second bucket.
+	var uploaded []*spb.HistoryRecord
+	uploaded = append(uploaded, throttler.Add(row(0, 0, "loss", 5))...)
+	uploaded = append(uploaded, throttler.Add(row(1, 0.1, "loss", 9))...)
+	uploaded = append(uploaded, throttler.Add(row(2, 0.2, "loss", 1))...)
+	uploaded = append(uploaded, throttler.Add(row(3, 0.3, "loss", 4))...)
+	uploaded = append(uploaded, throttler.Add(row(4, 0.4, "loss", 6))...)
+	assert.Equal(t, []int64{0}, steps(uploaded))
+
+	uploaded = append(uploaded, throttler.Flush()...)
+
+	assert.Equal(t, []int64{0, 1, 2, 4}, steps(uploaded))
+	assert.EqualValues(t, 1, throttler.Stats().ThinnedRows)
+	assert.EqualValues(t, 3, throttler.Stats().FirstThinnedStep)
+}
+
+func TestBuckets_ClosesBucketBeforeLaterRows(t *testing.T) {
+	throttler := historythrottle.New(
+		historythrottle.Policy{Mode: historythrottle.Buckets, Budget: 1},
+		nil,
+	)
+
+	throttler.Add(row(0, 0, "loss", 1))
+	throttler.Add(row(1, 0.1, "loss", 2))
+	throttler.Add(row(2, 0.2, "loss", 3))
+
+	// A row after the bucket's end releases the bucket first.
+	uploaded := throttler.Add(row(3, 10, "loss", 4))
+
+	assert.Equal(t, []int64{1, 2, 3}, steps(uploaded))
+}
+
+func TestRowsWithoutTimestamp_UseClock(t *testing.T) {
+	now := time.Unix(100, 0)
+	throttler := historythrottle.New(
+		historythrottle.Policy{Mode: historythrottle.Stride, Budget: 1},
+		func() time.Time { return now },
+	)
+	record := func(step int64) *spb.HistoryRecord {
+		return &spb.HistoryRecord{
+			Step: &spb.HistoryStep{Num: step},
+			Item: []*spb.HistoryItem{{Key: "a", ValueJson: "1"}},
+		}
+	}
+
+	assert.Len(t, throttler.Add(record(0)), 1)
+	assert.Len(t, throttler.Add(record(1)), 1) // first thinned value
+	assert.Empty(t, throttler.Add(record(2)))
+
+	// The rate drops below the budget after a long pause.
+	now = now.Add(time.Hour)
+	assert.Len(t, throttler.Add(record(3)), 1)
+}
+
+func TestToRunConfigData(t *testing.T) {
+	throttler := historythrottle.New(
+		historythrottle.Policy{Mode: historythrottle.Buckets, Budget: 1},
+		nil,
+	)
+	assert.Equal(t,
+		map[string]any{
+			"mode":           "buckets",
+			"budget":         1.0,
+			"thinned":        false,
+			"thinned_rows":   int64(0),
+			"dropped_values": int64(0),
+		},
+		throttler.ToRunConfigData())
+
+	throttler.Add(row(0, 0, "loss", 1))
+	throttler.Add(row(1, 0.1, "loss", 2))
+	throttler.Add(row(2, 0.2, "loss", 2))
+	throttler.Add(row(3, 0.3, "loss", 3))
+	throttler.Flush()
+
+	assert.Equal(t,
+		map[string]any{
+			"mode":               "buckets",
+			"budget":             1.0,
+			"thinned":            true,
+			"thinned_rows":       int64(1),
+			"dropped_values":     int64(1),
+			"first_thinned_step": int64(2),
+		},
+		throttler.ToRunConfigData())
+}
@@ -75,6 +75,10 @@ type ConsoleLogsPane struct {
 	active     bool
 	autoScroll bool
 
+	// levelFilter names the minimum level of the displayed lines,
+	// or is empty if they aren't filtered.
+	levelFilter string
+
 	// Cached layout params from the most recent [View] call, used by
 	// navigation methods (PageUp/PageDown) to compute page boundaries
 	// without re-deriving the layout.
@@ -114,6 +118,10 @@ func (c *ConsoleLogsPane) Active() bool { return c.active }
 // SetActive sets whether the bottom bar holds keyboard focus.
 func (c *ConsoleLogsPane) SetActive(active bool) { c.active = active }
 
+// SetLevelFilter sets the minimum level shown in the header, such as
+// "warning". An empty string means lines aren't filtered.
+func (c *ConsoleLogsPane) SetLevelFilter(level string) { c.levelFilter = level }
+
 // SetExpandedHeight sets the expanded height, clamped to [ConsoleLogsPaneMinHeight].
 func (c *ConsoleLogsPane) SetExpandedHeight(h int) {
 	c.animState.SetExpanded(max(h, ConsoleLogsPaneMinHeight))
@@ -194,6 +202,13 @@ func (c *ConsoleLogsPane) HasData() bool { return len(c.logs) > 0 }
 func (c *ConsoleLogsPane) renderHeader(
 	width int, runLabel string, startIdx, endIdx, total int) string {
 	title := consoleLogsPaneHeaderStyle.Render(consoleLogsPaneHeader)
+	if c.levelFilter != "" {
+		title = lipgloss.JoinHorizontal(
+			lipgloss.Left,
+			title,
+			navInfoStyle.Render(" ("+c.levelFilter+"+)"),
+		)
+	}
 	navInfo := navInfoStyle.Render(c.buildNavigationInfo(startIdx, endIdx, total))
 
 	left := title
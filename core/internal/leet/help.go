@@ -118,6 +118,9 @@ func symonTipsEntries() []HelpEntry {
 		{Key: "Tips", Description: ""},
 		{Key: "wandb leet config", Description: "Open the interactive config editor"},
 		{Key: "SYMON", Description: "Live system monitor"},
+		{Key: "--record <file>", Description: "Save samples to a .wandb file (upload with wandb sync)"},
+		{Key: "--replay <file>", Description: "Browse a recording instead of live samples"},
+		{Key: "--hosts <a:port,b:port>", Description: "Chart machines running wandb-core symon-agent"},
 		blankLine,
 	}
 }
@@ -68,6 +68,7 @@ func concatenateHistory(messages []HistoryMsg, runPath string) HistoryMsg {
 		RunPath: runPath,
 		Metrics: make(map[string]MetricData),
 		Media:   make(map[string][]MediaPoint),
+		Tables:  make(map[string][]TableRef),
 	}
 	for _, msg := range messages {
 		for metricName, data := range msg.Metrics {
@@ -79,6 +80,9 @@ func concatenateHistory(messages []HistoryMsg, runPath string) HistoryMsg {
 		for mediaKey, points := range msg.Media {
 			h.Media[mediaKey] = append(h.Media[mediaKey], points...)
 		}
+		for tableKey, refs := range msg.Tables {
+			h.Tables[tableKey] = append(h.Tables[tableKey], refs...)
+		}
 	}
 
 	if len(h.Metrics) == 0 {
@@ -87,6 +91,9 @@ func concatenateHistory(messages []HistoryMsg, runPath string) HistoryMsg {
 	if len(h.Media) == 0 {
 		h.Media = nil
 	}
+	if len(h.Tables) == 0 {
+		h.Tables = nil
+	}
 
 	return h
 }
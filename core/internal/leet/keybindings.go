@@ -65,6 +65,16 @@ func RunKeyBindings() []BindingCategory[Run] {
 					Description: "Toggle right sidebar with system metrics",
 					Handler:     (*Run).handleToggleRightSidebar,
 				},
+				{
+					Keys:        []string{"t"},
+					Description: "Toggle process table in system metrics sidebar",
+					Handler:     (*Run).handleToggleProcessTable,
+				},
+				{
+					Keys:        []string{"T"},
+					Description: "Cycle process table sort (cpu / memory / open files / pid)",
+					Handler:     (*Run).handleCycleProcessSort,
+				},
 				{
 					Keys:        []string{"3"},
 					Description: "Toggle media pane",
@@ -75,6 +85,16 @@ func RunKeyBindings() []BindingCategory[Run] {
 					Description: "Toggle console logs panel",
 					Handler:     (*Run).handleToggleConsoleLogsPane,
 				},
+				{
+					Keys:        []string{"v"},
+					Description: "Cycle console log level filter (all / warning+ / error+)",
+					Handler:     (*Run).handleCycleConsoleLogLevel,
+				},
+				{
+					Keys:        []string{"5"},
+					Description: "Toggle tables view",
+					Handler:     (*Run).handleToggleTablePane,
+				},
 				{
 					Keys:        []string{"drag border/separator"},
 					Description: "Resize panes with the mouse",
@@ -220,6 +240,36 @@ func RunKeyBindings() []BindingCategory[Run] {
 			},
 		},
 
+		{
+			Name: "Tables (when open)",
+			Bindings: []KeyBinding[Run]{
+				{
+					Keys:        []string{"tab", "shift+tab"},
+					Description: "Next / previous table",
+				},
+				{
+					Keys:        []string{",", "."},
+					Description: "Previous / next logged step",
+				},
+				{
+					Keys:        []string{"w/s/a/d", "↑/↓/←/→"},
+					Description: "Move row / column cursor",
+				},
+				{
+					Keys:        []string{"o"},
+					Description: "Cycle sort of the selected column (asc / desc / off)",
+				},
+				{
+					Keys:        []string{"/", "ctrl+l"},
+					Description: "Filter rows by substring / clear filter",
+				},
+				{
+					Keys:        []string{"esc", "5"},
+					Description: "Close tables view",
+				},
+			},
+		},
+
 		mouseCategory[Run](),
 	}
 }
@@ -538,6 +588,26 @@ func SymonKeyBindings() []BindingCategory[Symon] {
 					Description: "Clear system metrics filter",
 					Handler:     (*Symon).handleClearSystemMetricsFilter,
 				},
+				{
+					Keys:        []string{"o"},
+					Description: "Cycle shown hosts (all / each host) with --hosts",
+					Handler:     (*Symon).handleCycleHost,
+				},
+			},
+		},
+		{
+			Name: "Processes",
+			Bindings: []KeyBinding[Symon]{
+				{
+					Keys:        []string{"t"},
+					Description: "Toggle top-like process table",
+					Handler:     (*Symon).handleToggleProcesses,
+				},
+				{
+					Keys:        []string{"T"},
+					Description: "Cycle process table sort (cpu / memory / open files / pid)",
+					Handler:     (*Symon).handleCycleProcessSort,
+				},
 			},
 		},
 		{
@@ -252,8 +252,9 @@ func ParseHistory(runPath string, history *spb.HistoryRecord) tea.Msg {
 	}
 
 	media := parseHistoryMedia(runPath, step, mediaFieldsByKey)
+	tables := parseHistoryTables(runPath, step, mediaFieldsByKey)
 
-	if len(metrics) == 0 && len(media) == 0 {
+	if len(metrics) == 0 && len(media) == 0 && len(tables) == 0 {
 		return nil
 	}
 
@@ -264,6 +265,9 @@ func ParseHistory(runPath string, history *spb.HistoryRecord) tea.Msg {
 	if len(media) > 0 {
 		msg.Media = media
 	}
+	if len(tables) > 0 {
+		msg.Tables = tables
+	}
 	return msg
 }
 
@@ -329,6 +333,34 @@ func parseHistoryMedia(
 	return media
 }
 
+// parseHistoryTables builds table series from the per-key media fields of a
+// history record.
+func parseHistoryTables(
+	runPath string,
+	step int,
+	mediaFieldsByKey map[string]map[string]string,
+) map[string][]TableRef {
+	tables := make(map[string][]TableRef)
+	for mediaKey, fields := range mediaFieldsByKey {
+		if fields["_type"] != "table-file" {
+			continue
+		}
+		relPath := fields["path"]
+		if relPath == "" {
+			continue
+		}
+		tables[mediaKey] = append(tables[mediaKey], TableRef{
+			X:            float64(step),
+			FilePath:     resolveMediaPath(runPath, relPath),
+			RelativePath: relPath,
+			NRows:        parseHistoryInt(fields["nrows"]),
+			NCols:        parseHistoryInt(fields["ncols"]),
+			SHA256:       fields["sha256"],
+		})
+	}
+	return tables
+}
+
 // parseJSONStringArray decodes a JSON array of strings, returning nil on
 // malformed input.
 func parseJSONStringArray(v string) []string {
@@ -355,7 +387,7 @@ func historyMediaField(item *spb.HistoryItem) (mediaKey, field string, ok bool)
 	field = parts[len(parts)-1]
 	switch field {
 	case "_type", "path", "caption", "format", "width", "height", "sha256", "size",
-		"count", "filenames", "captions":
+		"count", "filenames", "captions", "ncols", "nrows":
 	default:
 		return "", "", false
 	}
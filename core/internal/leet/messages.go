@@ -5,6 +5,7 @@ import (
 
 	tea "charm.land/bubbletea/v2"
 
+	"github.com/wandb/wandb/core/internal/monitor"
 	spb "github.com/wandb/wandb/core/pkg/service_go_proto"
 )
 
@@ -18,6 +19,7 @@ type HistoryMsg struct {
 	RunPath string
 	Metrics map[string]MetricData
 	Media   map[string][]MediaPoint
+	Tables  map[string][]TableRef
 }
 
 // RunMsg contains data from the wandb run record.
@@ -56,6 +58,16 @@ type StatsMsg struct {
 	RunPath   string
 	Timestamp int64              // Unix timestamp in seconds
 	Metrics   map[string]float64 // metric name -> value
+
+	// Host is the machine the sample is from in multi-host SYMON,
+	// and empty otherwise.
+	Host string
+}
+
+// ProcessSnapshotMsg carries a live snapshot of the top processes
+// sampled by SYMON.
+type ProcessSnapshotMsg struct {
+	Snapshot monitor.ProcessSnapshot
 }
 
 // ConsoleLogMsg carries a raw console output record to be assembled
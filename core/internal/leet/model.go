@@ -160,10 +160,11 @@ func (m *Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	}
 
 	// Snapshot before sub-models consume the key — a filter's Enter exits
-	// filter mode and the run's Esc clears pane focus, so checking after
-	// would miss them.
+	// filter mode and the run's Esc clears pane focus or closes the tables
+	// view, so checking after would miss them.
 	awaitingInput := m.isAwaitingUserInput()
-	runHadPaneFocus := m.mode == viewModeRun && m.run != nil && m.run.HasPaneFocus()
+	runHadPaneFocus := m.mode == viewModeRun && m.run != nil &&
+		(m.run.HasPaneFocus() || m.run.TablesOpen())
 
 	cmds := m.updateSubComponents(msg)
 
@@ -4,7 +4,6 @@ import (
 	"context"
 	"fmt"
 	"io"
-	"os"
 	"reflect"
 	"strings"
 	"sync"
@@ -12,19 +11,15 @@ import (
 
 	tea "charm.land/bubbletea/v2"
 	"github.com/Khan/genqlient/graphql"
-	"google.golang.org/protobuf/types/known/wrapperspb"
 
 	"github.com/wandb/simplejsonext"
 
-	"github.com/wandb/wandb/core/internal/api"
 	"github.com/wandb/wandb/core/internal/gql"
-	"github.com/wandb/wandb/core/internal/httplayers"
 	"github.com/wandb/wandb/core/internal/observability"
+	"github.com/wandb/wandb/core/internal/remoterun"
 	"github.com/wandb/wandb/core/internal/runhistoryreader"
 	"github.com/wandb/wandb/core/internal/runhistoryreader/parquet"
 	"github.com/wandb/wandb/core/internal/runhistoryreader/parquet/ffi"
-	"github.com/wandb/wandb/core/internal/settings"
-	"github.com/wandb/wandb/core/internal/stream"
 	spb "github.com/wandb/wandb/core/pkg/service_go_proto"
 )
 
@@ -120,43 +115,19 @@ func newParquetHistorySource(
 // ParquetHistorySource for a remote run.
 func InitializeParquetHistorySource(
 	ctx context.Context,
-	runParams *RemoteRunParams,
+	runParams *remoterun.Params,
 	logger *observability.CoreLogger,
 ) tea.Cmd {
 	return func() tea.Msg {
-		// Read the API key passed by the Python wrapper.
-		apiKey := os.Getenv("WANDB_API_KEY")
-		if apiKey == "" {
-			return ErrorMsg{Err: fmt.Errorf("WANDB_API_KEY is not set")}
+		// The API key is passed by the Python wrapper.
+		clients, err := remoterun.NewClients(runParams, logger)
+		if err != nil {
+			return ErrorMsg{Err: err}
 		}
 
-		s := settings.From(&spb.Settings{
-			ApiKey:  wrapperspb.String(apiKey),
-			BaseUrl: wrapperspb.String(runParams.BaseURL),
-		})
-		baseURL := stream.BaseURLFromSettings(logger, s)
-		credentialProvider := stream.CredentialsFromSettings(logger, s)
-
-		graphqlClient := stream.NewGraphQLClient(
-			baseURL,
-			"", /*clientID*/
-			credentialProvider,
-			logger,
-			&observability.Peeker{},
-			s,
-		)
-		httpClient := api.NewClient(api.ClientOptions{
-			RetryMax:        3,
-			RetryWaitMin:    1 * time.Second,
-			RetryWaitMax:    10 * time.Second,
-			NonRetryTimeout: 10 * time.Second,
-			Logger:          logger.Logger,
-			PreRetryLayers:  httplayers.LimitTo(baseURL, credentialProvider),
-		})
-
 		runInfo, err := loadRunInfo(
 			ctx,
-			graphqlClient,
+			clients.GraphQL,
 			runParams.Entity,
 			runParams.Project,
 			runParams.RunID,
@@ -175,8 +146,8 @@ func InitializeParquetHistorySource(
 			runInfo.entity,
 			runInfo.project,
 			runInfo.runId,
-			graphqlClient,
-			httpClient,
+			clients.GraphQL,
+			clients.HTTP,
 			[]string{}, // keys
 			false,      // useCache
 			rustArrowWrapper,
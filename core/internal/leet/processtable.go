@@ -0,0 +1,225 @@
+package leet
+
+import (
+	"bytes"
+	"cmp"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"slices"
+	"strings"
+
+	"charm.land/lipgloss/v2"
+
+	"github.com/wandb/wandb/core/internal/monitor"
+)
+
+// processSnapshotTailBytes bounds how much of a processes file is read to
+// find its last snapshot.
+const processSnapshotTailBytes = 1 << 20
+
+// processSortColumn is the column a process table is sorted by.
+type processSortColumn int
+
+const (
+	processSortCPU processSortColumn = iota
+	processSortMemory
+	processSortFDs
+	processSortPid
+	processSortColumnCount
+)
+
+func (c processSortColumn) String() string {
+	switch c {
+	case processSortMemory:
+		return "memory"
+	case processSortFDs:
+		return "open files"
+	case processSortPid:
+		return "pid"
+	default:
+		return "cpu"
+	}
+}
+
+// processTableColumn describes a fixed-width numeric column.
+type processTableColumn struct {
+	title string
+	width int
+	sort  processSortColumn
+	value func(monitor.ProcessInfo) string
+}
+
+var processTableColumns = []processTableColumn{
+	{"PID", 8, processSortPid, func(p monitor.ProcessInfo) string {
+		return fmt.Sprint(p.Pid)
+	}},
+	{"CPU%", 7, processSortCPU, func(p monitor.ProcessInfo) string {
+		return fmt.Sprintf("%.1f", p.CPUPercent)
+	}},
+	{"RSS MB", 9, processSortMemory, func(p monitor.ProcessInfo) string {
+		return fmt.Sprintf("%.1f", p.RSSMB)
+	}},
+	{"THR", 5, -1, func(p monitor.ProcessInfo) string {
+		return fmt.Sprint(p.Threads)
+	}},
+	{"FDS", 6, processSortFDs, func(p monitor.ProcessInfo) string {
+		return fmt.Sprint(p.OpenFDs)
+	}},
+}
+
+// ProcessTableView renders a top-like table of per-process resource usage.
+//
+// It is shared by SYMON, which samples processes live, and the run view's
+// system metrics sidebar, which shows the run's latest logged snapshot.
+type ProcessTableView struct {
+	snapshot monitor.ProcessSnapshot
+	hasData  bool
+	sortBy   processSortColumn
+}
+
+func NewProcessTableView() *ProcessTableView {
+	return &ProcessTableView{}
+}
+
+// HasData reports whether a snapshot was set.
+func (v *ProcessTableView) HasData() bool { return v.hasData }
+
+// SetSnapshot replaces the displayed processes.
+func (v *ProcessTableView) SetSnapshot(snapshot monitor.ProcessSnapshot) {
+	v.snapshot = snapshot
+	v.hasData = true
+}
+
+// CycleSort switches to sorting by the next column.
+func (v *ProcessTableView) CycleSort() {
+	v.sortBy = (v.sortBy + 1) % processSortColumnCount
+}
+
+// SortLabel describes the current sort column.
+func (v *ProcessTableView) SortLabel() string {
+	return "sorted by " + v.sortBy.String()
+}
+
+// View renders the table within the given dimensions.
+//
+// emptyHint is shown until a snapshot is set.
+func (v *ProcessTableView) View(width, height int, emptyHint string) string {
+	if width <= 0 || height <= 0 {
+		return ""
+	}
+
+	if !v.hasData {
+		return lipgloss.Place(width, height, lipgloss.Left, lipgloss.Top,
+			navInfoStyle.Render(emptyHint))
+	}
+
+	lines := make([]string, 0, height)
+	lines = append(lines, v.renderHeader(width))
+	for _, p := range v.sortedProcesses() {
+		if len(lines) >= height {
+			break
+		}
+		lines = append(lines, v.renderRow(p, width))
+	}
+
+	return lipgloss.Place(width, height, lipgloss.Left, lipgloss.Top,
+		strings.Join(lines, "\n"))
+}
+
+func (v *ProcessTableView) renderHeader(width int) string {
+	var sb strings.Builder
+	for _, col := range processTableColumns {
+		style := tableColumnHeaderStyle
+		if col.sort == v.sortBy {
+			style = tableSelectedColumnHeaderStyle
+		}
+		sb.WriteString(style.Render(fmt.Sprintf("%*s", col.width, col.title)))
+	}
+	sb.WriteString(tableColumnHeaderStyle.Render("  COMMAND"))
+	return lipgloss.NewStyle().MaxWidth(width).Render(sb.String())
+}
+
+func (v *ProcessTableView) renderRow(p monitor.ProcessInfo, width int) string {
+	var sb strings.Builder
+	for _, col := range processTableColumns {
+		fmt.Fprintf(&sb, "%*s", col.width, col.value(p))
+	}
+
+	numbers := sb.String()
+	commandWidth := width - lipgloss.Width(numbers) - 2
+	if commandWidth <= 0 {
+		return tableCellStyle.Render(truncateValue(numbers, width))
+	}
+
+	return tableCellStyle.Render(
+		numbers + "  " + truncateValue(p.Cmdline, commandWidth))
+}
+
+// sortedProcesses returns the snapshot's processes in display order.
+func (v *ProcessTableView) sortedProcesses() []monitor.ProcessInfo {
+	procs := slices.Clone(v.snapshot.Processes)
+	slices.SortStableFunc(procs, func(a, b monitor.ProcessInfo) int {
+		switch v.sortBy {
+		case processSortMemory:
+			return cmp.Compare(b.RSSMB, a.RSSMB)
+		case processSortFDs:
+			return cmp.Compare(b.OpenFDs, a.OpenFDs)
+		case processSortPid:
+			return cmp.Compare(a.Pid, b.Pid)
+		default:
+			return cmp.Compare(b.CPUPercent, a.CPUPercent)
+		}
+	})
+	return procs
+}
+
+// renderProcessTableHeader renders the header shown above a process table
+// in place of the system metrics header.
+func renderProcessTableHeader(contentWidth int, view *ProcessTableView) string {
+	title := headerStyle.Render("Processes")
+	info := navInfoStyle.Render(" [" + view.SortLabel() + "]")
+	return lipgloss.NewStyle().MaxWidth(contentWidth).Render(
+		lipgloss.JoinHorizontal(lipgloss.Left, title, info))
+}
+
+// readLatestProcessSnapshot reads the last complete snapshot in a
+// processes file written by the system monitor.
+func readLatestProcessSnapshot(path string) (monitor.ProcessSnapshot, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return monitor.ProcessSnapshot{}, err
+	}
+	defer func() { _ = file.Close() }()
+
+	info, err := file.Stat()
+	if err != nil {
+		return monitor.ProcessSnapshot{}, err
+	}
+	offset := max(info.Size()-processSnapshotTailBytes, 0)
+	if _, err := file.Seek(offset, io.SeekStart); err != nil {
+		return monitor.ProcessSnapshot{}, err
+	}
+	tail, err := io.ReadAll(file)
+	if err != nil {
+		return monitor.ProcessSnapshot{}, err
+	}
+
+	// The writer may be in the middle of appending a line, so only
+	// newline-terminated lines are complete.
+	end := bytes.LastIndexByte(tail, '\n')
+	if end < 0 {
+		return monitor.ProcessSnapshot{}, fmt.Errorf("leet: no complete snapshot in %s", path)
+	}
+	start := bytes.LastIndexByte(tail[:end], '\n') + 1
+	if start == 0 && offset > 0 {
+		return monitor.ProcessSnapshot{}, fmt.Errorf("leet: snapshot too large in %s", path)
+	}
+
+	var snapshot monitor.ProcessSnapshot
+	if err := json.Unmarshal(tail[start:end], &snapshot); err != nil {
+		return monitor.ProcessSnapshot{}, err
+	}
+	return snapshot, nil
+}
@@ -0,0 +1,114 @@
+package leet_test
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	tea "charm.land/bubbletea/v2"
+	"github.com/stretchr/testify/require"
+
+	"github.com/wandb/wandb/core/internal/leet"
+	"github.com/wandb/wandb/core/internal/monitor"
+	"github.com/wandb/wandb/core/internal/observability"
+)
+
+func testProcessSnapshot() monitor.ProcessSnapshot {
+	return monitor.ProcessSnapshot{
+		Timestamp: 1000,
+		Processes: []monitor.ProcessInfo{
+			{Pid: 10, Cmdline: "python train.py", RSSMB: 100, CPUPercent: 90, OpenFDs: 5},
+			{Pid: 20, Cmdline: "dataloader", RSSMB: 900, CPUPercent: 10, OpenFDs: 50},
+		},
+	}
+}
+
+// firstRowIndex returns the index in view of the first line containing s.
+func firstRowIndex(view, s string) int {
+	for i, line := range strings.Split(view, "\n") {
+		if strings.Contains(line, s) {
+			return i
+		}
+	}
+	return -1
+}
+
+func TestProcessTableView_CycleSort(t *testing.T) {
+	v := leet.NewProcessTableView()
+	require.Contains(t, v.View(80, 5, "waiting"), "waiting")
+
+	v.SetSnapshot(testProcessSnapshot())
+
+	view := v.View(80, 5, "waiting")
+	require.Contains(t, view, "COMMAND")
+	require.Less(t, firstRowIndex(view, "train.py"), firstRowIndex(view, "dataloader"))
+	require.Equal(t, "sorted by cpu", v.SortLabel())
+
+	v.CycleSort()
+	view = v.View(80, 5, "waiting")
+	require.Equal(t, "sorted by memory", v.SortLabel())
+	require.Less(t, firstRowIndex(view, "dataloader"), firstRowIndex(view, "train.py"))
+}
+
+func TestProcessTableView_ClipsToHeight(t *testing.T) {
+	v := leet.NewProcessTableView()
+	v.SetSnapshot(testProcessSnapshot())
+
+	view := v.View(80, 2, "")
+
+	require.Contains(t, view, "train.py")
+	require.NotContains(t, view, "dataloader")
+}
+
+func TestRightSidebar_ShowsLatestProcessSnapshot(t *testing.T) {
+	logger := observability.NewNoOpLogger()
+	cfg := leet.NewConfigManager(filepath.Join(t.TempDir(), "config.json"), logger)
+	_, _ = cfg.SetLeftSidebarVisible(false), cfg.SetRightSidebarVisible(false)
+
+	old := monitor.ProcessSnapshot{
+		Processes: []monitor.ProcessInfo{{Pid: 1, Cmdline: "old-process"}},
+	}
+	oldLine, err := json.Marshal(old)
+	require.NoError(t, err)
+	latestLine, err := json.Marshal(testProcessSnapshot())
+	require.NoError(t, err)
+	path := filepath.Join(t.TempDir(), monitor.ProcessesFileName)
+	content := string(oldLine) + "\n" + string(latestLine) + "\n" + `{"partial`
+	require.NoError(t, os.WriteFile(path, []byte(content), 0o644))
+
+	rs := leet.NewRightSidebar(cfg, &leet.Focus{}, logger)
+	rs.SetProcessesFile(path)
+	expandRightSidebar(t, rs, 240, false)
+
+	rs.ToggleProcesses()
+	view := rs.View(20)
+
+	require.True(t, rs.IsShowingProcesses())
+	require.Contains(t, view, "Processes")
+	require.Contains(t, view, "train.py")
+	require.NotContains(t, view, "old-process")
+}
+
+func TestSymon_ToggleProcessTable(t *testing.T) {
+	logger := observability.NewNoOpLogger()
+	cfg := leet.NewConfigManager(filepath.Join(t.TempDir(), "config.json"), logger)
+
+	s := leet.NewSymon(leet.SymonParams{Config: cfg, Logger: logger})
+	defer s.Cleanup()
+	_, _ = s.Update(tea.WindowSizeMsg{Width: 160, Height: 40})
+
+	_, cmd := s.Update(tea.KeyPressMsg{Code: 't', Text: "t"})
+	require.NotNil(t, cmd, "toggling the table on should sample processes")
+	require.Contains(t, s.View().Content, "Collecting processes...")
+
+	_, _ = s.Update(leet.ProcessSnapshotMsg{Snapshot: testProcessSnapshot()})
+	require.Contains(t, s.View().Content, "train.py")
+
+	_, _ = s.Update(tea.KeyPressMsg{Code: 'T', Text: "T"})
+	require.Contains(t, s.View().Content, "sorted by memory")
+
+	_, _ = s.Update(tea.KeyPressMsg{Code: 't', Text: "t"})
+	require.NotContains(t, s.View().Content, "train.py")
+}
@@ -11,8 +11,9 @@ import (
 )
 
 const (
-	rightSidebarHeader      = "System Metrics"
-	rightSidebarHeaderLines = 1
+	rightSidebarHeader          = "System Metrics"
+	rightSidebarProcessesHeader = "Processes"
+	rightSidebarHeaderLines     = 1
 	// rightSidebarGridXOffset is the X offset from the sidebar's left edge
 	// to the start of the grid content (border + left padding).
 	rightSidebarGridXOffset = SidebarBorderCols + ContentPadding
@@ -25,6 +26,15 @@ type RightSidebar struct {
 	metricsGrid *SystemMetricsGrid
 	focusState  *Focus
 	logger      *observability.CoreLogger
+
+	// processes is the run's latest process snapshot, shown instead of
+	// the metrics grid while showProcesses is set.
+	processes     *ProcessTableView
+	showProcesses bool
+
+	// processesFile is the run file the system monitor appends process
+	// snapshots to, or empty if unknown.
+	processesFile string
 }
 
 func NewRightSidebar(
@@ -43,6 +53,7 @@ func NewRightSidebar(
 			initW, initH, config, config.SystemGrid, focusState, NewFilter(), logger),
 		logger:     logger,
 		focusState: focusState,
+		processes:  NewProcessTableView(),
 	}
 }
 
@@ -66,6 +77,46 @@ func (rs *RightSidebar) Toggle() {
 	rs.animState.Toggle()
 }
 
+// SetProcessesFile sets the file to read process snapshots from.
+func (rs *RightSidebar) SetProcessesFile(path string) {
+	rs.processesFile = path
+}
+
+// ToggleProcesses switches between the metrics grid and the process table.
+func (rs *RightSidebar) ToggleProcesses() {
+	rs.showProcesses = !rs.showProcesses
+	if rs.showProcesses {
+		rs.refreshProcesses()
+	}
+}
+
+// IsShowingProcesses returns true if the process table is shown.
+func (rs *RightSidebar) IsShowingProcesses() bool {
+	return rs.showProcesses
+}
+
+// CycleProcessSort switches the process table to the next sort column.
+func (rs *RightSidebar) CycleProcessSort() {
+	rs.processes.CycleSort()
+}
+
+// refreshProcesses reloads the latest process snapshot from the run files.
+func (rs *RightSidebar) refreshProcesses() {
+	if rs.processesFile == "" {
+		return
+	}
+
+	snapshot, err := readLatestProcessSnapshot(rs.processesFile)
+	if err != nil {
+		// The file only exists if the run was started with
+		// x_stats_top_processes, and may not have a snapshot yet.
+		rs.logger.Debug(fmt.Sprintf(
+			"rightsidebar: refreshProcesses: %v", err))
+		return
+	}
+	rs.processes.SetSnapshot(snapshot)
+}
+
 type systemGridMouseTarget struct {
 	adjustedX int
 	adjustedY int
@@ -75,7 +126,7 @@ type systemGridMouseTarget struct {
 }
 
 func (rs *RightSidebar) gridMouseTarget(x, y int) (systemGridMouseTarget, bool) {
-	if !rs.animState.IsVisible() {
+	if !rs.animState.IsVisible() || rs.showProcesses {
 		return systemGridMouseTarget{}, false
 	}
 
@@ -208,7 +259,14 @@ func (rs *RightSidebar) View(height int) string {
 		lipgloss.Top,
 		rs.renderHeader(),
 	)
-	body := lipgloss.JoinVertical(lipgloss.Left, head, rs.metricsGrid.View())
+	var content string
+	if rs.showProcesses {
+		content = rs.processes.View(contentW, gridHeight,
+			"No process snapshots. Set x_stats_top_processes to log them.")
+	} else {
+		content = rs.metricsGrid.View()
+	}
+	body := lipgloss.JoinVertical(lipgloss.Left, head, content)
 	styled := rightSidebarStyle.
 		Width(innerW).
 		MaxWidth(innerW).
@@ -259,6 +317,10 @@ func (rs *RightSidebar) ProcessStatsMsg(msg StatsMsg) {
 		len(msg.Metrics), rs.animState, rs.animState.Value()))
 
 	rs.metricsGrid.ProcessStats(msg)
+
+	if rs.showProcesses {
+		rs.refreshProcesses()
+	}
 }
 
 // calculateGridHeight returns the available height for the metrics grid.
@@ -268,6 +330,14 @@ func (rs *RightSidebar) calculateGridHeight(sidebarHeight int) int {
 
 // renderHeader renders the header line with title and navigation info.
 func (rs *RightSidebar) renderHeader() string {
+	if rs.showProcesses {
+		return lipgloss.JoinHorizontal(
+			lipgloss.Left,
+			rightSidebarHeaderStyle.Render(rightSidebarProcessesHeader),
+			navInfoStyle.Render(" ["+rs.processes.SortLabel()+"]"),
+		)
+	}
+
 	header := rightSidebarHeaderStyle.Render(rightSidebarHeader)
 
 	// Add navigation info if we have multiple pages.
@@ -3,6 +3,7 @@ package leet
 import (
 	"context"
 	"fmt"
+	"path/filepath"
 	"runtime/debug"
 	"strings"
 	"sync"
@@ -13,7 +14,9 @@ import (
 	"charm.land/lipgloss/v2"
 	"github.com/NimbleMarkets/ntcharts/v2/picture"
 
+	"github.com/wandb/wandb/core/internal/monitor"
 	"github.com/wandb/wandb/core/internal/observability"
+	"github.com/wandb/wandb/core/internal/remoterun"
 )
 
 // RunParams identifies the run LEET displays.
@@ -24,17 +27,7 @@ type RunParams struct {
 	RunFile string
 
 	// Remote identifies a run stored on a W&B server.
-	Remote *RemoteRunParams
-}
-
-// RemoteRunParams identifies a run stored on a W&B server.
-type RemoteRunParams struct {
-	// BaseURL is the W&B API base URL (e.g. https://api.wandb.ai).
-	BaseURL string
-
-	Entity  string
-	Project string
-	RunID   string
+	Remote *remoterun.Params
 }
 
 // Run holds data/state related to a single W&B run.
@@ -105,6 +98,8 @@ type Run struct {
 	consoleLogsPane      *ConsoleLogsPane
 	mediaStore           *MediaStore
 	mediaPane            *MediaPane
+	tableStore           *TableStore
+	tablePane            *TablePane
 
 	// Sidebar animation synchronization.
 	animationMu sync.Mutex
@@ -153,6 +148,12 @@ func NewRun(
 	metricsGrid.SetSingleSeriesColorMode(cfg.SingleRunColorMode())
 
 	mediaStore := NewMediaStore()
+	tableStore := NewTableStore()
+
+	var runFile string
+	if runParams != nil {
+		runFile = runParams.RunFile
+	}
 
 	run := &Run{
 		config:               cfg,
@@ -169,10 +170,16 @@ func NewRun(
 		consoleLogsPane:      NewConsoleLogsPane(consoleLogsPaneAnimState),
 		mediaStore:           mediaStore,
 		mediaPane:            NewMediaPane(mediaPaneAnimState, cfg.MediaGrid),
+		tableStore:           tableStore,
+		tablePane:            NewTablePane(tableStore, runFile),
 		watcherMgr:           NewWatcherManager(ch, logger),
 		heartbeatMgr:         NewHeartbeatManager(heartbeatInterval, ch, logger),
 		logger:               logger,
 	}
+	if runFile != "" {
+		run.rightSidebar.SetProcessesFile(filepath.Join(
+			filepath.Dir(runFile), "files", monitor.ProcessesFileName))
+	}
 	run.focusMgr = run.buildRunFocusManager()
 	run.drag = paneDragger{
 		saved:    cfg.RunLayout,
@@ -249,6 +256,18 @@ func (r *Run) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		}
 		return r, r.mediaPane.handlePrepareMsg()
 
+	case tablePaneLoadedMsg:
+		if t.pane != r.tablePane {
+			return r, nil
+		}
+		return r, tea.Batch(r.tablePane.HandleLoaded(t), r.tablePane.LoadCmd())
+
+	case tablePaneRetryMsg:
+		if t.pane != r.tablePane {
+			return r, nil
+		}
+		return r, r.tablePane.LoadCmd()
+
 	case tea.KeyPressMsg:
 		if c := r.handleKeyPressMsg(t); c != nil {
 			cmds = append(cmds, c)
@@ -267,6 +286,8 @@ func (r *Run) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 
 	default:
 		cmds = append(cmds, r.dispatch(msg)...)
+		// New history may add a step for the table being followed.
+		cmds = append(cmds, r.tablePane.LoadCmd())
 		return r, tea.Batch(cmds...)
 	}
 }
@@ -390,7 +411,10 @@ func (r *Run) renderMainView() string {
 
 	w := layout.mainContentAreaWidth
 	centralColumn := ""
-	if r.mediaPane.IsFullscreen() {
+	if r.tablePane.IsOpen() {
+		r.mediaPane.Park()
+		centralColumn = r.tablePane.View(w, layout.totalContentAreaHeight)
+	} else if r.mediaPane.IsFullscreen() {
 		centralColumn = r.mediaPane.View(w, layout.totalContentAreaHeight, "", "")
 	} else {
 		var sections []string
@@ -537,6 +561,10 @@ func (r *Run) renderStatusBar() string {
 
 // buildStatusText builds the main status text.
 func (r *Run) buildStatusText() string {
+	if r.tablePane.IsFilterMode() {
+		return fmt.Sprintf("Table filter: %s%s (Enter to apply • Esc to cancel)",
+			r.tablePane.FilterQuery(), string(mediumShadeBlock))
+	}
 	if r.leftSidebar.IsFilterMode() {
 		return r.buildOverviewFilterStatus()
 	}
@@ -686,7 +714,8 @@ func (r *Run) buildActiveStatus() string {
 
 // buildHelpText builds the help text for the status bar.
 func (r *Run) buildHelpText() string {
-	if r.metricsGrid.IsFilterMode() ||
+	if r.tablePane.IsFilterMode() ||
+		r.metricsGrid.IsFilterMode() ||
 		r.leftSidebar.IsFilterMode() ||
 		r.rightSidebar.IsFilterMode() {
 		return ""
@@ -695,7 +724,8 @@ func (r *Run) buildHelpText() string {
 }
 
 func (r *Run) IsFiltering() bool {
-	return r.metricsGrid.IsFilterMode() ||
+	return r.tablePane.IsFilterMode() ||
+		r.metricsGrid.IsFilterMode() ||
 		r.leftSidebar.IsFilterMode() ||
 		r.rightSidebar.IsFilterMode()
 }
@@ -706,6 +736,13 @@ func (r *Run) MediaFullscreen() bool {
 	return r.mediaPane != nil && r.mediaPane.IsFullscreen()
 }
 
+// TablesOpen reports whether the tables view has taken over the main column.
+func (r *Run) TablesOpen() bool {
+	r.stateMu.RLock()
+	defer r.stateMu.RUnlock()
+	return r.tablePane != nil && r.tablePane.IsOpen()
+}
+
 func (r *Run) updateBottomPaneHeights(mediaVisible, logsVisible bool) {
 	metricsVisible := r.metricsGridAnimState.TargetVisible()
 
@@ -15,6 +15,7 @@ import (
 
 	"github.com/wandb/wandb/core/internal/leet"
 	"github.com/wandb/wandb/core/internal/observability"
+	"github.com/wandb/wandb/core/internal/remoterun"
 	"github.com/wandb/wandb/core/internal/transactionlog"
 	spb "github.com/wandb/wandb/core/pkg/service_go_proto"
 )
@@ -76,7 +77,7 @@ func TestRemoteRun_DoesNotStartLocalWatcherAfterBootLoad(t *testing.T) {
 	logger := observability.NewNoOpLogger()
 	cfg := leet.NewConfigManager(filepath.Join(t.TempDir(), "config.json"), logger)
 	runParams := &leet.RunParams{
-		Remote: &leet.RemoteRunParams{
+		Remote: &remoterun.Params{
 			BaseURL: "https://api.wandb.ai",
 			Entity:  "entity",
 			Project: "project",
@@ -4,6 +4,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/wandb/wandb/core/internal/logparse"
 	"github.com/wandb/wandb/core/internal/terminalemulator"
 )
 
@@ -30,6 +31,18 @@ type ConsoleLogLine struct {
 	Timestamp time.Time
 	Content   string
 	IsStderr  bool
+
+	// level is the line's parsed log level, valid if levelParsed is set.
+	level       logparse.Level
+	levelParsed bool
+}
+
+// consoleLevelFilters are the minimum levels cycled through by
+// [RunConsoleLogs.CycleMinLevel], starting with no filter.
+var consoleLevelFilters = []logparse.Level{
+	logparse.LevelUnknown,
+	logparse.LevelWarning,
+	logparse.LevelError,
 }
 
 // RunConsoleLogs assembles raw output_raw records into display-ready lines.
@@ -58,6 +71,11 @@ type RunConsoleLogs struct {
 	// It is updated incrementally so View does not need to reformat every line on
 	// every render.
 	items []KeyValuePair
+
+	// minLevel hides lines that aren't log lines of at least this level.
+	//
+	// LevelUnknown disables the filter.
+	minLevel logparse.Level
 }
 
 // NewRunConsoleLogs creates an empty console log store with terminal
@@ -93,10 +111,56 @@ func (cl *RunConsoleLogs) ProcessRaw(text string, isStderr bool, ts time.Time) {
 	}
 }
 
+// MinLevel returns the minimum log level of displayed lines,
+// or LevelUnknown if lines aren't filtered.
+func (cl *RunConsoleLogs) MinLevel() logparse.Level { return cl.minLevel }
+
+// CycleMinLevel switches to the next level filter: all lines,
+// warnings and above, then errors and above.
+func (cl *RunConsoleLogs) CycleMinLevel() {
+	for i, level := range consoleLevelFilters {
+		if level == cl.minLevel {
+			cl.minLevel = consoleLevelFilters[(i+1)%len(consoleLevelFilters)]
+			return
+		}
+	}
+	cl.minLevel = logparse.LevelUnknown
+}
+
 // Items returns the assembled lines in [KeyValuePair] form.
 //
+// If a level filter is set, only log lines at or above that level are
+// included; their level is detected by trying every known log format.
+//
 // Callers must treat the returned slice as read-only.
 func (cl *RunConsoleLogs) Items() []KeyValuePair {
+	items := cl.allItems()
+	if cl.minLevel == logparse.LevelUnknown {
+		return items
+	}
+
+	var filtered []KeyValuePair
+	for i := range cl.lines {
+		if cl.lineLevel(i) >= cl.minLevel {
+			filtered = append(filtered, items[i])
+		}
+	}
+	return filtered
+}
+
+// lineLevel returns the log level of a line, parsing it if it changed.
+func (cl *RunConsoleLogs) lineLevel(idx int) logparse.Level {
+	line := &cl.lines[idx]
+	if !line.levelParsed {
+		entry, _ := logparse.All(line.Content)
+		line.level = entry.Level
+		line.levelParsed = true
+	}
+	return line.level
+}
+
+// allItems returns every assembled line in [KeyValuePair] form.
+func (cl *RunConsoleLogs) allItems() []KeyValuePair {
 	if len(cl.items) == len(cl.lines) {
 		return cl.items
 	}
@@ -136,6 +200,7 @@ func (cl *RunConsoleLogs) onLineChanged(idx int, content []rune) {
 	}
 	value := strings.TrimRight(string(content), " \t")
 	cl.lines[idx].Content = value
+	cl.lines[idx].levelParsed = false
 	if idx < len(cl.items) {
 		cl.items[idx].Value = value
 	}
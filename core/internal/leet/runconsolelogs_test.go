@@ -8,6 +8,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"github.com/wandb/wandb/core/internal/leet"
+	"github.com/wandb/wandb/core/internal/logparse"
 )
 
 func findKV(items []leet.KeyValuePair, valueSubstr string) (leet.KeyValuePair, int, bool) {
@@ -51,3 +52,37 @@ func TestRunConsoleLogs_AssemblesAcrossCallsAndPreservesTimestamps(t *testing.T)
 
 	require.Less(t, i1, i2, "expected log lines to preserve arrival order")
 }
+
+func TestRunConsoleLogs_CycleMinLevelFiltersLines(t *testing.T) {
+	cl := leet.NewRunConsoleLogs()
+	ts := time.Date(2026, time.February, 18, 10, 11, 12, 0, time.UTC)
+
+	cl.ProcessRaw("epoch 1\n", false, ts)
+	cl.ProcessRaw("WARNING:trainer:lr is high\n", false, ts)
+	cl.ProcessRaw(`{"level":"error","msg":"nan loss"}`+"\n", false, ts)
+
+	values := func() []string {
+		var result []string
+		for _, item := range cl.Items() {
+			if item.Value != "" {
+				result = append(result, item.Value)
+			}
+		}
+		return result
+	}
+
+	require.Len(t, values(), 3)
+
+	cl.CycleMinLevel()
+	require.Equal(t, logparse.LevelWarning, cl.MinLevel())
+	require.Equal(t,
+		[]string{"WARNING:trainer:lr is high", `{"level":"error","msg":"nan loss"}`},
+		values())
+
+	cl.CycleMinLevel()
+	require.Equal(t, []string{`{"level":"error","msg":"nan loss"}`}, values())
+
+	cl.CycleMinLevel()
+	require.Equal(t, logparse.LevelUnknown, cl.MinLevel())
+	require.Len(t, values(), 3)
+}
@@ -121,6 +121,7 @@ func (r *Run) handleHistoryMsg(msg HistoryMsg) {
 	if r.mediaStore.ProcessHistory(msg) {
 		r.mediaPane.SetStore(r.mediaStore)
 	}
+	r.tableStore.ProcessHistory(msg)
 	if shouldDraw && !r.suppressDraw {
 		r.metricsGrid.drawVisible()
 	}
@@ -132,6 +133,11 @@ func (r *Run) handleMouseMsg(msg tea.MouseMsg) tea.Cmd {
 
 	layout := r.computeViewports()
 
+	// The tables view owns the whole main column while open.
+	if r.tablePane.IsOpen() {
+		return r.handleTablePaneMouse(msg)
+	}
+
 	// Pane resizing wins over pane-local mouse handling.
 	if r.drag.handleMouse(msg, layout, r.dragTargets()) {
 		return nil
@@ -331,6 +337,11 @@ func (r *Run) handleMainContentMouse(msg tea.MouseMsg, layout Layout) tea.Cmd {
 
 // handleKeyPressMsg processes keyboard events using the centralized key bindings.
 func (r *Run) handleKeyPressMsg(msg tea.KeyPressMsg) tea.Cmd {
+	// The tables view keeps keys local while open.
+	if handled, cmd := r.tablePane.HandleKey(msg); handled {
+		return cmd
+	}
+
 	// Filter modes take priority.
 	if r.leftSidebar.IsFilterMode() {
 		r.leftSidebar.HandleFilterKey(msg)
@@ -463,6 +474,21 @@ func (r *Run) handleToggleRightSidebar(msg tea.KeyPressMsg) tea.Cmd {
 	return r.rightSidebar.animationCmd()
 }
 
+func (r *Run) handleToggleProcessTable(msg tea.KeyPressMsg) tea.Cmd {
+	r.rightSidebar.ToggleProcesses()
+
+	// Reveal the sidebar so that toggling the table on has a visible effect.
+	if r.rightSidebar.IsShowingProcesses() && !r.rightSidebar.animState.TargetVisible() {
+		return r.handleToggleRightSidebar(msg)
+	}
+	return nil
+}
+
+func (r *Run) handleCycleProcessSort(msg tea.KeyPressMsg) tea.Cmd {
+	r.rightSidebar.CycleProcessSort()
+	return nil
+}
+
 func (r *Run) handlePrevPage(msg tea.KeyPressMsg) tea.Cmd {
 	switch r.focusMgr.Current() {
 	case FocusTargetMetricsGrid:
@@ -807,6 +833,30 @@ func (r *Run) mediaPaneAnimationCmd() tea.Cmd {
 	})
 }
 
+// handleToggleTablePane opens or closes the tables view.
+func (r *Run) handleToggleTablePane(msg tea.KeyPressMsg) tea.Cmd {
+	if !r.tablePane.IsOpen() {
+		r.mediaPane.ExitFullscreen()
+	}
+	r.tablePane.Toggle()
+	return r.tablePane.LoadCmd()
+}
+
+// handleTablePaneMouse scrolls the tables view with the mouse wheel.
+func (r *Run) handleTablePaneMouse(msg tea.MouseMsg) tea.Cmd {
+	wheel, ok := msg.(tea.MouseWheelMsg)
+	if !ok {
+		return nil
+	}
+	switch wheel.Button {
+	case tea.MouseWheelUp:
+		r.tablePane.MoveRow(-1)
+	case tea.MouseWheelDown:
+		r.tablePane.MoveRow(1)
+	}
+	return nil
+}
+
 // handleToggleConsoleLogsPane toggles the console logs bottom bar and
 // resolves focus so a collapsing bar loses focus.
 func (r *Run) handleToggleConsoleLogsPane(msg tea.KeyPressMsg) tea.Cmd {
@@ -830,6 +880,17 @@ func (r *Run) handleToggleConsoleLogsPane(msg tea.KeyPressMsg) tea.Cmd {
 	return r.consoleLogsPaneAnimationCmd()
 }
 
+// handleCycleConsoleLogLevel cycles the minimum level of the lines shown
+// in the console logs pane.
+func (r *Run) handleCycleConsoleLogLevel(msg tea.KeyPressMsg) tea.Cmd {
+	r.consoleLogs.CycleMinLevel()
+	r.consoleLogsPane.SetLevelFilter(r.consoleLogs.MinLevel().String())
+	r.consoleLogsPane.ScrollToEnd()
+	r.consoleLogsPane.SetConsoleLogs(r.consoleLogs.Items())
+	r.focusMgr.Resolve()
+	return nil
+}
+
 func (r *Run) handleConsoleLogsPaneAnimation() []tea.Cmd {
 	r.consoleLogsPane.Update(time.Now())
 
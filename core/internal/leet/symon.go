@@ -3,6 +3,7 @@ package leet
 import (
 	"context"
 	"fmt"
+	"net/http"
 	"strconv"
 	"strings"
 	"time"
@@ -29,6 +30,17 @@ type SymonParams struct {
 
 	// Logger receives debug logs and captured errors. Nil uses a no-op logger.
 	Logger *observability.CoreLogger
+
+	// Recorder, if set, persists every sample taken.
+	Recorder *SymonRecorder
+
+	// Replay, if not nil, puts SYMON in replay mode: it shows these
+	// previously recorded samples instead of sampling the system.
+	Replay []StatsMsg
+
+	// Hosts, if not empty, puts SYMON in multi-host mode: it polls these
+	// agents every SamplingInterval instead of sampling the local system.
+	Hosts []SymonHost
 }
 
 // Symon is a standalone full-screen system metrics monitor.
@@ -52,6 +64,27 @@ type Symon struct {
 	sampler *SymonSampler
 	logger  *observability.CoreLogger
 
+	// processes is the top-like process table shown instead of the chart
+	// grid while showProcesses is set.
+	processes     *ProcessTableView
+	showProcesses bool
+
+	// recorder persists samples while recording, and is nil otherwise.
+	recorder *SymonRecorder
+
+	// replaying is set in replay mode, where there is no sampler.
+	replaying bool
+
+	// hosts are the polled agents in multi-host mode, where there is
+	// no sampler.
+	hosts        []*symonHostState
+	httpClient   *http.Client
+	pollInterval time.Duration
+
+	// selectedHost is the index of the only host shown, or -1 to show
+	// all hosts.
+	selectedHost int
+
 	shouldRestart bool
 }
 
@@ -67,37 +100,71 @@ func NewSymon(params SymonParams) *Symon {
 	}
 
 	ctx, cancel := context.WithCancel(context.Background())
-	focus := NewFocus()
-	rows, cols := cfg.SymonGrid()
 	help := NewHelp()
 	help.SetMode(viewModeSymon)
 
-	return &Symon{
-		ctx:    ctx,
-		cancel: cancel,
-		config: cfg,
-		keyMap: buildKeyMap(SymonKeyBindings()),
-		focus:  focus,
-		grid: NewSystemMetricsGrid(
-			MinMetricChartWidth*cols,
-			MinMetricChartHeight*rows,
-			cfg,
-			cfg.SymonGrid,
-			focus,
-			NewFilter(),
-			logger,
-		),
-		help: help,
-		sampler: NewSymonSampler(SymonSamplerParams{
+	symon := &Symon{
+		ctx:          ctx,
+		cancel:       cancel,
+		config:       cfg,
+		keyMap:       buildKeyMap(SymonKeyBindings()),
+		focus:        NewFocus(),
+		help:         help,
+		logger:       logger,
+		processes:    NewProcessTableView(),
+		recorder:     params.Recorder,
+		replaying:    params.Replay != nil,
+		selectedHost: -1,
+	}
+	symon.grid = symon.newGrid(NewFilter())
+
+	switch {
+	case symon.replaying:
+		for _, msg := range params.Replay {
+			symon.grid.ProcessStats(msg)
+		}
+	case len(params.Hosts) > 0:
+		for _, host := range params.Hosts {
+			symon.hosts = append(symon.hosts,
+				&symonHostState{SymonHost: host, label: host.Name})
+		}
+		symon.httpClient = &http.Client{Timeout: symonHostRequestTimeout}
+		symon.pollInterval = params.SamplingInterval
+		if symon.pollInterval <= 0 {
+			symon.pollInterval = DefaultSymonSamplingInterval
+		}
+	default:
+		symon.sampler = NewSymonSampler(SymonSamplerParams{
 			Interval: params.SamplingInterval,
 			Logger:   logger,
-		}),
-		logger: logger,
+		})
 	}
+
+	return symon
+}
+
+// newGrid creates an empty chart grid sized for the configured layout.
+func (s *Symon) newGrid(filter *Filter) *SystemMetricsGrid {
+	rows, cols := s.config.SymonGrid()
+	return NewSystemMetricsGrid(
+		MinMetricChartWidth*cols,
+		MinMetricChartHeight*rows,
+		s.config,
+		s.config.SymonGrid,
+		s.focus,
+		filter,
+		s.logger,
+	)
 }
 
 // Init starts the initial sampling pass.
 func (s *Symon) Init() tea.Cmd {
+	switch {
+	case s.replaying:
+		return tea.RequestBackgroundColor
+	case len(s.hosts) > 0:
+		return tea.Batch(tea.RequestBackgroundColor, s.pollHostsCmd(0))
+	}
 	return tea.Batch(tea.RequestBackgroundColor, s.sampleNowCmd())
 }
 
@@ -143,9 +210,24 @@ func (s *Symon) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	case StatsMsg:
 		// ProcessStats handles pagination and redraw when the chart set changes.
 		s.grid.ProcessStats(msg)
+		if s.replaying {
+			return s, nil
+		}
+		s.record(msg)
 		cmd := s.sampleLaterCmd()
+		if s.showProcesses {
+			cmd = tea.Batch(cmd, s.sampleProcessesCmd())
+		}
 		return s, cmd
 
+	case symonPollMsg:
+		s.applyPoll(msg)
+		return s, s.pollHostsCmd(s.pollInterval)
+
+	case ProcessSnapshotMsg:
+		s.processes.SetSnapshot(msg.Snapshot)
+		return s, nil
+
 	default:
 		return s, nil
 	}
@@ -300,6 +382,24 @@ func (s *Symon) handleClearSystemMetricsFilter(tea.KeyPressMsg) tea.Cmd {
 	return nil
 }
 
+func (s *Symon) handleToggleProcesses(tea.KeyPressMsg) tea.Cmd {
+	// Processes are only sampled on the local system, and not recorded.
+	if s.sampler == nil {
+		return nil
+	}
+
+	s.showProcesses = !s.showProcesses
+	if s.showProcesses {
+		return s.sampleProcessesCmd()
+	}
+	return nil
+}
+
+func (s *Symon) handleCycleProcessSort(tea.KeyPressMsg) tea.Cmd {
+	s.processes.CycleSort()
+	return nil
+}
+
 func (s *Symon) handleConfigSystemCols(tea.KeyPressMsg) tea.Cmd {
 	s.config.SetPendingGridConfig(gridConfigSymonCols)
 	return nil
@@ -333,6 +433,10 @@ func (s *Symon) handleConfigNumberKey(msg tea.KeyPressMsg) {
 // handleMouse maps mouse events in the terminal coordinate space onto the
 // system metrics grid.
 func (s *Symon) handleMouse(msg tea.MouseMsg) tea.Cmd {
+	if s.showProcesses {
+		return nil
+	}
+
 	mouse := msg.Mouse()
 	alt := mouse.Mod == tea.ModAlt
 
@@ -390,16 +494,25 @@ func (s *Symon) handleMouse(msg tea.MouseMsg) tea.Cmd {
 // renderMainView renders the header, system metrics grid, and status bar.
 func (s *Symon) renderMainView() string {
 	innerW := max(s.width-ContentPaddingCols, 0)
-	header := symonContainerStyle.Render(
-		renderSystemMetricsHeader(innerW, "System Metrics", "", s.grid))
 	bodyHeight := max(s.height-StatusBarHeight-symonHeaderLines, 0)
-	body := symonContainerStyle.Render(renderSystemMetricsBody(
-		innerW,
-		bodyHeight,
-		s.grid,
-		"Collecting system metrics...",
-		"No matching system metrics.",
-	))
+
+	var header, body string
+	if s.showProcesses {
+		header = symonContainerStyle.Render(renderProcessTableHeader(
+			innerW, s.processes))
+		body = symonContainerStyle.Render(s.processes.View(
+			innerW, bodyHeight, "Collecting processes..."))
+	} else {
+		header = symonContainerStyle.Render(
+			renderSystemMetricsHeader(innerW, "System Metrics", "", s.grid))
+		body = symonContainerStyle.Render(renderSystemMetricsBody(
+			innerW,
+			bodyHeight,
+			s.grid,
+			"Collecting system metrics...",
+			"No matching system metrics.",
+		))
+	}
 	statusBar := s.renderStatusBar()
 
 	fullView := lipgloss.JoinVertical(lipgloss.Left, header, body, statusBar)
@@ -443,7 +556,19 @@ func (s *Symon) buildStatusText() string {
 // buildActiveStatus summarizes the current chart count, filter, and focused
 // chart details while the user is not editing text input.
 func (s *Symon) buildActiveStatus() string {
-	parts := make([]string, 0, 4)
+	if s.showProcesses {
+		return "symon • processes • " + s.processes.SortLabel()
+	}
+
+	parts := make([]string, 0, 5)
+	switch {
+	case s.replaying:
+		parts = append(parts, "replay")
+	case len(s.hosts) > 0:
+		parts = append(parts, s.hostsStatus())
+	case s.recorder != nil:
+		parts = append(parts, "rec "+s.recorder.Path())
+	}
 	if count := s.grid.ChartCount(); count > 0 {
 		parts = append(parts, fmt.Sprintf("%d charts", count))
 	}
@@ -514,6 +639,24 @@ func (s *Symon) isAwaitingUserInput() bool {
 	return s.grid.IsFilterMode() || s.config.IsAwaitingGridConfig()
 }
 
+// record persists a sample if recording.
+//
+// Recording stops after the first error so that a full disk does not
+// produce an error per sample.
+func (s *Symon) record(msg StatsMsg) {
+	if s.recorder == nil {
+		return
+	}
+
+	if err := s.recorder.Record(msg); err != nil {
+		s.logger.CaptureError(
+			"leet",
+			fmt.Errorf("symon: failed to record sample: %v", err),
+		)
+		s.recorder = nil
+	}
+}
+
 // sampleNowCmd triggers an immediate sampling pass.
 func (s *Symon) sampleNowCmd() tea.Cmd {
 	ctx := s.ctx
@@ -527,6 +670,19 @@ func (s *Symon) sampleNowCmd() tea.Cmd {
 	}
 }
 
+// sampleProcessesCmd triggers a process table sampling pass.
+func (s *Symon) sampleProcessesCmd() tea.Cmd {
+	ctx := s.ctx
+	return func() tea.Msg {
+		select {
+		case <-ctx.Done():
+			return nil
+		default:
+			return s.sampler.SampleProcesses(ctx)
+		}
+	}
+}
+
 // sampleLaterCmd schedules the next sampling pass after the configured interval.
 //
 // The tick is started only after the current sample has been processed, which
@@ -0,0 +1,227 @@
+package leet
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"net"
+	"net/http"
+	"os"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/wandb/wandb/core/internal/observability"
+	"github.com/wandb/wandb/core/internal/randomid"
+)
+
+// DefaultSymonAgentAddr is the address a SYMON agent listens on by default.
+//
+// It is loopback-only; agents that multi-host dashboards connect to must
+// listen on an address reachable from the dashboard, e.g. ":7734".
+const DefaultSymonAgentAddr = "127.0.0.1:7734"
+
+// symonAgentSamplesPath is the HTTP endpoint serving an agent's samples.
+const symonAgentSamplesPath = "/v1/samples"
+
+// symonAgentMaxSamples is the number of recent samples an agent keeps for
+// dashboards that poll less often than it samples.
+const symonAgentMaxSamples = 1800
+
+// symonAgentSample is a sample in an agent's response.
+type symonAgentSample struct {
+	// Seq increases by one with every sample taken by the agent.
+	Seq uint64 `json:"seq"`
+
+	// Timestamp is the Unix time in seconds the sample was taken.
+	Timestamp int64 `json:"timestamp"`
+
+	Metrics map[string]float64 `json:"metrics"`
+}
+
+// symonAgentResponse is an agent's response to a samples request.
+type symonAgentResponse struct {
+	// Host is the agent's hostname.
+	Host string `json:"host"`
+
+	// Instance is a random ID chosen when the agent starts.
+	//
+	// It changes when the agent restarts and numbers its samples from
+	// the beginning again.
+	Instance string `json:"instance"`
+
+	// Samples are the samples taken after the requested sequence number,
+	// oldest first.
+	Samples []symonAgentSample `json:"samples"`
+}
+
+// SymonAgentParams configures a SymonAgent.
+type SymonAgentParams struct {
+	// Interval controls the delay between successive samples. Values less
+	// than or equal to zero use DefaultSymonSamplingInterval.
+	Interval time.Duration
+
+	// Logger receives debug logs and captured errors. Nil uses a no-op logger.
+	Logger *observability.CoreLogger
+}
+
+// SymonAgent samples the local system and serves the samples over HTTP
+// to multi-host SYMON dashboards.
+//
+// It is the headless counterpart of Symon: it uses the same sampler, and
+// needs no W&B server.
+type SymonAgent struct {
+	sampler  *SymonSampler
+	logger   *observability.CoreLogger
+	host     string
+	instance string
+
+	mu      sync.Mutex
+	samples []symonAgentSample // the most recent samples, oldest first
+	lastSeq uint64
+}
+
+func NewSymonAgent(params SymonAgentParams) *SymonAgent {
+	logger := params.Logger
+	if logger == nil {
+		logger = observability.NewNoOpLogger()
+	}
+
+	host, err := os.Hostname()
+	if err != nil {
+		logger.Debug(fmt.Sprintf("symon: agent has no hostname: %v", err))
+	}
+
+	return &SymonAgent{
+		sampler: NewSymonSampler(SymonSamplerParams{
+			Interval: params.Interval,
+			Logger:   logger,
+		}),
+		logger:   logger,
+		host:     host,
+		instance: randomid.GenerateUniqueID(8),
+	}
+}
+
+// Serve samples the system and serves requests on the listener until the
+// context is cancelled.
+//
+// The agent releases its sampler's resources when Serve returns, and may
+// not be used after.
+func (a *SymonAgent) Serve(ctx context.Context, listener net.Listener) error {
+	defer a.sampler.Cleanup()
+
+	mux := http.NewServeMux()
+	mux.HandleFunc(symonAgentSamplesPath, a.handleSamples)
+	server := &http.Server{
+		Handler:           mux,
+		ReadHeaderTimeout: 5 * time.Second,
+	}
+
+	var wg sync.WaitGroup
+	wg.Add(2)
+	go func() {
+		defer wg.Done()
+		a.sampleUntilDone(ctx)
+	}()
+	go func() {
+		defer wg.Done()
+		<-ctx.Done()
+
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+		defer cancel()
+		_ = server.Shutdown(shutdownCtx)
+	}()
+
+	err := server.Serve(listener)
+	wg.Wait()
+
+	if errors.Is(err, http.ErrServerClosed) {
+		return nil
+	}
+	return err
+}
+
+// sampleUntilDone samples the system at the sampler's interval.
+func (a *SymonAgent) sampleUntilDone(ctx context.Context) {
+	ticker := time.NewTicker(a.sampler.Interval())
+	defer ticker.Stop()
+
+	for {
+		a.addSample(a.sampler.Sample())
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// addSample stores a sample for dashboards to fetch.
+func (a *SymonAgent) addSample(msg StatsMsg) {
+	// JSON can't represent NaN or infinities.
+	metrics := make(map[string]float64, len(msg.Metrics))
+	for key, value := range msg.Metrics {
+		if !math.IsNaN(value) && !math.IsInf(value, 0) {
+			metrics[key] = value
+		}
+	}
+	if len(metrics) == 0 {
+		return
+	}
+
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	a.lastSeq++
+	a.samples = append(a.samples, symonAgentSample{
+		Seq:       a.lastSeq,
+		Timestamp: msg.Timestamp,
+		Metrics:   metrics,
+	})
+	if len(a.samples) > symonAgentMaxSamples {
+		a.samples = a.samples[len(a.samples)-symonAgentMaxSamples:]
+	}
+}
+
+// handleSamples serves the samples taken after the sequence number in the
+// "after" query parameter.
+//
+// If "after" is ahead of the agent, as happens when the agent restarts,
+// all stored samples are returned.
+func (a *SymonAgent) handleSamples(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	var after uint64
+	if param := r.URL.Query().Get("after"); param != "" {
+		var err error
+		if after, err = strconv.ParseUint(param, 10, 64); err != nil {
+			http.Error(w, "bad 'after' parameter", http.StatusBadRequest)
+			return
+		}
+	}
+
+	response := symonAgentResponse{Host: a.host, Instance: a.instance}
+
+	a.mu.Lock()
+	if after > a.lastSeq {
+		after = 0
+	}
+	for _, sample := range a.samples {
+		if sample.Seq > after {
+			response.Samples = append(response.Samples, sample)
+		}
+	}
+	a.mu.Unlock()
+
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(response); err != nil {
+		a.logger.Debug(fmt.Sprintf("symon: agent failed to write response: %v", err))
+	}
+}
@@ -0,0 +1,138 @@
+package leet
+
+import (
+	"context"
+	"math"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"testing"
+
+	tea "charm.land/bubbletea/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/wandb/wandb/core/internal/observability"
+)
+
+func newTestAgent(t *testing.T, host string) (*SymonAgent, *httptest.Server) {
+	t.Helper()
+
+	agent := NewSymonAgent(SymonAgentParams{})
+	t.Cleanup(agent.sampler.Cleanup)
+	agent.host = host
+
+	server := httptest.NewServer(http.HandlerFunc(agent.handleSamples))
+	t.Cleanup(server.Close)
+	return agent, server
+}
+
+func TestSymonAgent_ServesSamplesAfterSeq(t *testing.T) {
+	agent, server := newTestAgent(t, "node0")
+	agent.addSample(StatsMsg{Timestamp: 1, Metrics: map[string]float64{"cpu": 1}})
+	agent.addSample(StatsMsg{Timestamp: 2, Metrics: map[string]float64{"cpu": math.NaN()}})
+	agent.addSample(StatsMsg{Timestamp: 3, Metrics: map[string]float64{"cpu": 3}})
+
+	all, err := fetchSymonSamples(context.Background(), server.Client(),
+		server.URL+symonAgentSamplesPath)
+	require.NoError(t, err)
+	newer, err := fetchSymonSamples(context.Background(), server.Client(),
+		server.URL+symonAgentSamplesPath+"?after=1")
+	require.NoError(t, err)
+	restarted, err := fetchSymonSamples(context.Background(), server.Client(),
+		server.URL+symonAgentSamplesPath+"?after=100")
+	require.NoError(t, err)
+
+	assert.Equal(t, "node0", all.Host)
+	assert.Equal(t, agent.instance, all.Instance)
+	assert.Equal(t,
+		[]symonAgentSample{
+			{Seq: 1, Timestamp: 1, Metrics: map[string]float64{"cpu": 1}},
+			{Seq: 2, Timestamp: 3, Metrics: map[string]float64{"cpu": 3}},
+		},
+		all.Samples)
+	assert.Equal(t, all.Samples[1:], newer.Samples)
+	assert.Equal(t, all.Samples, restarted.Samples)
+}
+
+func TestParseSymonHosts(t *testing.T) {
+	hosts, err := ParseSymonHosts("node0:7734, gpu=https://node1:7734/ ,")
+
+	require.NoError(t, err)
+	assert.Equal(t,
+		[]SymonHost{
+			{URL: "http://node0:7734"},
+			{Name: "gpu", URL: "https://node1:7734"},
+		},
+		hosts)
+
+	_, err = ParseSymonHosts(" , ")
+	assert.Error(t, err)
+	_, err = ParseSymonHosts("ftp://node0")
+	assert.Error(t, err)
+}
+
+func TestSymon_MultiHost(t *testing.T) {
+	agent0, server0 := newTestAgent(t, "node0")
+	agent1, server1 := newTestAgent(t, "node1")
+	agent0.addSample(StatsMsg{Timestamp: 100, Metrics: map[string]float64{"gpu.0.temp": 40}})
+	agent1.addSample(StatsMsg{Timestamp: 100, Metrics: map[string]float64{"gpu.0.temp": 60}})
+
+	logger := observability.NewNoOpLogger()
+	symon := NewSymon(SymonParams{
+		Config: NewConfigManager(filepath.Join(t.TempDir(), "config.json"), logger),
+		Logger: logger,
+		Hosts: []SymonHost{
+			{URL: server0.URL},
+			{URL: server1.URL},
+			{Name: "down", URL: "http://127.0.0.1:1"},
+		},
+	})
+	defer symon.Cleanup()
+	require.Nil(t, symon.sampler)
+
+	var m tea.Model = symon
+	m, _ = m.Update(tea.WindowSizeMsg{Width: 120, Height: 40})
+	m, _ = m.Update(symon.pollHostsCmd(0)())
+
+	assert.Equal(t, uint64(1), symon.hosts[0].lastSeq)
+	assert.Error(t, symon.hosts[2].err)
+	assert.Contains(t, m.View().Content, "GPU Temp")
+	assert.Contains(t, symon.buildActiveStatus(), "hosts 2/3 up")
+	assert.Equal(t, "[2]", symon.grid.byBaseKey["gpu.temp"].TitleDetail())
+
+	_, _ = m.Update(tea.KeyPressMsg{Code: 'o', Text: "o"})
+
+	assert.Contains(t, symon.buildActiveStatus(), "host node0")
+	assert.Empty(t, symon.grid.byBaseKey["gpu.temp"].TitleDetail())
+}
+
+func TestSymon_RefetchesSamplesAfterAgentRestart(t *testing.T) {
+	logger := observability.NewNoOpLogger()
+	symon := NewSymon(SymonParams{
+		Config: NewConfigManager(filepath.Join(t.TempDir(), "config.json"), logger),
+		Logger: logger,
+		Hosts:  []SymonHost{{URL: "http://node0:7734"}},
+	})
+	defer symon.Cleanup()
+	poll := func(instance string, seqs ...uint64) {
+		response := symonAgentResponse{Host: "node0", Instance: instance}
+		for _, seq := range seqs {
+			response.Samples = append(response.Samples, symonAgentSample{
+				Seq:       seq,
+				Timestamp: int64(seq),
+				Metrics:   map[string]float64{"cpu": 1},
+			})
+		}
+		symon.applyPoll(symonPollMsg{results: []symonPollResult{{response: response}}})
+	}
+
+	poll("first", 1, 2, 3)
+	poll("second", 4)
+	assert.Equal(t, uint64(0), symon.hosts[0].lastSeq)
+	assert.Len(t, symon.hosts[0].history, 3)
+
+	poll("second", 1, 2, 3, 4)
+	assert.Equal(t, uint64(4), symon.hosts[0].lastSeq)
+	assert.Len(t, symon.hosts[0].history, 7)
+}
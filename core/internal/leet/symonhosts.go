@@ -0,0 +1,282 @@
+package leet
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	tea "charm.land/bubbletea/v2"
+)
+
+// symonHostRequestTimeout bounds each request to a host's agent.
+const symonHostRequestTimeout = 5 * time.Second
+
+// symonMaxHostSamples is the number of samples kept per host to redraw the
+// charts when the host selection changes.
+const symonMaxHostSamples = 3600
+
+// SymonHost is a SYMON agent that a multi-host dashboard polls.
+type SymonHost struct {
+	// Name labels the host's series, or is empty to use the hostname
+	// reported by the agent.
+	Name string
+
+	// URL is the agent's base URL.
+	URL string
+}
+
+// ParseSymonHosts parses a comma-separated list of agents.
+//
+// Each agent is "[name=]host:port" or "[name=]http(s)://host:port".
+func ParseSymonHosts(list string) ([]SymonHost, error) {
+	var hosts []SymonHost
+	for spec := range strings.SplitSeq(list, ",") {
+		spec = strings.TrimSpace(spec)
+		if spec == "" {
+			continue
+		}
+
+		var host SymonHost
+		if name, addr, ok := strings.Cut(spec, "="); ok {
+			host.Name, spec = strings.TrimSpace(name), strings.TrimSpace(addr)
+		}
+		if !strings.Contains(spec, "://") {
+			spec = "http://" + spec
+		}
+
+		u, err := url.Parse(spec)
+		if err != nil {
+			return nil, fmt.Errorf("bad host %q: %v", spec, err)
+		}
+		if u.Scheme != "http" && u.Scheme != "https" || u.Host == "" {
+			return nil, fmt.Errorf("bad host %q: expected host:port", spec)
+		}
+		host.URL = strings.TrimSuffix(u.String(), "/")
+		hosts = append(hosts, host)
+	}
+
+	if len(hosts) == 0 {
+		return nil, fmt.Errorf("no hosts given")
+	}
+	return hosts, nil
+}
+
+// symonHostState is the dashboard's state for a polled host.
+type symonHostState struct {
+	SymonHost
+
+	// label names the host in series and the status bar.
+	//
+	// It is empty until the first successful poll if no Name was given.
+	label string
+
+	// lastSeq is the sequence number of the last sample received.
+	lastSeq uint64
+
+	// instance is the agent's instance ID from the last poll.
+	instance string
+
+	// err is the error from the last poll, if it failed.
+	err error
+
+	// history is the host's most recent samples, oldest first.
+	history []StatsMsg
+}
+
+// symonPollMsg carries the results of polling every host.
+type symonPollMsg struct {
+	results []symonPollResult
+}
+
+type symonPollResult struct {
+	response symonAgentResponse
+	err      error
+}
+
+// pollHostsCmd fetches new samples from all hosts after a delay.
+func (s *Symon) pollHostsCmd(delay time.Duration) tea.Cmd {
+	ctx := s.ctx
+	client := s.httpClient
+	urls := make([]string, len(s.hosts))
+	for i, host := range s.hosts {
+		urls[i] = fmt.Sprintf("%s%s?after=%d",
+			host.URL, symonAgentSamplesPath, host.lastSeq)
+	}
+
+	poll := func() tea.Msg {
+		if ctx.Err() != nil {
+			return nil
+		}
+
+		msg := symonPollMsg{results: make([]symonPollResult, len(urls))}
+		var wg sync.WaitGroup
+		for i, u := range urls {
+			wg.Go(func() {
+				msg.results[i].response, msg.results[i].err =
+					fetchSymonSamples(ctx, client, u)
+			})
+		}
+		wg.Wait()
+		return msg
+	}
+
+	if delay <= 0 {
+		return poll
+	}
+	return tea.Tick(delay, func(time.Time) tea.Msg { return poll() })
+}
+
+// fetchSymonSamples requests samples from an agent.
+func fetchSymonSamples(
+	ctx context.Context,
+	client *http.Client,
+	url string,
+) (symonAgentResponse, error) {
+	var response symonAgentResponse
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, http.NoBody)
+	if err != nil {
+		return response, err
+	}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return response, err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode != http.StatusOK {
+		return response, fmt.Errorf("agent responded with %s", resp.Status)
+	}
+
+	err = json.NewDecoder(resp.Body).Decode(&response)
+	return response, err
+}
+
+// applyPoll adds newly polled samples to the charts.
+func (s *Symon) applyPoll(msg symonPollMsg) {
+	for i, result := range msg.results {
+		host := s.hosts[i]
+
+		host.err = result.err
+		if result.err != nil {
+			s.logger.Debug(fmt.Sprintf(
+				"symon: failed to poll %s: %v", host.URL, result.err))
+			continue
+		}
+
+		if host.label == "" {
+			host.label = s.uniqueHostLabel(result.response.Host, host.URL)
+		}
+
+		// A restarted agent numbers its samples from 1 again, so lastSeq
+		// may skip some of them. Request all of its samples on the next poll.
+		if instance := result.response.Instance; instance != host.instance {
+			restarted := host.instance != ""
+			host.instance = instance
+
+			if restarted {
+				host.lastSeq = 0
+				continue
+			}
+		}
+
+		for _, sample := range result.response.Samples {
+			host.lastSeq = sample.Seq
+			stats := StatsMsg{
+				Host:      host.label,
+				Timestamp: sample.Timestamp,
+				Metrics:   sample.Metrics,
+			}
+
+			host.history = append(host.history, stats)
+			if s.isHostShown(host) {
+				s.grid.ProcessStats(stats)
+			}
+		}
+		if len(host.history) > symonMaxHostSamples {
+			host.history = host.history[len(host.history)-symonMaxHostSamples:]
+		}
+	}
+}
+
+// uniqueHostLabel returns the hostname reported by an agent, or its URL
+// if another host already uses the name.
+func (s *Symon) uniqueHostLabel(hostname, url string) string {
+	if hostname == "" {
+		return url
+	}
+	for _, host := range s.hosts {
+		if host.label == hostname {
+			return url
+		}
+	}
+	return hostname
+}
+
+// isHostShown reports whether the host's series are drawn.
+func (s *Symon) isHostShown(host *symonHostState) bool {
+	return s.selectedHost < 0 || s.hosts[s.selectedHost] == host
+}
+
+// handleCycleHost switches between showing all hosts and each single host.
+func (s *Symon) handleCycleHost(tea.KeyPressMsg) tea.Cmd {
+	if len(s.hosts) == 0 {
+		return nil
+	}
+
+	// -1 (all hosts), 0, 1, ..., len-1, then back to -1.
+	s.selectedHost++
+	if s.selectedHost >= len(s.hosts) {
+		s.selectedHost = -1
+	}
+
+	s.rebuildGrid()
+	return nil
+}
+
+// rebuildGrid redraws the charts from the stored samples of the shown
+// hosts, keeping the metrics filter.
+func (s *Symon) rebuildGrid() {
+	s.grid = s.newGrid(s.grid.filter)
+	s.resizeGrid()
+
+	for _, host := range s.hosts {
+		if !s.isHostShown(host) {
+			continue
+		}
+		for _, stats := range host.history {
+			s.grid.ProcessStats(stats)
+		}
+	}
+}
+
+// hostsStatus summarizes the hosts for the status bar.
+func (s *Symon) hostsStatus() string {
+	up := 0
+	for _, host := range s.hosts {
+		if host.err == nil && host.label != "" {
+			up++
+		}
+	}
+	status := "hosts " + strconv.Itoa(up) + "/" + strconv.Itoa(len(s.hosts)) + " up"
+
+	if s.selectedHost >= 0 {
+		host := s.hosts[s.selectedHost]
+		label := host.label
+		if label == "" {
+			label = host.URL
+		}
+		status += " • host " + label
+		if host.err != nil {
+			status += " (unreachable)"
+		}
+	}
+	return status
+}
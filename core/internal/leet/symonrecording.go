@@ -0,0 +1,158 @@
+package leet
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"maps"
+	"os"
+	"slices"
+	"strconv"
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/wandb/wandb/core/internal/observability"
+	"github.com/wandb/wandb/core/internal/randomid"
+	"github.com/wandb/wandb/core/internal/transactionlog"
+	spb "github.com/wandb/wandb/core/pkg/service_go_proto"
+)
+
+// SymonRecorder persists SYMON samples to a .wandb transaction log.
+//
+// The recording is laid out like an offline run that only has system
+// metrics: a run record, a stats record per sample and, once closed, an
+// exit record. Besides replaying it with "leet --symon --replay", it can be
+// opened with "leet --run-file" or uploaded as a run with "wandb sync".
+//
+// Not safe for concurrent use.
+type SymonRecorder struct {
+	path      string
+	writer    *transactionlog.Writer
+	startTime time.Time
+}
+
+// NewSymonRecorder creates a recording at the given path.
+//
+// The file must not already exist.
+func NewSymonRecorder(path string) (*SymonRecorder, error) {
+	writer, err := transactionlog.OpenWriter(path)
+	if err != nil {
+		return nil, err
+	}
+
+	hostname, _ := os.Hostname()
+	startTime := time.Now()
+	runID := randomid.GenerateUniqueID(8)
+
+	err = writer.Write(&spb.Record{
+		RecordType: &spb.Record_Run{
+			Run: &spb.RunRecord{
+				RunId:       runID,
+				DisplayName: "symon-" + hostname,
+				Host:        hostname,
+				StartTime:   timestamppb.New(startTime),
+				JobType:     "symon",
+			},
+		},
+	})
+	if err == nil {
+		err = writer.Flush()
+	}
+	if err != nil {
+		_ = writer.Close()
+		return nil, fmt.Errorf("leet: failed to start recording: %v", err)
+	}
+
+	return &SymonRecorder{
+		path:      path,
+		writer:    writer,
+		startTime: startTime,
+	}, nil
+}
+
+// Path returns the recording's file path.
+func (r *SymonRecorder) Path() string {
+	return r.path
+}
+
+// Record appends a sample to the recording.
+//
+// The file is flushed after each sample so that a recording survives
+// the process being killed.
+func (r *SymonRecorder) Record(msg StatsMsg) error {
+	if len(msg.Metrics) == 0 {
+		return nil
+	}
+
+	keys := slices.Sorted(maps.Keys(msg.Metrics))
+	items := make([]*spb.StatsItem, 0, len(keys))
+	for _, key := range keys {
+		items = append(items, &spb.StatsItem{
+			Key:       key,
+			ValueJson: strconv.FormatFloat(msg.Metrics[key], 'g', -1, 64),
+		})
+	}
+
+	err := r.writer.Write(&spb.Record{
+		RecordType: &spb.Record_Stats{
+			Stats: &spb.StatsRecord{
+				StatsType: spb.StatsRecord_SYSTEM,
+				Timestamp: &timestamppb.Timestamp{Seconds: msg.Timestamp},
+				Item:      items,
+			},
+		},
+	})
+	if err != nil {
+		return err
+	}
+	return r.writer.Flush()
+}
+
+// Close marks the recording as finished and closes the file.
+func (r *SymonRecorder) Close() error {
+	err := r.writer.Write(&spb.Record{
+		RecordType: &spb.Record_Exit{
+			Exit: &spb.RunExitRecord{
+				Runtime: int32(time.Since(r.startTime).Seconds()),
+			},
+		},
+	})
+
+	return errors.Join(err, r.writer.Close())
+}
+
+// ReadSymonRecording reads the system metrics samples in a .wandb file.
+//
+// This accepts recordings made by SymonRecorder as well as the .wandb
+// files of regular runs. Corrupt records are skipped, so that a recording
+// cut short by a crash can still be replayed.
+func ReadSymonRecording(
+	path string,
+	logger *observability.CoreLogger,
+) ([]StatsMsg, error) {
+	reader, err := transactionlog.OpenReader(path, logger)
+	if err != nil {
+		return nil, err
+	}
+	defer reader.Close()
+
+	var samples []StatsMsg
+	for first := true; ; first = false {
+		record, err := reader.Read()
+		switch {
+		case errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF):
+			return samples, nil
+		case err != nil && first:
+			// Most likely not a .wandb file at all.
+			return nil, err
+		case err != nil:
+			logger.Warn(fmt.Sprintf("leet: skipping corrupt record in %s: %v", path, err))
+			continue
+		}
+
+		if msg, ok := ParseStats("", record.GetStats()).(StatsMsg); ok {
+			samples = append(samples, msg)
+		}
+	}
+}
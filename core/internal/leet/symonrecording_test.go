@@ -0,0 +1,97 @@
+package leet_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	tea "charm.land/bubbletea/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/wandb/wandb/core/internal/leet"
+	"github.com/wandb/wandb/core/internal/observability"
+)
+
+func TestSymonRecording_RoundTrip(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "symon.wandb")
+	recorder, err := leet.NewSymonRecorder(path)
+	require.NoError(t, err)
+
+	samples := []leet.StatsMsg{
+		{Timestamp: 100, Metrics: map[string]float64{"cpu": 12.5, "gpu.0.temp": 40}},
+		{Timestamp: 102, Metrics: map[string]float64{"cpu": 50}},
+	}
+	for _, sample := range samples {
+		require.NoError(t, recorder.Record(sample))
+	}
+	require.NoError(t, recorder.Close())
+
+	replay, err := leet.ReadSymonRecording(path, observability.NewNoOpLogger())
+	require.NoError(t, err)
+	assert.Equal(t, samples, replay)
+}
+
+func TestSymonRecording_ExistingFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "symon.wandb")
+	require.NoError(t, os.WriteFile(path, []byte("data"), 0o644))
+
+	_, err := leet.NewSymonRecorder(path)
+
+	assert.ErrorIs(t, err, os.ErrExist)
+}
+
+func TestSymonRecording_NotAWandbFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "notes.txt")
+	require.NoError(t, os.WriteFile(path, []byte("not a transaction log"), 0o644))
+
+	_, err := leet.ReadSymonRecording(path, observability.NewNoOpLogger())
+
+	assert.Error(t, err)
+}
+
+func TestSymon_Replay(t *testing.T) {
+	logger := observability.NewNoOpLogger()
+	cfg := leet.NewConfigManager(filepath.Join(t.TempDir(), "config.json"), logger)
+
+	symon := leet.NewSymon(leet.SymonParams{
+		Config: cfg,
+		Logger: logger,
+		Replay: []leet.StatsMsg{
+			{Timestamp: 100, Metrics: map[string]float64{"gpu.0.temp": 40}},
+			{Timestamp: 102, Metrics: map[string]float64{"gpu.0.temp": 45}},
+		},
+	})
+	defer symon.Cleanup()
+	var m tea.Model = symon
+	m, _ = m.Update(tea.WindowSizeMsg{Width: 120, Height: 40})
+
+	// Toggling the process table is a no-op since it isn't recorded.
+	m, _ = m.Update(tea.KeyPressMsg{Code: 't', Text: "t"})
+
+	view := m.View().Content
+	assert.Contains(t, view, "GPU Temp")
+	assert.Contains(t, view, "replay")
+}
+
+func TestSymon_RecordsSamples(t *testing.T) {
+	logger := observability.NewNoOpLogger()
+	cfg := leet.NewConfigManager(filepath.Join(t.TempDir(), "config.json"), logger)
+	path := filepath.Join(t.TempDir(), "symon.wandb")
+	recorder, err := leet.NewSymonRecorder(path)
+	require.NoError(t, err)
+
+	symon := leet.NewSymon(leet.SymonParams{
+		Config:   cfg,
+		Logger:   logger,
+		Recorder: recorder,
+	})
+	defer symon.Cleanup()
+	sample := leet.StatsMsg{Timestamp: 100, Metrics: map[string]float64{"cpu": 10}}
+	_, _ = symon.Update(sample)
+	require.NoError(t, recorder.Close())
+
+	replay, err := leet.ReadSymonRecording(path, logger)
+	require.NoError(t, err)
+	assert.Equal(t, []leet.StatsMsg{sample}, replay)
+}
@@ -1,6 +1,7 @@
 package leet
 
 import (
+	"context"
 	"fmt"
 	"maps"
 	"os"
@@ -20,6 +21,9 @@ import (
 // caller does not provide an explicit interval.
 const DefaultSymonSamplingInterval = 2 * time.Second
 
+// symonMaxProcesses is the number of processes kept in SYMON's process table.
+const symonMaxProcesses = 100
+
 // SymonSamplerParams configures a SymonSampler.
 type SymonSamplerParams struct {
 	// Interval controls the delay between successive sampling passes. Values
@@ -40,6 +44,12 @@ type SymonSampler struct {
 	interval  time.Duration
 	resources []monitor.Resource
 	logger    *observability.CoreLogger
+
+	// processTable samples the system's top processes.
+	//
+	// It is guarded by processMu since it is not safe for concurrent use.
+	processTable *monitor.ProcessTable
+	processMu    sync.Mutex
 }
 
 func NewSymonSampler(params SymonSamplerParams) *SymonSampler {
@@ -56,6 +66,9 @@ func NewSymonSampler(params SymonSamplerParams) *SymonSampler {
 	sampler := &SymonSampler{
 		interval: interval,
 		logger:   logger,
+		processTable: monitor.NewProcessTable(monitor.ProcessTableParams{
+			MaxProcesses: symonMaxProcesses,
+		}),
 	}
 
 	sampler.resources = append(sampler.resources,
@@ -134,6 +147,21 @@ func (s *SymonSampler) Sample() StatsMsg {
 	return out
 }
 
+// SampleProcesses takes a snapshot of the system's top processes.
+//
+// CPU usage is measured since the previous call, so the first snapshot
+// reports zero CPU for every process.
+func (s *SymonSampler) SampleProcesses(ctx context.Context) ProcessSnapshotMsg {
+	s.processMu.Lock()
+	defer s.processMu.Unlock()
+
+	snapshot, err := s.processTable.Snapshot(ctx)
+	if err != nil {
+		s.logger.Debug(fmt.Sprintf("symon: process table error: %v", err))
+	}
+	return ProcessSnapshotMsg{Snapshot: snapshot}
+}
+
 // Cleanup releases any resources that need explicit shutdown, such as the wandb-xpu
 // sidecar process managed by the monitor package.
 func (s *SymonSampler) Cleanup() {
@@ -180,7 +180,7 @@ func (g *SystemMetricsGrid) createMetricChart(def *MetricDef) systemMetricChart
 // Drawing is deferred to the next View() call to avoid redundant redraws
 // when processing a batch of metrics from a single stats record.
 func (g *SystemMetricsGrid) AddDataPoint(metricName string, timestamp int64, value float64) {
-	if g.addDataPoint(metricName, timestamp, value) {
+	if g.addDataPoint("", metricName, timestamp, value) {
 		g.refreshChartSet()
 	}
 }
@@ -194,7 +194,7 @@ func (g *SystemMetricsGrid) ProcessStats(msg StatsMsg) {
 
 	chartSetChanged := false
 	for metricName, value := range msg.Metrics {
-		if g.addDataPoint(metricName, msg.Timestamp, value) {
+		if g.addDataPoint(msg.Host, metricName, msg.Timestamp, value) {
 			chartSetChanged = true
 		}
 	}
@@ -204,7 +204,10 @@ func (g *SystemMetricsGrid) ProcessStats(msg StatsMsg) {
 }
 
 // addDataPoint adds a sample and reports whether the chart set changed.
+//
+// Samples from different hosts are separate series in the same chart.
 func (g *SystemMetricsGrid) addDataPoint(
+	host string,
 	metricName string,
 	timestamp int64,
 	value float64,
@@ -222,6 +225,13 @@ func (g *SystemMetricsGrid) addDataPoint(
 
 	baseKey := ExtractBaseKey(metricName)
 	seriesName := ExtractSeriesName(metricName)
+	switch {
+	case host == "":
+	case seriesName == DefaultSystemMetricSeriesName:
+		seriesName = host
+	default:
+		seriesName = host + " " + seriesName
+	}
 
 	chart, created := g.getOrCreateChart(baseKey, def)
 	chart.AddDataPoint(seriesName, timestamp, value)
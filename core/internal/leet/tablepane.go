@@ -0,0 +1,634 @@
+package leet
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	tea "charm.land/bubbletea/v2"
+	"charm.land/lipgloss/v2"
+)
+
+const (
+	tablePaneHeader      = "Tables"
+	tablePaneHeaderLines = 2
+	tablePaneFooterLines = 1
+	// tableGridHeaderLines is the column header row plus its underline.
+	tableGridHeaderLines = 2
+
+	tableColumnMinWidth = 6
+	tableColumnMaxWidth = 32
+	tableMediaColWidth  = 16
+	// tableMediaRowHeight is the row height used when a table has image
+	// cells, tall enough for a recognizable glyph thumbnail.
+	tableMediaRowHeight = 4
+	tableColumnSep      = " │ "
+
+	// tableLoadRetryDelay is how long to wait before reloading a table file
+	// that failed to load, such as one that hasn't been written yet.
+	tableLoadRetryDelay = 2 * time.Second
+
+	tablePaneHint = "tab: table • ,/.: step • o: sort • /: filter • esc: close"
+)
+
+var (
+	tablePaneHeaderStyle = lipgloss.NewStyle().
+				Foreground(colorLayoutHighlight).
+				Bold(true)
+
+	tablePaneInfoStyle = lipgloss.NewStyle().
+				Foreground(colorSubtle)
+
+	tableColumnHeaderStyle = lipgloss.NewStyle().
+				Foreground(colorSubheading).
+				Bold(true)
+
+	tableSelectedColumnHeaderStyle = lipgloss.NewStyle().
+					Foreground(colorLayoutHighlight).
+					Bold(true)
+
+	tableCellStyle = lipgloss.NewStyle().
+			Foreground(colorText)
+
+	tableSelectedRowStyle = lipgloss.NewStyle().
+				Background(colorSelected)
+
+	tableSeparatorStyle = lipgloss.NewStyle().
+				Foreground(colorLayout)
+)
+
+// TablePane renders wandb.Table values logged to a run as scrollable grids.
+//
+// It takes over the main column while open and keeps keys local, like the
+// media pane's fullscreen mode.
+type TablePane struct {
+	// store provides the table series shown by this pane.
+	store *TableStore
+	// runPath is the run's .wandb file, used to resolve media cells.
+	runPath string
+	// renderer draws image cells using the media pane's glyph renderer.
+	renderer *mediaImageRenderer
+
+	// open is whether the pane is shown.
+	open bool
+
+	// keyIndex is the selected table key within store.SeriesKeys().
+	keyIndex int
+	// stepIndices records the selected step index for each table key.
+	// Keys without an entry follow their latest step.
+	stepIndices map[string]int
+
+	// loaded is the table currently decoded into data.
+	loaded  TableRef
+	data    *TableData
+	loadErr error
+	// loading is the table being read in the background, if any.
+	loading TableRef
+	// retryAt is when a table that failed to load may be read again.
+	retryAt time.Time
+
+	// rows is the filtered and sorted view of data.Rows.
+	rows      []int
+	rowsDirty bool
+	// widths caches columnWidths for data.
+	widths []int
+
+	// cursorRow is the selected row index within rows.
+	cursorRow int
+	// topRow is the first row index within rows that is rendered.
+	topRow int
+	// cursorCol is the selected column.
+	cursorCol int
+	// leftCol is the first column that is rendered.
+	leftCol int
+
+	sortCol   int
+	sortOrder TableSortOrder
+
+	// filter is the applied substring filter.
+	filter string
+	// filterInput is set while the user is typing a filter into filterDraft.
+	filterInput bool
+	filterDraft string
+
+	// pageRows is the number of data rows visible in the last render.
+	pageRows int
+}
+
+func NewTablePane(store *TableStore, runPath string) *TablePane {
+	return &TablePane{
+		store:       store,
+		runPath:     runPath,
+		renderer:    newMediaImageRenderer(),
+		stepIndices: make(map[string]int),
+		pageRows:    1,
+	}
+}
+
+func (p *TablePane) IsOpen() bool       { return p.open }
+func (p *TablePane) IsFilterMode() bool { return p.filterInput }
+func (p *TablePane) HasData() bool      { return p.store != nil && !p.store.Empty() }
+
+// Toggle opens or closes the pane.
+func (p *TablePane) Toggle() {
+	p.open = !p.open
+	if !p.open {
+		p.filterInput = false
+		p.renderer.Park(nil)
+	}
+}
+
+// Close hides the pane.
+func (p *TablePane) Close() {
+	if p.open {
+		p.Toggle()
+	}
+}
+
+// FilterQuery returns the filter being typed, or the applied filter.
+func (p *TablePane) FilterQuery() string {
+	if p.filterInput {
+		return p.filterDraft
+	}
+	return p.filter
+}
+
+// selectedKey returns the currently selected table key.
+func (p *TablePane) selectedKey() string {
+	if p.store == nil {
+		return ""
+	}
+	keys := p.store.SeriesKeys()
+	if len(keys) == 0 {
+		return ""
+	}
+	p.keyIndex = clamp(p.keyIndex, 0, len(keys)-1)
+	return keys[p.keyIndex]
+}
+
+// selectedRef returns the selected table reference along with its step index
+// and the number of steps logged under its key.
+func (p *TablePane) selectedRef() (ref TableRef, idx, count int, ok bool) {
+	key := p.selectedKey()
+	if key == "" {
+		return TableRef{}, 0, 0, false
+	}
+	series := p.store.Series(key)
+	if len(series) == 0 {
+		return TableRef{}, 0, 0, false
+	}
+
+	idx, pinned := p.stepIndices[key]
+	if !pinned {
+		idx = len(series) - 1
+	}
+	idx = clamp(idx, 0, len(series)-1)
+	return series[idx], idx, len(series), true
+}
+
+// tablePaneLoadedMsg carries a table file read in the background.
+type tablePaneLoadedMsg struct {
+	pane *TablePane
+	ref  TableRef
+	data *TableData
+	err  error
+}
+
+// tablePaneRetryMsg asks the pane to reload a table that failed to load.
+type tablePaneRetryMsg struct {
+	pane *TablePane
+}
+
+// LoadCmd returns a command that reads the selected table if it isn't
+// loaded yet, or nil if there is nothing to read.
+//
+// Tables that failed to load are read again after tableLoadRetryDelay.
+func (p *TablePane) LoadCmd() tea.Cmd {
+	if !p.open {
+		return nil
+	}
+	ref, _, _, ok := p.selectedRef()
+	switch {
+	case !ok, ref == p.loading:
+		return nil
+	case ref == p.loaded && (p.loadErr == nil || time.Now().Before(p.retryAt)):
+		return nil
+	}
+
+	p.loading = ref
+	runPath := p.runPath
+	return func() tea.Msg {
+		data, err := LoadTableData(runPath, ref.FilePath)
+		return tablePaneLoadedMsg{pane: p, ref: ref, data: data, err: err}
+	}
+}
+
+// HandleLoaded stores a table read by LoadCmd.
+//
+// Returns a command to retry the read if it failed.
+func (p *TablePane) HandleLoaded(msg tablePaneLoadedMsg) tea.Cmd {
+	if msg.ref == p.loading {
+		p.loading = TableRef{}
+	}
+	if ref, _, _, ok := p.selectedRef(); !ok || ref != msg.ref {
+		return nil
+	}
+
+	p.loaded, p.data, p.loadErr = msg.ref, msg.data, msg.err
+	p.widths = nil
+	p.rowsDirty = true
+	if p.data != nil {
+		p.cursorCol = clamp(p.cursorCol, 0, max(len(p.data.Columns)-1, 0))
+		if p.sortCol >= len(p.data.Columns) {
+			p.sortCol, p.sortOrder = 0, TableSortNone
+		}
+	}
+
+	if msg.err == nil {
+		return nil
+	}
+	p.retryAt = time.Now().Add(tableLoadRetryDelay)
+	return tea.Tick(tableLoadRetryDelay, func(time.Time) tea.Msg {
+		return tablePaneRetryMsg{pane: p}
+	})
+}
+
+// current returns the decoded selected table, or nil if it isn't loaded.
+func (p *TablePane) current() *TableData {
+	ref, _, _, ok := p.selectedRef()
+	if !ok || ref != p.loaded {
+		return nil
+	}
+	return p.data
+}
+
+// isLoading reports whether the selected table hasn't been read yet.
+func (p *TablePane) isLoading() bool {
+	ref, _, _, ok := p.selectedRef()
+	return ok && ref != p.loaded
+}
+
+// sync rebuilds the row view of the selected table.
+func (p *TablePane) sync() {
+	if p.isLoading() {
+		p.rows = nil
+		p.rowsDirty = true
+		return
+	}
+
+	if p.rowsDirty {
+		p.rows = tableRowView(p.data, p.filter, p.sortCol, p.sortOrder)
+		p.rowsDirty = false
+		p.cursorRow = clamp(p.cursorRow, 0, max(len(p.rows)-1, 0))
+	}
+}
+
+// HandleKey handles table-pane-local keys. It returns whether the key was
+// consumed.
+//
+//gocyclo:ignore
+func (p *TablePane) HandleKey(msg tea.KeyPressMsg) (bool, tea.Cmd) {
+	if !p.open {
+		return false, nil
+	}
+	if p.filterInput {
+		p.handleFilterKey(msg)
+		return true, nil
+	}
+
+	switch normalizeKey(msg.String()) {
+	case "esc", "5":
+		p.Close()
+	case "tab":
+		p.cycleTable(1)
+	case "shift+tab":
+		p.cycleTable(-1)
+	case ",", "<":
+		p.stepBy(-1)
+	case ".", ">":
+		p.stepBy(1)
+	case "up", "w":
+		p.MoveRow(-1)
+	case "down", "s":
+		p.MoveRow(1)
+	case "left", "a":
+		p.MoveColumn(-1)
+	case "right", "d":
+		p.MoveColumn(1)
+	case "pgup":
+		p.MoveRow(-max(p.pageRows, 1))
+	case "pgdown":
+		p.MoveRow(max(p.pageRows, 1))
+	case "home":
+		p.MoveRow(-len(p.rows))
+	case "end":
+		p.MoveRow(len(p.rows))
+	case "o":
+		p.CycleSort()
+	case "/":
+		p.filterInput = true
+		p.filterDraft = p.filter
+	case "ctrl+l":
+		p.SetFilter("")
+	case "q", "ctrl+c":
+		// Let quit reach the run's key map.
+		return false, nil
+	}
+	return true, p.LoadCmd()
+}
+
+func (p *TablePane) handleFilterKey(msg tea.KeyPressMsg) {
+	switch msg.Code {
+	case tea.KeyEsc:
+		p.filterInput = false
+	case tea.KeyEnter:
+		p.filterInput = false
+		p.SetFilter(p.filterDraft)
+	case tea.KeyBackspace:
+		if r := []rune(p.filterDraft); len(r) > 0 {
+			p.filterDraft = string(r[:len(r)-1])
+		}
+	default:
+		if msg.Text != "" {
+			p.filterDraft += msg.Text
+		}
+	}
+}
+
+// SetFilter applies a substring filter to the table's rows.
+func (p *TablePane) SetFilter(filter string) {
+	p.filter = filter
+	p.rowsDirty = true
+	p.cursorRow, p.topRow = 0, 0
+}
+
+// CycleSort cycles the sort order of the selected column.
+func (p *TablePane) CycleSort() {
+	if p.sortCol != p.cursorCol {
+		p.sortCol, p.sortOrder = p.cursorCol, TableSortAsc
+	} else {
+		p.sortOrder = p.sortOrder.Next()
+	}
+	p.rowsDirty = true
+}
+
+func (p *TablePane) cycleTable(direction int) {
+	if p.store == nil {
+		return
+	}
+	n := len(p.store.SeriesKeys())
+	if n == 0 {
+		return
+	}
+	p.keyIndex = ((p.keyIndex+direction)%n + n) % n
+	p.cursorRow, p.topRow, p.cursorCol, p.leftCol = 0, 0, 0, 0
+	p.sortCol, p.sortOrder = 0, TableSortNone
+}
+
+// stepBy moves the selected table to an earlier or later logged step.
+//
+// Moving past the latest step resumes following new steps.
+func (p *TablePane) stepBy(delta int) {
+	_, idx, count, ok := p.selectedRef()
+	if !ok {
+		return
+	}
+	key := p.selectedKey()
+	next := clamp(idx+delta, 0, count-1)
+	if next == count-1 {
+		delete(p.stepIndices, key)
+	} else {
+		p.stepIndices[key] = next
+	}
+}
+
+// MoveRow moves the row cursor by delta rows.
+func (p *TablePane) MoveRow(delta int) {
+	p.sync()
+	if len(p.rows) == 0 {
+		return
+	}
+	p.cursorRow = clamp(p.cursorRow+delta, 0, len(p.rows)-1)
+}
+
+// MoveColumn moves the column cursor by delta columns.
+func (p *TablePane) MoveColumn(delta int) {
+	data := p.current()
+	if data == nil || len(data.Columns) == 0 {
+		return
+	}
+	p.cursorCol = clamp(p.cursorCol+delta, 0, len(data.Columns)-1)
+}
+
+// View renders the pane into a width×height block.
+func (p *TablePane) View(width, height int) string {
+	if width <= 0 || height <= 0 {
+		return ""
+	}
+	p.sync()
+
+	innerW := max(width-ContentPaddingCols, 1)
+	header := p.renderHeader(innerW)
+
+	bodyH := max(height-tablePaneHeaderLines-tablePaneFooterLines, 0)
+	var body string
+	switch {
+	case !p.HasData():
+		body = renderMediaPlaceholder(innerW, bodyH, "No tables logged.")
+	case p.isLoading():
+		body = renderMediaPlaceholder(innerW, bodyH, "Loading table...")
+	case p.loadErr != nil:
+		body = renderMediaPlaceholder(innerW, bodyH,
+			truncateValue(p.loadErr.Error(), innerW))
+	case p.data == nil || len(p.data.Columns) == 0:
+		body = renderMediaPlaceholder(innerW, bodyH, "Empty table.")
+	default:
+		body = p.renderGrid(innerW, bodyH)
+	}
+
+	footer := tablePaneInfoStyle.Render(truncateValue(p.footerText(), innerW))
+
+	content := lipgloss.JoinVertical(lipgloss.Left,
+		header,
+		"",
+		lipgloss.NewStyle().Width(innerW).Height(bodyH).MaxHeight(bodyH).Render(body),
+		footer,
+	)
+	return mediaPaneStyle.Width(width).MaxHeight(height).Render(content)
+}
+
+func (p *TablePane) renderHeader(width int) string {
+	title := tablePaneHeaderStyle.Render(tablePaneHeader)
+
+	var parts []string
+	if key := p.selectedKey(); key != "" {
+		n := len(p.store.SeriesKeys())
+		parts = append(parts, fmt.Sprintf("%s [%d/%d]", key, p.keyIndex+1, n))
+	}
+	if ref, idx, count, ok := p.selectedRef(); ok {
+		parts = append(parts, fmt.Sprintf("step %s [%d/%d]",
+			formatMediaAxisValue(ref.X), idx+1, count))
+	}
+	if p.current() != nil {
+		parts = append(parts, fmt.Sprintf("%d/%d rows", len(p.rows), len(p.data.Rows)))
+		if p.sortOrder != TableSortNone && p.sortCol < len(p.data.Columns) {
+			parts = append(parts, fmt.Sprintf("sort: %s %s",
+				p.data.Columns[p.sortCol], p.sortOrder.Indicator()))
+		}
+	}
+	if p.filter != "" {
+		parts = append(parts, fmt.Sprintf("filter: %q", p.filter))
+	}
+
+	info := strings.Join(parts, " • ")
+	avail := max(width-lipgloss.Width(title)-1, 0)
+	return title + " " + tablePaneInfoStyle.Render(truncateValue(info, avail))
+}
+
+func (p *TablePane) footerText() string {
+	if p.filterInput {
+		return fmt.Sprintf("Filter: %s%s (Enter to apply • Esc to cancel)",
+			p.filterDraft, string(mediumShadeBlock))
+	}
+	return tablePaneHint
+}
+
+// columnWidths returns each column's rendered width, sized to its content.
+//
+// The widths are computed once per loaded table.
+func (p *TablePane) columnWidths() []int {
+	if p.widths != nil {
+		return p.widths
+	}
+
+	widths := make([]int, len(p.data.Columns))
+	for c, name := range p.data.Columns {
+		w := lipgloss.Width(name) + 2 // room for the sort indicator
+		for _, r := range p.data.Rows {
+			cell := r[c]
+			if cell.Media != nil {
+				w = max(w, tableMediaColWidth)
+				continue
+			}
+			w = max(w, lipgloss.Width(cell.Text))
+		}
+		widths[c] = clamp(w, tableColumnMinWidth, tableColumnMaxWidth)
+	}
+	p.widths = widths
+	return widths
+}
+
+// visibleColumns returns the range of columns that fit in width, scrolling
+// horizontally so that the selected column is visible.
+func (p *TablePane) visibleColumns(widths []int, width int) (first, last int) {
+	sepW := lipgloss.Width(tableColumnSep)
+	fits := func(from, to int) bool {
+		total := 0
+		for c := from; c <= to; c++ {
+			total += widths[c]
+			if c > from {
+				total += sepW
+			}
+		}
+		return total <= width
+	}
+
+	p.leftCol = clamp(p.leftCol, 0, max(len(widths)-1, 0))
+	if p.cursorCol < p.leftCol {
+		p.leftCol = p.cursorCol
+	}
+	for p.leftCol < p.cursorCol && !fits(p.leftCol, p.cursorCol) {
+		p.leftCol++
+	}
+
+	last = p.leftCol
+	for last+1 < len(widths) && fits(p.leftCol, last+1) {
+		last++
+	}
+	return p.leftCol, last
+}
+
+func (p *TablePane) renderGrid(width, height int) string {
+	widths := p.columnWidths()
+	first, last := p.visibleColumns(widths, width)
+
+	rowH := 1
+	if p.data.HasMedia() {
+		rowH = tableMediaRowHeight
+	}
+	p.pageRows = max((height-tableGridHeaderLines)/rowH, 1)
+
+	if p.cursorRow < p.topRow {
+		p.topRow = p.cursorRow
+	}
+	if p.cursorRow >= p.topRow+p.pageRows {
+		p.topRow = p.cursorRow - p.pageRows + 1
+	}
+
+	lines := []string{
+		p.renderColumnHeader(widths, first, last),
+		tableSeparatorStyle.Render(strings.Repeat("─", width)),
+	}
+	if len(p.rows) == 0 {
+		lines = append(lines, tablePaneInfoStyle.Render("No matching rows."))
+	}
+
+	end := min(p.topRow+p.pageRows, len(p.rows))
+	for i := p.topRow; i < end; i++ {
+		lines = append(lines,
+			p.renderRow(p.data.Rows[p.rows[i]], widths, first, last, rowH, i == p.cursorRow))
+	}
+	return strings.Join(lines, "\n")
+}
+
+func (p *TablePane) renderColumnHeader(widths []int, first, last int) string {
+	cells := make([]string, 0, last-first+1)
+	for c := first; c <= last; c++ {
+		name := p.data.Columns[c]
+		if c == p.sortCol && p.sortOrder != TableSortNone {
+			name += " " + p.sortOrder.Indicator()
+		}
+		style := tableColumnHeaderStyle
+		if c == p.cursorCol {
+			style = tableSelectedColumnHeaderStyle
+		}
+		cells = append(cells,
+			style.Width(widths[c]).MaxWidth(widths[c]).Render(truncateValue(name, widths[c])))
+	}
+	return strings.Join(cells, tableSeparatorStyle.Render(tableColumnSep))
+}
+
+func (p *TablePane) renderRow(
+	row []TableCell,
+	widths []int,
+	first, last, rowH int,
+	selected bool,
+) string {
+	sep := strings.TrimSuffix(
+		strings.Repeat(tableSeparatorStyle.Render(tableColumnSep)+"\n", rowH), "\n")
+
+	blocks := make([]string, 0, 2*(last-first)+1)
+	for c := first; c <= last; c++ {
+		if c > first {
+			blocks = append(blocks, sep)
+		}
+		blocks = append(blocks, p.renderCell(row[c], widths[c], rowH, selected))
+	}
+	return lipgloss.JoinHorizontal(lipgloss.Top, blocks...)
+}
+
+func (p *TablePane) renderCell(cell TableCell, width, height int, selected bool) string {
+	if cell.Media != nil && height > 1 {
+		return lipgloss.NewStyle().Width(width).Height(height).
+			MaxWidth(width).MaxHeight(height).
+			Render(p.renderer.Render(cell.Media.FilePath, width, height))
+	}
+
+	style := tableCellStyle
+	if selected {
+		style = style.Inherit(tableSelectedRowStyle)
+	}
+	text := strings.ReplaceAll(cell.Text, "\n", " ")
+	return style.Width(width).Height(height).MaxWidth(width).
+		Render(truncateValue(text, width))
+}
@@ -0,0 +1,352 @@
+package leet
+
+import (
+	"fmt"
+	"math"
+	"os"
+	"path"
+	"path/filepath"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/wandb/simplejsonext"
+)
+
+// TableRef is a single wandb.Table logged at a particular history step.
+//
+// The table's contents live in a "table-file" JSON file under the run's files
+// directory; the history record only carries its location and shape.
+type TableRef struct {
+	X            float64
+	FilePath     string
+	RelativePath string
+	NRows        int
+	NCols        int
+	SHA256       string
+}
+
+// TableStore holds all table series for one run.
+//
+// Series are keyed by the logged history key (for example "eval/predictions").
+// Refs within a series are ordered by X.
+type TableStore struct {
+	mu sync.RWMutex
+
+	series map[string][]TableRef
+	keys   []string
+}
+
+func NewTableStore() *TableStore {
+	return &TableStore{series: make(map[string][]TableRef)}
+}
+
+// ProcessHistory ingests table references from a history message.
+//
+// Returns true when the store changed.
+func (s *TableStore) ProcessHistory(msg HistoryMsg) bool {
+	if len(msg.Tables) == 0 {
+		return false
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	changed := false
+	for key, refs := range msg.Tables {
+		if key == "" || len(refs) == 0 {
+			continue
+		}
+
+		if _, ok := s.series[key]; !ok {
+			s.keys = append(s.keys, key)
+			slices.SortFunc(s.keys, compareNatural)
+			changed = true
+		}
+
+		series := s.series[key]
+		for _, ref := range refs {
+			var refChanged bool
+			series, refChanged = upsertTableRef(series, ref)
+			changed = changed || refChanged
+		}
+		s.series[key] = series
+	}
+
+	return changed
+}
+
+func upsertTableRef(series []TableRef, ref TableRef) ([]TableRef, bool) {
+	idx := sort.Search(len(series), func(i int) bool {
+		return series[i].X > ref.X
+	})
+
+	// Last writer wins at a given X.
+	if idx > 0 && series[idx-1].X == ref.X {
+		if series[idx-1] == ref {
+			return series, false
+		}
+		series[idx-1] = ref
+		return series, true
+	}
+
+	series = slices.Insert(series, idx, ref)
+	return series, true
+}
+
+// SeriesKeys returns the sorted set of table keys.
+func (s *TableStore) SeriesKeys() []string {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return slices.Clone(s.keys)
+}
+
+// Series returns the table references logged under key, ordered by X.
+func (s *TableStore) Series(key string) []TableRef {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return slices.Clone(s.series[key])
+}
+
+// Empty reports whether the store contains any tables.
+func (s *TableStore) Empty() bool {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return len(s.keys) == 0
+}
+
+// TableCell is a single rendered cell of a table.
+//
+// Media is set for cells holding a wandb.Image, which the table pane renders
+// inline; Text is then the image's caption or file name.
+type TableCell struct {
+	Text  string
+	Media *MediaPoint
+
+	// num is the cell's numeric value when numeric is true, used for sorting.
+	num     float64
+	numeric bool
+}
+
+// TableData is the decoded content of a "table-file".
+type TableData struct {
+	Columns []string
+	Rows    [][]TableCell
+}
+
+// HasMedia reports whether any cell in the table is an image.
+func (t *TableData) HasMedia() bool {
+	for _, row := range t.Rows {
+		for _, cell := range row {
+			if cell.Media != nil {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// LoadTableData reads and decodes the table file at filePath.
+//
+// runPath is the run's .wandb file and is used to resolve media cells, whose
+// paths are relative to the run's files directory.
+func LoadTableData(runPath, filePath string) (*TableData, error) {
+	content, err := os.ReadFile(filePath)
+	if err != nil {
+		return nil, fmt.Errorf("read table: %w", err)
+	}
+	return parseTableData(runPath, content)
+}
+
+// parseTableData decodes the JSON layout written for wandb.Table values.
+//
+// Table files may contain NaN and ±Infinity, so they're decoded with
+// simplejsonext rather than encoding/json.
+func parseTableData(runPath string, content []byte) (*TableData, error) {
+	obj, err := simplejsonext.UnmarshalObject(content)
+	if err != nil {
+		return nil, fmt.Errorf("decode table: %w", err)
+	}
+
+	columns, _ := obj["columns"].([]any)
+	rows, _ := obj["data"].([]any)
+
+	data := &TableData{Columns: make([]string, len(columns))}
+	for i, v := range columns {
+		data.Columns[i] = tableCellFromValue(runPath, v).Text
+	}
+
+	data.Rows = make([][]TableCell, 0, len(rows))
+	for _, v := range rows {
+		values, ok := v.([]any)
+		if !ok {
+			continue
+		}
+		row := make([]TableCell, len(data.Columns))
+		for j := 0; j < len(values) && j < len(row); j++ {
+			row[j] = tableCellFromValue(runPath, values[j])
+		}
+		data.Rows = append(data.Rows, row)
+	}
+
+	return data, nil
+}
+
+func tableCellFromValue(runPath string, v any) TableCell {
+	switch x := v.(type) {
+	case nil:
+		return TableCell{}
+	case string:
+		return TableCell{Text: x}
+	case bool:
+		return TableCell{Text: strconv.FormatBool(x)}
+	case int64:
+		return TableCell{
+			Text:    strconv.FormatInt(x, 10),
+			num:     float64(x),
+			numeric: true,
+		}
+	case float64:
+		return TableCell{
+			Text:    strconv.FormatFloat(x, 'g', -1, 64),
+			num:     x,
+			numeric: !math.IsNaN(x),
+		}
+	case map[string]any:
+		if cell, ok := tableMediaCell(runPath, x); ok {
+			return cell
+		}
+	}
+
+	text, err := simplejsonext.Marshal(v)
+	if err != nil {
+		return TableCell{Text: fmt.Sprint(v)}
+	}
+	return TableCell{Text: string(text)}
+}
+
+// tableMediaCell converts a serialized wandb.Image into a media cell.
+func tableMediaCell(runPath string, obj map[string]any) (TableCell, bool) {
+	if typ, _ := obj["_type"].(string); typ != "image-file" {
+		return TableCell{}, false
+	}
+	relPath, _ := obj["path"].(string)
+	if relPath == "" {
+		return TableCell{}, false
+	}
+
+	caption, _ := obj["caption"].(string)
+	format, _ := obj["format"].(string)
+	sha256, _ := obj["sha256"].(string)
+	width, _ := obj["width"].(int64)
+	height, _ := obj["height"].(int64)
+
+	text := caption
+	if text == "" {
+		text = path.Base(filepath.ToSlash(relPath))
+	}
+
+	return TableCell{
+		Text: text,
+		Media: &MediaPoint{
+			FilePath:     resolveMediaPath(runPath, relPath),
+			RelativePath: relPath,
+			Caption:      caption,
+			Format:       format,
+			Width:        int(width),
+			Height:       int(height),
+			SHA256:       sha256,
+		},
+	}, true
+}
+
+// TableSortOrder is the sort direction applied to a table column.
+type TableSortOrder int
+
+const (
+	TableSortNone TableSortOrder = iota
+	TableSortAsc
+	TableSortDesc
+)
+
+// Next cycles none → ascending → descending → none.
+func (o TableSortOrder) Next() TableSortOrder {
+	return (o + 1) % 3
+}
+
+// Indicator is the glyph shown next to a sorted column's header.
+func (o TableSortOrder) Indicator() string {
+	switch o {
+	case TableSortAsc:
+		return "▲"
+	case TableSortDesc:
+		return "▼"
+	default:
+		return ""
+	}
+}
+
+// tableRowView returns the indices of the rows of data that match filter,
+// ordered by column col according to order.
+//
+// The filter is a case-insensitive substring match against every cell's text.
+// Sorting is stable, so rows with equal keys keep their logged order.
+func tableRowView(data *TableData, filter string, col int, order TableSortOrder) []int {
+	if data == nil {
+		return nil
+	}
+
+	needle := strings.ToLower(filter)
+	rows := make([]int, 0, len(data.Rows))
+	for i, row := range data.Rows {
+		if needle == "" || tableRowMatches(row, needle) {
+			rows = append(rows, i)
+		}
+	}
+
+	if order == TableSortNone || col < 0 || col >= len(data.Columns) {
+		return rows
+	}
+
+	slices.SortStableFunc(rows, func(a, b int) int {
+		c := compareTableCells(data.Rows[a][col], data.Rows[b][col])
+		if order == TableSortDesc {
+			return -c
+		}
+		return c
+	})
+	return rows
+}
+
+func tableRowMatches(row []TableCell, needle string) bool {
+	for _, cell := range row {
+		if strings.Contains(strings.ToLower(cell.Text), needle) {
+			return true
+		}
+	}
+	return false
+}
+
+// compareTableCells orders numbers before text, numbers numerically and text
+// naturally.
+func compareTableCells(a, b TableCell) int {
+	switch {
+	case a.numeric && b.numeric:
+		switch {
+		case a.num < b.num:
+			return -1
+		case a.num > b.num:
+			return 1
+		default:
+			return 0
+		}
+	case a.numeric:
+		return -1
+	case b.numeric:
+		return 1
+	default:
+		return compareNatural(a.Text, b.Text)
+	}
+}
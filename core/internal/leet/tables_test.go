@@ -0,0 +1,224 @@
+package leet_test
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	tea "charm.land/bubbletea/v2"
+	"github.com/stretchr/testify/require"
+
+	"github.com/wandb/wandb/core/internal/leet"
+	spb "github.com/wandb/wandb/core/pkg/service_go_proto"
+)
+
+func tableHistory(step, key, relPath string) *spb.HistoryRecord {
+	return &spb.HistoryRecord{
+		Item: []*spb.HistoryItem{
+			{NestedKey: []string{"_step"}, ValueJson: step},
+			{NestedKey: []string{key, "_type"}, ValueJson: `"table-file"`},
+			{NestedKey: []string{key, "path"}, ValueJson: `"` + relPath + `"`},
+			{NestedKey: []string{key, "ncols"}, ValueJson: "2"},
+			{NestedKey: []string{key, "nrows"}, ValueJson: "3"},
+			{NestedKey: []string{key, "sha256"}, ValueJson: `"abc"`},
+			{NestedKey: []string{key, "size"}, ValueJson: "120"},
+		},
+	}
+}
+
+// writeTableFile writes a table-file JSON under the run's files directory.
+func writeTableFile(t *testing.T, runPath, relPath, content string) {
+	t.Helper()
+	path := filepath.Join(filepath.Dir(runPath), "files", relPath)
+	require.NoError(t, os.MkdirAll(filepath.Dir(path), 0o755))
+	require.NoError(t, os.WriteFile(path, []byte(content), 0o644))
+}
+
+func TestParseHistory_TableFile(t *testing.T) {
+	runPath := filepath.Join("tmp", "run-123", "run-123.wandb")
+	relPath := "media/table/eval/preds_5_abc.table.json"
+
+	msg, ok := leet.ParseHistory(runPath, tableHistory("5", "eval/preds", relPath)).(leet.HistoryMsg)
+	require.True(t, ok)
+	require.Empty(t, msg.Metrics, "table shape fields must not become metrics")
+	require.Empty(t, msg.Media)
+	require.Len(t, msg.Tables["eval/preds"], 1)
+
+	ref := msg.Tables["eval/preds"][0]
+	require.Equal(t, 5.0, ref.X)
+	require.Equal(t, filepath.Join(filepath.Dir(runPath), "files", relPath), ref.FilePath)
+	require.Equal(t, 3, ref.NRows)
+	require.Equal(t, 2, ref.NCols)
+}
+
+func TestTableStore_OrdersStepsAndDedupes(t *testing.T) {
+	store := leet.NewTableStore()
+	require.True(t, store.Empty())
+
+	require.True(t, store.ProcessHistory(leet.HistoryMsg{Tables: map[string][]leet.TableRef{
+		"b": {{X: 3, FilePath: "b3"}},
+		"a": {{X: 2, FilePath: "a2"}, {X: 1, FilePath: "a1"}},
+	}}))
+	require.False(t, store.ProcessHistory(leet.HistoryMsg{Tables: map[string][]leet.TableRef{
+		"a": {{X: 1, FilePath: "a1"}},
+	}}))
+
+	require.Equal(t, []string{"a", "b"}, store.SeriesKeys())
+	series := store.Series("a")
+	require.Len(t, series, 2)
+	require.Equal(t, "a1", series[0].FilePath)
+	require.Equal(t, "a2", series[1].FilePath)
+}
+
+func TestLoadTableData_ParsesCellsAndMedia(t *testing.T) {
+	runPath := filepath.Join(t.TempDir(), "run-1.wandb")
+	writeTableFile(t, runPath, "t.table.json", `{
+		"columns": ["id", "score", "image", "meta"],
+		"data": [
+			[1, 0.5, {"_type": "image-file", "path": "media/images/a.png", "caption": "cat"}, null],
+			[2, NaN, "n/a", {"k": "v"}]
+		]
+	}`)
+
+	data, err := leet.LoadTableData(runPath, filepath.Join(filepath.Dir(runPath), "files", "t.table.json"))
+	require.NoError(t, err)
+	require.Equal(t, []string{"id", "score", "image", "meta"}, data.Columns)
+	require.Len(t, data.Rows, 2)
+	require.True(t, data.HasMedia())
+
+	media := data.Rows[0][2].Media
+	require.NotNil(t, media)
+	require.Equal(t, "cat", data.Rows[0][2].Text)
+	require.Equal(t,
+		filepath.Join(filepath.Dir(runPath), "files", "media", "images", "a.png"),
+		media.FilePath)
+
+	require.Equal(t, "NaN", data.Rows[1][1].Text)
+	require.Equal(t, `{"k":"v"}`, data.Rows[1][3].Text)
+}
+
+func TestLoadTableData_MissingFile(t *testing.T) {
+	_, err := leet.LoadTableData("run.wandb", filepath.Join(t.TempDir(), "missing.json"))
+	require.Error(t, err)
+}
+
+func testTablePane(t *testing.T) *leet.TablePane {
+	t.Helper()
+	runPath := filepath.Join(t.TempDir(), "run-1.wandb")
+	writeTableFile(t, runPath, "preds_1.table.json", `{
+		"columns": ["label", "loss"],
+		"data": [["dog", 0.9], ["cat", 0.1], ["bird", 10], ["cow", 2]]
+	}`)
+	writeTableFile(t, runPath, "preds_2.table.json", `{
+		"columns": ["label", "loss"],
+		"data": [["eel", 1]]
+	}`)
+
+	store := leet.NewTableStore()
+	store.ProcessHistory(leet.HistoryMsg{Tables: map[string][]leet.TableRef{
+		"preds": {
+			{X: 1, FilePath: filepath.Join(filepath.Dir(runPath), "files", "preds_1.table.json")},
+			{X: 2, FilePath: filepath.Join(filepath.Dir(runPath), "files", "preds_2.table.json")},
+		},
+	}})
+
+	pane := leet.NewTablePane(store, runPath)
+	pane.Toggle()
+	return pane
+}
+
+func tableKey(t *testing.T, pane *leet.TablePane, keys ...string) {
+	t.Helper()
+	for _, key := range keys {
+		var msg tea.KeyPressMsg
+		switch key {
+		case "enter":
+			msg = tea.KeyPressMsg{Code: tea.KeyEnter}
+		case "esc":
+			msg = tea.KeyPressMsg{Code: tea.KeyEsc}
+		default:
+			msg = navBindingMsg(t, key)
+		}
+		handled, cmd := pane.HandleKey(msg)
+		require.Truef(t, handled, "key %q should be handled", key)
+		pane.TestApply(cmd)
+	}
+}
+
+func TestTablePane_FollowsLatestStepAndSteps(t *testing.T) {
+	pane := testTablePane(t)
+	require.Equal(t, []string{"eel"}, pane.TestRowTexts(0))
+
+	tableKey(t, pane, ",")
+	require.Equal(t, []string{"dog", "cat", "bird", "cow"}, pane.TestRowTexts(0))
+
+	tableKey(t, pane, ".")
+	require.Equal(t, []string{"eel"}, pane.TestRowTexts(0))
+}
+
+func TestTablePane_SortCyclesNumerically(t *testing.T) {
+	pane := testTablePane(t)
+	tableKey(t, pane, ",", "right", "o")
+	require.Equal(t, []string{"cat", "dog", "cow", "bird"}, pane.TestRowTexts(0))
+
+	tableKey(t, pane, "o")
+	require.Equal(t, []string{"bird", "cow", "dog", "cat"}, pane.TestRowTexts(0))
+
+	tableKey(t, pane, "o")
+	require.Equal(t, []string{"dog", "cat", "bird", "cow"}, pane.TestRowTexts(0))
+}
+
+func TestTablePane_Filter(t *testing.T) {
+	pane := testTablePane(t)
+	tableKey(t, pane, ",", "/")
+	require.True(t, pane.IsFilterMode())
+
+	tableKey(t, pane, "O", "enter")
+	require.False(t, pane.IsFilterMode())
+	require.Equal(t, []string{"dog", "cow"}, pane.TestRowTexts(0))
+
+	handled, _ := pane.HandleKey(tea.KeyPressMsg{Code: 'l', Mod: tea.ModCtrl})
+	require.True(t, handled)
+	require.Len(t, pane.TestRowTexts(0), 4)
+}
+
+func TestTablePane_ViewAndClose(t *testing.T) {
+	pane := testTablePane(t)
+	tableKey(t, pane, ",")
+
+	view := pane.View(80, 12)
+	require.Contains(t, view, "Tables")
+	require.Contains(t, view, "preds")
+	require.Contains(t, view, "step 1 [1/2]")
+	require.Contains(t, view, "bird")
+	require.LessOrEqual(t, len(strings.Split(view, "\n")), 12)
+
+	tableKey(t, pane, "esc")
+	require.False(t, pane.IsOpen())
+}
+
+func TestTablePane_RetriesFailedLoad(t *testing.T) {
+	runPath := filepath.Join(t.TempDir(), "run-1.wandb")
+	store := leet.NewTableStore()
+	store.ProcessHistory(leet.HistoryMsg{Tables: map[string][]leet.TableRef{
+		"preds": {{X: 1, FilePath: filepath.Join(filepath.Dir(runPath), "files", "preds_1.table.json")}},
+	}})
+	pane := leet.NewTablePane(store, runPath)
+	pane.Toggle()
+	require.Contains(t, pane.View(80, 12), "Loading table...")
+
+	pane.TestLoad()
+	require.Contains(t, pane.View(80, 12), "read table")
+	require.Nil(t, pane.LoadCmd(), "should wait before retrying")
+
+	writeTableFile(t, runPath, "preds_1.table.json", `{"columns": ["label"], "data": [["eel"]]}`)
+	pane.TestRetryNow()
+	require.Equal(t, []string{"eel"}, pane.TestRowTexts(0))
+}
+
+func TestTablePane_EmptyStore(t *testing.T) {
+	pane := leet.NewTablePane(leet.NewTableStore(), "run.wandb")
+	pane.Toggle()
+	require.Contains(t, pane.View(60, 8), "No tables logged.")
+}
@@ -742,3 +742,40 @@ func (w *Workspace) TestFilteredRunKeys() []string {
 func (w *Workspace) TestRunByKey(key string) *WorkspaceRun {
 	return w.runsByKey[key]
 }
+
+// TestLoad reads the selected table synchronously, as the run would after
+// running the pane's load command.
+func (p *TablePane) TestLoad() {
+	p.TestApply(p.LoadCmd())
+}
+
+// TestApply runs a command returned by the pane and handles the table it
+// loads, if any.
+func (p *TablePane) TestApply(cmd tea.Cmd) {
+	if cmd == nil {
+		return
+	}
+	if msg, ok := cmd().(tablePaneLoadedMsg); ok {
+		p.HandleLoaded(msg)
+	}
+}
+
+// TestRetryNow lets a table that failed to load be read again immediately.
+func (p *TablePane) TestRetryNow() {
+	p.retryAt = time.Time{}
+}
+
+// TestRowTexts returns the text of column col for each row of the table
+// pane's current filtered and sorted view.
+func (p *TablePane) TestRowTexts(col int) []string {
+	p.TestLoad()
+	p.sync()
+	if p.current() == nil {
+		return nil
+	}
+	texts := make([]string, len(p.rows))
+	for i, row := range p.rows {
+		texts[i] = p.data.Rows[row][col].Text
+	}
+	return texts
+}
@@ -0,0 +1,192 @@
+package logparse
+
+import (
+	"encoding/json"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// pythonLevels matches the level names of Python's logging module.
+//
+// Only upper-case names are accepted, as lower-case words are too likely to
+// appear at the start of ordinary output.
+const pythonLevels = `DEBUG|INFO|WARNING|WARN|ERROR|CRITICAL|FATAL`
+
+// pythonTimestamp matches the default format of %(asctime)s, with
+// an optional 'T' separator.
+const pythonTimestamp = `\d{4}-\d{2}-\d{2}[ T]\d{2}:\d{2}:\d{2}(?:[,.]\d+)?`
+
+var (
+	// pythonBasicFormat is logging.basicConfig()'s default format,
+	// "%(levelname)s:%(name)s:%(message)s".
+	pythonBasicFormat = regexp.MustCompile(
+		`^(` + pythonLevels + `):([^:\s]+):(.*)$`)
+
+	// pythonDashedFormat is the format from Python's logging cookbook,
+	// "%(asctime)s - %(name)s - %(levelname)s - %(message)s".
+	pythonDashedFormat = regexp.MustCompile(
+		`^(` + pythonTimestamp + `) - (\S+) - (` + pythonLevels + `) - (.*)$`)
+
+	// pythonLevelFormat is a timestamp, optionally in brackets, followed
+	// by a level, optionally in brackets, as in
+	// "%(asctime)s [%(levelname)s] %(message)s".
+	pythonLevelFormat = regexp.MustCompile(
+		`^\[?(` + pythonTimestamp + `)\]?\s+\[?(` + pythonLevels + `)\]?:?\s+(.*)$`)
+
+	// glogFormat is the header glog and klog write before each message:
+	//
+	//	Lmmdd hh:mm:ss.uuuuuu threadid file:line] msg
+	glogFormat = regexp.MustCompile(
+		`^([IWEF])(\d{2})(\d{2}) (\d{2}:\d{2}:\d{2}\.\d+)\s+\d+ ([^\s\]]+)\] ?(.*)$`)
+)
+
+// Python parses lines written by Python's logging module in its common
+// formats.
+func Python(line string) (Entry, bool) {
+	if m := pythonBasicFormat.FindStringSubmatch(line); m != nil {
+		return Entry{
+			Level:   ParseLevel(m[1]),
+			Logger:  m[2],
+			Message: m[3],
+		}, true
+	}
+
+	if m := pythonDashedFormat.FindStringSubmatch(line); m != nil {
+		return Entry{
+			Time:    parsePythonTime(m[1]),
+			Logger:  m[2],
+			Level:   ParseLevel(m[3]),
+			Message: m[4],
+		}, true
+	}
+
+	if m := pythonLevelFormat.FindStringSubmatch(line); m != nil {
+		return Entry{
+			Time:    parsePythonTime(m[1]),
+			Level:   ParseLevel(m[2]),
+			Message: m[3],
+		}, true
+	}
+
+	return Entry{}, false
+}
+
+// parsePythonTime parses a %(asctime)s timestamp in local time.
+func parsePythonTime(value string) time.Time {
+	value = strings.Replace(value, ",", ".", 1)
+	value = strings.Replace(value, "T", " ", 1)
+
+	t, err := time.ParseInLocation("2006-01-02 15:04:05.999999999", value, time.Local)
+	if err != nil {
+		return time.Time{}
+	}
+	return t
+}
+
+// Glog parses lines written by glog and klog.
+//
+// The header has no year, so the current year is assumed.
+func Glog(line string) (Entry, bool) {
+	m := glogFormat.FindStringSubmatch(line)
+	if m == nil {
+		return Entry{}, false
+	}
+
+	var level Level
+	switch m[1] {
+	case "I":
+		level = LevelInfo
+	case "W":
+		level = LevelWarning
+	case "E":
+		level = LevelError
+	case "F":
+		level = LevelCritical
+	}
+
+	t, err := time.ParseInLocation(
+		"2006 01 02 15:04:05.999999999",
+		strconv.Itoa(time.Now().Year())+" "+m[2]+" "+m[3]+" "+m[4],
+		time.Local,
+	)
+	if err != nil {
+		t = time.Time{}
+	}
+
+	return Entry{
+		Time:    t,
+		Level:   level,
+		Logger:  m[5],
+		Message: m[6],
+	}, true
+}
+
+// JSON field names used by common structured loggers, in order
+// of preference.
+var (
+	jsonLevelKeys   = []string{"level", "levelname", "severity", "lvl", "log.level"}
+	jsonMessageKeys = []string{"msg", "message", "event"}
+	jsonLoggerKeys  = []string{"logger", "name", "logger_name", "caller"}
+	jsonTimeKeys    = []string{"time", "timestamp", "ts", "@timestamp", "asctime"}
+)
+
+// JSON parses JSON objects that have a level field, as written by
+// structured loggers like slog, zap, structlog and python-json-logger.
+func JSON(line string) (Entry, bool) {
+	line = strings.TrimSpace(line)
+	if !strings.HasPrefix(line, "{") {
+		return Entry{}, false
+	}
+
+	var fields map[string]any
+	if err := json.Unmarshal([]byte(line), &fields); err != nil {
+		return Entry{}, false
+	}
+
+	levelName, ok := firstString(fields, jsonLevelKeys)
+	if !ok {
+		return Entry{}, false
+	}
+
+	entry := Entry{Level: ParseLevel(levelName)}
+	entry.Message, _ = firstString(fields, jsonMessageKeys)
+	entry.Logger, _ = firstString(fields, jsonLoggerKeys)
+	entry.Time = jsonTime(fields)
+
+	return entry, true
+}
+
+// firstString returns the first of the keys that has a string value.
+func firstString(fields map[string]any, keys []string) (string, bool) {
+	for _, key := range keys {
+		if value, ok := fields[key].(string); ok {
+			return value, true
+		}
+	}
+	return "", false
+}
+
+// jsonTime returns the timestamp in a JSON log line, given either as
+// an RFC 3339 string or as seconds since the Unix epoch.
+func jsonTime(fields map[string]any) time.Time {
+	for _, key := range jsonTimeKeys {
+		switch value := fields[key].(type) {
+		case string:
+			if t, err := time.Parse(time.RFC3339Nano, value); err == nil {
+				return t
+			}
+			if t := parsePythonTime(value); !t.IsZero() {
+				return t
+			}
+
+		case float64:
+			seconds := int64(value)
+			nanos := int64((value - float64(seconds)) * float64(time.Second))
+			return time.Unix(seconds, nanos)
+		}
+	}
+
+	return time.Time{}
+}
@@ -0,0 +1,145 @@
+// Package logparse extracts the severity, source and message from lines of
+// console output written by common logging libraries.
+package logparse
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// Level is the severity of a log line.
+type Level int
+
+const (
+	// LevelUnknown is the level of a line that isn't a recognized log line.
+	LevelUnknown Level = iota
+	LevelDebug
+	LevelInfo
+	LevelWarning
+	LevelError
+	LevelCritical
+)
+
+// Levels is every known level from least to most severe.
+var Levels = []Level{
+	LevelDebug,
+	LevelInfo,
+	LevelWarning,
+	LevelError,
+	LevelCritical,
+}
+
+func (l Level) String() string {
+	switch l {
+	case LevelDebug:
+		return "debug"
+	case LevelInfo:
+		return "info"
+	case LevelWarning:
+		return "warning"
+	case LevelError:
+		return "error"
+	case LevelCritical:
+		return "critical"
+	default:
+		return ""
+	}
+}
+
+// ParseLevel returns the level with the given name.
+//
+// It accepts the names used by common logging libraries in any case,
+// such as "WARN" or "fatal", and returns LevelUnknown for anything else.
+func ParseLevel(name string) Level {
+	switch strings.ToLower(strings.TrimSpace(name)) {
+	case "trace", "debug", "dbg":
+		return LevelDebug
+	case "info", "information", "notice":
+		return LevelInfo
+	case "warn", "warning":
+		return LevelWarning
+	case "err", "error":
+		return LevelError
+	case "crit", "critical", "fatal", "panic", "emerg", "alert":
+		return LevelCritical
+	default:
+		return LevelUnknown
+	}
+}
+
+// Entry is the parsed form of a log line.
+type Entry struct {
+	// Time is the timestamp written in the line, or the zero time if
+	// the line has none.
+	Time time.Time
+
+	Level Level
+
+	// Logger is the name of the logger or source location that wrote
+	// the line, if known.
+	Logger string
+
+	// Message is the line without its timestamp, level and logger.
+	Message string
+}
+
+// Parser parses a single line of output.
+//
+// It reports false if the line isn't in its format.
+type Parser func(line string) (Entry, bool)
+
+// ByName returns the parser with the given name.
+//
+// The names are "python" for Python's logging module, "json" for
+// JSON lines and "glog" for glog and klog.
+func ByName(name string) (Parser, error) {
+	switch name {
+	case "python":
+		return Python, nil
+	case "json":
+		return JSON, nil
+	case "glog", "klog":
+		return Glog, nil
+	default:
+		return nil, fmt.Errorf("logparse: unknown parser %q", name)
+	}
+}
+
+// FromNames returns a parser that tries each named parser in order.
+//
+// It returns nil if names is empty.
+func FromNames(names []string) (Parser, error) {
+	parsers := make([]Parser, 0, len(names))
+
+	for _, name := range names {
+		parser, err := ByName(name)
+		if err != nil {
+			return nil, err
+		}
+		parsers = append(parsers, parser)
+	}
+
+	if len(parsers) == 0 {
+		return nil, nil
+	}
+	return Chain(parsers...), nil
+}
+
+// Chain returns a parser that uses the first of the parsers that accepts
+// a line.
+func Chain(parsers ...Parser) Parser {
+	return func(line string) (Entry, bool) {
+		for _, parse := range parsers {
+			if entry, ok := parse(line); ok {
+				return entry, true
+			}
+		}
+		return Entry{}, false
+	}
+}
+
+// All tries every known format.
+func All(line string) (Entry, bool) {
+	return Chain(JSON, Glog, Python)(line)
+}
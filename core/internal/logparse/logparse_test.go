@@ -0,0 +1,137 @@
+package logparse_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/wandb/wandb/core/internal/logparse"
+)
+
+func TestParseLevel(t *testing.T) {
+	assert.Equal(t, logparse.LevelWarning, logparse.ParseLevel("WARN"))
+	assert.Equal(t, logparse.LevelCritical, logparse.ParseLevel("fatal"))
+	assert.Equal(t, logparse.LevelDebug, logparse.ParseLevel(" trace "))
+	assert.Equal(t, logparse.LevelUnknown, logparse.ParseLevel("loud"))
+}
+
+func TestPython(t *testing.T) {
+	testCases := []struct {
+		name  string
+		line  string
+		entry logparse.Entry
+	}{
+		{
+			name: "basicConfig",
+			line: "ERROR:torch.distributed:connection lost",
+			entry: logparse.Entry{
+				Level:   logparse.LevelError,
+				Logger:  "torch.distributed",
+				Message: "connection lost",
+			},
+		},
+		{
+			name: "dashed",
+			line: "2024-01-02 03:04:05,678 - trainer - WARNING - lr is high",
+			entry: logparse.Entry{
+				Time: time.Date(2024, 1, 2, 3, 4, 5, 678_000_000,
+					time.Local),
+				Level:   logparse.LevelWarning,
+				Logger:  "trainer",
+				Message: "lr is high",
+			},
+		},
+		{
+			name: "bracketed level",
+			line: "2024-01-02T03:04:05 [INFO] epoch 1",
+			entry: logparse.Entry{
+				Time:    time.Date(2024, 1, 2, 3, 4, 5, 0, time.Local),
+				Level:   logparse.LevelInfo,
+				Message: "epoch 1",
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			entry, ok := logparse.Python(tc.line)
+
+			require.True(t, ok)
+			assert.Equal(t, tc.entry, entry)
+		})
+	}
+}
+
+func TestPython_RejectsOrdinaryOutput(t *testing.T) {
+	for _, line := range []string{
+		"Epoch 1: loss=0.5",
+		"error: something happened",
+		"INFO and more",
+	} {
+		_, ok := logparse.Python(line)
+		assert.False(t, ok, line)
+	}
+}
+
+func TestGlog(t *testing.T) {
+	entry, ok := logparse.Glog(
+		"E0102 03:04:05.678901   12345 server.go:42] request failed")
+
+	require.True(t, ok)
+	assert.Equal(t, logparse.LevelError, entry.Level)
+	assert.Equal(t, "server.go:42", entry.Logger)
+	assert.Equal(t, "request failed", entry.Message)
+	assert.Equal(t, time.January, entry.Time.Month())
+	assert.Equal(t, 2, entry.Time.Day())
+	assert.Equal(t, 678901000, entry.Time.Nanosecond())
+}
+
+func TestJSON(t *testing.T) {
+	entry, ok := logparse.JSON(
+		`{"time":"2024-01-02T03:04:05Z","level":"WARN",` +
+			`"msg":"disk almost full","logger":"storage"}`)
+
+	require.True(t, ok)
+	assert.Equal(t,
+		logparse.Entry{
+			Time:    time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC),
+			Level:   logparse.LevelWarning,
+			Logger:  "storage",
+			Message: "disk almost full",
+		},
+		entry)
+}
+
+func TestJSON_UnixTimestamp(t *testing.T) {
+	entry, ok := logparse.JSON(`{"ts": 1704164645.5, "severity": "error"}`)
+
+	require.True(t, ok)
+	assert.Equal(t, logparse.LevelError, entry.Level)
+	assert.Equal(t, time.Unix(1704164645, 500_000_000), entry.Time)
+}
+
+func TestJSON_RequiresLevel(t *testing.T) {
+	_, ok := logparse.JSON(`{"loss": 0.5}`)
+	assert.False(t, ok)
+
+	_, ok = logparse.JSON(`{"level": "info"`)
+	assert.False(t, ok)
+}
+
+func TestFromNames(t *testing.T) {
+	parser, err := logparse.FromNames([]string{"json", "python"})
+	require.NoError(t, err)
+
+	entry, ok := parser("INFO:root:hello")
+	assert.True(t, ok)
+	assert.Equal(t, "hello", entry.Message)
+
+	_, err = logparse.FromNames([]string{"xml"})
+	assert.ErrorContains(t, err, `unknown parser "xml"`)
+
+	parser, err = logparse.FromNames(nil)
+	assert.NoError(t, err)
+	assert.Nil(t, parser)
+}
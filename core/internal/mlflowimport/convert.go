@@ -0,0 +1,466 @@
+package mlflowimport
+
+import (
+	"cmp"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"math"
+	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/wandb/simplejsonext"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/wandb/wandb/core/internal/observability"
+	"github.com/wandb/wandb/core/internal/pathtree"
+	"github.com/wandb/wandb/core/internal/runhistory"
+	"github.com/wandb/wandb/core/internal/runsummary"
+	"github.com/wandb/wandb/core/internal/transactionlog"
+	spb "github.com/wandb/wandb/core/pkg/service_go_proto"
+)
+
+const (
+	// maxTagLength is the longest tag the W&B backend accepts.
+	maxTagLength = 64
+
+	// artifactsFilesDir is the subdirectory of the run's files directory
+	// that MLflow artifacts are imported into.
+	//
+	// It keeps artifacts from clashing with files the SDK writes there,
+	// like config.yaml and wandb-summary.json.
+	artifactsFilesDir = "artifacts"
+
+	// MLflow system tags that map onto W&B run fields.
+	tagRunName   = "mlflow.runName"
+	tagNote      = "mlflow.note.content"
+	tagGitCommit = "mlflow.source.git.commit"
+	tagGitRepo   = "mlflow.source.git.repoURL"
+)
+
+// Params are the options for importing a run.
+type Params struct {
+	// WandbDir is the directory in which to create offline run directories.
+	WandbDir string
+
+	// Entity is the W&B entity for the run, or empty for the default.
+	Entity string
+
+	// Project is the W&B project for the run.
+	//
+	// If empty, the project is named after the run's MLflow experiment.
+	Project string
+
+	Logger *observability.CoreLogger
+}
+
+// Result describes an imported run.
+type Result struct {
+	// RunDir is the offline run directory.
+	RunDir string
+
+	// WandbFile is the run's .wandb transaction log.
+	WandbFile string
+
+	// Steps is the number of history steps written.
+	Steps int
+
+	// Files is the number of artifact files imported.
+	Files int
+}
+
+// ImportRun writes an MLflow run as an offline W&B run.
+//
+// The run's params become its config, its metrics become history with
+// their original steps and timestamps, its tags become W&B tags, notes
+// and git info, and its local artifacts become run files.
+//
+// The offline run directory is named after the run's start time and ID,
+// so importing a run twice fails with an error wrapping fs.ErrExist.
+func ImportRun(run *Run, params Params) (*Result, error) {
+	runDir := filepath.Join(
+		params.WandbDir,
+		fmt.Sprintf("offline-run-%s-%s",
+			run.StartTime.Format("20060102_150405"),
+			run.ID),
+	)
+	if err := os.MkdirAll(params.WandbDir, 0o755); err != nil {
+		return nil, err
+	}
+	if err := os.Mkdir(runDir, 0o755); err != nil {
+		return nil, err
+	}
+
+	result := &Result{
+		RunDir:    runDir,
+		WandbFile: filepath.Join(runDir, fmt.Sprintf("run-%s.wandb", run.ID)),
+	}
+
+	err := importRun(run, params, result)
+	if err != nil {
+		// Don't leave a partial run behind to be synced.
+		_ = os.RemoveAll(runDir)
+		return nil, err
+	}
+
+	return result, nil
+}
+
+func importRun(run *Run, params Params, result *Result) (err error) {
+	writer, err := transactionlog.OpenWriter(result.WandbFile)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		err = errors.Join(err, writer.Close())
+	}()
+
+	record, err := runRecord(run, params)
+	if err != nil {
+		return err
+	}
+	if err := writer.Write(record); err != nil {
+		return err
+	}
+
+	files, err := importArtifacts(run.ArtifactsDir, filepath.Join(result.RunDir, "files"))
+	if err != nil {
+		return fmt.Errorf("mlflowimport: importing artifacts: %v", err)
+	}
+	result.Files = len(files)
+	if len(files) > 0 {
+		if err := writer.Write(filesRecord(files)); err != nil {
+			return err
+		}
+	}
+
+	summary := runsummary.New()
+	for _, row := range historyRows(run.Metrics) {
+		history := runhistory.New()
+		history.SetInt(pathtree.PathOf("_step"), row.step)
+		history.SetFloat(pathtree.PathOf("_timestamp"), unixSeconds(row.timestamp))
+		history.SetFloat(pathtree.PathOf("_runtime"),
+			row.timestamp.Sub(run.StartTime).Seconds())
+		for key, value := range row.values {
+			history.SetFloat(pathtree.PathOf(key), value)
+		}
+
+		items, err := history.ToRecords()
+		if err != nil {
+			return err
+		}
+		err = writer.Write(&spb.Record{RecordType: &spb.Record_History{
+			History: &spb.HistoryRecord{
+				Step: &spb.HistoryStep{Num: row.step},
+				Item: items,
+			},
+		}})
+		if err != nil {
+			return err
+		}
+
+		if _, err := summary.UpdateSummaries(history); err != nil {
+			params.Logger.Warn(
+				"mlflowimport: failed to update summary",
+				"run", run.ID, "error", err)
+		}
+		result.Steps++
+	}
+
+	if result.Steps > 0 {
+		summaryItems, err := summary.ToRecords()
+		if err != nil {
+			return err
+		}
+		err = writer.Write(&spb.Record{RecordType: &spb.Record_Summary{
+			Summary: &spb.SummaryRecord{Update: summaryItems},
+		}})
+		if err != nil {
+			return err
+		}
+	}
+
+	return writer.Write(exitRecord(run))
+}
+
+// runRecord makes the Record that starts the run.
+func runRecord(run *Run, params Params) (*spb.Record, error) {
+	project := params.Project
+	if project == "" {
+		project = projectName(run.Experiment)
+	}
+
+	config, err := configRecord(run)
+	if err != nil {
+		return nil, err
+	}
+
+	record := &spb.RunRecord{
+		RunId:       run.ID,
+		Entity:      params.Entity,
+		Project:     project,
+		DisplayName: run.Name,
+		Notes:       run.Tags[tagNote],
+		Tags:        userTags(run.Tags),
+		StartTime:   timestamppb.New(run.StartTime),
+		Config:      config,
+	}
+
+	if commit := run.Tags[tagGitCommit]; commit != "" {
+		record.Git = &spb.GitRepoRecord{
+			RemoteUrl: run.Tags[tagGitRepo],
+			Commit:    commit,
+		}
+	}
+
+	return &spb.Record{RecordType: &spb.Record_Run{Run: record}}, nil
+}
+
+// configRecord converts the run's params into config.
+//
+// Params are typed if they look like numbers or booleans, since MLflow
+// stores everything as a string. MLflow's IDs and system tags are kept
+// under an "mlflow" key.
+func configRecord(run *Run) (*spb.ConfigRecord, error) {
+	config := &spb.ConfigRecord{}
+	addItem := func(key string, value any) error {
+		valueJSON, err := simplejsonext.Marshal(value)
+		if err != nil {
+			return fmt.Errorf("mlflowimport: config value for %q: %v", key, err)
+		}
+		config.Update = append(config.Update, &spb.ConfigItem{
+			Key:       key,
+			ValueJson: string(valueJSON),
+		})
+		return nil
+	}
+
+	for _, key := range sortedKeys(run.Params) {
+		if err := addItem(key, paramValue(run.Params[key])); err != nil {
+			return nil, err
+		}
+	}
+
+	mlflowInfo := map[string]any{
+		"run_id":          run.ID,
+		"experiment_id":   run.Experiment.ID,
+		"experiment_name": run.Experiment.Name,
+	}
+	for key, value := range run.Tags {
+		name, isSystemTag := strings.CutPrefix(key, "mlflow.")
+		if isSystemTag && key != tagNote {
+			mlflowInfo[name] = value
+		}
+	}
+	if err := addItem("mlflow", mlflowInfo); err != nil {
+		return nil, err
+	}
+
+	return config, nil
+}
+
+// paramValue converts an MLflow param string to a typed config value.
+func paramValue(param string) any {
+	if x, err := strconv.ParseInt(param, 10, 64); err == nil {
+		return x
+	}
+
+	// ParseFloat also accepts "inf" and "nan", which are more likely
+	// to be strings than numbers.
+	if x, err := strconv.ParseFloat(param, 64); err == nil &&
+		!math.IsInf(x, 0) && !math.IsNaN(x) {
+		return x
+	}
+
+	switch param {
+	case "True", "true":
+		return true
+	case "False", "false":
+		return false
+	case "None":
+		return nil
+	default:
+		return param
+	}
+}
+
+// userTags converts the run's non-system tags into W&B tags.
+//
+// W&B tags are plain labels, so a tag with a value becomes "key:value".
+func userTags(tags map[string]string) []string {
+	var result []string
+	for _, key := range sortedKeys(tags) {
+		if strings.HasPrefix(key, "mlflow.") {
+			continue
+		}
+
+		tag := key
+		if value := tags[key]; value != "" {
+			tag = key + ":" + value
+		}
+		if runes := []rune(tag); len(runes) > maxTagLength {
+			tag = string(runes[:maxTagLength])
+		}
+		result = append(result, tag)
+	}
+	return result
+}
+
+// projectName derives a W&B project name from an MLflow experiment.
+func projectName(experiment *Experiment) string {
+	name := strings.Map(func(r rune) rune {
+		switch r {
+		case '/', '\\', '#', '?', '%', ':':
+			return '-'
+		}
+		return r
+	}, strings.TrimSpace(experiment.Name))
+
+	if name == "" {
+		return "mlflow-" + experiment.ID
+	}
+	return name
+}
+
+// exitRecord marks the run finished, or failed if MLflow doesn't
+// consider it finished.
+func exitRecord(run *Run) *spb.Record {
+	exit := &spb.RunExitRecord{ExitCode: 1}
+	if run.Status == statusFinished {
+		exit.ExitCode = 0
+	}
+	if !run.EndTime.IsZero() {
+		exit.Runtime = int32(run.EndTime.Sub(run.StartTime).Seconds())
+	}
+
+	return &spb.Record{RecordType: &spb.Record_Exit{Exit: exit}}
+}
+
+func filesRecord(paths []string) *spb.Record {
+	files := &spb.FilesRecord{}
+	for _, path := range paths {
+		files.Files = append(files.Files, &spb.FilesItem{
+			Path:   path,
+			Policy: spb.FilesItem_END,
+		})
+	}
+
+	return &spb.Record{RecordType: &spb.Record_Files{Files: files}}
+}
+
+// historyRow is the metrics logged at one MLflow step.
+type historyRow struct {
+	step int64
+
+	// timestamp is the latest time a metric was logged at the step.
+	timestamp time.Time
+
+	values map[string]float64
+}
+
+// historyRows merges the run's metrics into rows by step, in order.
+//
+// If a metric was logged more than once at a step, the latest value wins.
+func historyRows(metrics map[string][]MetricPoint) []*historyRow {
+	rowsByStep := make(map[int64]*historyRow)
+	for key, points := range metrics {
+		for _, point := range points {
+			row, ok := rowsByStep[point.Step]
+			if !ok {
+				row = &historyRow{
+					step:   point.Step,
+					values: make(map[string]float64),
+				}
+				rowsByStep[point.Step] = row
+			}
+
+			if point.Timestamp.After(row.timestamp) {
+				row.timestamp = point.Timestamp
+			}
+			row.values[key] = point.Value
+		}
+	}
+
+	rows := make([]*historyRow, 0, len(rowsByStep))
+	for _, row := range rowsByStep {
+		rows = append(rows, row)
+	}
+	slices.SortFunc(rows, func(a, b *historyRow) int {
+		return cmp.Compare(a.step, b.step)
+	})
+	return rows
+}
+
+// importArtifacts links or copies the run's artifacts into its files
+// directory, returning their paths relative to it.
+func importArtifacts(artifactsDir, filesDir string) ([]string, error) {
+	if artifactsDir == "" {
+		return nil, nil
+	}
+
+	var paths []string
+	err := filepath.WalkDir(artifactsDir,
+		func(path string, d fs.DirEntry, err error) error {
+			if err != nil || d.IsDir() {
+				return err
+			}
+
+			relPath, err := filepath.Rel(artifactsDir, path)
+			if err != nil {
+				return err
+			}
+			filesPath := filepath.Join(artifactsFilesDir, relPath)
+
+			if err := linkOrCopy(path, filepath.Join(filesDir, filesPath)); err != nil {
+				return err
+			}
+			paths = append(paths, filepath.ToSlash(filesPath))
+			return nil
+		})
+
+	return paths, err
+}
+
+// linkOrCopy hard-links src to dst, copying it if linking fails,
+// such as when they're on different filesystems.
+func linkOrCopy(src, dst string) error {
+	if err := os.MkdirAll(filepath.Dir(dst), 0o755); err != nil {
+		return err
+	}
+	if os.Link(src, dst) == nil {
+		return nil
+	}
+
+	in, err := os.Open(src)
+	if err != nil {
+		return err
+	}
+	defer in.Close()
+
+	out, err := os.OpenFile(dst, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0o644)
+	if err != nil {
+		return err
+	}
+	_, err = io.Copy(out, in)
+	return errors.Join(err, out.Close())
+}
+
+// unixSeconds converts a time to fractional seconds since the epoch,
+// the format of the _timestamp history key.
+func unixSeconds(t time.Time) float64 {
+	return float64(t.UnixMilli()) / 1000
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	slices.Sort(keys)
+	return keys
+}
@@ -0,0 +1,253 @@
+package mlflowimport_test
+
+import (
+	"errors"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/wandb/wandb/core/internal/mlflowimport"
+	"github.com/wandb/wandb/core/internal/observabilitytest"
+	"github.com/wandb/wandb/core/internal/transactionlog"
+	spb "github.com/wandb/wandb/core/pkg/service_go_proto"
+)
+
+func writeFile(t *testing.T, path, content string) {
+	t.Helper()
+	require.NoError(t, os.MkdirAll(filepath.Dir(path), 0o755))
+	require.NoError(t, os.WriteFile(path, []byte(content), 0o644))
+}
+
+// makeStore creates a file store with one experiment containing a
+// finished run, a deleted run and a deleted experiment.
+func makeStore(t *testing.T) string {
+	t.Helper()
+	store := t.TempDir()
+
+	writeFile(t, filepath.Join(store, "1", "meta.yaml"),
+		"experiment_id: '1'\nname: my/experiment\nlifecycle_stage: active\n")
+	writeFile(t, filepath.Join(store, "2", "meta.yaml"),
+		"experiment_id: '2'\nname: trash\nlifecycle_stage: deleted\n")
+	writeFile(t, filepath.Join(store, ".trash", "meta.yaml"), "name: x\n")
+	require.NoError(t, os.MkdirAll(filepath.Join(store, "models"), 0o755))
+
+	run := filepath.Join(store, "1", "abc123")
+	writeFile(t, filepath.Join(run, "meta.yaml"),
+		"run_id: abc123\n"+
+			"run_name: bright-fox\n"+
+			"status: 3\n"+
+			"start_time: 1700000000000\n"+
+			"end_time: 1700000060000\n"+
+			"lifecycle_stage: active\n"+
+			"artifact_uri: file:///nonexistent/artifacts\n")
+	writeFile(t, filepath.Join(run, "params", "lr"), "0.01")
+	writeFile(t, filepath.Join(run, "params", "epochs"), "10")
+	writeFile(t, filepath.Join(run, "params", "optimizer"), "adam")
+	writeFile(t, filepath.Join(run, "params", "shuffle"), "True")
+	writeFile(t, filepath.Join(run, "tags", "team"), "vision")
+	writeFile(t, filepath.Join(run, "tags", "baseline"), "")
+	writeFile(t, filepath.Join(run, "tags", "mlflow.user"), "alice")
+	writeFile(t, filepath.Join(run, "tags", "mlflow.note.content"), "first try")
+	writeFile(t, filepath.Join(run, "tags", "mlflow.source.git.commit"), "deadbeef")
+	writeFile(t, filepath.Join(run, "metrics", "loss"),
+		"1700000001000 2.5 0\n"+
+			"1700000002000 1.5 1\n"+
+			"1700000003000 0.5 2\n")
+	writeFile(t, filepath.Join(run, "metrics", "eval", "acc"),
+		"1700000002500 0.75 1\n")
+	writeFile(t, filepath.Join(run, "artifacts", "model", "weights.bin"), "weights")
+
+	deleted := filepath.Join(store, "1", "def456")
+	writeFile(t, filepath.Join(deleted, "meta.yaml"),
+		"run_id: def456\nstatus: 4\nstart_time: 1\nlifecycle_stage: deleted\n")
+
+	return store
+}
+
+func readRecords(t *testing.T, path string) []*spb.Record {
+	t.Helper()
+
+	reader, err := transactionlog.OpenReader(path, observabilitytest.NewTestLogger(t))
+	require.NoError(t, err)
+	defer reader.Close()
+
+	var records []*spb.Record
+	for {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			return records
+		}
+		require.NoError(t, err)
+		records = append(records, record)
+	}
+}
+
+func TestReadStore(t *testing.T) {
+	store := makeStore(t)
+
+	experiments, err := mlflowimport.ReadExperiments(store)
+	require.NoError(t, err)
+	require.Len(t, experiments, 1)
+	assert.Equal(t, "1", experiments[0].ID)
+	assert.Equal(t, "my/experiment", experiments[0].Name)
+
+	runs, err := mlflowimport.ReadRuns(experiments[0])
+	require.NoError(t, err)
+	require.Len(t, runs, 1)
+	run := runs[0]
+	assert.Equal(t, "abc123", run.ID)
+	assert.Equal(t, "bright-fox", run.Name)
+	assert.Equal(t, time.UnixMilli(1700000000000), run.StartTime)
+	assert.Equal(t, time.UnixMilli(1700000060000), run.EndTime)
+	assert.Equal(t, "0.01", run.Params["lr"])
+	assert.Equal(t, "vision", run.Tags["team"])
+	assert.Len(t, run.Metrics["loss"], 3)
+	assert.Equal(t,
+		[]mlflowimport.MetricPoint{{
+			Timestamp: time.UnixMilli(1700000002500),
+			Value:     0.75,
+			Step:      1,
+		}},
+		run.Metrics["eval/acc"])
+	assert.Equal(t, filepath.Join(store, "1", "abc123", "artifacts"), run.ArtifactsDir)
+}
+
+func TestReadRuns_BadMetricFile(t *testing.T) {
+	store := t.TempDir()
+	writeFile(t, filepath.Join(store, "0", "meta.yaml"), "name: Default\n")
+	writeFile(t, filepath.Join(store, "0", "r", "meta.yaml"), "run_id: r\n")
+	writeFile(t, filepath.Join(store, "0", "r", "metrics", "loss"), "not a number\n")
+
+	experiments, err := mlflowimport.ReadExperiments(store)
+	require.NoError(t, err)
+	_, err = mlflowimport.ReadRuns(experiments[0])
+
+	assert.ErrorContains(t, err, `metric "loss"`)
+}
+
+func TestImportRun(t *testing.T) {
+	experiments, err := mlflowimport.ReadExperiments(makeStore(t))
+	require.NoError(t, err)
+	runs, err := mlflowimport.ReadRuns(experiments[0])
+	require.NoError(t, err)
+	wandbDir := t.TempDir()
+	params := mlflowimport.Params{
+		WandbDir: wandbDir,
+		Logger:   observabilitytest.NewTestLogger(t),
+	}
+
+	result, err := mlflowimport.ImportRun(runs[0], params)
+	require.NoError(t, err)
+
+	assert.Equal(t,
+		filepath.Join(wandbDir, "offline-run-"+
+			time.UnixMilli(1700000000000).Format("20060102_150405")+"-abc123"),
+		result.RunDir)
+	assert.Equal(t, filepath.Join(result.RunDir, "run-abc123.wandb"), result.WandbFile)
+	assert.Equal(t, 3, result.Steps)
+	assert.Equal(t, 1, result.Files)
+
+	weights, err := os.ReadFile(
+		filepath.Join(result.RunDir, "files", "artifacts", "model", "weights.bin"))
+	require.NoError(t, err)
+	assert.Equal(t, "weights", string(weights))
+
+	records := readRecords(t, result.WandbFile)
+	require.Len(t, records, 7) // run, files, 3 history, summary, exit
+
+	run := records[0].GetRun()
+	assert.Equal(t, "abc123", run.RunId)
+	assert.Equal(t, "my-experiment", run.Project)
+	assert.Equal(t, "bright-fox", run.DisplayName)
+	assert.Equal(t, "first try", run.Notes)
+	assert.Equal(t, []string{"baseline", "team:vision"}, run.Tags)
+	assert.Equal(t, "deadbeef", run.Git.GetCommit())
+	config := make(map[string]string)
+	for _, item := range run.Config.GetUpdate() {
+		config[item.Key] = item.ValueJson
+	}
+	assert.Equal(t, "0.01", config["lr"])
+	assert.Equal(t, "10", config["epochs"])
+	assert.Equal(t, `"adam"`, config["optimizer"])
+	assert.Equal(t, "true", config["shuffle"])
+	assert.JSONEq(t,
+		`{
+			"run_id": "abc123",
+			"experiment_id": "1",
+			"experiment_name": "my/experiment",
+			"user": "alice",
+			"source.git.commit": "deadbeef"
+		}`,
+		config["mlflow"])
+
+	assert.Equal(t,
+		[]*spb.FilesItem{{Path: "artifacts/model/weights.bin", Policy: spb.FilesItem_END}},
+		records[1].GetFiles().GetFiles())
+
+	history := records[3].GetHistory()
+	assert.Equal(t, int64(1), history.GetStep().GetNum())
+	items := make(map[string]string)
+	for _, item := range history.GetItem() {
+		items[strings.Join(item.NestedKey, ".")] = item.ValueJson
+	}
+	assert.Equal(t, "1", items["_step"])
+	assert.JSONEq(t, "1700000002.5", items["_timestamp"])
+	assert.Equal(t, "2.5", items["_runtime"])
+	assert.Equal(t, "1.5", items["loss"])
+	assert.Equal(t, "0.75", items["eval/acc"])
+
+	summary := make(map[string]string)
+	for _, item := range records[5].GetSummary().GetUpdate() {
+		summary[item.Key] = item.ValueJson
+	}
+	assert.Equal(t, "0.5", summary["loss"])
+	assert.Equal(t, "0.75", summary["eval/acc"])
+
+	assert.Equal(t, int32(0), records[6].GetExit().GetExitCode())
+	assert.Equal(t, int32(60), records[6].GetExit().GetRuntime())
+}
+
+func TestImportRun_AlreadyImported(t *testing.T) {
+	experiments, err := mlflowimport.ReadExperiments(makeStore(t))
+	require.NoError(t, err)
+	runs, err := mlflowimport.ReadRuns(experiments[0])
+	require.NoError(t, err)
+	params := mlflowimport.Params{
+		WandbDir: t.TempDir(),
+		Logger:   observabilitytest.NewTestLogger(t),
+	}
+
+	_, err = mlflowimport.ImportRun(runs[0], params)
+	require.NoError(t, err)
+	_, err = mlflowimport.ImportRun(runs[0], params)
+
+	assert.ErrorIs(t, err, fs.ErrExist)
+}
+
+func TestImportRun_UnfinishedRun(t *testing.T) {
+	run := &mlflowimport.Run{
+		ID:         "xyz",
+		Experiment: &mlflowimport.Experiment{ID: "7"},
+		Status:     1, // RUNNING
+		StartTime:  time.UnixMilli(1700000000000),
+	}
+
+	result, err := mlflowimport.ImportRun(run, mlflowimport.Params{
+		WandbDir: t.TempDir(),
+		Project:  "override",
+		Logger:   observabilitytest.NewTestLogger(t),
+	})
+	require.NoError(t, err)
+
+	records := readRecords(t, result.WandbFile)
+	require.Len(t, records, 2) // run, exit
+	assert.Equal(t, "override", records[0].GetRun().GetProject())
+	assert.Equal(t, int32(1), records[1].GetExit().GetExitCode())
+}
@@ -0,0 +1,355 @@
+// Package mlflowimport converts runs in an MLflow file store into
+// offline W&B runs.
+//
+// An MLflow file store is the "mlruns" directory that MLflow writes to
+// when tracking locally. Each run is converted into a .wandb transaction
+// log and a files directory, laid out like an offline run written by the
+// SDK, so that it can be uploaded with the usual sync path.
+package mlflowimport
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io/fs"
+	"net/url"
+	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"gopkg.in/yaml.v3"
+)
+
+// statusFinished is the MLflow status of a successfully finished run,
+// as stored in a run's meta.yaml.
+const statusFinished = 3
+
+// lifecycleDeleted marks experiments and runs in MLflow's trash.
+const lifecycleDeleted = "deleted"
+
+// Experiment is an MLflow experiment in a file store.
+type Experiment struct {
+	ID   string
+	Name string
+
+	// Dir is the experiment's directory in the file store.
+	Dir string
+}
+
+// Run is an MLflow run read from a file store.
+type Run struct {
+	ID   string
+	Name string
+
+	Experiment *Experiment
+
+	// Status is the run's MLflow status, such as FINISHED.
+	Status int
+
+	StartTime time.Time
+
+	// EndTime is when the run ended, or zero if it didn't.
+	EndTime time.Time
+
+	// Params are the run's parameters, which MLflow stores as strings.
+	Params map[string]string
+
+	// Tags are the run's tags, including MLflow's "mlflow." system tags.
+	Tags map[string]string
+
+	// Metrics are the run's metric histories by metric name.
+	Metrics map[string][]MetricPoint
+
+	// ArtifactsDir is the local directory with the run's artifacts,
+	// or empty if they aren't stored locally.
+	ArtifactsDir string
+}
+
+// MetricPoint is one logged value of an MLflow metric.
+type MetricPoint struct {
+	Timestamp time.Time
+	Value     float64
+	Step      int64
+}
+
+type experimentMeta struct {
+	ExperimentID   string `yaml:"experiment_id"`
+	Name           string `yaml:"name"`
+	LifecycleStage string `yaml:"lifecycle_stage"`
+}
+
+type runMeta struct {
+	RunID          string `yaml:"run_id"`
+	RunName        string `yaml:"run_name"`
+	Status         int    `yaml:"status"`
+	StartTime      int64  `yaml:"start_time"`
+	EndTime        *int64 `yaml:"end_time"`
+	LifecycleStage string `yaml:"lifecycle_stage"`
+	ArtifactURI    string `yaml:"artifact_uri"`
+}
+
+// ReadExperiments lists the active experiments in a file store.
+//
+// Deleted experiments and MLflow's internal directories are skipped.
+func ReadExperiments(storeDir string) ([]*Experiment, error) {
+	entries, err := os.ReadDir(storeDir)
+	if err != nil {
+		return nil, err
+	}
+
+	var experiments []*Experiment
+	for _, entry := range entries {
+		if !entry.IsDir() || strings.HasPrefix(entry.Name(), ".") {
+			continue
+		}
+
+		dir := filepath.Join(storeDir, entry.Name())
+		var meta experimentMeta
+		switch err := readYAML(filepath.Join(dir, "meta.yaml"), &meta); {
+		case errors.Is(err, fs.ErrNotExist):
+			// Not an experiment, e.g. the "models" registry directory.
+			continue
+		case err != nil:
+			return nil, err
+		}
+
+		if meta.LifecycleStage == lifecycleDeleted {
+			continue
+		}
+
+		id := meta.ExperimentID
+		if id == "" {
+			id = entry.Name()
+		}
+		experiments = append(experiments, &Experiment{
+			ID:   id,
+			Name: meta.Name,
+			Dir:  dir,
+		})
+	}
+
+	return experiments, nil
+}
+
+// ReadRuns reads the active runs in an experiment, oldest first.
+func ReadRuns(experiment *Experiment) ([]*Run, error) {
+	entries, err := os.ReadDir(experiment.Dir)
+	if err != nil {
+		return nil, err
+	}
+
+	var runs []*Run
+	for _, entry := range entries {
+		if !entry.IsDir() {
+			continue
+		}
+
+		run, err := readRun(experiment, filepath.Join(experiment.Dir, entry.Name()))
+		switch {
+		case errors.Is(err, fs.ErrNotExist):
+			// Not a run directory.
+			continue
+		case err != nil:
+			return nil, fmt.Errorf("mlflowimport: reading run %s: %v", entry.Name(), err)
+		case run != nil:
+			runs = append(runs, run)
+		}
+	}
+
+	slices.SortStableFunc(runs, func(a, b *Run) int {
+		return a.StartTime.Compare(b.StartTime)
+	})
+	return runs, nil
+}
+
+// readRun reads a run directory, returning nil if the run is deleted.
+func readRun(experiment *Experiment, dir string) (*Run, error) {
+	var meta runMeta
+	if err := readYAML(filepath.Join(dir, "meta.yaml"), &meta); err != nil {
+		return nil, err
+	}
+	if meta.LifecycleStage == lifecycleDeleted {
+		return nil, nil
+	}
+
+	run := &Run{
+		ID:         meta.RunID,
+		Name:       meta.RunName,
+		Experiment: experiment,
+		Status:     meta.Status,
+		StartTime:  time.UnixMilli(meta.StartTime),
+	}
+	if run.ID == "" {
+		run.ID = filepath.Base(dir)
+	}
+	if meta.EndTime != nil {
+		run.EndTime = time.UnixMilli(*meta.EndTime)
+	}
+
+	var err error
+	if run.Params, err = readValueFiles(filepath.Join(dir, "params")); err != nil {
+		return nil, err
+	}
+	if run.Tags, err = readValueFiles(filepath.Join(dir, "tags")); err != nil {
+		return nil, err
+	}
+	if run.Metrics, err = readMetrics(filepath.Join(dir, "metrics")); err != nil {
+		return nil, err
+	}
+	if run.Name == "" {
+		run.Name = run.Tags[tagRunName]
+	}
+
+	run.ArtifactsDir = artifactsDir(dir, meta.ArtifactURI)
+	return run, nil
+}
+
+// artifactsDir returns the local directory of a run's artifacts.
+//
+// The artifact URI usually points into the run's directory, but the file
+// store may have been moved since, so that location is preferred.
+func artifactsDir(runDir, artifactURI string) string {
+	if dir := filepath.Join(runDir, "artifacts"); isDir(dir) {
+		return dir
+	}
+
+	uri, err := url.Parse(artifactURI)
+	if err != nil {
+		return ""
+	}
+
+	var dir string
+	switch uri.Scheme {
+	case "file":
+		dir = filepath.FromSlash(uri.Path)
+	case "":
+		dir = artifactURI
+	default:
+		// Artifacts in remote storage aren't imported.
+		return ""
+	}
+
+	if !isDir(dir) {
+		return ""
+	}
+	return dir
+}
+
+// readValueFiles reads a directory of params or tags.
+//
+// Each file holds one value, and its path relative to the directory is
+// the key. Keys containing slashes are stored in subdirectories.
+func readValueFiles(dir string) (map[string]string, error) {
+	values := make(map[string]string)
+	err := walkKeys(dir, func(key, path string) error {
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return err
+		}
+		values[key] = string(data)
+		return nil
+	})
+	return values, err
+}
+
+// readMetrics reads a directory of metric histories.
+//
+// Each line of a metric's file is "<timestamp-ms> <value> [<step>]";
+// the step is missing in files written by old MLflow versions.
+func readMetrics(dir string) (map[string][]MetricPoint, error) {
+	metrics := make(map[string][]MetricPoint)
+	err := walkKeys(dir, func(key, path string) error {
+		points, err := readMetricFile(path)
+		if err != nil {
+			return fmt.Errorf("metric %q: %v", key, err)
+		}
+		metrics[key] = points
+		return nil
+	})
+	return metrics, err
+}
+
+func readMetricFile(path string) ([]MetricPoint, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	var points []MetricPoint
+	scanner := bufio.NewScanner(file)
+	for lineNum := 1; scanner.Scan(); lineNum++ {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) == 0 {
+			continue
+		}
+		if len(fields) < 2 || len(fields) > 3 {
+			return nil, fmt.Errorf("line %d: expected 2 or 3 fields", lineNum)
+		}
+
+		timestamp, err := strconv.ParseInt(fields[0], 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("line %d: bad timestamp: %v", lineNum, err)
+		}
+		value, err := strconv.ParseFloat(fields[1], 64)
+		if err != nil {
+			return nil, fmt.Errorf("line %d: bad value: %v", lineNum, err)
+		}
+		var step int64
+		if len(fields) == 3 {
+			if step, err = strconv.ParseInt(fields[2], 10, 64); err != nil {
+				return nil, fmt.Errorf("line %d: bad step: %v", lineNum, err)
+			}
+		}
+
+		points = append(points, MetricPoint{
+			Timestamp: time.UnixMilli(timestamp),
+			Value:     value,
+			Step:      step,
+		})
+	}
+
+	return points, scanner.Err()
+}
+
+// walkKeys calls fn for each file under dir with its slash-separated
+// path relative to dir.
+//
+// A missing directory has no keys.
+func walkKeys(dir string, fn func(key, path string) error) error {
+	err := filepath.WalkDir(dir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil || d.IsDir() {
+			return err
+		}
+
+		key, err := filepath.Rel(dir, path)
+		if err != nil {
+			return err
+		}
+		return fn(filepath.ToSlash(key), path)
+	})
+
+	if errors.Is(err, fs.ErrNotExist) && !isDir(dir) {
+		return nil
+	}
+	return err
+}
+
+func readYAML(path string, out any) error {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+	if err := yaml.Unmarshal(data, out); err != nil {
+		return fmt.Errorf("%s: %v", path, err)
+	}
+	return nil
+}
+
+func isDir(path string) bool {
+	info, err := os.Stat(path)
+	return err == nil && info.IsDir()
+}
@@ -47,18 +47,20 @@ const (
 	cgroupV2CPUMaxFile = "cpu.max"
 )
 
-// defaultCgroupPaths is the production configuration: read the real /proc
-// for /proc/self. Tests construct a cgroupPaths pointing at a fake /proc
-// tree and a synthetic pid so they do not depend on the host's cgroups.
+// defaultCgroupPaths is the production configuration: read the real /proc.
+// The system monitor sets pid to the monitored process. Tests construct a
+// cgroupPaths pointing at a fake /proc tree and a synthetic pid so they do
+// not depend on the host's cgroups.
 var defaultCgroupPaths = cgroupPaths{
 	procRoot: procfs.DefaultMountPoint,
 }
 
 // cgroupPaths configures which procfs tree to read.
 //
-// The zero value reads the real /proc/self. Tests redirect procRoot at a
-// temporary directory and set pid to read a synthetic process entry under
-// it.
+// The zero value reads the real /proc/self. The system monitor sets pid to
+// read the cgroup of the monitored process instead, and tests redirect
+// procRoot at a temporary directory and set pid to read a synthetic
+// process entry under it.
 //
 // logicalCPUCount is the host's logical CPU count. It is supplied here
 // instead of being read inside cgroup detection so callers (production via
@@ -122,16 +124,7 @@ func detectCgroupResourceLimits(paths cgroupPaths) *cgroupResourceLimits {
 		return nil
 	}
 
-	// Cgroup v2 has a single unified hierarchy per mount namespace, so we
-	// expect at most one cgroup2 mount visible to the process. Use the
-	// first one and skip cgroup v1 entries.
-	var leafDir string
-	for _, mount := range mounts {
-		if mount.FSType == cgroupV2FSType {
-			leafDir = cgroupDir(mount, procInfo.cgroupV2Path)
-			break
-		}
-	}
+	leafDir := leafCgroupDir(procInfo, mounts)
 	if leafDir == "" {
 		return nil
 	}
@@ -155,6 +148,36 @@ func detectCgroupResourceLimits(paths cgroupPaths) *cgroupResourceLimits {
 	return limits
 }
 
+// detectCgroupDir returns the leaf cgroup v2 directory of this process,
+// regardless of whether any limits are set on it.
+//
+// Returns "" under the same conditions as detectCgroupResourceLimits,
+// except that the absence of limits is not one of them.
+func detectCgroupDir(paths cgroupPaths) string {
+	procInfo, mounts, err := readCgroupProcInfo(paths)
+	if err != nil || procInfo.cgroupV2Path == "" {
+		return ""
+	}
+	return leafCgroupDir(procInfo, mounts)
+}
+
+// leafCgroupDir locates the process's cgroup v2 path under a cgroup2
+// mount.
+//
+// Cgroup v2 has a single unified hierarchy per mount namespace, so we
+// expect at most one cgroup2 mount visible to the process. Use the
+// first one and skip cgroup v1 entries.
+//
+// Returns "" if there is no cgroup2 mount.
+func leafCgroupDir(procInfo procCgroupInfo, mounts []*procfs.MountInfo) string {
+	for _, mount := range mounts {
+		if mount.FSType == cgroupV2FSType {
+			return cgroupDir(mount, procInfo.cgroupV2Path)
+		}
+	}
+	return ""
+}
+
 // procCgroupInfo carries the per-process facts read from /proc that we
 // need to locate and interpret cgroup files.
 type procCgroupInfo struct {
@@ -196,9 +219,9 @@ func readCgroupProcInfo(paths cgroupPaths) (procCgroupInfo, []*procfs.MountInfo,
 		return procCgroupInfo{}, nil, err
 	}
 
-	// Prefer pid-addressed reads when set so tests can inspect a
-	// synthetic process under a fake /proc tree. In production, paths.pid
-	// is 0 and we read /proc/self.
+	// Prefer pid-addressed reads when set, to inspect the monitored
+	// process or a synthetic process under a fake /proc tree. Without
+	// a pid, we read /proc/self.
 	//
 	// Mounts must come from the same process's mountinfo because mount
 	// namespaces can differ between processes — using the wrong process's
@@ -0,0 +1,38 @@
+package monitor
+
+import (
+	"context"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/wandb/wandb/core/internal/coremetrics"
+	spb "github.com/wandb/wandb/core/pkg/service_go_proto"
+)
+
+// CoreMetrics reports wandb-core's own internal state as system metrics.
+type CoreMetrics struct {
+	registry *coremetrics.Registry
+}
+
+// NewCoreMetrics returns a resource that samples the registry, or nil if
+// the registry is nil.
+func NewCoreMetrics(registry *coremetrics.Registry) *CoreMetrics {
+	if registry == nil {
+		return nil
+	}
+	return &CoreMetrics{registry: registry}
+}
+
+func (c *CoreMetrics) Sample() (*spb.StatsRecord, error) {
+	values := c.registry.Sample()
+
+	metrics := make(map[string]any, len(values))
+	for key, value := range values {
+		metrics[key] = value
+	}
+	return marshal(metrics, timestamppb.Now()), nil
+}
+
+func (c *CoreMetrics) Probe(_ context.Context) *spb.EnvironmentRecord {
+	return nil
+}
@@ -0,0 +1,30 @@
+package monitor_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/wandb/wandb/core/internal/coremetrics"
+	"github.com/wandb/wandb/core/internal/monitor"
+)
+
+func TestCoreMetrics_NilRegistry(t *testing.T) {
+	assert.Nil(t, monitor.NewCoreMetrics(nil))
+}
+
+func TestCoreMetrics_Sample(t *testing.T) {
+	registry := coremetrics.New()
+	registry.Counter("filestream.retries").Add(4)
+
+	stats, err := monitor.NewCoreMetrics(registry).Sample()
+
+	require.NoError(t, err)
+	values := make(map[string]string)
+	for _, item := range stats.Item {
+		values[item.Key] = item.ValueJson
+	}
+	assert.Equal(t, "4", values["_wandb/core.filestream.retries"])
+	assert.Contains(t, values, "_wandb/core.go.goroutines")
+}
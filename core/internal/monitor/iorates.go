@@ -0,0 +1,157 @@
+package monitor
+
+import (
+	"fmt"
+	"path"
+	"time"
+
+	"github.com/shirou/gopsutil/v4/disk"
+	"github.com/shirou/gopsutil/v4/net"
+)
+
+// globFilter selects device names using include and exclude glob patterns.
+type globFilter struct {
+	// include are the patterns of names to select.
+	include []string
+
+	// exclude are the patterns of names to leave out even if
+	// they match include.
+	exclude []string
+}
+
+// Match reports whether name matches an include pattern and no exclude
+// pattern.
+//
+// Malformed patterns never match.
+func (f globFilter) Match(name string) bool {
+	return matchesAny(f.include, name) && !matchesAny(f.exclude, name)
+}
+
+func matchesAny(patterns []string, name string) bool {
+	for _, pattern := range patterns {
+		if ok, err := path.Match(pattern, name); err == nil && ok {
+			return true
+		}
+	}
+	return false
+}
+
+// ioRates computes per-interface network and per-device disk throughput
+// from cumulative counters.
+//
+// Rates are averaged over the time since the previous sample, so they
+// reflect bursts within a sampling interval unlike the cumulative
+// network.sent and disk.<device>.in metrics.
+type ioRates struct {
+	// interfaces selects the network interfaces to report.
+	//
+	// If it has no include patterns, no network rates are reported.
+	interfaces globFilter
+
+	// devices selects the disk devices to report.
+	devices globFilter
+
+	// defaultDevices are the devices to report if devices has no
+	// include patterns.
+	defaultDevices map[string]struct{}
+
+	// prevNetTime and prevNet are the time and counters of the previous
+	// network sample, keyed by interface name.
+	prevNetTime time.Time
+	prevNet     map[string]net.IOCountersStat
+
+	// prevDiskTime and prevDisk are the time and counters of the previous
+	// disk sample, keyed by device name.
+	prevDiskTime time.Time
+	prevDisk     map[string]disk.IOCountersStat
+}
+
+// collectNetwork adds the send and receive rates in MB/s of each selected
+// interface as "network.<interface>.sentMBps" and
+// "network.<interface>.recvMBps".
+//
+// Interfaces that appeared since the previous sample are reported
+// starting from the next one.
+func (r *ioRates) collectNetwork(
+	counters []net.IOCountersStat,
+	now time.Time,
+	metrics map[string]any,
+) {
+	if len(r.interfaces.include) == 0 {
+		return
+	}
+
+	current := make(map[string]net.IOCountersStat)
+	for _, c := range counters {
+		if r.interfaces.Match(c.Name) {
+			current[c.Name] = c
+		}
+	}
+
+	if elapsed := now.Sub(r.prevNetTime).Seconds(); r.prevNet != nil && elapsed > 0 {
+		for name, c := range current {
+			prev, ok := r.prevNet[name]
+			if !ok {
+				continue
+			}
+
+			metrics[fmt.Sprintf("network.%s.sentMBps", name)] =
+				mbPerSec(c.BytesSent, prev.BytesSent, elapsed)
+			metrics[fmt.Sprintf("network.%s.recvMBps", name)] =
+				mbPerSec(c.BytesRecv, prev.BytesRecv, elapsed)
+		}
+	}
+
+	r.prevNetTime = now
+	r.prevNet = current
+}
+
+// collectDisk adds the read and write rates in MB/s of each selected
+// device as "disk.<device>.readMBps" and "disk.<device>.writeMBps".
+//
+// Devices that appeared since the previous sample are reported
+// starting from the next one.
+func (r *ioRates) collectDisk(
+	counters map[string]disk.IOCountersStat,
+	now time.Time,
+	metrics map[string]any,
+) {
+	current := make(map[string]disk.IOCountersStat)
+	for name, c := range counters {
+		if r.selectsDevice(name) {
+			current[name] = c
+		}
+	}
+
+	if elapsed := now.Sub(r.prevDiskTime).Seconds(); r.prevDisk != nil && elapsed > 0 {
+		for name, c := range current {
+			prev, ok := r.prevDisk[name]
+			if !ok {
+				continue
+			}
+
+			metrics[fmt.Sprintf("disk.%s.readMBps", name)] =
+				mbPerSec(c.ReadBytes, prev.ReadBytes, elapsed)
+			metrics[fmt.Sprintf("disk.%s.writeMBps", name)] =
+				mbPerSec(c.WriteBytes, prev.WriteBytes, elapsed)
+		}
+	}
+
+	r.prevDiskTime = now
+	r.prevDisk = current
+}
+
+// selectsDevice reports whether to report rates for a disk device.
+func (r *ioRates) selectsDevice(name string) bool {
+	if len(r.devices.include) > 0 {
+		return r.devices.Match(name)
+	}
+
+	_, isDefault := r.defaultDevices[name]
+	return isDefault && !matchesAny(r.devices.exclude, name)
+}
+
+// mbPerSec converts the increase of a byte counter to MB/s.
+func mbPerSec(current, previous uint64, elapsedSeconds float64) float64 {
+	return float64(counterDelta(current, previous)) / 1024 / 1024 / elapsedSeconds
+}
@@ -0,0 +1,125 @@
+package monitor
+
+import (
+	"testing"
+	"time"
+
+	"github.com/shirou/gopsutil/v4/disk"
+	"github.com/shirou/gopsutil/v4/net"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGlobFilter(t *testing.T) {
+	f := globFilter{include: []string{"ib*", "eth0"}, exclude: []string{"ib1"}}
+
+	require.True(t, f.Match("ib0"))
+	require.True(t, f.Match("eth0"))
+	require.False(t, f.Match("ib1"))
+	require.False(t, f.Match("eth1"))
+	require.False(t, globFilter{include: []string{"["}}.Match("["))
+}
+
+func TestIORates_Network(t *testing.T) {
+	r := &ioRates{interfaces: globFilter{
+		include: []string{"*"},
+		exclude: []string{"lo"},
+	}}
+	start := time.Unix(1000, 0)
+
+	r.collectNetwork(
+		[]net.IOCountersStat{
+			{Name: "ib0", BytesSent: 0, BytesRecv: 1 << 20},
+			{Name: "lo", BytesSent: 0, BytesRecv: 0},
+		},
+		start,
+		make(map[string]any),
+	)
+	metrics := make(map[string]any)
+	r.collectNetwork(
+		[]net.IOCountersStat{
+			{Name: "ib0", BytesSent: 20 << 20, BytesRecv: 11 << 20},
+			{Name: "lo", BytesSent: 1 << 20, BytesRecv: 1 << 20},
+			{Name: "eth0", BytesSent: 1 << 20, BytesRecv: 1 << 20},
+		},
+		start.Add(2*time.Second),
+		metrics,
+	)
+
+	require.Equal(t,
+		map[string]any{
+			"network.ib0.sentMBps": 10.0,
+			"network.ib0.recvMBps": 5.0,
+		},
+		metrics)
+}
+
+func TestIORates_NetworkDisabledWithoutInclude(t *testing.T) {
+	r := &ioRates{}
+	metrics := make(map[string]any)
+
+	r.collectNetwork(
+		[]net.IOCountersStat{{Name: "eth0"}}, time.Unix(1000, 0), metrics)
+	r.collectNetwork(
+		[]net.IOCountersStat{{Name: "eth0"}}, time.Unix(1001, 0), metrics)
+
+	require.Empty(t, metrics)
+}
+
+func TestIORates_Disk(t *testing.T) {
+	testCases := []struct {
+		name    string
+		devices globFilter
+		want    map[string]any
+	}{
+		{
+			name: "default devices",
+			want: map[string]any{
+				"disk.sda.readMBps":  1.0,
+				"disk.sda.writeMBps": 2.0,
+			},
+		},
+		{
+			name:    "default devices with exclude",
+			devices: globFilter{exclude: []string{"sd*"}},
+			want:    map[string]any{},
+		},
+		{
+			name:    "include",
+			devices: globFilter{include: []string{"nvme*"}},
+			want: map[string]any{
+				"disk.nvme0n1.readMBps":  3.0,
+				"disk.nvme0n1.writeMBps": 0.0,
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			r := &ioRates{
+				devices:        tc.devices,
+				defaultDevices: map[string]struct{}{"sda": {}},
+			}
+			start := time.Unix(1000, 0)
+
+			r.collectDisk(
+				map[string]disk.IOCountersStat{
+					"sda":     {},
+					"nvme0n1": {},
+				},
+				start,
+				make(map[string]any),
+			)
+			metrics := make(map[string]any)
+			r.collectDisk(
+				map[string]disk.IOCountersStat{
+					"sda":     {ReadBytes: 10 << 20, WriteBytes: 20 << 20},
+					"nvme0n1": {ReadBytes: 30 << 20},
+				},
+				start.Add(10*time.Second),
+				metrics,
+			)
+
+			require.Equal(t, tc.want, metrics)
+		})
+	}
+}
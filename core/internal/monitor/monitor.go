@@ -3,9 +3,12 @@ package monitor
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
+	"os"
 	"os/exec"
+	"path/filepath"
 	"strings"
 	"sync"
 	"sync/atomic"
@@ -21,7 +24,10 @@ import (
 	"github.com/wandb/simplejsonext"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
+	"github.com/wandb/wandb/core/internal/coremetrics"
+	"github.com/wandb/wandb/core/internal/fileutil"
 	"github.com/wandb/wandb/core/internal/observability"
+	"github.com/wandb/wandb/core/internal/redact"
 	"github.com/wandb/wandb/core/internal/runhandle"
 	"github.com/wandb/wandb/core/internal/runwork"
 	"github.com/wandb/wandb/core/internal/settings"
@@ -93,6 +99,9 @@ type SystemMonitor struct {
 
 	// Information about the Git repository, if applicable.
 	git *spb.GitRepoRecord
+
+	// printer is used to show run warnings.
+	printer *observability.Printer
 }
 
 // SystemMonitorFactory constructs a SystemMonitor.
@@ -114,6 +123,13 @@ type SystemMonitorFactory struct {
 
 	// Unique identifier of the writer to the run.
 	WriterID sharedmode.ClientID
+
+	// Printer is used to show run warnings, such as OOM kills.
+	Printer *observability.Printer
+
+	// CoreMetrics is wandb-core's internal state to report as system
+	// metrics, or nil if it isn't reported.
+	CoreMetrics *coremetrics.Registry
 }
 
 // New initializes and returns a new SystemMonitor instance.
@@ -132,6 +148,7 @@ func (f *SystemMonitorFactory) New(extraWork runwork.ExtraWork) *SystemMonitor {
 		samplingInterval: defaultSamplingInterval,
 		graphqlClient:    f.GraphqlClient,
 		writerID:         f.WriterID,
+		printer:          f.Printer,
 	}
 
 	if sm.settings.IsDisableStats() {
@@ -152,13 +169,16 @@ func (f *SystemMonitorFactory) New(extraWork runwork.ExtraWork) *SystemMonitor {
 	}
 	sm.logger.Debug(fmt.Sprintf("monitor: sampling interval: %v", sm.samplingInterval))
 
-	sm.initializeResources(f.XPUResourceManager)
+	sm.initializeResources(f.XPUResourceManager, f.CoreMetrics)
 
 	return sm
 }
 
 // initializeResources sets up the resources to be monitored based on the provided settings.
-func (sm *SystemMonitor) initializeResources(xpuResourceManager *XPUResourceManager) {
+func (sm *SystemMonitor) initializeResources(
+	xpuResourceManager *XPUResourceManager,
+	coreMetrics *coremetrics.Registry,
+) {
 	pid := sm.settings.GetStatsPid()
 	samplingInterval := sm.settings.GetStatsSamplingInterval()
 	neuronMonitorConfigPath := sm.settings.GetStatsNeuronMonitorConfigPath()
@@ -170,6 +190,11 @@ func (sm *SystemMonitor) initializeResources(xpuResourceManager *XPUResourceMana
 			TrackProcessTree:            sm.settings.GetStatsTrackProcessTree(),
 			DisableCgroupResourceLimits: sm.settings.GetStatsNoCgroup(),
 			DiskPaths:                   sm.settings.GetStatsDiskPaths(),
+			NetworkInterfaces:           sm.settings.GetStatsNetworkInterfaces(),
+			NetworkInterfacesExclude:    sm.settings.GetStatsNetworkInterfacesExclude(),
+			DiskDevices:                 sm.settings.GetStatsDiskDevices(),
+			DiskDevicesExclude:          sm.settings.GetStatsDiskDevicesExclude(),
+			Printer:                     sm.printer,
 		},
 	); system != nil {
 		sm.resources = append(sm.resources, system)
@@ -235,6 +260,11 @@ func (sm *SystemMonitor) initializeResources(xpuResourceManager *XPUResourceMana
 			}
 		}
 	}
+
+	// wandb-core's own state.
+	if cm := NewCoreMetrics(coreMetrics); cm != nil {
+		sm.resources = append(sm.resources, cm)
+	}
 }
 
 // marshal constructs a StatsRecord protobuf message from the provided stats map and timestamp.
@@ -367,6 +397,12 @@ func (sm *SystemMonitor) Start(git *spb.GitRepoRecord) {
 				sm.monitorResource(resource)
 			})
 		}
+
+		if maxProcesses := sm.settings.GetStatsTopProcesses(); maxProcesses > 0 {
+			sm.wg.Go(func() {
+				sm.monitorProcesses(maxProcesses)
+			})
+		}
 	}
 }
 
@@ -416,6 +452,22 @@ func (sm *SystemMonitor) Resume() {
 	}
 }
 
+// metricLabel returns the suffix label for system metric keys.
+//
+// In shared mode, every writer's metrics are labeled so that writers on
+// different nodes don't overwrite each other; writers without an explicit
+// label use a prefix of their ID.
+func (sm *SystemMonitor) metricLabel() string {
+	if !sm.settings.IsSharedMode() {
+		return sm.settings.GetLabel()
+	}
+
+	return sharedmode.Writer{
+		ID:    sm.writerID,
+		Label: sm.settings.GetLabel(),
+	}.Name()
+}
+
 // monitorResource handles the monitoring loop for a single resource.
 //
 // It handles sampling, aggregation, and reporting of metrics
@@ -472,7 +524,7 @@ func (sm *SystemMonitor) monitorResource(resource Resource) {
 			}
 
 			// Label for custom grouping of stats, e.g. per node in a multi-node run.
-			if label := sm.settings.GetLabel(); label != "" {
+			if label := sm.metricLabel(); label != "" {
 				for _, item := range metrics.Item {
 					item.Key = fmt.Sprintf("%s/l:%s", item.Key, label)
 				}
@@ -494,6 +546,133 @@ func (sm *SystemMonitor) monitorResource(resource Resource) {
 
 }
 
+// monitorProcesses periodically appends snapshots of the top processes
+// to a run file.
+//
+// It is meant to run in its own goroutine.
+func (sm *SystemMonitor) monitorProcesses(maxProcesses int) {
+	defer func() {
+		if err := recover(); err != nil {
+			sm.logger.CaptureError(
+				"monitor",
+				fmt.Errorf("monitor: panic in process table: %v", err),
+			)
+		}
+	}()
+
+	// In shared mode, each writer needs its own file.
+	fileName := ProcessesFileName
+	if label := sm.metricLabel(); label != "" {
+		fileName = fmt.Sprintf(
+			"%s-%s.jsonl",
+			strings.TrimSuffix(ProcessesFileName, ".jsonl"),
+			fileutil.SanitizeWindowsFilename(label),
+		)
+	}
+
+	filesDir := sm.settings.GetFilesDir()
+	if err := os.MkdirAll(filesDir, 0o755); err != nil {
+		sm.logger.CaptureError(
+			"monitor",
+			fmt.Errorf("monitor: failed to create files dir: %v", err))
+		return
+	}
+	file, err := os.OpenFile(
+		filepath.Join(filesDir, fileName),
+		os.O_CREATE|os.O_APPEND|os.O_WRONLY,
+		0o644,
+	)
+	if err != nil {
+		sm.logger.CaptureError(
+			"monitor",
+			fmt.Errorf("monitor: failed to open process table file: %v", err))
+		return
+	}
+	defer func() { _ = file.Close() }()
+
+	sm.extraWork.AddWorkOrCancel(
+		sm.ctx.Done(),
+		runwork.NoRequest(runwork.WorkFromRecord(&spb.Record{
+			RecordType: &spb.Record_Files{
+				Files: &spb.FilesRecord{
+					Files: []*spb.FilesItem{{
+						Path:   fileName,
+						Policy: spb.FilesItem_LIVE,
+						Type:   spb.FilesItem_WANDB,
+					}},
+				},
+			},
+		})),
+	)
+
+	// Command lines often contain credentials, so they are always redacted,
+	// including with the user's console redaction patterns.
+	redactor, err := redact.New(
+		redact.BuiltinDetectors,
+		sm.settings.GetConsoleRedactPatterns(),
+	)
+	if err != nil {
+		sm.logger.Warn(fmt.Sprintf(
+			"monitor: using built-in redaction for process table: %v", err))
+		redactor, _ = redact.New(redact.BuiltinDetectors, nil)
+	}
+
+	table := NewProcessTable(ProcessTableParams{
+		Pid:          sm.settings.GetStatsPid(),
+		MaxProcesses: maxProcesses,
+		Redactor:     redactor,
+	})
+
+	var fileSize int64
+	if info, err := file.Stat(); err == nil {
+		fileSize = info.Size()
+	}
+
+	ticker := time.NewTicker(sm.samplingInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-sm.ctx.Done():
+			return
+		case <-ticker.C:
+			if sm.state.Load() != StateRunning {
+				continue
+			}
+
+			snapshot, err := table.Snapshot(sm.ctx)
+			if err != nil {
+				sm.logger.Debug(fmt.Sprintf("monitor: process table error: %v", err))
+				continue
+			}
+
+			line, err := json.Marshal(snapshot)
+			if err != nil {
+				sm.logger.CaptureError(
+					"monitor",
+					fmt.Errorf("monitor: failed to marshal process table: %v", err))
+				return
+			}
+
+			fileSize += int64(len(line)) + 1
+			if fileSize > maxProcessesFileBytes {
+				sm.logger.Warn(
+					"monitor: process table file is too large, stopping",
+					"path", file.Name(),
+				)
+				return
+			}
+
+			if _, err := file.Write(append(line, '\n')); err != nil {
+				sm.logger.CaptureError(
+					"monitor",
+					fmt.Errorf("monitor: failed to write process table: %v", err))
+				return
+			}
+		}
+	}
+}
+
 // ShouldCaptureSamplingError checks if a resource sampling error should be sent to Sentry.
 //
 // Use to filter out expected/transient failures. Keep this intentionally small and specific.
@@ -2,11 +2,15 @@ package monitor_test
 
 import (
 	"errors"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/wrapperspb"
 
 	"github.com/wandb/wandb/core/internal/monitor"
 	"github.com/wandb/wandb/core/internal/observabilitytest"
@@ -25,6 +29,39 @@ func newTestSystemMonitor(t *testing.T) *monitor.SystemMonitor {
 	return factory.New(runworktest.New())
 }
 
+func TestSystemMonitor_SharedModeLabelsMetricsWithWriterID(t *testing.T) {
+	extraWork := runworktest.New()
+	factory := &monitor.SystemMonitorFactory{
+		Logger: observabilitytest.NewTestLogger(t),
+		Settings: settings.From(&spb.Settings{
+			XShared:                wrapperspb.Bool(true),
+			XStatsSamplingInterval: wrapperspb.Double(0.01),
+		}),
+		XPUResourceManager: monitor.NewXPUResourceManager(false),
+		WriterID:           "abcdefghijkl",
+	}
+	sm := factory.New(extraWork)
+
+	sm.Start(nil)
+	var stats []*spb.StatsRecord
+	require.Eventually(t, func() bool {
+		stats = stats[:0]
+		for _, record := range extraWork.AllRecords() {
+			if record.GetStats() != nil {
+				stats = append(stats, record.GetStats())
+			}
+		}
+		return len(stats) > 0
+	}, 5*time.Second, 10*time.Millisecond)
+	sm.Finish()
+
+	for _, item := range stats[0].GetItem() {
+		assert.True(t,
+			strings.HasSuffix(item.GetKey(), "/l:abcdefgh"),
+			"unlabeled key %q", item.GetKey())
+	}
+}
+
 func TestSystemMonitor_BasicStateTransitions(t *testing.T) {
 	sm := newTestSystemMonitor(t)
 
@@ -0,0 +1,317 @@
+package monitor
+
+import (
+	"bufio"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/prometheus/procfs"
+
+	"github.com/wandb/wandb/core/internal/observability"
+)
+
+// This file samples Linux signals that explain why a workload is slow or
+// was killed even when its CPU and memory percentages look fine:
+//
+//   - Pressure stall information (PSI): the share of wall time in which
+//     some or all tasks were stalled waiting for CPU, memory or I/O. It is
+//     read system-wide from /proc/pressure/* and, inside a cgroup v2, from
+//     the leaf cgroup's *.pressure files.
+//   - CPU throttling from the leaf cgroup's cpu.stat: how often the
+//     cgroup hit its cpu.max quota and how long it was throttled for.
+//   - OOM events from the leaf cgroup's memory.events. An increase in
+//     oom_kill means the kernel killed a process in the cgroup, which we
+//     also surface as a run warning since it usually explains a crash.
+//   - Page fault rates, from the leaf cgroup's memory.stat or, outside a
+//     cgroup, from /proc/vmstat.
+//
+// All of these files are optional. PSI requires Linux 4.20+ and may be
+// disabled at boot; cgroup files only exist for cgroup v2 and for enabled
+// controllers. Missing files silently produce no metrics.
+//
+// Reference: https://docs.kernel.org/accounting/psi.html
+
+// pressureResources are the resources for which the kernel reports PSI.
+var pressureResources = []string{"cpu", "memory", "io"}
+
+// pressureStats samples stall, throttling, OOM and page fault metrics.
+//
+// It keeps the readings from the previous sample to compute rates, and
+// the readings at creation to report counters relative to the start of
+// monitoring, like the network and disk I/O metrics.
+type pressureStats struct {
+	// procRoot is the procfs mount point.
+	procRoot string
+
+	// cgroupDir is the leaf cgroup v2 directory of the process, or empty
+	// if it could not be found or cgroup metrics are disabled.
+	cgroupDir string
+
+	// printer is used to warn about OOM kills. May be nil.
+	printer *observability.Printer
+
+	// getNow returns the current time.
+	getNow func() time.Time
+
+	// initCPUStat and initMemoryEvents are the cgroup counters
+	// at creation.
+	initCPUStat      map[string]uint64
+	initMemoryEvents map[string]uint64
+
+	// prevTime, prevCPUStat and prevFaults are the time and counters
+	// of the previous sample.
+	prevTime    time.Time
+	prevCPUStat map[string]uint64
+	prevFaults  map[string]uint64
+
+	// prevOOMKills is the oom_kill counter last seen, used to detect
+	// new OOM kills.
+	prevOOMKills uint64
+}
+
+// newPressureStats takes the initial readings.
+//
+// If noCgroup is set, only system-wide metrics are collected.
+func newPressureStats(
+	paths cgroupPaths,
+	noCgroup bool,
+	printer *observability.Printer,
+	getNow func() time.Time,
+) *pressureStats {
+	procRoot := paths.procRoot
+	if procRoot == "" {
+		procRoot = procfs.DefaultMountPoint
+	}
+	if getNow == nil {
+		getNow = time.Now
+	}
+
+	p := &pressureStats{
+		procRoot: procRoot,
+		printer:  printer,
+		getNow:   getNow,
+	}
+	if !noCgroup {
+		p.cgroupDir = detectCgroupDir(paths)
+	}
+
+	if p.cgroupDir != "" {
+		p.initCPUStat, _ = readKeyedUints(filepath.Join(p.cgroupDir, "cpu.stat"))
+		p.initMemoryEvents, _ = readKeyedUints(filepath.Join(p.cgroupDir, "memory.events"))
+		p.prevCPUStat = p.initCPUStat
+		p.prevOOMKills = p.initMemoryEvents["oom_kill"]
+	}
+	p.prevFaults = p.readFaults()
+	p.prevTime = getNow()
+
+	return p
+}
+
+// collect adds the available metrics to the map.
+//
+// Metrics:
+//   - pressure.<resource>.{some,full}: system-wide PSI avg10, in percent
+//   - cgroup.pressure.<resource>.{some,full}: the cgroup's PSI avg10
+//   - cgroup.cpu.throttledPercent: percent of CPU periods since the
+//     previous sample in which the cgroup was throttled
+//   - cgroup.cpu.throttledSec: seconds spent throttled since start
+//   - cgroup.memory.oomEvents, cgroup.memory.oomKills: number of times
+//     the cgroup hit its memory limit and processes were OOM-killed
+//     since start
+//   - memory.pageFaults, memory.majorPageFaults: page faults per second
+//     since the previous sample
+func (p *pressureStats) collect(metrics map[string]any) {
+	now := p.getNow()
+	elapsed := now.Sub(p.prevTime).Seconds()
+	p.prevTime = now
+
+	for _, resource := range pressureResources {
+		collectPressure(
+			filepath.Join(p.procRoot, "pressure", resource),
+			"pressure."+resource,
+			metrics,
+		)
+	}
+
+	if p.cgroupDir != "" {
+		for _, resource := range pressureResources {
+			collectPressure(
+				filepath.Join(p.cgroupDir, resource+".pressure"),
+				"cgroup.pressure."+resource,
+				metrics,
+			)
+		}
+
+		p.collectCPUThrottling(metrics)
+		p.collectOOMEvents(metrics)
+	}
+
+	p.collectPageFaults(elapsed, metrics)
+}
+
+// collectCPUThrottling reports the cgroup's cpu.stat throttling counters.
+func (p *pressureStats) collectCPUThrottling(metrics map[string]any) {
+	stat, ok := readKeyedUints(filepath.Join(p.cgroupDir, "cpu.stat"))
+	if !ok {
+		return
+	}
+	prev := p.prevCPUStat
+	p.prevCPUStat = stat
+
+	// The throttling fields are only present when the cpu controller
+	// is enabled for the cgroup.
+	if _, ok := stat["nr_periods"]; !ok {
+		return
+	}
+
+	periods := counterDelta(stat["nr_periods"], prev["nr_periods"])
+	throttled := counterDelta(stat["nr_throttled"], prev["nr_throttled"])
+	if periods > 0 {
+		metrics["cgroup.cpu.throttledPercent"] =
+			float64(throttled) / float64(periods) * 100
+	} else {
+		metrics["cgroup.cpu.throttledPercent"] = 0.0
+	}
+
+	throttledUsec := counterDelta(
+		stat["throttled_usec"],
+		p.initCPUStat["throttled_usec"],
+	)
+	metrics["cgroup.cpu.throttledSec"] = float64(throttledUsec) / 1e6
+}
+
+// collectOOMEvents reports the cgroup's memory.events OOM counters and
+// warns if processes were OOM-killed since the previous sample.
+func (p *pressureStats) collectOOMEvents(metrics map[string]any) {
+	events, ok := readKeyedUints(filepath.Join(p.cgroupDir, "memory.events"))
+	if !ok {
+		return
+	}
+
+	oomKills := events["oom_kill"]
+	metrics["cgroup.memory.oomEvents"] = float64(
+		counterDelta(events["oom"], p.initMemoryEvents["oom"]))
+	metrics["cgroup.memory.oomKills"] = float64(
+		counterDelta(oomKills, p.initMemoryEvents["oom_kill"]))
+
+	if oomKills > p.prevOOMKills && p.printer != nil {
+		p.printer.Warnf(
+			"The kernel killed %d process(es) in this run's cgroup"+
+				" because it ran out of memory (cgroup.memory.oomKills).",
+			oomKills-p.prevOOMKills,
+		)
+	}
+	p.prevOOMKills = oomKills
+}
+
+// collectPageFaults reports page fault rates.
+func (p *pressureStats) collectPageFaults(elapsed float64, metrics map[string]any) {
+	faults := p.readFaults()
+	prev := p.prevFaults
+	p.prevFaults = faults
+
+	if faults == nil || prev == nil || elapsed <= 0 {
+		return
+	}
+
+	metrics["memory.pageFaults"] =
+		float64(counterDelta(faults["pgfault"], prev["pgfault"])) / elapsed
+	metrics["memory.majorPageFaults"] =
+		float64(counterDelta(faults["pgmajfault"], prev["pgmajfault"])) / elapsed
+}
+
+// readFaults reads the page fault counters of the cgroup, or of the
+// whole system if not in a cgroup.
+//
+// Returns nil if neither is available.
+func (p *pressureStats) readFaults() map[string]uint64 {
+	if p.cgroupDir != "" {
+		stat, ok := readKeyedUints(filepath.Join(p.cgroupDir, "memory.stat"))
+		if _, hasFaults := stat["pgfault"]; ok && hasFaults {
+			return stat
+		}
+	}
+
+	vmstat, ok := readKeyedUints(filepath.Join(p.procRoot, "vmstat"))
+	if _, hasFaults := vmstat["pgfault"]; ok && hasFaults {
+		return vmstat
+	}
+
+	return nil
+}
+
+// collectPressure reports the avg10 values of a PSI file as
+// "<prefix>.some" and "<prefix>.full".
+//
+// File format: one line per kind, such as
+//
+//	some avg10=0.00 avg60=0.00 avg300=0.00 total=0
+//	full avg10=0.00 avg60=0.00 avg300=0.00 total=0
+//
+// The avg fields are percentages of wall time over the trailing window.
+// The "full" line is missing for system-wide CPU on older kernels.
+func collectPressure(path, prefix string, metrics map[string]any) {
+	file, err := os.Open(path)
+	if err != nil {
+		return
+	}
+	defer func() { _ = file.Close() }()
+
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) < 2 || fields[0] != "some" && fields[0] != "full" {
+			continue
+		}
+
+		for _, field := range fields[1:] {
+			value, ok := strings.CutPrefix(field, "avg10=")
+			if !ok {
+				continue
+			}
+
+			if avg10, err := strconv.ParseFloat(value, 64); err == nil {
+				metrics[prefix+"."+fields[0]] = avg10
+			}
+		}
+	}
+}
+
+// readKeyedUints parses a flat-keyed file such as cpu.stat,
+// memory.events, memory.stat or /proc/vmstat.
+//
+// File format: one "<key> <uint64>" pair per line. Lines that don't
+// match are skipped. Returns false if the file cannot be read.
+func readKeyedUints(path string) (map[string]uint64, bool) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, false
+	}
+	defer func() { _ = file.Close() }()
+
+	values := make(map[string]uint64)
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) != 2 {
+			continue
+		}
+
+		if value, err := strconv.ParseUint(fields[1], 10, 64); err == nil {
+			values[fields[0]] = value
+		}
+	}
+
+	return values, scanner.Err() == nil
+}
+
+// counterDelta returns the increase of a counter, or 0 if it was reset.
+func counterDelta(current, previous uint64) uint64 {
+	if current < previous {
+		return 0
+	}
+	return current - previous
+}
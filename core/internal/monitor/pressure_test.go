@@ -0,0 +1,146 @@
+package monitor
+
+import (
+	"fmt"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/wandb/wandb/core/internal/observability"
+)
+
+// setupPressureTree writes a fake /proc with a process in a cgroup v2
+// leaf directory and returns the cgroup directory.
+func setupPressureTree(t *testing.T, root string) string {
+	t.Helper()
+	mountPoint := filepath.Join(root, "sys", "fs", "cgroup")
+	cgroupPath := filepath.Join(mountPoint, "kubepods", "pod123")
+
+	writeTestFile(t, testProcCgroupPath(root), "0::/kubepods/pod123\n")
+	writeTestFile(
+		t,
+		testProcMountInfoPath(root),
+		fmt.Sprintf("1 0 0:1 / %s rw,relatime - cgroup2 cgroup rw\n", mountPoint),
+	)
+	return cgroupPath
+}
+
+func TestPressureStats(t *testing.T) {
+	root := t.TempDir()
+	cgroupPath := setupPressureTree(t, root)
+
+	writeTestFile(t, filepath.Join(root, "pressure", "cpu"),
+		"some avg10=1.50 avg60=1.00 avg300=0.50 total=100\n")
+	writeTestFile(t, filepath.Join(root, "pressure", "memory"),
+		"some avg10=2.00 avg60=0.00 avg300=0.00 total=0\n"+
+			"full avg10=0.25 avg60=0.00 avg300=0.00 total=0\n")
+	writeTestFile(t, filepath.Join(cgroupPath, "io.pressure"),
+		"some avg10=3.00 avg60=0.00 avg300=0.00 total=0\n"+
+			"full avg10=1.00 avg60=0.00 avg300=0.00 total=0\n")
+	writeTestFile(t, filepath.Join(cgroupPath, "cpu.stat"),
+		"usage_usec 100\nnr_periods 100\nnr_throttled 10\nthrottled_usec 5000000\n")
+	writeTestFile(t, filepath.Join(cgroupPath, "memory.events"),
+		"low 0\nhigh 0\nmax 3\noom 1\noom_kill 1\n")
+	writeTestFile(t, filepath.Join(cgroupPath, "memory.stat"),
+		"anon 4096\npgfault 1000\npgmajfault 10\n")
+
+	now := time.Unix(1000, 0)
+	printer := observability.NewPrinter(16)
+	p := newPressureStats(testCgroupPaths(root), false, printer, func() time.Time { return now })
+	require.Equal(t, cgroupPath, p.cgroupDir)
+
+	now = now.Add(10 * time.Second)
+	writeTestFile(t, filepath.Join(cgroupPath, "cpu.stat"),
+		"usage_usec 200\nnr_periods 200\nnr_throttled 35\nthrottled_usec 7500000\n")
+	writeTestFile(t, filepath.Join(cgroupPath, "memory.events"),
+		"low 0\nhigh 0\nmax 5\noom 3\noom_kill 3\n")
+	writeTestFile(t, filepath.Join(cgroupPath, "memory.stat"),
+		"anon 4096\npgfault 3000\npgmajfault 60\n")
+	metrics := make(map[string]any)
+	p.collect(metrics)
+
+	require.Equal(t,
+		map[string]any{
+			"pressure.cpu.some":           1.5,
+			"pressure.memory.some":        2.0,
+			"pressure.memory.full":        0.25,
+			"cgroup.pressure.io.some":     3.0,
+			"cgroup.pressure.io.full":     1.0,
+			"cgroup.cpu.throttledPercent": 25.0,
+			"cgroup.cpu.throttledSec":     2.5,
+			"cgroup.memory.oomEvents":     2.0,
+			"cgroup.memory.oomKills":      2.0,
+			"memory.pageFaults":           200.0,
+			"memory.majorPageFaults":      5.0,
+		},
+		metrics)
+
+	messages := printer.Read()
+	require.Len(t, messages, 1)
+	require.Equal(t, observability.Warning, messages[0].Severity)
+	require.Contains(t, messages[0].Content, "killed 2 process(es)")
+
+	// No new OOM kills, so no new warning.
+	now = now.Add(10 * time.Second)
+	p.collect(make(map[string]any))
+	require.Empty(t, printer.Read())
+}
+
+func TestPressureStats_NoCgroup(t *testing.T) {
+	root := t.TempDir()
+	writeTestFile(t, filepath.Join(root, "vmstat"),
+		"nr_free_pages 10\npgfault 100\npgmajfault 1\n")
+
+	now := time.Unix(1000, 0)
+	p := newPressureStats(testCgroupPaths(root), false, nil, func() time.Time { return now })
+	require.Empty(t, p.cgroupDir)
+
+	now = now.Add(2 * time.Second)
+	writeTestFile(t, filepath.Join(root, "vmstat"),
+		"nr_free_pages 10\npgfault 300\npgmajfault 5\n")
+	metrics := make(map[string]any)
+	p.collect(metrics)
+
+	require.Equal(t,
+		map[string]any{
+			"memory.pageFaults":      100.0,
+			"memory.majorPageFaults": 2.0,
+		},
+		metrics)
+}
+
+func TestPressureStats_CgroupDisabled(t *testing.T) {
+	root := t.TempDir()
+	setupPressureTree(t, root)
+
+	p := newPressureStats(testCgroupPaths(root), true, nil, nil)
+	require.Empty(t, p.cgroupDir)
+
+	metrics := make(map[string]any)
+	p.collect(metrics)
+	for key := range metrics {
+		require.NotContains(t, key, "cgroup.")
+	}
+}
+
+func TestPressureStats_CPUControllerDisabled(t *testing.T) {
+	root := t.TempDir()
+	cgroupPath := setupPressureTree(t, root)
+	// Without the cpu controller, cpu.stat has only usage fields.
+	writeTestFile(t, filepath.Join(cgroupPath, "cpu.stat"),
+		"usage_usec 100\nuser_usec 50\nsystem_usec 50\n")
+
+	p := newPressureStats(testCgroupPaths(root), false, nil, nil)
+	metrics := make(map[string]any)
+	p.collect(metrics)
+
+	require.NotContains(t, metrics, "cgroup.cpu.throttledPercent")
+	require.NotContains(t, metrics, "cgroup.cpu.throttledSec")
+}
+
+func TestCounterDelta(t *testing.T) {
+	require.Equal(t, uint64(5), counterDelta(10, 5))
+	require.Zero(t, counterDelta(5, 10))
+}
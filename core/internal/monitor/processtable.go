@@ -0,0 +1,185 @@
+package monitor
+
+import (
+	"cmp"
+	"context"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/shirou/gopsutil/v4/process"
+
+	"github.com/wandb/wandb/core/internal/redact"
+)
+
+// ProcessesFileName is the run file to which snapshots of the top
+// processes are appended, one JSON-encoded ProcessSnapshot per line.
+const ProcessesFileName = "wandb-processes.jsonl"
+
+// maxProcessesFileBytes is the size after which no more snapshots are
+// appended to the processes file.
+const maxProcessesFileBytes = 64 << 20
+
+// ProcessInfo is the resource usage of a single process.
+type ProcessInfo struct {
+	Pid     int32  `json:"pid"`
+	Cmdline string `json:"cmdline"`
+
+	// RSSMB is the resident set size in MB.
+	RSSMB float64 `json:"rss_mb"`
+
+	// CPUPercent is the CPU usage since the previous snapshot, where 100
+	// is one fully used core. It is 0 in a process's first snapshot.
+	CPUPercent float64 `json:"cpu_percent"`
+
+	Threads int32 `json:"threads"`
+	OpenFDs int32 `json:"open_fds"`
+}
+
+// ProcessSnapshot is the resource usage of the top processes at a point
+// in time.
+type ProcessSnapshot struct {
+	// Timestamp is the time of the snapshot in seconds since the epoch.
+	Timestamp int64 `json:"timestamp"`
+
+	// Processes is sorted by CPU usage and then by memory, descending.
+	Processes []ProcessInfo `json:"processes"`
+}
+
+// ProcessTableParams are used to create a ProcessTable.
+type ProcessTableParams struct {
+	// Pid is the root of the process tree to snapshot.
+	//
+	// If not positive, all processes on the system are included.
+	Pid int32
+
+	// MaxProcesses is the number of processes to keep in a snapshot.
+	//
+	// If not positive, all processes are kept.
+	MaxProcesses int
+
+	// Redactor masks secrets in command lines.
+	//
+	// If nil, command lines are recorded as is.
+	Redactor *redact.Redactor
+}
+
+// ProcessTable takes snapshots of the processes using the most resources,
+// like top.
+//
+// It is not safe for concurrent use.
+type ProcessTable struct {
+	pid          int32
+	maxProcesses int
+	redactor     *redact.Redactor
+
+	// procs caches processes by PID between snapshots so that their CPU
+	// usage can be computed over the interval between snapshots.
+	procs map[int32]*process.Process
+}
+
+func NewProcessTable(params ProcessTableParams) *ProcessTable {
+	return &ProcessTable{
+		pid:          params.Pid,
+		maxProcesses: params.MaxProcesses,
+		redactor:     params.Redactor,
+		procs:        make(map[int32]*process.Process),
+	}
+}
+
+// Snapshot returns the resource usage of the top processes.
+//
+// Processes that exit while the snapshot is taken are skipped.
+func (t *ProcessTable) Snapshot(ctx context.Context) (ProcessSnapshot, error) {
+	now := time.Now()
+
+	procs, err := t.listProcesses(ctx)
+	if err != nil {
+		return ProcessSnapshot{}, err
+	}
+
+	// Reuse cached processes so that CPU usage is measured since the
+	// previous snapshot, and forget processes that exited.
+	current := make(map[int32]*process.Process, len(procs))
+	for _, p := range procs {
+		if cached, ok := t.procs[p.Pid]; ok {
+			p = cached
+		}
+		current[p.Pid] = p
+	}
+	t.procs = current
+
+	infos := make([]ProcessInfo, 0, len(current))
+	for _, p := range current {
+		if info, ok := processInfo(ctx, p); ok {
+			infos = append(infos, info)
+		}
+	}
+
+	slices.SortFunc(infos, func(a, b ProcessInfo) int {
+		return cmp.Or(
+			cmp.Compare(b.CPUPercent, a.CPUPercent),
+			cmp.Compare(b.RSSMB, a.RSSMB),
+			cmp.Compare(a.Pid, b.Pid),
+		)
+	})
+	if t.maxProcesses > 0 && len(infos) > t.maxProcesses {
+		infos = infos[:t.maxProcesses]
+	}
+
+	if t.redactor != nil {
+		for i := range infos {
+			infos[i].Cmdline, _ = t.redactor.Redact(infos[i].Cmdline)
+		}
+	}
+
+	return ProcessSnapshot{Timestamp: now.Unix(), Processes: infos}, nil
+}
+
+// listProcesses returns the processes to include in a snapshot.
+func (t *ProcessTable) listProcesses(ctx context.Context) ([]*process.Process, error) {
+	if t.pid <= 0 {
+		return process.ProcessesWithContext(ctx)
+	}
+
+	// Bound the walk like collectProcessTreeMetrics does.
+	ctx, cancel := context.WithTimeout(ctx, 2*time.Second)
+	defer cancel()
+	return processTree(ctx, t.pid, true)
+}
+
+// processInfo reads a process's resource usage.
+//
+// Returns false if the process no longer exists. Other fields that
+// can't be read, such as the open files of another user's process,
+// are left as zero.
+func processInfo(ctx context.Context, p *process.Process) (ProcessInfo, bool) {
+	memInfo, err := p.MemoryInfoWithContext(ctx)
+	if err != nil {
+		return ProcessInfo{}, false
+	}
+
+	info := ProcessInfo{
+		Pid:   p.Pid,
+		RSSMB: float64(memInfo.RSS) / 1024 / 1024,
+	}
+
+	if cmdline, err := p.CmdlineWithContext(ctx); err == nil && cmdline != "" {
+		info.Cmdline = cmdline
+	} else if name, err := p.NameWithContext(ctx); err == nil {
+		info.Cmdline = name
+	}
+	info.Cmdline = strings.TrimSpace(info.Cmdline)
+
+	if cpuPercent, err := p.PercentWithContext(ctx, 0); err == nil {
+		info.CPUPercent = cpuPercent
+	}
+	if threads, err := p.NumThreadsWithContext(ctx); err == nil {
+		info.Threads = threads
+	}
+	if fds, err := p.NumFDsWithContext(ctx); err == nil {
+		info.OpenFDs = fds
+	}
+
+	return info, true
+}
@@ -0,0 +1,179 @@
+package monitor_test
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+
+	"github.com/wandb/wandb/core/internal/monitor"
+	"github.com/wandb/wandb/core/internal/observabilitytest"
+	"github.com/wandb/wandb/core/internal/redact"
+	"github.com/wandb/wandb/core/internal/runworktest"
+	"github.com/wandb/wandb/core/internal/settings"
+	"github.com/wandb/wandb/core/internal/sharedmode"
+	spb "github.com/wandb/wandb/core/pkg/service_go_proto"
+)
+
+func TestProcessTable_ProcessTree(t *testing.T) {
+	table := monitor.NewProcessTable(monitor.ProcessTableParams{
+		Pid: int32(os.Getpid()),
+	})
+
+	snapshot, err := table.Snapshot(context.Background())
+	require.NoError(t, err)
+
+	idx := slices.IndexFunc(snapshot.Processes, func(p monitor.ProcessInfo) bool {
+		return p.Pid == int32(os.Getpid())
+	})
+	require.GreaterOrEqual(t, idx, 0)
+	self := snapshot.Processes[idx]
+	assert.NotEmpty(t, self.Cmdline)
+	assert.Positive(t, self.RSSMB)
+	assert.Positive(t, self.Threads)
+	assert.NotZero(t, snapshot.Timestamp)
+}
+
+func TestProcessTable_TopProcesses(t *testing.T) {
+	table := monitor.NewProcessTable(monitor.ProcessTableParams{
+		MaxProcesses: 3,
+	})
+
+	_, err := table.Snapshot(context.Background())
+	require.NoError(t, err)
+	snapshot, err := table.Snapshot(context.Background())
+	require.NoError(t, err)
+
+	assert.LessOrEqual(t, len(snapshot.Processes), 3)
+	assert.True(t, slices.IsSortedFunc(snapshot.Processes,
+		func(a, b monitor.ProcessInfo) int {
+			switch {
+			case a.CPUPercent > b.CPUPercent:
+				return -1
+			case a.CPUPercent < b.CPUPercent:
+				return 1
+			default:
+				return 0
+			}
+		}))
+}
+
+func TestSystemMonitor_WritesProcessSnapshots(t *testing.T) {
+	syncDir := t.TempDir()
+	extraWork := runworktest.New()
+	factory := &monitor.SystemMonitorFactory{
+		Logger: observabilitytest.NewTestLogger(t),
+		Settings: settings.From(&spb.Settings{
+			SyncDir:                &wrapperspb.StringValue{Value: syncDir},
+			XStatsPid:              &wrapperspb.Int32Value{Value: int32(os.Getpid())},
+			XStatsSamplingInterval: &wrapperspb.DoubleValue{Value: 0.01},
+			XStatsTopProcesses:     &wrapperspb.Int32Value{Value: 2},
+		}),
+		XPUResourceManager: monitor.NewXPUResourceManager(false),
+	}
+	sm := factory.New(extraWork)
+
+	sm.Start(nil)
+	path := filepath.Join(syncDir, "files", monitor.ProcessesFileName)
+	require.Eventually(t, func() bool {
+		content, err := os.ReadFile(path)
+		return err == nil && strings.Contains(string(content), "\n")
+	}, 5*time.Second, 10*time.Millisecond)
+	sm.Finish()
+
+	content, err := os.ReadFile(path)
+	require.NoError(t, err)
+	firstLine, _, _ := strings.Cut(string(content), "\n")
+	var snapshot monitor.ProcessSnapshot
+	require.NoError(t, json.Unmarshal([]byte(firstLine), &snapshot))
+	assert.NotEmpty(t, snapshot.Processes)
+	assert.LessOrEqual(t, len(snapshot.Processes), 2)
+
+	var files []*spb.FilesItem
+	for _, record := range extraWork.AllRecords() {
+		files = append(files, record.GetFiles().GetFiles()...)
+	}
+	require.Len(t, files, 1)
+	assert.Equal(t, monitor.ProcessesFileName, files[0].Path)
+	assert.Equal(t, spb.FilesItem_LIVE, files[0].Policy)
+}
+
+func TestProcessTable_RedactsCmdlines(t *testing.T) {
+	// Test binaries are always run with -test.* flags.
+	redactor, err := redact.New(nil, []string{`-(test\.\S+)`})
+	require.NoError(t, err)
+	table := monitor.NewProcessTable(monitor.ProcessTableParams{
+		Pid:      int32(os.Getpid()),
+		Redactor: redactor,
+	})
+
+	snapshot, err := table.Snapshot(context.Background())
+	require.NoError(t, err)
+
+	idx := slices.IndexFunc(snapshot.Processes, func(p monitor.ProcessInfo) bool {
+		return p.Pid == int32(os.Getpid())
+	})
+	require.GreaterOrEqual(t, idx, 0)
+	self := snapshot.Processes[idx]
+	assert.Contains(t, self.Cmdline, "-"+redact.Marker)
+	assert.NotContains(t, self.Cmdline, "-test.")
+}
+
+func TestSystemMonitor_SharedModeWritersUseSeparateProcessFiles(t *testing.T) {
+	syncDir := t.TempDir()
+	writers := []struct {
+		id    sharedmode.ClientID
+		label string
+		file  string
+	}{
+		{id: "writer-a-id", file: "wandb-processes-writer-a.jsonl"},
+		{id: "writer-b-id", file: "wandb-processes-writer-b.jsonl"},
+		{id: "writer-c-id", label: "../node/1", file: "wandb-processes-.._node_1.jsonl"},
+	}
+
+	for _, writer := range writers {
+		extraWork := runworktest.New()
+		factory := &monitor.SystemMonitorFactory{
+			Logger: observabilitytest.NewTestLogger(t),
+			Settings: settings.From(&spb.Settings{
+				SyncDir:                &wrapperspb.StringValue{Value: syncDir},
+				XShared:                &wrapperspb.BoolValue{Value: true},
+				XLabel:                 &wrapperspb.StringValue{Value: writer.label},
+				XStatsPid:              &wrapperspb.Int32Value{Value: int32(os.Getpid())},
+				XStatsSamplingInterval: &wrapperspb.DoubleValue{Value: 0.01},
+				XStatsTopProcesses:     &wrapperspb.Int32Value{Value: 1},
+			}),
+			XPUResourceManager: monitor.NewXPUResourceManager(false),
+			WriterID:           writer.id,
+		}
+		sm := factory.New(extraWork)
+
+		sm.Start(nil)
+		path := filepath.Join(syncDir, "files", writer.file)
+		require.Eventually(t, func() bool {
+			content, err := os.ReadFile(path)
+			return err == nil && strings.Contains(string(content), "\n")
+		}, 5*time.Second, 10*time.Millisecond)
+		sm.Finish()
+
+		var files []string
+		for _, record := range extraWork.AllRecords() {
+			for _, file := range record.GetFiles().GetFiles() {
+				files = append(files, file.Path)
+			}
+		}
+		assert.Equal(t, []string{writer.file}, files)
+	}
+
+	entries, err := os.ReadDir(syncDir)
+	require.NoError(t, err)
+	require.Len(t, entries, 1, "files escaped the files directory")
+}
@@ -17,6 +17,7 @@ import (
 	"github.com/shirou/gopsutil/v4/process"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
+	"github.com/wandb/wandb/core/internal/observability"
 	spb "github.com/wandb/wandb/core/pkg/service_go_proto"
 )
 
@@ -64,6 +65,12 @@ type System struct {
 
 	// networkBytesRecvInit stores the initial network bytes received to calculate deltas
 	networkBytesRecvInit int
+
+	// pressure samples stall, CPU throttling, OOM and page fault metrics.
+	pressure *pressureStats
+
+	// ioRates computes per-interface network and per-device disk rates.
+	ioRates *ioRates
 }
 
 type SystemParams struct {
@@ -71,6 +78,21 @@ type SystemParams struct {
 	DiskPaths                   []string
 	TrackProcessTree            bool
 	DisableCgroupResourceLimits bool
+
+	// NetworkInterfaces and NetworkInterfacesExclude are glob patterns
+	// selecting the network interfaces to report rates for.
+	NetworkInterfaces        []string
+	NetworkInterfacesExclude []string
+
+	// DiskDevices and DiskDevicesExclude are glob patterns selecting the
+	// disk devices to report rates for. If DiskDevices is empty, the
+	// devices backing DiskPaths are used.
+	DiskDevices        []string
+	DiskDevicesExclude []string
+
+	// Printer is used to warn about processes killed for running out of
+	// memory. May be nil.
+	Printer *observability.Printer
 }
 
 func NewSystem(params SystemParams) *System {
@@ -87,12 +109,21 @@ func NewSystem(params SystemParams) *System {
 	s.cpuCountPhysical, _ = cpu.Counts(false)
 	cpuCountLogical, _ := cpu.Counts(true)
 
+	// Cgroup metrics describe the cgroup of the monitored process.
+	paths := defaultCgroupPaths
+	paths.pid = int(params.Pid)
+	paths.logicalCPUCount = cpuCountLogical
 	if !params.DisableCgroupResourceLimits {
-		paths := defaultCgroupPaths
-		paths.logicalCPUCount = cpuCountLogical
 		s.cgroup = detectCgroupResourceLimits(paths)
 	}
 
+	s.pressure = newPressureStats(
+		paths,
+		params.DisableCgroupResourceLimits,
+		params.Printer,
+		nil,
+	)
+
 	// Initialize disk devices and I/O counters.
 	s.initializeDisk()
 
@@ -103,6 +134,21 @@ func NewSystem(params SystemParams) *System {
 		s.networkBytesRecvInit = int(netIOCounters[0].BytesRecv)
 	}
 
+	// Take the initial readings for per-interface and per-device rates.
+	s.ioRates = &ioRates{
+		interfaces: globFilter{
+			include: params.NetworkInterfaces,
+			exclude: params.NetworkInterfacesExclude,
+		},
+		devices: globFilter{
+			include: params.DiskDevices,
+			exclude: params.DiskDevicesExclude,
+		},
+		defaultDevices: s.diskDevices,
+	}
+	_ = s.collectNetworkRates(make(map[string]any))
+	_ = s.CollectDiskIOMetrics(make(map[string]any))
+
 	return s
 }
 
@@ -191,6 +237,16 @@ func pseudoDevice(d string) bool {
 // On some systems, this operation can be expensive, so by default it only returns the
 // root process, if it exists.
 func (s *System) processAndDescendants(ctx context.Context, pid int32) ([]*process.Process, error) {
+	return processTree(ctx, pid, s.trackProcessTree)
+}
+
+// processTree finds the root process and, if trackTree is set,
+// all its children, recursively.
+func processTree(
+	ctx context.Context,
+	pid int32,
+	trackTree bool,
+) ([]*process.Process, error) {
 	rootProc, err := process.NewProcess(pid)
 	if err != nil {
 		return nil, err
@@ -198,7 +254,7 @@ func (s *System) processAndDescendants(ctx context.Context, pid int32) ([]*proce
 
 	out := []*process.Process{rootProc}
 
-	if !s.trackProcessTree {
+	if !trackTree {
 		return out, nil
 	}
 
@@ -236,6 +292,7 @@ func (s *System) processAndDescendants(ctx context.Context, pid int32) ([]*proce
 //   - CPU utilization (process-specific)
 //   - Thread count (process-specific)
 //   - Disk usage and I/O metrics
+//   - Pressure stall, cgroup CPU throttling, OOM and page fault metrics (Linux)
 func (s *System) Sample() (*spb.StatsRecord, error) {
 	metrics := make(map[string]any)
 	var errs []error
@@ -261,6 +318,11 @@ func (s *System) Sample() (*spb.StatsRecord, error) {
 		errs = append(errs, err)
 	}
 
+	// Collect pressure stall, throttling, OOM and page fault metrics.
+	if s.pressure != nil {
+		s.pressure.collect(metrics)
+	}
+
 	// Collect process-specific metrics.
 	if s.pid > 0 {
 		proc, err := process.NewProcess(s.pid)
@@ -349,6 +411,21 @@ func (s *System) collectNetworkMetrics(metrics map[string]any) error {
 		metrics["network.recv"] = float64(int(netIOCounters[0].BytesRecv) - s.networkBytesRecvInit)
 	}
 
+	return s.collectNetworkRates(metrics)
+}
+
+// collectNetworkRates gathers per-interface network throughput.
+func (s *System) collectNetworkRates(metrics map[string]any) error {
+	if s.ioRates == nil || len(s.ioRates.interfaces.include) == 0 {
+		return nil
+	}
+
+	counters, err := net.IOCounters(true)
+	if err != nil {
+		return err
+	}
+
+	s.ioRates.collectNetwork(counters, time.Now(), metrics)
 	return nil
 }
 
@@ -439,6 +516,10 @@ func (s *System) CollectDiskIOMetrics(metrics map[string]any) error {
 		metrics[fmt.Sprintf("disk.%s.in", dev)] = float64(inBytes) / 1024 / 1024
 		metrics[fmt.Sprintf("disk.%s.out", dev)] = float64(outBytes) / 1024 / 1024
 	}
+
+	if s.ioRates != nil {
+		s.ioRates.collectDisk(ios, time.Now(), metrics)
+	}
 	return nil
 }
 
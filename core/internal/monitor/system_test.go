@@ -103,3 +103,52 @@ func TestCollectDiskIOMetrics(t *testing.T) {
 	require.InEpsilon(t, wantInMB, gotIn, 1e-6, "read MB")
 	require.InEpsilon(t, wantOutMB, gotOut, 1e-6, "write MB")
 }
+
+func TestCollectDiskIOMetrics_DeviceRates(t *testing.T) {
+	origParts := monitor.DiskPartitions
+	origIO := monitor.DiskIOCounters
+	t.Cleanup(func() {
+		monitor.DiskPartitions = origParts
+		monitor.DiskIOCounters = origIO
+	})
+
+	monitor.DiskPartitions = func(all bool) ([]disk.PartitionStat, error) {
+		return []disk.PartitionStat{
+			{Device: "/dev/nvme0n1", Mountpoint: "/"},
+		}, nil
+	}
+	monitor.DiskIOCounters = func() (map[string]disk.IOCountersStat, error) {
+		return map[string]disk.IOCountersStat{
+			"nvme0n1": {ReadBytes: 0, WriteBytes: 0},
+			"sda":     {ReadBytes: 0, WriteBytes: 0},
+			"sdb":     {ReadBytes: 0, WriteBytes: 0},
+		}, nil
+	}
+
+	sys := monitor.NewSystem(monitor.SystemParams{
+		Pid:                0,
+		DiskPaths:          []string{"/"},
+		DiskDevices:        []string{"sd*"},
+		DiskDevicesExclude: []string{"sdb"},
+	})
+
+	monitor.DiskIOCounters = func() (map[string]disk.IOCountersStat, error) {
+		return map[string]disk.IOCountersStat{
+			"nvme0n1": {ReadBytes: 1 << 20, WriteBytes: 1 << 20},
+			"sda":     {ReadBytes: 1 << 20, WriteBytes: 2 << 20},
+			"sdb":     {ReadBytes: 1 << 20, WriteBytes: 1 << 20},
+		}, nil
+	}
+
+	metrics := make(map[string]any)
+	require.NoError(t, sys.CollectDiskIOMetrics(metrics))
+
+	readRate, ok := metrics["disk.sda.readMBps"].(float64)
+	require.True(t, ok, "disk.sda.readMBps missing")
+	writeRate, ok := metrics["disk.sda.writeMBps"].(float64)
+	require.True(t, ok, "disk.sda.writeMBps missing")
+	require.Greater(t, readRate, 0.0)
+	require.InEpsilon(t, 2*readRate, writeRate, 1e-6)
+	require.NotContains(t, metrics, "disk.sdb.readMBps")
+	require.NotContains(t, metrics, "disk.nvme0n1.readMBps")
+}
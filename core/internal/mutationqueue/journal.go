@@ -0,0 +1,216 @@
+package mutationqueue
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"slices"
+
+	"github.com/Khan/genqlient/graphql"
+)
+
+// JournalPath returns the path of the journal for a run's transaction log.
+//
+// The journal is stored next to the .wandb file so that it's found by
+// `wandb sync`.
+func JournalPath(transactionLogPath string) string {
+	return transactionLogPath + ".mutations"
+}
+
+// Entry is a GraphQL request saved to be sent later.
+type Entry struct {
+	// ID identifies the entry in its journal.
+	//
+	// IDs start at 1 and increase in the order entries are added.
+	ID uint64 `json:"id"`
+
+	OpName    string          `json:"op_name"`
+	Query     string          `json:"query"`
+	Variables json.RawMessage `json:"variables,omitempty"`
+}
+
+// Request returns the GraphQL request to send for the entry.
+func (e Entry) Request() *graphql.Request {
+	req := &graphql.Request{
+		OpName: e.OpName,
+		Query:  e.Query,
+	}
+
+	if len(e.Variables) > 0 {
+		req.Variables = e.Variables
+	}
+
+	return req
+}
+
+// journalRecord is one line in a journal file.
+//
+// Exactly one field is set.
+type journalRecord struct {
+	// Entry is a request added to the journal.
+	Entry *Entry `json:"entry,omitempty"`
+
+	// Ack is the ID of an entry that no longer needs to be sent.
+	Ack uint64 `json:"ack,omitempty"`
+}
+
+// Journal is an append-only file of GraphQL requests waiting to be sent.
+//
+// Each line is a JSON object that either adds a request or acknowledges
+// that an earlier request was sent. The file is deleted once all of its
+// requests are acknowledged.
+//
+// If acknowledging a request fails, it may be sent again later, so
+// requests in a journal must be safe to send more than once.
+//
+// It is not safe for concurrent use.
+type Journal struct {
+	path string
+
+	// pending are the unacknowledged entries in the order they were added.
+	pending []Entry
+
+	// nextID is the ID to assign to the next entry.
+	nextID uint64
+}
+
+// OpenJournal loads the journal at the path.
+//
+// The file doesn't need to exist. Lines that can't be parsed, such as one
+// left partly written by a crash, are skipped.
+func OpenJournal(path string) (*Journal, error) {
+	journal := &Journal{path: path, nextID: 1}
+
+	file, err := os.Open(path)
+	switch {
+	case errors.Is(err, os.ErrNotExist):
+		return journal, nil
+	case err != nil:
+		return nil, fmt.Errorf("mutationqueue: failed to open journal: %v", err)
+	}
+	defer func() { _ = file.Close() }()
+
+	acked := make(map[uint64]bool)
+	reader := bufio.NewReader(file)
+
+	for {
+		line, err := reader.ReadBytes('\n')
+		if err != nil && !errors.Is(err, io.EOF) {
+			return nil, fmt.Errorf("mutationqueue: failed to read journal: %v", err)
+		}
+
+		var record journalRecord
+		if json.Unmarshal(line, &record) == nil {
+			switch {
+			case record.Entry != nil:
+				journal.pending = append(journal.pending, *record.Entry)
+				journal.nextID = max(journal.nextID, record.Entry.ID+1)
+			case record.Ack != 0:
+				acked[record.Ack] = true
+			}
+		}
+
+		if err != nil {
+			break
+		}
+	}
+
+	journal.pending = slices.DeleteFunc(journal.pending, func(e Entry) bool {
+		return acked[e.ID]
+	})
+
+	return journal, nil
+}
+
+// Len returns the number of requests waiting to be sent.
+func (j *Journal) Len() int {
+	return len(j.pending)
+}
+
+// Front returns the oldest request waiting to be sent.
+//
+// It returns false if the journal is empty.
+func (j *Journal) Front() (Entry, bool) {
+	if len(j.pending) == 0 {
+		return Entry{}, false
+	}
+
+	return j.pending[0], true
+}
+
+// Append saves a request at the end of the journal.
+func (j *Journal) Append(req *graphql.Request) (Entry, error) {
+	entry := Entry{
+		ID:     j.nextID,
+		OpName: req.OpName,
+		Query:  req.Query,
+	}
+
+	if req.Variables != nil {
+		variables, err := json.Marshal(req.Variables)
+		if err != nil {
+			return Entry{}, fmt.Errorf(
+				"mutationqueue: failed to marshal variables: %v", err)
+		}
+		entry.Variables = variables
+	}
+
+	if err := j.write(journalRecord{Entry: &entry}); err != nil {
+		return Entry{}, err
+	}
+
+	j.nextID++
+	j.pending = append(j.pending, entry)
+	return entry, nil
+}
+
+// Ack marks a request as sent.
+//
+// The request is removed from the journal even if an error is returned,
+// in which case it may be loaded again by a later OpenJournal.
+func (j *Journal) Ack(id uint64) error {
+	j.pending = slices.DeleteFunc(j.pending, func(e Entry) bool {
+		return e.ID == id
+	})
+
+	if len(j.pending) > 0 {
+		return j.write(journalRecord{Ack: id})
+	}
+
+	err := os.Remove(j.path)
+	if err != nil && !errors.Is(err, os.ErrNotExist) {
+		return fmt.Errorf("mutationqueue: failed to delete journal: %v", err)
+	}
+
+	return nil
+}
+
+// write appends a record to the journal file and syncs it to disk.
+func (j *Journal) write(record journalRecord) error {
+	line, err := json.Marshal(record)
+	if err != nil {
+		return fmt.Errorf("mutationqueue: failed to marshal record: %v", err)
+	}
+
+	file, err := os.OpenFile(j.path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o644)
+	if err != nil {
+		return fmt.Errorf("mutationqueue: failed to open journal: %v", err)
+	}
+
+	_, err = file.Write(append(line, '\n'))
+	if err == nil {
+		err = file.Sync()
+	}
+	if closeErr := file.Close(); err == nil {
+		err = closeErr
+	}
+
+	if err != nil {
+		return fmt.Errorf("mutationqueue: failed to write journal: %v", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,71 @@
+package mutationqueue_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/Khan/genqlient/graphql"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/wandb/wandb/core/internal/mutationqueue"
+)
+
+func TestJournal_PersistsUnacknowledgedEntries(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "run.wandb.mutations")
+	journal, err := mutationqueue.OpenJournal(path)
+	require.NoError(t, err)
+
+	first, err := journal.Append(&graphql.Request{
+		OpName:    "First",
+		Query:     "mutation First { a }",
+		Variables: map[string]any{"x": 1},
+	})
+	require.NoError(t, err)
+	_, err = journal.Append(&graphql.Request{OpName: "Second", Query: "q2"})
+	require.NoError(t, err)
+	require.NoError(t, journal.Ack(first.ID))
+
+	reopened, err := mutationqueue.OpenJournal(path)
+	require.NoError(t, err)
+
+	entry, ok := reopened.Front()
+	require.True(t, ok)
+	assert.Equal(t, 1, reopened.Len())
+	assert.Equal(t, "Second", entry.OpName)
+	assert.Nil(t, entry.Request().Variables)
+
+	third, err := reopened.Append(&graphql.Request{OpName: "Third"})
+	require.NoError(t, err)
+	assert.Greater(t, third.ID, entry.ID)
+}
+
+func TestJournal_DeletedWhenEmpty(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "run.wandb.mutations")
+	journal, err := mutationqueue.OpenJournal(path)
+	require.NoError(t, err)
+
+	entry, err := journal.Append(&graphql.Request{OpName: "Op"})
+	require.NoError(t, err)
+	require.FileExists(t, path)
+
+	require.NoError(t, journal.Ack(entry.ID))
+	assert.NoFileExists(t, path)
+}
+
+func TestJournal_SkipsPartialLines(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "run.wandb.mutations")
+	require.NoError(t, os.WriteFile(path,
+		[]byte(`{"entry":{"id":1,"op_name":"Op","query":"q"}}`+"\n"+
+			`{"entry":{"id":2,"op_na`),
+		0o644))
+
+	journal, err := mutationqueue.OpenJournal(path)
+	require.NoError(t, err)
+
+	entry, ok := journal.Front()
+	require.True(t, ok)
+	assert.Equal(t, 1, journal.Len())
+	assert.EqualValues(t, 1, entry.ID)
+}
@@ -0,0 +1,401 @@
+// Package mutationqueue saves GraphQL mutations that fail because the
+// W&B server can't be reached and sends them once it can.
+//
+// Only requests explicitly marked as Replayable are saved. A mutation is
+// replayable if sending it late or more than once is harmless, like
+// committing an artifact or linking it to a collection.
+package mutationqueue
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/Khan/genqlient/graphql"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+
+	"github.com/wandb/wandb/core/internal/observability"
+	"github.com/wandb/wandb/core/internal/wboperation"
+)
+
+// DefaultRetryInterval is how often saved requests are retried by default.
+const DefaultRetryInterval = 30 * time.Second
+
+type contextKey int
+
+const replayableContextKey contextKey = iota
+
+// Replayable marks GraphQL requests made with the context as safe to save
+// and send later if the server can't be reached.
+func Replayable(ctx context.Context) context.Context {
+	return context.WithValue(ctx, replayableContextKey, true)
+}
+
+// isReplayable reports whether the context was marked with Replayable.
+func isReplayable(ctx context.Context) bool {
+	replayable, _ := ctx.Value(replayableContextKey).(bool)
+	return replayable
+}
+
+// SavedError is returned for a replayable request that couldn't be sent
+// and was saved to be sent later.
+type SavedError struct {
+	Cause error
+}
+
+func (e *SavedError) Error() string {
+	return fmt.Sprintf("%v (saved to retry later)", e.Cause)
+}
+
+func (e *SavedError) Unwrap() error {
+	return e.Cause
+}
+
+// IsSaved reports whether the error is for a request that was saved to be
+// sent later.
+//
+// Callers should treat such requests as successful, since they're sent in
+// order once the server can be reached.
+func IsSaved(err error) bool {
+	var saved *SavedError
+	return errors.As(err, &saved)
+}
+
+// Params are used to create a Queue.
+type Params struct {
+	// Client sends GraphQL requests.
+	Client graphql.Client
+
+	// JournalPath is the file in which to save requests.
+	//
+	// If empty, requests are never saved.
+	JournalPath string
+
+	Logger     *observability.CoreLogger
+	Operations *wboperation.WandbOperations
+
+	// RetryInterval is how long to wait between attempts to send saved
+	// requests in the background.
+	//
+	// Defaults to DefaultRetryInterval.
+	RetryInterval time.Duration
+}
+
+// Queue is a GraphQL client that saves replayable requests that fail
+// because the server can't be reached.
+//
+// Saved requests are retried in the background and sent in the order
+// they were made. A replayable request made while others are saved is
+// sent only after them, so that an older request never overwrites the
+// effect of a newer one.
+//
+// Requests that aren't replayable are passed to the underlying client.
+type Queue struct {
+	client        graphql.Client
+	logger        *observability.CoreLogger
+	operations    *wboperation.WandbOperations
+	retryInterval time.Duration
+
+	// mu is held while sending replayable requests, so that they're sent
+	// in order, and guards the fields below.
+	mu sync.Mutex
+
+	// journalOrNil holds the saved requests, or is nil if requests aren't
+	// saved.
+	journalOrNil *Journal
+
+	// operation shows the status of saved requests while there are any.
+	operation *wboperation.WandbOperation
+	progress  *wboperation.WandbProgress
+
+	// sent is the number of saved requests sent since the operation
+	// was created.
+	sent int
+
+	// finished is whether Finish was called.
+	finished bool
+
+	replayCtx    context.Context
+	cancelReplay context.CancelFunc
+
+	startOnce  sync.Once
+	finishOnce sync.Once
+	wake       chan struct{}
+	done       chan struct{}
+	wg         sync.WaitGroup
+}
+
+// New returns a Queue for the given parameters.
+//
+// If the journal already contains requests, they're sent in
+// the background.
+func New(params Params) *Queue {
+	replayCtx, cancelReplay := context.WithCancel(context.Background())
+
+	queue := &Queue{
+		client:        params.Client,
+		logger:        params.Logger,
+		operations:    params.Operations,
+		retryInterval: params.RetryInterval,
+		replayCtx:     replayCtx,
+		cancelReplay:  cancelReplay,
+		wake:          make(chan struct{}, 1),
+		done:          make(chan struct{}),
+	}
+
+	if queue.retryInterval <= 0 {
+		queue.retryInterval = DefaultRetryInterval
+	}
+
+	if params.JournalPath == "" {
+		return queue
+	}
+
+	journal, err := OpenJournal(params.JournalPath)
+	if err != nil {
+		params.Logger.CaptureError("mutationqueue", err)
+		return queue
+	}
+	queue.journalOrNil = journal
+
+	if journal.Len() > 0 {
+		params.Logger.Info(
+			"mutationqueue: found saved requests",
+			"path", params.JournalPath,
+			"count", journal.Len(),
+		)
+
+		queue.lockedUpdateOperation()
+		queue.startReplaying()
+		queue.wake <- struct{}{}
+	}
+
+	return queue
+}
+
+// MakeRequest implements graphql.Client.MakeRequest.
+func (q *Queue) MakeRequest(
+	ctx context.Context,
+	req *graphql.Request,
+	resp *graphql.Response,
+) error {
+	if q.journalOrNil == nil || !isReplayable(ctx) {
+		return q.client.MakeRequest(ctx, req, resp)
+	}
+
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	if err := q.lockedReplay(ctx); err != nil {
+		if ctx.Err() != nil {
+			return err
+		}
+
+		// Save the request after the others to preserve the order.
+		return q.lockedSave(req, err)
+	}
+
+	err := q.client.MakeRequest(ctx, req, resp)
+	if isConnectionError(ctx, err) {
+		return q.lockedSave(req, err)
+	}
+
+	return err
+}
+
+// Finish stops retrying saved requests in the background and makes
+// a final attempt to send them.
+//
+// It returns the number of requests that remain saved. They're sent when
+// the journal is next opened, such as by `wandb sync`.
+func (q *Queue) Finish(ctx context.Context) int {
+	if q == nil {
+		return 0
+	}
+
+	q.finishOnce.Do(func() {
+		// Prevent the background goroutine from starting after this.
+		q.startOnce.Do(func() {})
+
+		q.cancelReplay()
+		close(q.done)
+		q.wg.Wait()
+	})
+
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	if q.journalOrNil == nil {
+		return 0
+	}
+
+	if err := q.lockedReplay(ctx); err != nil {
+		q.logger.Warn(
+			"mutationqueue: failed to send saved requests",
+			"error", err,
+		)
+	}
+
+	q.finished = true
+	q.operation.Finish()
+	q.operation = nil
+
+	return q.journalOrNil.Len()
+}
+
+// lockedSave adds a request that couldn't be sent to the journal.
+//
+// It returns the error to report for the request.
+func (q *Queue) lockedSave(req *graphql.Request, cause error) error {
+	entry, err := q.journalOrNil.Append(req)
+	if err != nil {
+		q.logger.CaptureError("mutationqueue", err)
+		return cause
+	}
+
+	q.logger.Warn(
+		"mutationqueue: saved request to retry later",
+		"opName", req.OpName,
+		"id", entry.ID,
+		"error", cause,
+	)
+
+	q.lockedUpdateOperation()
+	q.operation.MarkRetryingError(cause)
+	q.startReplaying()
+
+	return &SavedError{Cause: cause}
+}
+
+// lockedReplay sends saved requests in order.
+//
+// It stops and returns an error if a request fails because the server
+// can't be reached or the context is cancelled. Requests rejected by the
+// server are dropped, as retrying them would fail the same way.
+func (q *Queue) lockedReplay(ctx context.Context) error {
+	for {
+		entry, ok := q.journalOrNil.Front()
+		if !ok {
+			return nil
+		}
+
+		var data json.RawMessage
+		err := q.client.MakeRequest(
+			ctx,
+			entry.Request(),
+			&graphql.Response{Data: &data},
+		)
+
+		switch {
+		case err != nil && ctx.Err() != nil:
+			return err
+
+		case isConnectionError(ctx, err):
+			q.operation.MarkRetryingError(err)
+			return err
+
+		case err != nil:
+			q.logger.CaptureError(
+				"mutationqueue",
+				fmt.Errorf(
+					"mutationqueue: dropping saved %s request: %v",
+					entry.OpName,
+					err,
+				))
+		}
+
+		if err := q.journalOrNil.Ack(entry.ID); err != nil {
+			q.logger.CaptureError("mutationqueue", err)
+		}
+
+		q.sent++
+		q.operation.ClearError()
+		q.lockedUpdateOperation()
+	}
+}
+
+// lockedUpdateOperation creates, updates or finishes the operation
+// showing the status of saved requests.
+func (q *Queue) lockedUpdateOperation() {
+	if q.finished {
+		return
+	}
+
+	remaining := q.journalOrNil.Len()
+
+	if remaining == 0 {
+		q.operation.Finish()
+		q.operation = nil
+		q.progress = nil
+		q.sent = 0
+		return
+	}
+
+	if q.operation == nil {
+		q.operation = q.operations.New("retrying requests saved while offline")
+		q.progress, _ = q.operation.NewProgress()
+	}
+
+	q.progress.SetUnitsOfTotal(q.sent, q.sent+remaining, "requests")
+}
+
+// startReplaying starts the background goroutine if it isn't running.
+func (q *Queue) startReplaying() {
+	q.startOnce.Do(func() {
+		q.wg.Add(1)
+		go q.replayPeriodically()
+	})
+}
+
+// replayPeriodically tries to send saved requests until Finish is called.
+func (q *Queue) replayPeriodically() {
+	defer q.wg.Done()
+
+	for {
+		select {
+		case <-q.done:
+			return
+		case <-q.wake:
+		case <-time.After(q.retryInterval):
+		}
+
+		q.mu.Lock()
+		err := q.lockedReplay(q.replayCtx)
+		q.mu.Unlock()
+
+		if err != nil && q.replayCtx.Err() == nil {
+			q.logger.Info(
+				"mutationqueue: still unable to send saved requests",
+				"error", err,
+			)
+		}
+	}
+}
+
+// isConnectionError reports whether a request failed because the server
+// couldn't be reached, as opposed to the server rejecting it.
+//
+// Errors after the context is cancelled are not connection errors.
+func isConnectionError(ctx context.Context, err error) bool {
+	if err == nil || ctx.Err() != nil {
+		return false
+	}
+
+	var httpErr *graphql.HTTPError
+	var gqlErrs gqlerror.List
+	var syntaxErr *json.SyntaxError
+	var typeErr *json.UnmarshalTypeError
+
+	switch {
+	case errors.As(err, &httpErr),
+		errors.As(err, &gqlErrs),
+		errors.As(err, &syntaxErr),
+		errors.As(err, &typeErr):
+		return false
+	default:
+		return true
+	}
+}
@@ -0,0 +1,203 @@
+package mutationqueue_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/Khan/genqlient/graphql"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/wandb/wandb/core/internal/gqlmock"
+	"github.com/wandb/wandb/core/internal/mutationqueue"
+	"github.com/wandb/wandb/core/internal/observabilitytest"
+	"github.com/wandb/wandb/core/internal/wboperation"
+)
+
+var errConnection = errors.New("dial tcp: connection refused")
+
+type testFixture struct {
+	Queue       *mutationqueue.Queue
+	Client      *gqlmock.MockClient
+	Operations  *wboperation.WandbOperations
+	JournalPath string
+}
+
+func setup(t *testing.T, journalPath string) testFixture {
+	t.Helper()
+
+	if journalPath == "" {
+		journalPath = filepath.Join(t.TempDir(), "run.wandb.mutations")
+	}
+
+	client := gqlmock.NewMockClient()
+	operations := wboperation.NewOperations()
+	queue := mutationqueue.New(mutationqueue.Params{
+		Client:        client,
+		JournalPath:   journalPath,
+		Logger:        observabilitytest.NewTestLogger(t),
+		Operations:    operations,
+		RetryInterval: time.Hour,
+	})
+	t.Cleanup(func() {
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		queue.Finish(ctx)
+	})
+
+	return testFixture{
+		Queue:       queue,
+		Client:      client,
+		Operations:  operations,
+		JournalPath: journalPath,
+	}
+}
+
+func request(opName string) *graphql.Request {
+	return &graphql.Request{
+		OpName:    opName,
+		Query:     "mutation " + opName + " { x }",
+		Variables: map[string]any{"id": opName},
+	}
+}
+
+func send(ctx context.Context, queue *mutationqueue.Queue, opName string) error {
+	var data map[string]any
+	return queue.MakeRequest(ctx, request(opName), &graphql.Response{Data: &data})
+}
+
+func opNames(requests []*graphql.Request) []string {
+	var names []string
+	for _, req := range requests {
+		names = append(names, req.OpName)
+	}
+	return names
+}
+
+func TestNotReplayable_NotSaved(t *testing.T) {
+	x := setup(t, "")
+	x.Client.StubMatchWithError(gqlmock.WithOpName("Op"), errConnection)
+
+	err := send(context.Background(), x.Queue, "Op")
+
+	assert.ErrorIs(t, err, errConnection)
+	assert.NotErrorAs(t, err, new(*mutationqueue.SavedError))
+	assert.NoFileExists(t, x.JournalPath)
+}
+
+func TestConnectionError_SavesAndSendsBeforeNextRequest(t *testing.T) {
+	x := setup(t, "")
+	ctx := mutationqueue.Replayable(context.Background())
+	x.Client.StubMatchWithError(gqlmock.WithOpName("CommitArtifact"), errConnection)
+
+	err := send(ctx, x.Queue, "CommitArtifact")
+
+	assert.ErrorAs(t, err, new(*mutationqueue.SavedError))
+	assert.FileExists(t, x.JournalPath)
+	stats := x.Operations.ToProto()
+	require.Len(t, stats.Operations, 1)
+	assert.Equal(t, "retrying requests saved while offline", stats.Operations[0].Desc)
+	assert.Equal(t, "0/1 requests", stats.Operations[0].Progress)
+	assert.Contains(t, stats.Operations[0].ErrorStatus, "connection refused")
+
+	x.Client.StubMatchOnce(gqlmock.WithOpName("CommitArtifact"), `{}`)
+	x.Client.StubMatchOnce(gqlmock.WithOpName("LinkArtifact"), `{}`)
+	err = send(ctx, x.Queue, "LinkArtifact")
+
+	require.NoError(t, err)
+	assert.True(t, x.Client.AllStubsUsed())
+	assert.Equal(t,
+		[]string{"CommitArtifact", "CommitArtifact", "LinkArtifact"},
+		opNames(x.Client.AllRequests()))
+	assert.Equal(t,
+		map[string]any{"id": "CommitArtifact"},
+		jsonVariables(t, x.Client.AllRequests()[1]))
+	assert.NoFileExists(t, x.JournalPath)
+	assert.Empty(t, x.Operations.ToProto().Operations)
+}
+
+func TestSavedRequestsBlockLaterOnesWhileOffline(t *testing.T) {
+	x := setup(t, "")
+	ctx := mutationqueue.Replayable(context.Background())
+	x.Client.StubMatchWithError(gqlmock.WithOpName("First"), errConnection)
+	x.Client.StubMatchWithError(gqlmock.WithOpName("First"), errConnection)
+
+	_ = send(ctx, x.Queue, "First")
+	err := send(ctx, x.Queue, "Second")
+
+	assert.ErrorAs(t, err, new(*mutationqueue.SavedError))
+	assert.Equal(t, []string{"First", "First"}, opNames(x.Client.AllRequests()))
+	assert.Equal(t, "0/2 requests", x.Operations.ToProto().Operations[0].Progress)
+}
+
+func TestRejectedSavedRequest_Dropped(t *testing.T) {
+	x := setup(t, "")
+	ctx := mutationqueue.Replayable(context.Background())
+	x.Client.StubMatchWithError(gqlmock.WithOpName("Bad"), errConnection)
+	_ = send(ctx, x.Queue, "Bad")
+
+	x.Client.StubMatchWithError(
+		gqlmock.WithOpName("Bad"),
+		&graphql.HTTPError{StatusCode: 400},
+	)
+	x.Client.StubMatchOnce(gqlmock.WithOpName("Good"), `{}`)
+	err := send(ctx, x.Queue, "Good")
+
+	require.NoError(t, err)
+	assert.NoFileExists(t, x.JournalPath)
+}
+
+func TestFinish_ReturnsRemaining(t *testing.T) {
+	x := setup(t, "")
+	ctx := mutationqueue.Replayable(context.Background())
+	x.Client.StubMatchWithError(gqlmock.WithOpName("Op"), errConnection)
+	x.Client.StubMatchWithError(gqlmock.WithOpName("Op"), errConnection)
+	_ = send(ctx, x.Queue, "Op")
+
+	remaining := x.Queue.Finish(context.Background())
+
+	assert.Equal(t, 1, remaining)
+	assert.FileExists(t, x.JournalPath)
+	assert.Empty(t, x.Operations.ToProto().Operations)
+}
+
+func TestNew_SendsExistingJournalInBackground(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "run.wandb.mutations")
+	journal, err := mutationqueue.OpenJournal(path)
+	require.NoError(t, err)
+	_, err = journal.Append(request("Op"))
+	require.NoError(t, err)
+
+	client := gqlmock.NewMockClient()
+	client.StubMatchOnce(gqlmock.WithOpName("Op"), `{}`)
+	queue := mutationqueue.New(mutationqueue.Params{
+		Client:        client,
+		JournalPath:   path,
+		Logger:        observabilitytest.NewTestLogger(t),
+		RetryInterval: time.Hour,
+	})
+
+	assert.Eventually(t,
+		client.AllStubsUsed,
+		time.Second,
+		10*time.Millisecond)
+	assert.Eventually(t,
+		func() bool { return queue.Finish(context.Background()) == 0 },
+		time.Second,
+		10*time.Millisecond)
+	assert.NoFileExists(t, path)
+}
+
+func jsonVariables(t *testing.T, req *graphql.Request) map[string]any {
+	t.Helper()
+
+	var variables map[string]any
+	raw, ok := req.Variables.(json.RawMessage)
+	require.True(t, ok)
+	require.NoError(t, json.Unmarshal(raw, &variables))
+	return variables
+}
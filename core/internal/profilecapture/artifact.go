@@ -0,0 +1,47 @@
+package profilecapture
+
+import (
+	"encoding/json"
+	"fmt"
+	"path/filepath"
+
+	"google.golang.org/protobuf/proto"
+
+	"github.com/wandb/wandb/core/pkg/artifacts"
+	spb "github.com/wandb/wandb/core/pkg/service_go_proto"
+)
+
+// ArtifactType is the type of artifacts holding profiles.
+const ArtifactType = "profile"
+
+// Artifact builds a profile artifact from the profile's files.
+//
+// base provides the artifact's identity, such as its name and run; its type,
+// metadata and manifest are set here. The index is stored both as a file
+// and as the artifact's metadata, so that profiles can be compared without
+// downloading them.
+func (p *Profile) Artifact(base *spb.ArtifactRecord) (*spb.ArtifactRecord, error) {
+	meta, err := json.Marshal(p.Index)
+	if err != nil {
+		return nil, err
+	}
+
+	record := proto.Clone(base).(*spb.ArtifactRecord)
+	record.Type = ArtifactType
+	record.Metadata = string(meta)
+	record.Manifest = nil
+
+	builder := artifacts.NewArtifactBuilder(record)
+	names := []string{IndexFileName}
+	for _, entry := range p.Index.Entries {
+		if entry.Status == StatusOK {
+			names = append(names, entry.File)
+		}
+	}
+	for _, name := range names {
+		if err := builder.AddFile(filepath.Join(p.Dir, name), name); err != nil {
+			return nil, fmt.Errorf("profilecapture: failed to add %q: %v", name, err)
+		}
+	}
+	return builder.GetArtifact(), nil
+}
@@ -0,0 +1,421 @@
+// Package profilecapture gathers a performance profile of a run for upload
+// as a profile artifact.
+//
+// A capture runs several collectors side by side for a fixed window: a
+// high-frequency burst of system metrics, `perf` and `py-spy` samples of the
+// run's process if those tools are installed, and pprof profiles of
+// wandb-core itself. The files are described by a timestamped index so that
+// profiles of different runs can be compared.
+package profilecapture
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"runtime/pprof"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/wandb/wandb/core/internal/observability"
+	spb "github.com/wandb/wandb/core/pkg/service_go_proto"
+)
+
+const (
+	// DefaultDuration is the capture window used when none is given.
+	DefaultDuration = 30 * time.Second
+
+	// MaxDuration bounds the capture window.
+	MaxDuration = 10 * time.Minute
+
+	// DefaultBurstInterval is the system metrics sampling interval used
+	// when none is given.
+	DefaultBurstInterval = 100 * time.Millisecond
+
+	// IndexFileName is the name of the index in the profile's directory.
+	IndexFileName = "index.json"
+)
+
+// Statuses of a collector in the index.
+const (
+	StatusOK      = "ok"
+	StatusSkipped = "skipped"
+	StatusFailed  = "failed"
+)
+
+// Params configures a capture.
+type Params struct {
+	// Pid is the process to profile with perf and py-spy.
+	//
+	// If zero, those tools are skipped.
+	Pid int32
+
+	// Duration is the length of the capture window.
+	//
+	// Values less than or equal to zero use DefaultDuration, and values
+	// above MaxDuration are clamped.
+	Duration time.Duration
+
+	// Label is an optional label stored in the index.
+	Label string
+
+	// RunID is the ID of the profiled run, stored in the index.
+	RunID string
+
+	// Dir is the directory to write the profile's files to.
+	//
+	// It is created if it doesn't exist.
+	Dir string
+
+	// Sample takes a system metrics sample for the burst.
+	//
+	// If nil, no system metrics are collected.
+	Sample func() (*spb.StatsRecord, error)
+
+	// BurstInterval is the delay between system metrics samples.
+	//
+	// Values less than or equal to zero use DefaultBurstInterval.
+	BurstInterval time.Duration
+
+	Logger *observability.CoreLogger
+}
+
+// Entry describes the output of one collector.
+type Entry struct {
+	// Tool is the collector's name, such as "perf" or "pprof-cpu".
+	Tool string `json:"tool"`
+
+	// File is the output's path relative to the profile's directory.
+	//
+	// It is empty unless Status is StatusOK.
+	File string `json:"file,omitempty"`
+
+	// Status is one of StatusOK, StatusSkipped or StatusFailed.
+	Status string `json:"status"`
+
+	// Error explains why the collector was skipped or failed.
+	Error string `json:"error,omitempty"`
+}
+
+// Index describes a captured profile.
+type Index struct {
+	Label           string    `json:"label,omitempty"`
+	RunID           string    `json:"run_id,omitempty"`
+	Host            string    `json:"host,omitempty"`
+	Pid             int32     `json:"pid,omitempty"`
+	StartTime       time.Time `json:"start_time"`
+	EndTime         time.Time `json:"end_time"`
+	DurationSeconds float64   `json:"duration_seconds"`
+	Entries         []Entry   `json:"entries"`
+}
+
+// Profile is a captured profile written to disk.
+type Profile struct {
+	// Dir is the directory containing the profile's files and index.
+	Dir string
+
+	Index Index
+}
+
+// collector gathers one kind of output during the capture window.
+//
+// run is given a context that expires at the end of the window. It returns
+// the name of the file it wrote relative to the profile's directory, or an
+// error. Returning a skippedError marks the collector as skipped rather
+// than failed.
+type collector struct {
+	tool string
+	run  func(windowCtx context.Context) (string, error)
+}
+
+// skippedError marks a collector that had nothing to do.
+type skippedError struct{ reason string }
+
+func (e *skippedError) Error() string { return e.reason }
+
+// Capture collects a profile for the configured window.
+//
+// It blocks until the window ends. If the context is cancelled first,
+// collectors are stopped and the context's error is returned.
+func Capture(ctx context.Context, params Params) (*Profile, error) {
+	if params.Logger == nil {
+		params.Logger = observability.NewNoOpLogger()
+	}
+
+	window := params.Duration
+	if window <= 0 {
+		window = DefaultDuration
+	}
+	window = min(window, MaxDuration)
+
+	if err := os.MkdirAll(params.Dir, 0o755); err != nil {
+		return nil, fmt.Errorf("profilecapture: failed to create directory: %v", err)
+	}
+
+	host, _ := os.Hostname()
+	index := Index{
+		Label:     params.Label,
+		RunID:     params.RunID,
+		Host:      host,
+		Pid:       params.Pid,
+		StartTime: time.Now().UTC(),
+	}
+
+	// External tools are told the window's length and stop by themselves,
+	// so they get the outer context and are only killed on cancellation.
+	collectors := []collector{
+		{"system", func(windowCtx context.Context) (string, error) {
+			return sampleSystem(windowCtx, params)
+		}},
+		{"perf", func(context.Context) (string, error) {
+			return runPerf(ctx, params, window)
+		}},
+		{"py-spy", func(context.Context) (string, error) {
+			return runPySpy(ctx, params, window)
+		}},
+		{"pprof-cpu", func(windowCtx context.Context) (string, error) {
+			return profileCPU(windowCtx, params.Dir)
+		}},
+	}
+
+	windowCtx, cancel := context.WithTimeout(ctx, window)
+	defer cancel()
+
+	index.Entries = make([]Entry, len(collectors))
+	var wg sync.WaitGroup
+	for i, c := range collectors {
+		wg.Go(func() {
+			file, err := c.run(windowCtx)
+			index.Entries[i] = makeEntry(c.tool, file, err)
+		})
+	}
+	wg.Wait()
+
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	// Snapshots of wandb-core's state at the end of the window.
+	for _, name := range []string{"heap", "goroutine"} {
+		file, err := writeLookupProfile(params.Dir, name)
+		index.Entries = append(index.Entries, makeEntry("pprof-"+name, file, err))
+	}
+
+	index.EndTime = time.Now().UTC()
+	index.DurationSeconds = index.EndTime.Sub(index.StartTime).Seconds()
+
+	for _, entry := range index.Entries {
+		if entry.Status == StatusFailed {
+			params.Logger.Warn(
+				"profilecapture: collector failed",
+				"tool", entry.Tool,
+				"error", entry.Error,
+			)
+		}
+	}
+
+	if err := writeIndex(params.Dir, index); err != nil {
+		return nil, err
+	}
+
+	return &Profile{Dir: params.Dir, Index: index}, nil
+}
+
+func makeEntry(tool, file string, err error) Entry {
+	entry := Entry{Tool: tool, File: file, Status: StatusOK}
+
+	var skipped *skippedError
+	switch {
+	case errors.As(err, &skipped):
+		entry.Status = StatusSkipped
+		entry.Error = skipped.reason
+	case err != nil:
+		entry.Status = StatusFailed
+		entry.Error = err.Error()
+	}
+	if err != nil {
+		entry.File = ""
+	}
+
+	return entry
+}
+
+func writeIndex(dir string, index Index) error {
+	data, err := json.MarshalIndent(index, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	err = os.WriteFile(filepath.Join(dir, IndexFileName), data, 0o644)
+	if err != nil {
+		return fmt.Errorf("profilecapture: failed to write index: %v", err)
+	}
+	return nil
+}
+
+// burstSample is a line in the system metrics burst file.
+type burstSample struct {
+	// Timestamp is the Unix time in seconds the sample was taken.
+	Timestamp float64 `json:"timestamp"`
+
+	Metrics map[string]json.RawMessage `json:"metrics"`
+}
+
+// sampleSystem writes system metrics samples as JSON lines until the
+// window ends.
+func sampleSystem(ctx context.Context, params Params) (string, error) {
+	if params.Sample == nil {
+		return "", &skippedError{"no system metrics sampler"}
+	}
+
+	interval := params.BurstInterval
+	if interval <= 0 {
+		interval = DefaultBurstInterval
+	}
+
+	const name = "system.jsonl"
+	file, err := os.Create(filepath.Join(params.Dir, name))
+	if err != nil {
+		return "", err
+	}
+	defer func() { _ = file.Close() }()
+
+	encoder := json.NewEncoder(file)
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		stats, err := params.Sample()
+		if stats != nil {
+			sample := burstSample{
+				Timestamp: float64(time.Now().UnixMicro()) / 1e6,
+				Metrics:   make(map[string]json.RawMessage, len(stats.Item)),
+			}
+			for _, item := range stats.Item {
+				sample.Metrics[item.Key] = json.RawMessage(item.ValueJson)
+			}
+			if err := encoder.Encode(sample); err != nil {
+				return "", err
+			}
+		} else if err != nil {
+			params.Logger.Debug("profilecapture: failed to sample", "error", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return name, file.Close()
+		case <-ticker.C:
+		}
+	}
+}
+
+// runPerf records stack samples of the process with `perf record`.
+func runPerf(ctx context.Context, params Params, window time.Duration) (string, error) {
+	if params.Pid == 0 {
+		return "", &skippedError{"no process to profile"}
+	}
+	perf, err := exec.LookPath("perf")
+	if err != nil {
+		return "", &skippedError{"perf is not installed"}
+	}
+
+	const name = "perf.data"
+	seconds := strconv.Itoa(wholeSeconds(window))
+	cmd := exec.CommandContext(ctx, perf,
+		"record", "-F", "99", "-g",
+		"-p", strconv.Itoa(int(params.Pid)),
+		"-o", filepath.Join(params.Dir, name),
+		"--", "sleep", seconds,
+	)
+	return name, runTool(cmd)
+}
+
+// runPySpy records Python stack samples of the process with `py-spy record`.
+func runPySpy(ctx context.Context, params Params, window time.Duration) (string, error) {
+	if params.Pid == 0 {
+		return "", &skippedError{"no process to profile"}
+	}
+	pySpy, err := exec.LookPath("py-spy")
+	if err != nil {
+		return "", &skippedError{"py-spy is not installed"}
+	}
+
+	const name = "py-spy.speedscope.json"
+	cmd := exec.CommandContext(ctx, pySpy,
+		"record",
+		"--pid", strconv.Itoa(int(params.Pid)),
+		"--duration", strconv.Itoa(wholeSeconds(window)),
+		"--format", "speedscope",
+		"--output", filepath.Join(params.Dir, name),
+		"--nonblocking",
+	)
+	return name, runTool(cmd)
+}
+
+// runTool runs an external profiler, including the end of its output in
+// the error if it fails.
+func runTool(cmd *exec.Cmd) error {
+	output, err := cmd.CombinedOutput()
+	if err != nil {
+		if len(output) > 0 {
+			return fmt.Errorf("%v: %s", err, lastLine(output))
+		}
+		return err
+	}
+	return nil
+}
+
+// profileCPU records a CPU profile of wandb-core until the window ends.
+func profileCPU(ctx context.Context, dir string) (string, error) {
+	const name = "wandb-core.cpu.pprof"
+	file, err := os.Create(filepath.Join(dir, name))
+	if err != nil {
+		return "", err
+	}
+	defer func() { _ = file.Close() }()
+
+	// This fails if a profile is already being taken, such as through the
+	// pprof server.
+	if err := pprof.StartCPUProfile(file); err != nil {
+		_ = file.Close()
+		_ = os.Remove(file.Name())
+		return "", err
+	}
+
+	<-ctx.Done()
+	pprof.StopCPUProfile()
+	return name, file.Close()
+}
+
+// writeLookupProfile writes one of the runtime's named profiles.
+func writeLookupProfile(dir, profile string) (string, error) {
+	name := "wandb-core." + profile + ".pprof"
+	file, err := os.Create(filepath.Join(dir, name))
+	if err != nil {
+		return "", err
+	}
+	defer func() { _ = file.Close() }()
+
+	if err := pprof.Lookup(profile).WriteTo(file, 0); err != nil {
+		return "", err
+	}
+	return name, file.Close()
+}
+
+// wholeSeconds rounds the window up to whole seconds for tools that
+// don't accept fractions.
+func wholeSeconds(d time.Duration) int {
+	return max(1, int(math.Ceil(d.Seconds())))
+}
+
+// lastLine returns the last non-empty line of a tool's output.
+func lastLine(output []byte) string {
+	trimmed := strings.TrimSpace(string(output))
+	return trimmed[strings.LastIndexByte(trimmed, '\n')+1:]
+}
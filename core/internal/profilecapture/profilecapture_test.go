@@ -0,0 +1,161 @@
+package profilecapture_test
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/wandb/wandb/core/internal/profilecapture"
+	spb "github.com/wandb/wandb/core/pkg/service_go_proto"
+)
+
+// fakePySpy is a py-spy stand-in that writes its arguments to its output.
+const fakePySpy = `#!/bin/sh
+args="$*"
+while [ $# -gt 0 ]; do
+	if [ "$1" = "--output" ]; then echo "$args" > "$2"; fi
+	shift
+done
+`
+
+// withTools makes the given scripts the only executables on PATH.
+func withTools(t *testing.T, scripts map[string]string) {
+	t.Helper()
+	if runtime.GOOS == "windows" {
+		t.Skip("fake tools are shell scripts")
+	}
+
+	bin := t.TempDir()
+	for name, script := range scripts {
+		path := filepath.Join(bin, name)
+		require.NoError(t, os.WriteFile(path, []byte(script), 0o755))
+	}
+	t.Setenv("PATH", bin)
+}
+
+func entriesByTool(index profilecapture.Index) map[string]profilecapture.Entry {
+	entries := make(map[string]profilecapture.Entry)
+	for _, entry := range index.Entries {
+		entries[entry.Tool] = entry
+	}
+	return entries
+}
+
+func TestCapture(t *testing.T) {
+	withTools(t, map[string]string{"py-spy": fakePySpy})
+	dir := filepath.Join(t.TempDir(), "profile")
+
+	profile, err := profilecapture.Capture(context.Background(),
+		profilecapture.Params{
+			Pid:      1234,
+			Duration: 300 * time.Millisecond,
+			Label:    "epoch-1",
+			RunID:    "run-id",
+			Dir:      dir,
+			Sample: func() (*spb.StatsRecord, error) {
+				return &spb.StatsRecord{Item: []*spb.StatsItem{
+					{Key: "cpu", ValueJson: "12.5"},
+				}}, nil
+			},
+			BurstInterval: 50 * time.Millisecond,
+		})
+	require.NoError(t, err)
+
+	index := profile.Index
+	assert.Equal(t, "epoch-1", index.Label)
+	assert.Equal(t, "run-id", index.RunID)
+	assert.False(t, index.EndTime.Before(index.StartTime))
+
+	entries := entriesByTool(index)
+	assert.Equal(t, profilecapture.StatusSkipped, entries["perf"].Status)
+	assert.Equal(t, "perf is not installed", entries["perf"].Error)
+	for _, tool := range []string{
+		"system", "py-spy", "pprof-cpu", "pprof-heap", "pprof-goroutine",
+	} {
+		assert.Equal(t, profilecapture.StatusOK, entries[tool].Status, tool)
+		assert.FileExists(t, filepath.Join(dir, entries[tool].File), tool)
+	}
+
+	pySpyArgs, err := os.ReadFile(filepath.Join(dir, entries["py-spy"].File))
+	require.NoError(t, err)
+	assert.Contains(t, string(pySpyArgs), "record --pid 1234 --duration 1")
+
+	burst, err := os.ReadFile(filepath.Join(dir, entries["system"].File))
+	require.NoError(t, err)
+	lines := strings.Split(strings.TrimSpace(string(burst)), "\n")
+	assert.Greater(t, len(lines), 1)
+	assert.Contains(t, lines[0], `"metrics":{"cpu":12.5}`)
+
+	var written profilecapture.Index
+	data, err := os.ReadFile(filepath.Join(dir, profilecapture.IndexFileName))
+	require.NoError(t, err)
+	require.NoError(t, json.Unmarshal(data, &written))
+	assert.Equal(t, index.Entries, written.Entries)
+}
+
+func TestCapture_NoPid(t *testing.T) {
+	withTools(t, map[string]string{"py-spy": fakePySpy})
+
+	profile, err := profilecapture.Capture(context.Background(),
+		profilecapture.Params{
+			Duration: time.Millisecond,
+			Dir:      t.TempDir(),
+		})
+	require.NoError(t, err)
+
+	entries := entriesByTool(profile.Index)
+	assert.Equal(t, profilecapture.StatusSkipped, entries["system"].Status)
+	assert.Equal(t, profilecapture.StatusSkipped, entries["perf"].Status)
+	assert.Equal(t, profilecapture.StatusSkipped, entries["py-spy"].Status)
+	assert.Empty(t, entries["py-spy"].File)
+}
+
+func TestCapture_Cancelled(t *testing.T) {
+	withTools(t, nil)
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	_, err := profilecapture.Capture(ctx, profilecapture.Params{Dir: t.TempDir()})
+
+	assert.ErrorIs(t, err, context.Canceled)
+}
+
+func TestArtifact(t *testing.T) {
+	withTools(t, nil)
+	profile, err := profilecapture.Capture(context.Background(),
+		profilecapture.Params{
+			Duration: time.Millisecond,
+			Dir:      t.TempDir(),
+		})
+	require.NoError(t, err)
+
+	artifact, err := profile.Artifact(&spb.ArtifactRecord{
+		Name:  "profile-run-id",
+		RunId: "run-id",
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, "profile-run-id", artifact.Name)
+	assert.Equal(t, profilecapture.ArtifactType, artifact.Type)
+	assert.Contains(t, artifact.Metadata, `"entries"`)
+	var paths []string
+	for _, entry := range artifact.Manifest.Contents {
+		paths = append(paths, entry.Path)
+	}
+	assert.ElementsMatch(t,
+		[]string{
+			profilecapture.IndexFileName,
+			"wandb-core.cpu.pprof",
+			"wandb-core.heap.pprof",
+			"wandb-core.goroutine.pprof",
+		},
+		paths)
+}
@@ -0,0 +1,224 @@
+package redact
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"path"
+	"strings"
+
+	"github.com/wandb/simplejsonext"
+)
+
+// Roots of the paths matched by a Policy.
+const (
+	RootConfig   = "config"
+	RootMetadata = "metadata"
+	RootSummary  = "summary"
+)
+
+// HashPrefix starts each value replaced by its hash.
+const HashPrefix = "sha256:"
+
+// Policy removes or hashes values in a run's config, summary and metadata
+// before they're uploaded.
+//
+// Rules are dotted path patterns such as "config.db.password" or
+// "metadata.git.remote". Each component is matched with path.Match, and
+// a "**" component matches any number of components. A pattern that
+// doesn't start with "config", "metadata" or "summary" matches keys at
+// any depth under any root, so "*token*" matches "config.hf_token" and
+// "metadata.env.GITHUB_TOKEN".
+//
+// When a value matches a rule, its whole subtree is affected. Keep rules
+// take precedence over drop rules, which take precedence over hash rules.
+//
+// A nil Policy does nothing.
+type Policy struct {
+	drop []pathPattern
+	hash []pathPattern
+	keep []pathPattern
+}
+
+// pathPattern is a parsed rule.
+type pathPattern struct {
+	// root is the first component of the pattern, or empty for patterns
+	// that match at any depth.
+	root string
+
+	components []string
+}
+
+// NewPolicy returns a policy with the given rules.
+//
+// It returns nil if there are no drop or hash rules.
+func NewPolicy(drop, hash, keep []string) (*Policy, error) {
+	if len(drop) == 0 && len(hash) == 0 {
+		return nil, nil
+	}
+
+	policy := &Policy{}
+
+	var err error
+	if policy.drop, err = parsePatterns(drop); err != nil {
+		return nil, err
+	}
+	if policy.hash, err = parsePatterns(hash); err != nil {
+		return nil, err
+	}
+	if policy.keep, err = parsePatterns(keep); err != nil {
+		return nil, err
+	}
+
+	return policy, nil
+}
+
+func parsePatterns(patterns []string) ([]pathPattern, error) {
+	parsed := make([]pathPattern, 0, len(patterns))
+
+	for _, pattern := range patterns {
+		components := strings.Split(pattern, ".")
+		for _, component := range components {
+			if component == "" {
+				return nil, fmt.Errorf("redact: empty component in %q", pattern)
+			}
+			if _, err := path.Match(component, ""); err != nil {
+				return nil, fmt.Errorf("redact: invalid pattern %q: %v", pattern, err)
+			}
+		}
+
+		switch components[0] {
+		case RootConfig, RootMetadata, RootSummary:
+			parsed = append(parsed, pathPattern{
+				root:       components[0],
+				components: components[1:],
+			})
+		default:
+			parsed = append(parsed, pathPattern{
+				components: append([]string{"**"}, components...),
+			})
+		}
+	}
+
+	return parsed, nil
+}
+
+// Apply returns a copy of the tree under the root with the policy applied.
+//
+// The input is not modified.
+func (p *Policy) Apply(root string, tree map[string]any) map[string]any {
+	if p == nil {
+		return tree
+	}
+
+	result, _ := p.applyMap(root, nil, tree)
+	return result
+}
+
+// ApplyValue applies the policy to a single value at a path under the root.
+//
+// Rules matching an ancestor of the path apply to the value too, as they
+// would if the value were part of a tree passed to Apply.
+//
+// It returns false if the value should be dropped.
+func (p *Policy) ApplyValue(root string, keyPath []string, value any) (any, bool) {
+	if p == nil {
+		return value, true
+	}
+
+	for i := 1; i < len(keyPath); i++ {
+		switch {
+		case matchesAny(p.keep, root, keyPath[:i]):
+			return value, true
+		case matchesAny(p.drop, root, keyPath[:i]):
+			return nil, false
+		case matchesAny(p.hash, root, keyPath[:i]):
+			return hashValue(value), true
+		}
+	}
+
+	return p.applyValue(root, keyPath, value)
+}
+
+func (p *Policy) applyValue(root string, keyPath []string, value any) (any, bool) {
+	switch {
+	case matchesAny(p.keep, root, keyPath):
+		return value, true
+	case matchesAny(p.drop, root, keyPath):
+		return nil, false
+	case matchesAny(p.hash, root, keyPath):
+		return hashValue(value), true
+	}
+
+	if subtree, ok := value.(map[string]any); ok {
+		return p.applyMap(root, keyPath, subtree)
+	}
+
+	return value, true
+}
+
+func (p *Policy) applyMap(
+	root string,
+	keyPath []string,
+	tree map[string]any,
+) (map[string]any, bool) {
+	result := make(map[string]any, len(tree))
+
+	for key, value := range tree {
+		childPath := append(keyPath[:len(keyPath):len(keyPath)], key)
+		if newValue, ok := p.applyValue(root, childPath, value); ok {
+			result[key] = newValue
+		}
+	}
+
+	return result, true
+}
+
+// hashValue returns a string with the SHA-256 hash of the value.
+func hashValue(value any) string {
+	text, ok := value.(string)
+	if !ok {
+		text, _ = simplejsonext.MarshalToString(value)
+	}
+
+	sum := sha256.Sum256([]byte(text))
+	return HashPrefix + hex.EncodeToString(sum[:])
+}
+
+func matchesAny(patterns []pathPattern, root string, keyPath []string) bool {
+	for _, pattern := range patterns {
+		if pattern.root != "" && pattern.root != root {
+			continue
+		}
+
+		if matchComponents(pattern.components, keyPath) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// matchComponents reports whether the pattern components match the whole
+// key path.
+func matchComponents(pattern, keyPath []string) bool {
+	if len(pattern) == 0 {
+		return len(keyPath) == 0
+	}
+
+	if pattern[0] == "**" {
+		for i := 0; i <= len(keyPath); i++ {
+			if matchComponents(pattern[1:], keyPath[i:]) {
+				return true
+			}
+		}
+		return false
+	}
+
+	if len(keyPath) == 0 {
+		return false
+	}
+
+	ok, _ := path.Match(pattern[0], keyPath[0])
+	return ok && matchComponents(pattern[1:], keyPath[1:])
+}
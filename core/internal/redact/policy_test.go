@@ -0,0 +1,111 @@
+package redact_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/wandb/wandb/core/internal/redact"
+)
+
+func TestNewPolicy_NilWithoutRules(t *testing.T) {
+	policy, err := redact.NewPolicy(nil, nil, []string{"config.lr"})
+
+	require.NoError(t, err)
+	assert.Nil(t, policy)
+
+	tree := map[string]any{"a": 1}
+	assert.Equal(t, tree, policy.Apply(redact.RootConfig, tree))
+}
+
+func TestNewPolicy_InvalidPattern(t *testing.T) {
+	_, err := redact.NewPolicy([]string{"config.[a"}, nil, nil)
+	assert.ErrorContains(t, err, `invalid pattern "config.[a"`)
+
+	_, err = redact.NewPolicy([]string{"config..a"}, nil, nil)
+	assert.ErrorContains(t, err, `empty component in "config..a"`)
+}
+
+func TestPolicy_DropHashAndKeep(t *testing.T) {
+	policy, err := redact.NewPolicy(
+		[]string{"config.db.password", "*token*"},
+		[]string{"config.user.email"},
+		[]string{"config.tokenizer"},
+	)
+	require.NoError(t, err)
+
+	config := map[string]any{
+		"lr":        0.1,
+		"tokenizer": "bpe",
+		"hf_token":  "hf_abc",
+		"db":        map[string]any{"host": "db.local", "password": "hunter2"},
+		"user":      map[string]any{"email": "a@b.c"},
+	}
+
+	result := policy.Apply(redact.RootConfig, config)
+
+	assert.Equal(t,
+		map[string]any{
+			"lr":        0.1,
+			"tokenizer": "bpe",
+			"db":        map[string]any{"host": "db.local"},
+			"user": map[string]any{
+				"email": "sha256:" +
+					"d648b243a3e817eaa3309e00e183483f2867baadf522099f0c2121770536b25a",
+			},
+		},
+		result)
+	assert.Contains(t, config, "hf_token", "input must not be modified")
+}
+
+func TestPolicy_RootedPatternsOnlyMatchTheirRoot(t *testing.T) {
+	policy, err := redact.NewPolicy([]string{"metadata.host", "metadata.git.remote"}, nil, nil)
+	require.NoError(t, err)
+
+	metadata := map[string]any{
+		"host": "gpu-01",
+		"git":  map[string]any{"remote": "https://x@github.com/a/b", "commit": "abc"},
+	}
+	config := map[string]any{"host": "kept"}
+
+	assert.Equal(t,
+		map[string]any{"git": map[string]any{"commit": "abc"}},
+		policy.Apply(redact.RootMetadata, metadata))
+	assert.Equal(t, config, policy.Apply(redact.RootConfig, config))
+}
+
+func TestPolicy_DoubleStar(t *testing.T) {
+	policy, err := redact.NewPolicy([]string{"summary.**.secret"}, nil, nil)
+	require.NoError(t, err)
+
+	_, ok := policy.ApplyValue(redact.RootSummary, []string{"a", "b", "secret"}, 1)
+	assert.False(t, ok)
+
+	_, ok = policy.ApplyValue(redact.RootSummary, []string{"secret"}, 1)
+	assert.False(t, ok)
+
+	value, ok := policy.ApplyValue(redact.RootSummary, []string{"a"}, 1)
+	assert.True(t, ok)
+	assert.Equal(t, 1, value)
+}
+
+func TestPolicy_ApplyValueMatchesAncestors(t *testing.T) {
+	policy, err := redact.NewPolicy(
+		[]string{"config.db", "*password*"},
+		[]string{"config.user"},
+		[]string{"config.cache"},
+	)
+	require.NoError(t, err)
+
+	_, ok := policy.ApplyValue(redact.RootConfig, []string{"db", "host"}, "x")
+	assert.False(t, ok)
+
+	value, ok := policy.ApplyValue(redact.RootConfig, []string{"user", "email"}, "e")
+	assert.True(t, ok)
+	assert.Contains(t, value, redact.HashPrefix)
+
+	value, ok = policy.ApplyValue(redact.RootConfig, []string{"cache", "password"}, "p")
+	assert.True(t, ok)
+	assert.Equal(t, "p", value)
+}
@@ -0,0 +1,140 @@
+// Package redact finds and masks secrets in text.
+package redact
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// Marker replaces each redacted secret.
+const Marker = "[REDACTED]"
+
+// Detector finds one kind of secret.
+//
+// If the pattern has capture groups, only the text matched by the groups
+// is redacted, so that a pattern can include context like "token=" without
+// hiding it. Otherwise, the whole match is redacted.
+type Detector struct {
+	Name    string
+	Pattern *regexp.Regexp
+}
+
+// BuiltinDetectors find common credentials that show up in program output.
+var BuiltinDetectors = []Detector{
+	{
+		Name:    "aws_access_key_id",
+		Pattern: regexp.MustCompile(`\b(?:AKIA|ASIA|AGPA|AIDA|AROA|ANPA|ANVA|AIPA)[0-9A-Z]{16}\b`),
+	},
+	{
+		Name: "aws_secret_access_key",
+		Pattern: regexp.MustCompile(
+			`(?i)aws_?secret_?access_?key["']?\s*[:=]\s*["']?([A-Za-z0-9/+=]{40})\b`),
+	},
+	{
+		Name:    "bearer_token",
+		Pattern: regexp.MustCompile(`(?i)\bbearer\s+([A-Za-z0-9\-._~+/]{8,}=*)`),
+	},
+	{
+		// W&B API keys are 40 hex characters, optionally following a
+		// prefix like "local-". Bare hex strings aren't matched because
+		// they're more often git commit hashes.
+		Name: "wandb_api_key",
+		Pattern: regexp.MustCompile(
+			`(?i)(?:wandb_api_key|api_key|apikey)["']?\s*[:=]\s*["']?` +
+				`((?:[a-z0-9]+-)?[0-9a-f]{40})\b`),
+	},
+	{
+		Name:    "wandb_prefixed_api_key",
+		Pattern: regexp.MustCompile(`\blocal-[0-9a-f]{40}\b`),
+	},
+	{
+		// Signatures and credentials in pre-signed S3, GCS and Azure URLs.
+		Name: "presigned_url",
+		Pattern: regexp.MustCompile(
+			`(?i)[?&](?:X-Amz-Signature|X-Amz-Credential|X-Amz-Security-Token|` +
+				`X-Goog-Signature|X-Goog-Credential|sig)=([^&\s"'#]+)`),
+	},
+}
+
+// Redactor masks secrets found by a list of detectors.
+//
+// It is safe for concurrent use.
+type Redactor struct {
+	detectors []Detector
+}
+
+// New returns a Redactor that uses the given detectors and user-provided
+// regular expressions.
+//
+// It returns an error if a pattern is not a valid Go regular expression.
+func New(detectors []Detector, patterns []string) (*Redactor, error) {
+	all := append([]Detector(nil), detectors...)
+
+	for i, pattern := range patterns {
+		re, err := regexp.Compile(pattern)
+		if err != nil {
+			return nil, fmt.Errorf("redact: invalid pattern %q: %v", pattern, err)
+		}
+
+		all = append(all, Detector{
+			Name:    fmt.Sprintf("pattern_%d", i),
+			Pattern: re,
+		})
+	}
+
+	return &Redactor{detectors: all}, nil
+}
+
+// Redact returns the text with each secret replaced by Marker and
+// the number of secrets replaced.
+func (r *Redactor) Redact(text string) (string, int) {
+	total := 0
+
+	for _, detector := range r.detectors {
+		var count int
+		text, count = redactMatches(text, detector.Pattern)
+		total += count
+	}
+
+	return text, total
+}
+
+// redactMatches replaces the text matched by the pattern, or by its capture
+// groups if it has any.
+func redactMatches(text string, pattern *regexp.Regexp) (string, int) {
+	matches := pattern.FindAllStringSubmatchIndex(text, -1)
+	if len(matches) == 0 {
+		return text, 0
+	}
+
+	var b strings.Builder
+	count := 0
+	last := 0
+
+	for _, match := range matches {
+		spans := [][2]int{{match[0], match[1]}}
+		if pattern.NumSubexp() > 0 {
+			spans = spans[:0]
+			for i := 2; i+1 < len(match); i += 2 {
+				if match[i] >= last && match[i] < match[i+1] {
+					spans = append(spans, [2]int{match[i], match[i+1]})
+				}
+			}
+		}
+
+		for _, span := range spans {
+			if span[0] < last {
+				continue
+			}
+
+			b.WriteString(text[last:span[0]])
+			b.WriteString(Marker)
+			last = span[1]
+			count++
+		}
+	}
+
+	b.WriteString(text[last:])
+	return b.String(), count
+}
@@ -0,0 +1,119 @@
+// Package remoterun connects to runs stored on a W&B server for reading.
+package remoterun
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/Khan/genqlient/graphql"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+
+	"github.com/wandb/wandb/core/internal/api"
+	"github.com/wandb/wandb/core/internal/httplayers"
+	"github.com/wandb/wandb/core/internal/observability"
+	"github.com/wandb/wandb/core/internal/settings"
+	"github.com/wandb/wandb/core/internal/stream"
+	spb "github.com/wandb/wandb/core/pkg/service_go_proto"
+)
+
+// Params identifies a run stored on a W&B server.
+type Params struct {
+	// BaseURL is the W&B API base URL (e.g. https://api.wandb.ai).
+	BaseURL string
+
+	Entity  string
+	Project string
+	RunID   string
+}
+
+// Clients are the clients for reading a run from a W&B server.
+type Clients struct {
+	// GraphQL is the client for the W&B GraphQL API.
+	GraphQL graphql.Client
+
+	// HTTP is the client for downloading the run's files.
+	HTTP api.RetryableClient
+}
+
+// NewClients returns clients for reading the run from its server.
+//
+// The API key is read from the WANDB_API_KEY environment variable.
+func NewClients(
+	params *Params,
+	logger *observability.CoreLogger,
+) (*Clients, error) {
+	apiKey := os.Getenv("WANDB_API_KEY")
+	if apiKey == "" {
+		return nil, errors.New("WANDB_API_KEY is not set")
+	}
+
+	s := settings.From(&spb.Settings{
+		ApiKey:  wrapperspb.String(apiKey),
+		BaseUrl: wrapperspb.String(params.BaseURL),
+	})
+	baseURL := stream.BaseURLFromSettings(logger, s)
+	credentialProvider := stream.CredentialsFromSettings(logger, s)
+
+	return &Clients{
+		GraphQL: stream.NewGraphQLClient(
+			baseURL,
+			"", /*clientID*/
+			credentialProvider,
+			logger,
+			&observability.Peeker{},
+			s,
+		),
+		HTTP: api.NewClient(api.ClientOptions{
+			RetryMax:        3,
+			RetryWaitMin:    1 * time.Second,
+			RetryWaitMax:    10 * time.Second,
+			NonRetryTimeout: 10 * time.Second,
+			Logger:          logger.Logger,
+			PreRetryLayers:  httplayers.LimitTo(baseURL, credentialProvider),
+		}),
+	}, nil
+}
+
+// ParseURL parses a W&B run URL into Params.
+//
+// Accepted shapes:
+//
+//	https://<host>/<entity>/<project>/<run-id>
+//	https://<host>/<entity>/<project>/runs/<run-id>
+//
+// The host is used as-is; canonicalization (e.g. wandb.ai -> api.wandb.ai)
+// is the launcher's responsibility.
+func ParseURL(s string) (*Params, error) {
+	u, err := url.Parse(s)
+	if err != nil {
+		return nil, fmt.Errorf("invalid remote URL %q: %w", s, err)
+	}
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return nil, fmt.Errorf("remote URL must use http(s), got %q", s)
+	}
+	if u.Host == "" {
+		return nil, fmt.Errorf("remote URL is missing host: %q", s)
+	}
+
+	parts := strings.Split(strings.Trim(u.Path, "/"), "/")
+	if len(parts) == 4 && parts[2] == "runs" {
+		parts = []string{parts[0], parts[1], parts[3]}
+	}
+	if len(parts) != 3 || parts[0] == "" || parts[1] == "" || parts[2] == "" {
+		return nil, fmt.Errorf(
+			"remote URL must be https://<host>/<entity>/<project>/runs/<run-id>, got %q",
+			s,
+		)
+	}
+
+	return &Params{
+		BaseURL: u.Scheme + "://" + u.Host,
+		Entity:  parts[0],
+		Project: parts[1],
+		RunID:   parts[2],
+	}, nil
+}
@@ -1,4 +1,4 @@
-package leet_test
+package remoterun_test
 
 import (
 	"testing"
@@ -6,19 +6,19 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
-	"github.com/wandb/wandb/core/internal/leet"
+	"github.com/wandb/wandb/core/internal/remoterun"
 )
 
-func TestParseRemoteURL(t *testing.T) {
+func TestParseURL(t *testing.T) {
 	tests := []struct {
 		name string
 		url  string
-		want *leet.RemoteRunParams
+		want *remoterun.Params
 	}{
 		{
 			name: "run URL with runs segment",
 			url:  "https://wandb.ai/my-entity/my-project/runs/abc123",
-			want: &leet.RemoteRunParams{
+			want: &remoterun.Params{
 				BaseURL: "https://wandb.ai",
 				Entity:  "my-entity",
 				Project: "my-project",
@@ -28,7 +28,7 @@ func TestParseRemoteURL(t *testing.T) {
 		{
 			name: "run URL without runs segment",
 			url:  "https://api.wandb.ai/my-entity/my-project/abc123",
-			want: &leet.RemoteRunParams{
+			want: &remoterun.Params{
 				BaseURL: "https://api.wandb.ai",
 				Entity:  "my-entity",
 				Project: "my-project",
@@ -38,7 +38,7 @@ func TestParseRemoteURL(t *testing.T) {
 		{
 			name: "entity named runs",
 			url:  "https://wandb.ai/runs/my-project/runs/abc123",
-			want: &leet.RemoteRunParams{
+			want: &remoterun.Params{
 				BaseURL: "https://wandb.ai",
 				Entity:  "runs",
 				Project: "my-project",
@@ -48,7 +48,7 @@ func TestParseRemoteURL(t *testing.T) {
 		{
 			name: "trailing slash",
 			url:  "http://localhost:8080/my-entity/my-project/runs/abc123/",
-			want: &leet.RemoteRunParams{
+			want: &remoterun.Params{
 				BaseURL: "http://localhost:8080",
 				Entity:  "my-entity",
 				Project: "my-project",
@@ -58,14 +58,14 @@ func TestParseRemoteURL(t *testing.T) {
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			got, err := leet.ParseRemoteURL(tt.url)
+			got, err := remoterun.ParseURL(tt.url)
 			require.NoError(t, err)
 			assert.Equal(t, tt.want, got)
 		})
 	}
 }
 
-func TestParseRemoteURL_Errors(t *testing.T) {
+func TestParseURL_Errors(t *testing.T) {
 	urls := []string{
 		"ftp://wandb.ai/entity/project/runs/abc123",
 		"wandb.ai/entity/project/runs/abc123",
@@ -77,7 +77,7 @@ func TestParseRemoteURL_Errors(t *testing.T) {
 	}
 	for _, url := range urls {
 		t.Run(url, func(t *testing.T) {
-			_, err := leet.ParseRemoteURL(url)
+			_, err := remoterun.ParseURL(url)
 			require.Error(t, err)
 		})
 	}
@@ -134,6 +134,24 @@ func (rc *RunConfig) AddInternalData(
 	}
 }
 
+// Inserts the per-writer attribution data of a shared-mode run.
+func (rc *RunConfig) AddWriterData(writers map[string]any) {
+	if len(writers) > 0 {
+		rc.pathTree.Set(
+			pathtree.PathOf("_wandb", "w"),
+			writers,
+		)
+	}
+}
+
+// Inserts the run's history upload throttling policy and its effect.
+func (rc *RunConfig) AddHistoryThrottleData(data map[string]any) {
+	rc.pathTree.Set(
+		pathtree.PathOf("_wandb", "history_throttle"),
+		data,
+	)
+}
+
 // Incorporates the config from a run that's being resumed.
 func (rc *RunConfig) MergeResumedConfig(oldConfig map[string]any) {
 	// Add any top-level keys that aren't already set.
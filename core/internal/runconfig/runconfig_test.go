@@ -117,6 +117,38 @@ func TestAddInternalData(t *testing.T) {
 	)
 }
 
+func TestAddHistoryThrottleData(t *testing.T) {
+	runConfig := runconfig.NewFrom(map[string]any{"lr": 0.1})
+
+	runConfig.AddHistoryThrottleData(map[string]any{"mode": "stride"})
+
+	assert.Equal(t,
+		map[string]any{
+			"lr": 0.1,
+			"_wandb": map[string]any{
+				"history_throttle": map[string]any{"mode": "stride"},
+			},
+		},
+		runConfig.CloneTree(),
+	)
+}
+
+func TestAddWriterData(t *testing.T) {
+	runConfig := runconfig.NewFrom(map[string]any{"lr": 0.1})
+
+	runConfig.AddWriterData(map[string]any{"id": map[string]any{"label": "a"}})
+
+	assert.Equal(t,
+		map[string]any{
+			"lr": 0.1,
+			"_wandb": map[string]any{
+				"w": map[string]any{"id": map[string]any{"label": "a"}},
+			},
+		},
+		runConfig.CloneTree(),
+	)
+}
+
 func ignoreError(_err error) {}
 
 func TestCloneTree(t *testing.T) {
@@ -0,0 +1,74 @@
+package runconsolelogs
+
+import (
+	"maps"
+	"slices"
+
+	"github.com/wandb/wandb/core/internal/redact"
+)
+
+// lineRedactor masks secrets in console lines before they're written.
+//
+// Line changes are held until the end of each write to the console so that
+// a secret is never uploaded while only partly written. A secret split
+// across separate writes may still be partly uploaded.
+//
+// It is not safe for concurrent use.
+type lineRedactor struct {
+	redactor *redact.Redactor
+
+	// pending are the lines changed since the last flush by line number.
+	pending map[int]*RunLogsLine
+
+	// count is the number of secrets redacted in finalized lines.
+	count int64
+}
+
+func newLineRedactor(redactor *redact.Redactor) *lineRedactor {
+	return &lineRedactor{
+		redactor: redactor,
+		pending:  make(map[int]*RunLogsLine),
+	}
+}
+
+// OnChange records that a line was modified.
+func (r *lineRedactor) OnChange(lineNum int, line *RunLogsLine) {
+	r.pending[lineNum] = line
+}
+
+// Flush passes redacted copies of the lines changed since the last flush
+// to the callback in order.
+func (r *lineRedactor) Flush(onChange func(int, *RunLogsLine)) {
+	for _, lineNum := range slices.Sorted(maps.Keys(r.pending)) {
+		redacted, _ := r.redact(r.pending[lineNum])
+		onChange(lineNum, redacted)
+	}
+
+	clear(r.pending)
+}
+
+// Finalize counts the secrets in a line that can no longer change
+// and returns its redacted copy.
+func (r *lineRedactor) Finalize(line *RunLogsLine) *RunLogsLine {
+	redacted, count := r.redact(line)
+	r.count += int64(count)
+	return redacted
+}
+
+// Count returns the number of secrets redacted from finalized lines.
+func (r *lineRedactor) Count() int64 {
+	return r.count
+}
+
+// redact returns a copy of the line with secrets masked, or the line itself
+// if it has none.
+func (r *lineRedactor) redact(line *RunLogsLine) (*RunLogsLine, int) {
+	content, count := r.redactor.Redact(string(line.Content))
+	if count == 0 {
+		return line, 0
+	}
+
+	redacted := line.Clone()
+	redacted.Content = []rune(content)
+	return redacted, count
+}
@@ -11,8 +11,10 @@ import (
 
 	"github.com/wandb/wandb/core/internal/filestream"
 	"github.com/wandb/wandb/core/internal/fileutil"
+	"github.com/wandb/wandb/core/internal/logparse"
 	"github.com/wandb/wandb/core/internal/observability"
 	"github.com/wandb/wandb/core/internal/paths"
+	"github.com/wandb/wandb/core/internal/redact"
 	"github.com/wandb/wandb/core/internal/runfiles"
 	"github.com/wandb/wandb/core/internal/terminalemulator"
 	spb "github.com/wandb/wandb/core/pkg/service_go_proto"
@@ -64,6 +66,16 @@ type Sender struct {
 	// isMultipart indicates whether we're using chunked file output.
 	isMultipart bool
 
+	// structuredWriterOrNil saves parsed log lines, if log parsing is enabled.
+	structuredWriterOrNil *structuredLogWriter
+
+	// redactorOrNil masks secrets before lines are written, if redaction
+	// is enabled.
+	redactorOrNil *lineRedactor
+
+	// writeLine sends a changed line to the file and filestream writers.
+	writeLine func(int, *RunLogsLine)
+
 	// model is the combined output of all logs sources.
 	model *RunLogsChangeModel
 }
@@ -108,6 +120,16 @@ type Params struct {
 
 	// ChunkMaxSeconds is a time-based rollover threshold for multipart console logs, in seconds.
 	ChunkMaxSeconds int32
+
+	// LogParser, if set, parses each line of output once it stops changing.
+	//
+	// Recognized log lines are saved to a JSON Lines file next to the
+	// console output file and counted by level.
+	LogParser logparse.Parser
+
+	// Redactor, if set, masks secrets in the output before it is written
+	// to the console output file, the filestream or the structured logs.
+	Redactor *redact.Redactor
 }
 
 func New(params Params) *Sender {
@@ -154,18 +176,49 @@ func New(params Params) *Sender {
 		})
 	}
 
+	var structuredWriter *structuredLogWriter
+	if params.LogParser != nil {
+		structuredWriter = newStructuredLogWriter(
+			params.LogParser,
+			params.FilesDir,
+			outputFileName,
+			params.Logger,
+			params.RunfilesUploaderOrNil,
+		)
+	}
+
+	writeLine := func(lineNum int, line *RunLogsLine) {
+		if fileWriter != nil {
+			fileWriter.UpdateLine(lineNum, line)
+		}
+		if fsWriter != nil {
+			fsWriter.UpdateLine(lineNum, line)
+		}
+	}
+
+	var redactor *lineRedactor
+	if params.Redactor != nil {
+		redactor = newLineRedactor(params.Redactor)
+	}
+
 	model := &RunLogsChangeModel{
 		maxLines:      maxTerminalLines,
 		maxLineLength: maxTerminalLineLength,
 		getNow:        params.GetNow,
-		onChange: func(lineNum int, line *RunLogsLine) {
-			if fileWriter != nil {
-				fileWriter.UpdateLine(lineNum, line)
-			}
-			if fsWriter != nil {
-				fsWriter.UpdateLine(lineNum, line)
+		onChange:      writeLine,
+	}
+
+	switch {
+	case redactor != nil:
+		model.onChange = redactor.OnChange
+		model.onFinalize = func(lineNum int, line *RunLogsLine) {
+			line = redactor.Finalize(line)
+			if structuredWriter != nil {
+				structuredWriter.FinalizeLine(lineNum, line)
 			}
-		},
+		}
+	case structuredWriter != nil:
+		model.onFinalize = structuredWriter.FinalizeLine
 	}
 
 	return &Sender{
@@ -187,6 +240,9 @@ func New(params Params) *Sender {
 		fsWriter:              fsWriter,
 		fileWriter:            fileWriter,
 		isMultipart:           params.Multipart,
+		structuredWriterOrNil: structuredWriter,
+		redactorOrNil:         redactor,
+		writeLine:             writeLine,
 		model:                 model,
 	}
 }
@@ -197,6 +253,10 @@ func New(params Params) *Sender {
 func (s *Sender) Finish() {
 	s.mu.Lock()
 	s.isFinished = true
+	s.model.FinalizeAll()
+	if s.structuredWriterOrNil != nil {
+		s.structuredWriterOrNil.Finish()
+	}
 	s.mu.Unlock()
 
 	if s.fsWriter != nil {
@@ -207,6 +267,53 @@ func (s *Sender) Finish() {
 	}
 }
 
+// SummaryCounts returns statistics about the console output keyed by
+// run summary key.
+//
+// These are the number of parsed log lines at each level, such as
+// "_console/errors", and the number of redacted secrets,
+// "_console/redactions".
+//
+// Lines are counted once they stop changing, so the most recent lines
+// may not be included until Finish. Returns nil if neither log parsing
+// nor redaction is enabled.
+func (s *Sender) SummaryCounts() map[string]int64 {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.structuredWriterOrNil == nil && s.redactorOrNil == nil {
+		return nil
+	}
+
+	summary := make(map[string]int64)
+
+	if s.structuredWriterOrNil != nil {
+		counts := s.structuredWriterOrNil.Counts()
+		for _, level := range logparse.Levels {
+			summary[levelSummaryKey(level)] = counts[level]
+		}
+	}
+
+	if s.redactorOrNil != nil {
+		summary["_console/redactions"] = s.redactorOrNil.Count()
+	}
+
+	return summary
+}
+
+// levelSummaryKey is the run summary key for the number of log lines
+// at a level.
+func levelSummaryKey(level logparse.Level) string {
+	switch level {
+	case logparse.LevelWarning:
+		return "_console/warnings"
+	case logparse.LevelError:
+		return "_console/errors"
+	default:
+		return "_console/" + level.String()
+	}
+}
+
 // StreamLoggerOutput appends a custom line of text to the run's console logs.
 //
 // This implements `run.write_logs()` in the Python client.
@@ -223,6 +330,8 @@ func (s *Sender) StreamLoggerOutput(record *spb.OutputLoggerRecord) {
 		// We can discard the line reference because we never change the line.
 		_ = s.model.NextLine("", s.streamLabel, line)
 	}
+
+	s.flushRedactedLines()
 }
 
 // StreamLogs updates the run's captured console logs.
@@ -249,4 +358,16 @@ func (s *Sender) StreamLogs(record *spb.OutputRawRecord) {
 			record.OutputType,
 		)
 	}
+
+	s.flushRedactedLines()
+}
+
+// flushRedactedLines writes the lines changed by the last write to
+// the console, if redaction is enabled.
+//
+// The mutex must be held.
+func (s *Sender) flushRedactedLines() {
+	if s.redactorOrNil != nil {
+		s.redactorOrNil.Flush(s.writeLine)
+	}
 }
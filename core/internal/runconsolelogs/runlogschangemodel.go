@@ -25,6 +25,10 @@ type RunLogsChangeModel struct {
 	// onChange is invoked whenever a line is modified.
 	onChange func(int, *RunLogsLine)
 
+	// onFinalize, if set, is invoked when a line can no longer change,
+	// either because it's too old to track or because of FinalizeAll.
+	onFinalize func(int, *RunLogsLine)
+
 	// getNow returns the current time.
 	getNow func() time.Time
 
@@ -153,8 +157,13 @@ func (o *RunLogsChangeModel) NextLine(
 	o.lines = append(o.lines, line)
 
 	if len(o.lines) > o.maxLines {
+		oldest := o.lines[0]
 		o.firstLineNum += 1
 		o.lines = slices.Delete(o.lines, 0, 1)
+
+		if o.onFinalize != nil {
+			o.onFinalize(o.firstLineNum-1, oldest)
+		}
 	}
 
 	o.onChange(lineNum, line)
@@ -162,6 +171,20 @@ func (o *RunLogsChangeModel) NextLine(
 	return RunLogsLineRef{output: o, lineNum: lineNum}
 }
 
+// FinalizeAll stops tracking all lines, finalizing them.
+//
+// Lines created afterward are tracked as usual.
+func (o *RunLogsChangeModel) FinalizeAll() {
+	if o.onFinalize != nil {
+		for i, line := range o.lines {
+			o.onFinalize(o.firstLineNum+i, line)
+		}
+	}
+
+	o.firstLineNum += len(o.lines)
+	o.lines = nil
+}
+
 // RunLogsLineSupplier allows a terminal emulator to request new lines in the
 // output buffer.
 type RunLogsLineSupplier struct {
@@ -0,0 +1,174 @@
+package runconsolelogs
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/wandb/wandb/core/internal/filetransfer"
+	"github.com/wandb/wandb/core/internal/logparse"
+	"github.com/wandb/wandb/core/internal/observability"
+	"github.com/wandb/wandb/core/internal/paths"
+	"github.com/wandb/wandb/core/internal/runfiles"
+)
+
+// StructuredLogsExtension replaces the console output file's extension
+// to name the file of parsed log lines, e.g. "output.jsonl".
+const StructuredLogsExtension = ".jsonl"
+
+// structuredLogWriter parses finished console lines and saves those in
+// a recognized log format to a JSON Lines file.
+//
+// It also counts the lines at each level.
+//
+// It is not safe for concurrent use.
+type structuredLogWriter struct {
+	parse logparse.Parser
+
+	filesDir string
+	path     paths.RelativePath
+
+	// file and buffer are opened when the first line is recognized.
+	file   *os.File
+	buffer *bufio.Writer
+
+	// broken indicates an error occurred writing the file, after which
+	// lines are counted but not saved.
+	broken bool
+
+	counts map[logparse.Level]int64
+
+	logger        *observability.CoreLogger
+	uploaderOrNil runfiles.Uploader
+}
+
+// structuredLogLine is a line in the structured logs file.
+type structuredLogLine struct {
+	// Line is the index of the line in the run's console output,
+	// counting from zero across all parts in multipart mode.
+	Line int `json:"line"`
+
+	// TS is the time from the log line, or else the time it was captured.
+	TS string `json:"ts"`
+
+	Level   string `json:"level"`
+	Logger  string `json:"logger,omitempty"`
+	Message string `json:"message"`
+
+	Stderr bool   `json:"stderr,omitempty"`
+	Label  string `json:"label,omitempty"`
+}
+
+func newStructuredLogWriter(
+	parse logparse.Parser,
+	filesDir string,
+	outputFileName paths.RelativePath,
+	logger *observability.CoreLogger,
+	uploaderOrNil runfiles.Uploader,
+) *structuredLogWriter {
+	extension := filepath.Ext(string(outputFileName))
+	path, _ := paths.Relative(
+		strings.TrimSuffix(string(outputFileName), extension) +
+			StructuredLogsExtension,
+	)
+
+	return &structuredLogWriter{
+		parse:         parse,
+		filesDir:      filesDir,
+		path:          *path,
+		counts:        make(map[logparse.Level]int64),
+		logger:        logger,
+		uploaderOrNil: uploaderOrNil,
+	}
+}
+
+// FinalizeLine parses a line that will no longer change.
+func (w *structuredLogWriter) FinalizeLine(lineNum int, line *RunLogsLine) {
+	entry, ok := w.parse(string(line.Content))
+	if !ok || entry.Level == logparse.LevelUnknown {
+		return
+	}
+
+	w.counts[entry.Level]++
+
+	if w.broken {
+		return
+	}
+
+	timestamp := line.Timestamp
+	if !entry.Time.IsZero() {
+		timestamp = entry.Time
+	}
+
+	data, err := json.Marshal(structuredLogLine{
+		Line:    lineNum,
+		TS:      strings.TrimSuffix(timestamp.UTC().Format(rfc3339Micro), "Z"),
+		Level:   entry.Level.String(),
+		Logger:  entry.Logger,
+		Message: entry.Message,
+		Stderr:  line.StreamPrefix != "",
+		Label:   line.StreamLabel,
+	})
+	if err == nil {
+		err = w.write(data)
+	}
+
+	if err != nil {
+		w.broken = true
+		w.logger.CaptureError(
+			"runconsolelogs",
+			fmt.Errorf("runconsolelogs: failed to write structured logs: %v", err),
+		)
+	}
+}
+
+// write appends a line to the file, creating it if necessary.
+func (w *structuredLogWriter) write(data []byte) error {
+	if w.file == nil {
+		file, err := os.Create(filepath.Join(w.filesDir, string(w.path)))
+		if err != nil {
+			return err
+		}
+
+		w.file = file
+		w.buffer = bufio.NewWriter(file)
+	}
+
+	if _, err := w.buffer.Write(data); err != nil {
+		return err
+	}
+	return w.buffer.WriteByte('\n')
+}
+
+// Counts returns the number of lines seen at each level.
+func (w *structuredLogWriter) Counts() map[logparse.Level]int64 {
+	return w.counts
+}
+
+// Finish closes and uploads the file, if any lines were saved.
+func (w *structuredLogWriter) Finish() {
+	if w.file == nil {
+		return
+	}
+
+	err := w.buffer.Flush()
+	if closeErr := w.file.Close(); err == nil {
+		err = closeErr
+	}
+	w.file = nil
+
+	if err != nil {
+		w.logger.CaptureError(
+			"runconsolelogs",
+			fmt.Errorf("runconsolelogs: failed to save structured logs: %v", err),
+		)
+		return
+	}
+
+	if w.uploaderOrNil != nil {
+		w.uploaderOrNil.UploadNow(w.path, filetransfer.RunFileKindWandb)
+	}
+}
@@ -170,6 +170,75 @@ func TestUploader(t *testing.T) {
 				fakeFileWatcher.IsWatching(filepath.Join(filesDir, "test.txt")))
 		})
 
+	runTest("Process with 'live' glob uploads matching files and watches tree",
+		func() {},
+		func(t *testing.T) {
+			stubCreateRunFilesOneFile(mockGQLClient, "ckpt/model.ckpt")
+			writeEmptyFile(t, filepath.Join(filesDir, "ckpt", "model.ckpt"))
+			writeEmptyFile(t, filepath.Join(filesDir, "ckpt", "notes.txt"))
+
+			uploader.Process(&spb.FilesRecord{
+				Files: []*spb.FilesItem{
+					{Path: "ckpt/**/*.ckpt", Policy: spb.FilesItem_LIVE},
+				},
+			})
+			uploader.Finish()
+
+			patterns, ok := fakeFileWatcher.TreePatterns(
+				filepath.Join(filesDir, "ckpt"))
+			assert.True(t, ok)
+			assert.Equal(t, []string{"**/*.ckpt"}, patterns)
+			require.Len(t, fakeFileTransfer.Tasks(), 1)
+			task, ok := fakeFileTransfer.Tasks()[0].(*filetransfer.DefaultUploadTask)
+			require.True(t, ok)
+			assert.Equal(t, "ckpt/model.ckpt", task.Name)
+		})
+
+	runTest("Process with 'live' policy watches file with glob characters",
+		func() {},
+		func(t *testing.T) {
+			stubCreateRunFilesOneFile(mockGQLClient, "files/myfile[1].txt")
+			writeEmptyFile(t, filepath.Join(filesDir, "files", "myfile[1].txt"))
+
+			uploader.Process(&spb.FilesRecord{
+				Files: []*spb.FilesItem{
+					{Path: "files/myfile[1].txt", Policy: spb.FilesItem_LIVE},
+				},
+			})
+			uploader.Finish()
+
+			assert.True(t, fakeFileWatcher.IsWatching(
+				filepath.Join(filesDir, "files", "myfile[1].txt")))
+			_, ok := fakeFileWatcher.TreePatterns(filepath.Join(filesDir, "files"))
+			assert.False(t, ok)
+			require.Len(t, fakeFileTransfer.Tasks(), 1)
+			task, ok := fakeFileTransfer.Tasks()[0].(*filetransfer.DefaultUploadTask)
+			require.True(t, ok)
+			assert.Equal(t, "files/myfile[1].txt", task.Name)
+		})
+
+	runTest("Process with 'live' directory uploads new files",
+		func() {},
+		func(t *testing.T) {
+			require.NoError(t, os.MkdirAll(filepath.Join(filesDir, "ckpt"), 0o755))
+			uploader.Process(&spb.FilesRecord{
+				Files: []*spb.FilesItem{
+					{Path: "ckpt", Policy: spb.FilesItem_LIVE},
+				},
+			})
+
+			stubCreateRunFilesOneFile(mockGQLClient, "ckpt/sub/new.txt")
+			newFile := filepath.Join(filesDir, "ckpt", "sub", "new.txt")
+			writeEmptyFile(t, newFile)
+			fakeFileWatcher.OnChange(newFile)
+			uploader.Finish()
+
+			require.Len(t, fakeFileTransfer.Tasks(), 1)
+			task, ok := fakeFileTransfer.Tasks()[0].(*filetransfer.DefaultUploadTask)
+			require.True(t, ok)
+			assert.Equal(t, "ckpt/sub/new.txt", task.Name)
+		})
+
 	runTest("Process sets file category",
 		func() {},
 		func(t *testing.T) {
@@ -3,9 +3,11 @@ package runfiles
 import (
 	"errors"
 	"fmt"
+	"io/fs"
 	"maps"
 	"os"
 	"path/filepath"
+	"slices"
 	"strings"
 	"sync"
 	"time"
@@ -140,6 +142,16 @@ func (u *uploader) Process(record *spb.FilesRecord) {
 			strings.HasPrefix(file.GetPath(), "media") {
 			category = filetransfer.RunFileKindMedia
 		}
+
+		// Live directories and globs are watched for new and changed files.
+		if file.GetPolicy() == spb.FilesItem_LIVE {
+			if root, patterns, isTree := u.liveTree(runPath); isTree {
+				nowFiles = append(nowFiles,
+					u.watchLiveTree(root, patterns, category)...)
+				continue
+			}
+		}
+
 		u.knownFile(runPath).SetCategory(category)
 
 		switch file.GetPolicy() {
@@ -172,6 +184,119 @@ func (u *uploader) Process(record *spb.FilesRecord) {
 	u.uploadBatcher.Add(nowFiles)
 }
 
+// liveTree returns the directory to watch recursively for a 'live' path
+// that is a directory or a glob, and the glob's pattern relative to it.
+//
+// A path that exists is never treated as a glob, so that files with
+// characters like '[' in their names are uploaded as they are.
+//
+// Returns false if the path is a plain file.
+func (u *uploader) liveTree(
+	runPath paths.RelativePath,
+) (root paths.RelativePath, patterns []string, isTree bool) {
+	info, err := os.Stat(u.toRealPath(string(runPath)))
+	switch {
+	case err == nil && info.IsDir():
+		return runPath, nil, true
+	case err == nil:
+		return "", nil, false
+	}
+
+	components := strings.Split(filepath.ToSlash(string(runPath)), "/")
+	for i, component := range components {
+		if !strings.ContainsAny(component, "*?[") {
+			continue
+		}
+
+		return paths.RelativePath(filepath.Join(components[:i]...)),
+			[]string{strings.Join(components[i:], "/")},
+			true
+	}
+
+	return "", nil, false
+}
+
+// watchLiveTree watches a directory for files to upload and returns the
+// files already in it.
+//
+// All files in the tree matching the patterns are uploaded immediately,
+// whenever they change, and at the end of the run.
+//
+// The mutex must be held.
+func (u *uploader) watchLiveTree(
+	root paths.RelativePath,
+	patterns []string,
+	category filetransfer.RunFileKind,
+) []paths.RelativePath {
+	realRoot := u.toRealPath(string(root))
+
+	err := u.watcher.WatchTree(realRoot, patterns, func(realPath string) {
+		u.onLiveFileChanged(realPath, category)
+	})
+	if err != nil {
+		u.logger.CaptureError(
+			"runfiles",
+			fmt.Errorf("runfiles: error watching directory: %v", err),
+			"path", string(root),
+		)
+		return nil
+	}
+
+	var existing []paths.RelativePath
+	_ = filepath.WalkDir(realRoot, func(path string, d fs.DirEntry, err error) error {
+		if err != nil || d.IsDir() {
+			return nil
+		}
+
+		rel, err := filepath.Rel(realRoot, path)
+		if err != nil {
+			return nil
+		}
+		if len(patterns) > 0 &&
+			!slices.ContainsFunc(patterns, func(pattern string) bool {
+				return watcher.MatchGlob(pattern, filepath.ToSlash(rel))
+			}) {
+			return nil
+		}
+
+		runPath := paths.RelativePath(filepath.Join(string(root), rel))
+		u.knownFile(runPath).SetCategory(category)
+		u.uploadAtEnd[runPath] = struct{}{}
+		existing = append(existing, runPath)
+		return nil
+	})
+
+	return existing
+}
+
+// onLiveFileChanged schedules an upload for a file in a live tree.
+func (u *uploader) onLiveFileChanged(
+	realPath string,
+	category filetransfer.RunFileKind,
+) {
+	rel, err := filepath.Rel(u.settings.GetFilesDir(), realPath)
+	if err != nil {
+		u.logger.CaptureError(
+			"runfiles",
+			fmt.Errorf("runfiles: live file outside files directory: %v", err),
+			"path", realPath,
+		)
+		return
+	}
+	runPath := paths.RelativePath(rel)
+
+	// Changes after Finish() are expected and can be ignored, since
+	// live files are uploaded at the end of the run.
+	if u.lockForOperation("onLiveFileChanged") != nil {
+		return
+	}
+	defer u.stateMu.Unlock()
+
+	u.knownFile(runPath).SetCategory(category)
+	u.uploadAtEnd[runPath] = struct{}{}
+	u.uploadBatcher.Add([]paths.RelativePath{runPath})
+}
+
 // toRealPath takes a path relative to the run's files directory and returns
 // either an absolute path to that file or a path that's relative to the
 // current working directory.
@@ -0,0 +1,367 @@
+// Package runfooter formats the run summary printed to the terminal
+// when a run finishes.
+package runfooter
+
+import (
+	"fmt"
+	"math"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/wandb/wandb/core/internal/runsummary"
+	spb "github.com/wandb/wandb/core/pkg/service_go_proto"
+)
+
+// sparklineWidth is the maximum number of characters in a sparkline.
+const sparklineWidth = 40
+
+// maxStringLength is the number of characters of a string summary value
+// to print before truncating it.
+const maxStringLength = 20
+
+// Params are the data to show in the footer.
+type Params struct {
+	// History is a sample of each metric's history values.
+	History []*spb.SampledHistoryItem
+
+	// Summary is the statistics of each top-level summary metric.
+	Summary []runsummary.MetricStats
+
+	// BestSummaryType returns Min or Max if the metric has a goal.
+	//
+	// If nil, no best values are shown.
+	BestSummaryType func(key string) runsummary.SummaryTypeFlags
+
+	// FileCounts and FileStats describe the run's uploaded files.
+	//
+	// If FileCounts is nil, no upload stats are shown.
+	FileCounts *spb.FileCounts
+	FileStats  *spb.FilePusherStats
+
+	// MaxHistoryMetrics and MaxSummaryMetrics are the maximum number of
+	// metrics to list in each section.
+	MaxHistoryMetrics int
+	MaxSummaryMetrics int
+}
+
+// Lines returns the footer's lines of text.
+//
+// Metrics whose names start with an underscore are omitted. Sections with
+// nothing to show are skipped, so the result may be empty.
+func Lines(params Params) []string {
+	var lines []string
+
+	if historyLines := historySection(params); len(historyLines) > 0 {
+		lines = append(lines, "Run history:")
+		lines = append(lines, historyLines...)
+	}
+
+	if summaryLines := summarySection(params); len(summaryLines) > 0 {
+		if len(lines) > 0 {
+			lines = append(lines, "")
+		}
+		lines = append(lines, "Run summary:")
+		lines = append(lines, summaryLines...)
+	}
+
+	if params.FileCounts != nil {
+		if len(lines) > 0 {
+			lines = append(lines, "")
+		}
+		lines = append(lines, uploadStats(params.FileCounts, params.FileStats))
+	}
+
+	return lines
+}
+
+// historySection returns a sparkline for each sampled metric.
+func historySection(params Params) []string {
+	items := slices.DeleteFunc(
+		slices.Clone(params.History),
+		func(item *spb.SampledHistoryItem) bool {
+			return strings.HasPrefix(item.GetKey(), "_")
+		})
+	slices.SortFunc(items, func(a, b *spb.SampledHistoryItem) int {
+		return strings.Compare(a.GetKey(), b.GetKey())
+	})
+
+	var rows [][]string
+	for _, item := range items {
+		if len(rows) >= params.MaxHistoryMetrics {
+			break
+		}
+
+		values := item.GetValuesFloat()
+		if len(values) == 0 {
+			for _, value := range item.GetValuesInt() {
+				values = append(values, float32(value))
+			}
+		}
+
+		if line := Sparkline(downsample(values, sparklineWidth)); line != "" {
+			rows = append(rows, []string{item.GetKey(), line})
+		}
+	}
+
+	if len(rows) > 0 && len(rows) < len(items) {
+		rows = append(rows, []string{fmt.Sprintf("+%d", len(items)-len(rows)), "..."})
+	}
+
+	return grid(rows)
+}
+
+// summarySection returns the final value of each summary metric and
+// any statistics tracked for it.
+func summarySection(params Params) []string {
+	var rows [][]string
+	total := 0
+
+	for _, stats := range params.Summary {
+		if strings.HasPrefix(stats.Key, "_") {
+			continue
+		}
+
+		value, ok := formatValue(stats.Latest)
+		if !ok {
+			continue
+		}
+
+		total++
+		if len(rows) >= params.MaxSummaryMetrics {
+			continue
+		}
+
+		best := runsummary.Unset
+		if params.BestSummaryType != nil {
+			best = params.BestSummaryType(stats.Key)
+		}
+
+		rows = append(rows, []string{
+			stats.Key,
+			value,
+			formatStats(stats, best),
+		})
+	}
+
+	if len(rows) > 0 && len(rows) < total {
+		rows = append(rows, []string{fmt.Sprintf("+%d", total-len(rows)), "..."})
+	}
+
+	return grid(rows)
+}
+
+// formatStats describes the statistics to show for a metric besides
+// its final value.
+//
+// If the metric has summary types, only those are shown. Otherwise,
+// its minimum and maximum are shown if it was logged more than once.
+// The best value is marked if the metric has a goal.
+func formatStats(
+	stats runsummary.MetricStats,
+	best runsummary.SummaryTypeFlags,
+) string {
+	if stats.Count == 0 || stats.Track.IsEmpty() && stats.Count < 2 {
+		return ""
+	}
+
+	type stat struct {
+		flag  runsummary.SummaryTypeFlags
+		label string
+		value float64
+	}
+	allStats := []stat{
+		{runsummary.Min, "min", stats.Min},
+		{runsummary.Max, "max", stats.Max},
+		{runsummary.Mean, "mean", stats.Mean},
+	}
+
+	track := stats.Track
+	if track.IsEmpty() {
+		track = runsummary.Min | runsummary.Max
+	}
+
+	var parts []string
+	shownBest := false
+	for _, s := range allStats {
+		if !track.HasAny(s.flag) {
+			continue
+		}
+
+		part := fmt.Sprintf("%s %s", s.label, formatNumber(s.value))
+		if best.HasAny(s.flag) {
+			part += " (best)"
+			shownBest = true
+		}
+		parts = append(parts, part)
+	}
+
+	switch {
+	case shownBest:
+	case best.HasAny(runsummary.Min):
+		parts = append(parts, "best "+formatNumber(stats.Min))
+	case best.HasAny(runsummary.Max):
+		parts = append(parts, "best "+formatNumber(stats.Max))
+	}
+
+	return strings.Join(parts, ", ")
+}
+
+// uploadStats describes the files uploaded by the run.
+func uploadStats(counts *spb.FileCounts, stats *spb.FilePusherStats) string {
+	line := fmt.Sprintf(
+		"Synced %d W&B file(s), %d media file(s), %d artifact file(s)"+
+			" and %d other file(s)",
+		counts.GetWandbCount(),
+		counts.GetMediaCount(),
+		counts.GetArtifactCount(),
+		counts.GetOtherCount(),
+	)
+
+	if uploaded := stats.GetUploadedBytes(); uploaded > 0 {
+		line += fmt.Sprintf(" (%s)", formatBytes(uploaded))
+	}
+
+	return line
+}
+
+// Sparkline draws the values using block characters of varying heights.
+//
+// Non-finite values are drawn as spaces. It returns an empty string if
+// there are no finite values.
+func Sparkline(values []float32) string {
+	const sparkChars = "▁▂▃▄▅▆▇█"
+	chars := []rune(sparkChars)
+
+	minValue := math.Inf(1)
+	maxValue := math.Inf(-1)
+	for _, value := range values {
+		if isFinite(value) {
+			minValue = min(minValue, float64(value))
+			maxValue = max(maxValue, float64(value))
+		}
+	}
+
+	if math.IsInf(minValue, 1) {
+		return ""
+	}
+
+	var sb strings.Builder
+	for _, value := range values {
+		switch {
+		case !isFinite(value):
+			sb.WriteRune(' ')
+		case maxValue == minValue:
+			sb.WriteRune(chars[0])
+		default:
+			scaled := (float64(value) - minValue) / (maxValue - minValue)
+			sb.WriteRune(chars[int(math.Round(scaled*float64(len(chars)-1)))])
+		}
+	}
+
+	return sb.String()
+}
+
+// downsample picks up to n evenly spaced values, including the first
+// and last ones.
+func downsample(values []float32, n int) []float32 {
+	if len(values) <= n {
+		return values
+	}
+
+	ratio := float64(len(values)-1) / float64(n-1)
+	result := make([]float32, n)
+	for i := range n {
+		result[i] = values[int(float64(i)*ratio)]
+	}
+
+	return result
+}
+
+// grid formats rows of columns, right-aligning the first column and
+// left-aligning the others.
+func grid(rows [][]string) []string {
+	widths := make([]int, 0)
+	for _, row := range rows {
+		for i, cell := range row {
+			if i >= len(widths) {
+				widths = append(widths, 0)
+			}
+			widths[i] = max(widths[i], len([]rune(cell)))
+		}
+	}
+
+	lines := make([]string, 0, len(rows))
+	for _, row := range rows {
+		var sb strings.Builder
+		for i, cell := range row {
+			padding := strings.Repeat(" ", widths[i]-len([]rune(cell)))
+			switch {
+			case i == 0:
+				sb.WriteString(padding + cell)
+			case i == len(row)-1:
+				sb.WriteString(" " + cell)
+			default:
+				sb.WriteString(" " + cell + padding)
+			}
+		}
+		lines = append(lines, strings.TrimRight(sb.String(), " "))
+	}
+
+	return lines
+}
+
+// formatValue formats a summary value for printing.
+//
+// It returns false for values that aren't numbers or strings.
+func formatValue(value any) (string, bool) {
+	switch x := value.(type) {
+	case int64:
+		return strconv.FormatInt(x, 10), true
+	case float64:
+		return formatNumber(x), true
+	case bool:
+		return strconv.FormatBool(x), true
+	case string:
+		if runes := []rune(x); len(runes) > maxStringLength {
+			return string(runes[:maxStringLength]) + "...", true
+		}
+		return x, true
+	default:
+		return "", false
+	}
+}
+
+// formatNumber formats a float rounded to 5 decimal places.
+//
+// Very large values use scientific notation.
+func formatNumber(value float64) string {
+	if math.Abs(value) < 1e15 {
+		return strconv.FormatFloat(math.Round(value*1e5)/1e5, 'f', -1, 64)
+	}
+
+	return strconv.FormatFloat(value, 'g', -1, 64)
+}
+
+// formatBytes formats a byte count using the largest fitting unit.
+func formatBytes(bytes int64) string {
+	const unit = 1024
+
+	if bytes < unit {
+		return fmt.Sprintf("%dB", bytes)
+	}
+
+	value := float64(bytes) / unit
+	for _, suffix := range []string{"KB", "MB", "GB"} {
+		if value < unit {
+			return fmt.Sprintf("%.1f%s", value, suffix)
+		}
+		value /= unit
+	}
+
+	return fmt.Sprintf("%.1fTB", value)
+}
+
+func isFinite(value float32) bool {
+	return !math.IsNaN(float64(value)) && !math.IsInf(float64(value), 0)
+}
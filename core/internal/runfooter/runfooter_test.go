@@ -0,0 +1,105 @@
+package runfooter_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/wandb/wandb/core/internal/runfooter"
+	"github.com/wandb/wandb/core/internal/runsummary"
+	spb "github.com/wandb/wandb/core/pkg/service_go_proto"
+)
+
+func TestSparkline(t *testing.T) {
+	assert.Equal(t,
+		"▁▁▂▄▅▇▇██▆▄▂",
+		runfooter.Sparkline([]float32{
+			0.5, 1.2, 3.5, 7.3, 8.0, 12.5, 13.2, 15.0, 14.2, 11.8, 6.1, 1.9,
+		}))
+	assert.Equal(t, "▁▁▁", runfooter.Sparkline([]float32{2, 2, 2}))
+	assert.Equal(t,
+		"▁ █",
+		runfooter.Sparkline([]float32{0, float32(math.NaN()), 1}))
+	assert.Empty(t, runfooter.Sparkline([]float32{float32(math.Inf(1))}))
+}
+
+func TestLines(t *testing.T) {
+	lines := runfooter.Lines(runfooter.Params{
+		History: []*spb.SampledHistoryItem{
+			{Key: "loss", ValuesFloat: []float32{3, 2, 1}},
+			{Key: "_step", ValuesFloat: []float32{1, 2, 3}},
+			{Key: "acc", ValuesFloat: []float32{0, 1}},
+		},
+		Summary: []runsummary.MetricStats{
+			{Key: "acc", Latest: 0.9, Min: 0.1, Max: 0.9, Count: 2},
+			{Key: "epoch", Latest: int64(3), Min: 3, Max: 3, Count: 1},
+			{Key: "loss", Latest: 1.0, Min: 1, Max: 3, Mean: 2, Count: 3,
+				Track: runsummary.Mean},
+			{Key: "name", Latest: "a very long string that is truncated"},
+			{Key: "nested", Latest: map[string]any{"a": 1}},
+			{Key: "title", Latest: "exactly twenty chars"},
+		},
+		BestSummaryType: func(key string) runsummary.SummaryTypeFlags {
+			if key == "loss" {
+				return runsummary.Min
+			}
+			return runsummary.Unset
+		},
+		FileCounts:        &spb.FileCounts{WandbCount: 3, OtherCount: 1},
+		FileStats:         &spb.FilePusherStats{UploadedBytes: 2048},
+		MaxHistoryMetrics: 10,
+		MaxSummaryMetrics: 10,
+	})
+
+	assert.Equal(t,
+		[]string{
+			"Run history:",
+			" acc ▁█",
+			"loss █▅▁",
+			"",
+			"Run summary:",
+			"  acc 0.9                     min 0.1, max 0.9",
+			"epoch 3",
+			" loss 1                       mean 2, best 1",
+			" name a very long string t...",
+			"title exactly twenty chars",
+			"",
+			"Synced 3 W&B file(s), 0 media file(s), 0 artifact file(s)" +
+				" and 1 other file(s) (2.0KB)",
+		},
+		lines)
+}
+
+func TestLines_LimitsMetrics(t *testing.T) {
+	lines := runfooter.Lines(runfooter.Params{
+		History: []*spb.SampledHistoryItem{
+			{Key: "a", ValuesFloat: []float32{1}},
+			{Key: "b", ValuesFloat: []float32{1}},
+			{Key: "c", ValuesFloat: []float32{1}},
+		},
+		Summary: []runsummary.MetricStats{
+			{Key: "a", Latest: 1.0},
+			{Key: "b", Latest: 2.0},
+		},
+		MaxHistoryMetrics: 2,
+		MaxSummaryMetrics: 1,
+	})
+
+	assert.Equal(t,
+		[]string{
+			"Run history:",
+			" a ▁",
+			" b ▁",
+			"+1 ...",
+			"",
+			"Run summary:",
+			" a 1",
+			"+1 ...",
+		},
+		lines)
+}
+
+func TestLines_Empty(t *testing.T) {
+	assert.Empty(t, runfooter.Lines(runfooter.Params{}))
+}
@@ -0,0 +1,168 @@
+package runhistoryreader
+
+import (
+	"context"
+	"sync"
+
+	"github.com/Khan/genqlient/graphql"
+
+	"github.com/wandb/wandb/core/internal/api"
+	"github.com/wandb/wandb/core/internal/runhistoryreader/parquet"
+	"github.com/wandb/wandb/core/internal/runhistoryreader/parquet/ffi"
+)
+
+const (
+	// maxConcurrentRunReads is the number of runs in a batch that are
+	// read at the same time.
+	maxConcurrentRunReads = 8
+
+	// maxConcurrentDownloads is the number of history files downloaded
+	// at the same time across all batches.
+	maxConcurrentDownloads = 16
+)
+
+// RunPath identifies a run.
+type RunPath struct {
+	Entity  string
+	Project string
+	RunID   string
+}
+
+// BatchRequest describes the history to read for a batch of runs.
+type BatchRequest struct {
+	// Runs is the runs to read.
+	Runs []RunPath
+
+	// Keys is the metrics to read. If empty, all metrics are read.
+	Keys []string
+
+	// MinStep and MaxStep are the range of steps to read,
+	// including MinStep and excluding MaxStep.
+	MinStep int64
+	MaxStep int64
+
+	// UseCache is whether to reuse previously downloaded history files.
+	UseCache bool
+
+	// Sampling, if set, downsamples each run's rows.
+	Sampling *Sampling
+}
+
+// RunHistory is the result of reading a single run in a batch.
+type RunHistory struct {
+	Run RunPath
+
+	// Rows is the run's history rows sorted by step.
+	Rows []parquet.KeyValueList
+
+	// Err is set if the run's history could not be read.
+	Err error
+}
+
+// BatchReader reads the history of many runs concurrently.
+//
+// All batches read by a BatchReader share a limit on the number of history
+// files being downloaded, so that concurrent batches don't multiply the
+// load on the network.
+type BatchReader struct {
+	graphqlClient    graphql.Client
+	httpClient       api.RetryableClient
+	rustArrowWrapper *ffi.RustArrowWrapper
+
+	// downloadLimiter is shared by the HistoryReaders of all batches.
+	downloadLimiter chan struct{}
+
+	// maxConcurrentRuns is the number of runs in a batch read at once.
+	maxConcurrentRuns int
+}
+
+// NewBatchReader returns a new BatchReader.
+func NewBatchReader(
+	graphqlClient graphql.Client,
+	httpClient api.RetryableClient,
+	rustArrowWrapper *ffi.RustArrowWrapper,
+) *BatchReader {
+	return &BatchReader{
+		graphqlClient:     graphqlClient,
+		httpClient:        httpClient,
+		rustArrowWrapper:  rustArrowWrapper,
+		downloadLimiter:   make(chan struct{}, maxConcurrentDownloads),
+		maxConcurrentRuns: maxConcurrentRunReads,
+	}
+}
+
+// Read starts reading the history of the requested runs.
+//
+// The returned channel receives each run's history as soon as it is read,
+// in no particular order, and is closed after every run is done.
+// It is buffered to hold all results, so it need not be drained.
+//
+// Cancelling the context stops reads that haven't finished; those runs are
+// reported with the context's error.
+func (b *BatchReader) Read(
+	ctx context.Context,
+	request BatchRequest,
+) <-chan RunHistory {
+	results := make(chan RunHistory, len(request.Runs))
+	runLimiter := make(chan struct{}, b.maxConcurrentRuns)
+
+	var wg sync.WaitGroup
+	for _, run := range request.Runs {
+		wg.Go(func() {
+			select {
+			case runLimiter <- struct{}{}:
+			case <-ctx.Done():
+				results <- RunHistory{Run: run, Err: ctx.Err()}
+				return
+			}
+			defer func() { <-runLimiter }()
+
+			rows, err := b.readRun(ctx, run, request)
+			results <- RunHistory{Run: run, Rows: rows, Err: err}
+		})
+	}
+
+	go func() {
+		wg.Wait()
+		close(results)
+	}()
+
+	return results
+}
+
+// readRun reads the requested history of a single run.
+func (b *BatchReader) readRun(
+	ctx context.Context,
+	run RunPath,
+	request BatchRequest,
+) ([]parquet.KeyValueList, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	reader, err := newHistoryReader(
+		ctx,
+		run,
+		b.graphqlClient,
+		b.httpClient,
+		request.Keys,
+		request.UseCache,
+		b.rustArrowWrapper,
+		b.downloadLimiter,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer reader.Release()
+
+	if request.Sampling != nil {
+		return reader.GetSampledHistorySteps(
+			ctx,
+			request.MinStep,
+			request.MaxStep,
+			*request.Sampling,
+		)
+	}
+
+	return reader.GetHistorySteps(ctx, request.MinStep, request.MaxStep)
+}
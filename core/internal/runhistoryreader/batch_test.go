@@ -0,0 +1,105 @@
+// These tests use the mock Rust FFI from reader_test.go, which is excluded
+// when building with -race.
+
+//go:build !race
+
+package runhistoryreader
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/hashicorp/go-retryablehttp"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/wandb/wandb/core/internal/gqlmock"
+	"github.com/wandb/wandb/core/internal/runhistoryreader/parquet"
+)
+
+func TestBatchReader_Read(t *testing.T) {
+	ctx := t.Context()
+	t.Setenv("WANDB_CACHE_DIR", t.TempDir())
+
+	columns := []columnDef{
+		{name: "_step", colType: "int64"},
+		{name: "metric1", colType: "float64"},
+	}
+	data := []map[string]any{
+		{"_step": int64(0), "metric1": 1.0},
+		{"_step": int64(1), "metric1": 2.0},
+		{"_step": int64(2), "metric1": 3.0},
+	}
+
+	server := createHttpServer(t, respondWithContent(t, createDummyFileContent()))
+	mockGQL := gqlmock.NewMockClient()
+	mockGQL.StubMatchOnce(
+		gqlmock.WithVariables(gqlmock.GQLVar("runName", gomock.Eq("run-a"))),
+		`{
+			"project": {
+				"run": {
+					"parquetHistory": {
+						"parquetUrls": ["`+server.URL+`/a.parquet"]
+					}
+				}
+			}
+		}`,
+	)
+	mockGQL.StubMatchWithError(
+		gqlmock.WithVariables(gqlmock.GQLVar("runName", gomock.Eq("run-b"))),
+		errors.New("test error"),
+	)
+	rustWrapper := createMockRustArrowWrapper(
+		t,
+		columns,
+		map[uintptr][]map[string]any{1: data},
+	)
+
+	batchReader := NewBatchReader(mockGQL, retryablehttp.NewClient(), rustWrapper)
+	// The mock FFI is not safe for concurrent use.
+	batchReader.maxConcurrentRuns = 1
+
+	runA := RunPath{Entity: "test-entity", Project: "test-project", RunID: "run-a"}
+	runB := RunPath{Entity: "test-entity", Project: "test-project", RunID: "run-b"}
+	results := batchReader.Read(ctx, BatchRequest{
+		Runs:     []RunPath{runA, runB},
+		MinStep:  0,
+		MaxStep:  10,
+		Sampling: &Sampling{TargetPoints: 2, Aggregation: EveryNth},
+	})
+
+	byRun := make(map[RunPath]RunHistory)
+	for result := range results {
+		byRun[result.Run] = result
+	}
+	assert.Len(t, byRun, 2)
+	assert.NoError(t, byRun[runA].Err)
+	assert.Equal(t,
+		[]parquet.KeyValueList{
+			{{Key: "_step", Value: int64(0)}, {Key: "metric1", Value: 1.0}},
+			{{Key: "_step", Value: int64(2)}, {Key: "metric1", Value: 3.0}},
+		},
+		byRun[runA].Rows)
+	assert.ErrorContains(t, byRun[runB].Err, "test error")
+}
+
+func TestBatchReader_Read_Cancelled(t *testing.T) {
+	ctx, cancel := context.WithCancel(t.Context())
+	cancel()
+
+	batchReader := NewBatchReader(
+		gqlmock.NewMockClient(),
+		retryablehttp.NewClient(),
+		createMockRustArrowWrapper(t, nil, nil),
+	)
+	run := RunPath{Entity: "test-entity", Project: "test-project", RunID: "run-a"}
+
+	results := batchReader.Read(ctx, BatchRequest{Runs: []RunPath{run}})
+
+	result := <-results
+	assert.Equal(t, run, result.Run)
+	assert.ErrorIs(t, result.Err, context.Canceled)
+	_, open := <-results
+	assert.False(t, open)
+}
@@ -9,3 +9,7 @@ func openLibrary(path string) (uintptr, error) {
 	}
 	return uintptr(dll.Handle), nil
 }
+
+func findSymbol(lib uintptr, name string) (uintptr, error) {
+	return syscall.GetProcAddress(syscall.Handle(lib), name)
+}
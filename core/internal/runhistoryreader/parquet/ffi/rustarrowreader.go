@@ -1,6 +1,7 @@
 package ffi
 
 import (
+	"bytes"
 	"context"
 	"encoding/binary"
 	"encoding/json"
@@ -54,6 +55,16 @@ type RustArrowWrapper struct {
 		outResult *StepScanResult,
 	) *byte
 
+	// scanStepRangeIPC is like scanStepRange, but outputs an Arrow IPC stream.
+	//
+	// It is nil if the library predates the function.
+	scanStepRangeIPC func(
+		readerPtr unsafe.Pointer,
+		minStep int64,
+		maxStep int64,
+		outResult *StepScanResult,
+	) *byte
+
 	// freeString is a Rust FFI to free a string allocated by Rust.
 	freeString func(s *byte)
 	// freeBuffer is a Rust FFI to free a byte buffer allocated by Rust.
@@ -112,15 +123,33 @@ func NewRustArrowWrapper() (*RustArrowWrapper, error) {
 	var freeReader func(readerPtr unsafe.Pointer)
 	purego.RegisterLibFunc(&freeReader, rustLib, "free_reader")
 
+	// Registering a missing function panics, so check for it first
+	// in case an older library is installed.
+	var scanStepRangeIPC func(
+		readerPtr unsafe.Pointer,
+		minStep int64,
+		maxStep int64,
+		outResult *StepScanResult,
+	) *byte
+	if sym, err := findSymbol(rustLib, "reader_scan_step_range_ipc"); err == nil && sym != 0 {
+		purego.RegisterFunc(&scanStepRangeIPC, sym)
+	}
+
 	return &RustArrowWrapper{
-		createReader:  createReader,
-		freeString:    freeString,
-		freeBuffer:    freeBuffer,
-		freeReader:    freeReader,
-		scanStepRange: scanStepRange,
+		createReader:     createReader,
+		freeString:       freeString,
+		freeBuffer:       freeBuffer,
+		freeReader:       freeReader,
+		scanStepRange:    scanStepRange,
+		scanStepRangeIPC: scanStepRangeIPC,
 	}, nil
 }
 
+// SupportsArrowIPC reports whether the library can return Arrow IPC streams.
+func (w *RustArrowWrapper) SupportsArrowIPC() bool {
+	return w.scanStepRangeIPC != nil
+}
+
 func RustArrowWrapperTester(
 	createReader func(
 		filePath *byte,
@@ -144,6 +173,33 @@ func RustArrowWrapperTester(
 	}
 }
 
+// RustArrowWrapperTesterWithIPC is like RustArrowWrapperTester,
+// with a function to fake Arrow IPC scans.
+func RustArrowWrapperTesterWithIPC(
+	createReader func(
+		filePath *byte,
+		columnNames **byte,
+		numColumns int,
+		outError **byte,
+	) unsafe.Pointer,
+	scanStepRange func(
+		readerPtr unsafe.Pointer,
+		minStep int64,
+		maxStep int64,
+		outResult *StepScanResult,
+	) *byte,
+	scanStepRangeIPC func(
+		readerPtr unsafe.Pointer,
+		minStep int64,
+		maxStep int64,
+		outResult *StepScanResult,
+	) *byte,
+) *RustArrowWrapper {
+	wrapper := RustArrowWrapperTester(createReader, scanStepRange)
+	wrapper.scanStepRangeIPC = scanStepRangeIPC
+	return wrapper
+}
+
 // CreateRustArrowReader creates a new RustArrowReader for a given list of file paths.
 // Only the columns names provided will be read from the parquet files.
 func CreateRustArrowReader(
@@ -194,12 +250,71 @@ func (r *RustArrowReader) ScanStepRange(
 	minStep int64,
 	maxStep int64,
 ) ([]parquet.KeyValueList, error) {
+	results := []parquet.KeyValueList{}
+	err := r.scan(ctx, r.rustArrowWrapper.scanStepRange, minStep, maxStep,
+		func(bufferBytes []byte) error {
+			var err error
+			results, err = parseScanResultData(bufferBytes)
+			if err != nil {
+				return fmt.Errorf("failed to decode binary data: %w", err)
+			}
+			return nil
+		})
+	if err != nil {
+		return nil, err
+	}
+
+	return results, nil
+}
+
+// ScanStepRangeIPC reads the rows between minStep and maxStep as an
+// Arrow IPC stream, in the file's own schema.
+//
+// It returns nil if there are no rows in the range, and an error if the
+// library doesn't support Arrow IPC.
+func (r *RustArrowReader) ScanStepRangeIPC(
+	ctx context.Context,
+	minStep int64,
+	maxStep int64,
+) ([]byte, error) {
+	if !r.rustArrowWrapper.SupportsArrowIPC() {
+		return nil, errors.New("the parquet reader library does not support Arrow IPC")
+	}
+
+	var stream []byte
+	err := r.scan(ctx, r.rustArrowWrapper.scanStepRangeIPC, minStep, maxStep,
+		func(bufferBytes []byte) error {
+			// The buffer is freed after this returns.
+			stream = bytes.Clone(bufferBytes)
+			return nil
+		})
+	if err != nil {
+		return nil, err
+	}
+
+	return stream, nil
+}
+
+// scan calls a Rust scan function and passes the serialized result,
+// if there are any rows, to decode before the buffer is freed.
+func (r *RustArrowReader) scan(
+	ctx context.Context,
+	scanFunc func(
+		readerPtr unsafe.Pointer,
+		minStep int64,
+		maxStep int64,
+		outResult *StepScanResult,
+	) *byte,
+	minStep int64,
+	maxStep int64,
+	decode func(bufferBytes []byte) error,
+) error {
 	if ctx.Err() != nil {
-		return nil, ctx.Err()
+		return ctx.Err()
 	}
 
 	var scanResult StepScanResult
-	errCStr := r.rustArrowWrapper.scanStepRange(
+	errCStr := scanFunc(
 		r.reader,
 		minStep,
 		maxStep,
@@ -210,10 +325,10 @@ func (r *RustArrowReader) ScanStepRange(
 	if errCStr != nil {
 		errMsg := decodeRustError(errCStr)
 		r.rustArrowWrapper.freeString(errCStr)
-		return nil, fmt.Errorf("error scanning step range: %s", errMsg)
+		return fmt.Errorf("error scanning step range: %s", errMsg)
 	}
 	if scanResult.NumRowsReturned == 0 || scanResult.DataLen == 0 {
-		return []parquet.KeyValueList{}, nil
+		return nil
 	}
 
 	// The data pointers come from Rust FFI,
@@ -236,12 +351,7 @@ func (r *RustArrowReader) ScanStepRange(
 		r.rustArrowWrapper.freeBuffer(unsafe.Pointer(vecPtr))
 	}()
 
-	results, err := parseScanResultData(bufferBytes)
-	if err != nil {
-		return nil, fmt.Errorf("failed to decode binary data: %w", err)
-	}
-
-	return results, nil
+	return decode(bufferBytes)
 }
 
 // Release frees the resources allocated by the RustReader.
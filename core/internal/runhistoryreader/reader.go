@@ -1,6 +1,7 @@
 package runhistoryreader
 
 import (
+	"cmp"
 	"context"
 	"fmt"
 	"math"
@@ -10,6 +11,7 @@ import (
 	"sync"
 
 	"github.com/Khan/genqlient/graphql"
+	"golang.org/x/sync/errgroup"
 
 	"github.com/wandb/wandb/core/internal/api"
 	"github.com/wandb/wandb/core/internal/runhistoryreader/parquet"
@@ -47,6 +49,11 @@ type HistoryReader struct {
 
 	// parquetReaders is the readers for the history files.
 	parquetReaders []*ffi.RustArrowReader
+
+	// downloadLimiter bounds the number of history files downloaded at once.
+	//
+	// It may be shared with other readers.
+	downloadLimiter chan struct{}
 }
 
 // New returns a new HistoryReader.
@@ -60,18 +67,43 @@ func New(
 	keys []string,
 	useCache bool,
 	rustArrowWrapper *ffi.RustArrowWrapper,
+) (*HistoryReader, error) {
+	return newHistoryReader(
+		ctx,
+		RunPath{Entity: entity, Project: project, RunID: runId},
+		graphqlClient,
+		httpClient,
+		keys,
+		useCache,
+		rustArrowWrapper,
+		make(chan struct{}, 1),
+	)
+}
+
+// newHistoryReader returns a new HistoryReader that downloads history files
+// concurrently, up to the capacity of downloadLimiter.
+func newHistoryReader(
+	ctx context.Context,
+	run RunPath,
+	graphqlClient graphql.Client,
+	httpClient api.RetryableClient,
+	keys []string,
+	useCache bool,
+	rustArrowWrapper *ffi.RustArrowWrapper,
+	downloadLimiter chan struct{},
 ) (*HistoryReader, error) {
 	if len(keys) > 0 && !slices.Contains(keys, "_step") {
 		keys = append([]string{"_step"}, keys...)
 	}
 
 	historyReader := &HistoryReader{
-		entity:        entity,
-		graphqlClient: graphqlClient,
-		httpClient:    httpClient,
-		project:       project,
-		runId:         runId,
-		keys:          keys,
+		entity:          run.Entity,
+		graphqlClient:   graphqlClient,
+		httpClient:      httpClient,
+		project:         run.Project,
+		runId:           run.RunID,
+		keys:            keys,
+		downloadLimiter: downloadLimiter,
 
 		minLiveStep: math.MaxInt64,
 	}
@@ -121,20 +153,13 @@ func (h *HistoryReader) GetHistorySteps(
 	defer h.mu.Unlock()
 
 	results := []parquet.KeyValueList{}
-	for _, reader := range h.parquetReaders {
-		resultsForPartition, err := reader.ScanStepRange(ctx, minStep, maxStep)
-		if err != nil {
-			return nil, err
-		}
-		results = append(results, resultsForPartition...)
-	}
-
-	selectAllColumns := len(h.keys) == 0
-	livehistory, err := h.getLiveData(ctx, minStep, maxStep, selectAllColumns)
+	err := h.scanHistorySteps(ctx, minStep, maxStep,
+		func(rows []parquet.KeyValueList) {
+			results = append(results, rows...)
+		})
 	if err != nil {
 		return nil, err
 	}
-	results = append(results, livehistory...)
 
 	steps := make([]int64, len(results))
 	for i, row := range results {
@@ -157,6 +182,114 @@ func (h *HistoryReader) GetHistorySteps(
 	return sorted, nil
 }
 
+// GetSampledHistorySteps is like GetHistorySteps, but downsamples the rows
+// between minStep and maxStep according to sampling.
+//
+// Rows are aggregated as each parquet partition and the live data are
+// scanned, rather than after loading all of them. The result is sorted
+// by step.
+//
+// It is safe to call from multiple goroutines.
+func (h *HistoryReader) GetSampledHistorySteps(
+	ctx context.Context,
+	minStep int64,
+	maxStep int64,
+	sampling Sampling,
+) ([]parquet.KeyValueList, error) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	sampler := newSampler(sampling, minStep, maxStep)
+	err := h.scanHistorySteps(ctx, minStep, maxStep,
+		func(rows []parquet.KeyValueList) {
+			for _, row := range rows {
+				sampler.Add(row)
+			}
+		})
+	if err != nil {
+		return nil, err
+	}
+
+	return sampler.Rows(), nil
+}
+
+// ArrowHistory is part of a run's history in Arrow IPC format.
+type ArrowHistory struct {
+	// Streams holds an Arrow IPC stream for each history file
+	// that has rows in the requested range.
+	//
+	// Each stream uses its file's schema, which may differ between files.
+	Streams [][]byte
+
+	// LiveRows holds rows that haven't been exported to history files yet,
+	// sorted by step.
+	LiveRows []parquet.KeyValueList
+}
+
+// GetHistoryStepsArrow is like GetHistorySteps, but returns the rows read
+// from history files as Arrow IPC streams instead of converting them.
+//
+// It is safe to call from multiple goroutines.
+func (h *HistoryReader) GetHistoryStepsArrow(
+	ctx context.Context,
+	minStep int64,
+	maxStep int64,
+) (*ArrowHistory, error) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	history := &ArrowHistory{}
+	for _, reader := range h.parquetReaders {
+		stream, err := reader.ScanStepRangeIPC(ctx, minStep, maxStep)
+		if err != nil {
+			return nil, err
+		}
+		if stream != nil {
+			history.Streams = append(history.Streams, stream)
+		}
+	}
+
+	selectAllColumns := len(h.keys) == 0
+	liveHistory, err := h.getLiveData(ctx, minStep, maxStep, selectAllColumns)
+	if err != nil {
+		return nil, err
+	}
+	slices.SortStableFunc(liveHistory, func(a, b parquet.KeyValueList) int {
+		return cmp.Compare(a.StepValue(), b.StepValue())
+	})
+	history.LiveRows = liveHistory
+
+	return history, nil
+}
+
+// scanHistorySteps passes the rows between minStep and maxStep to visit,
+// one parquet partition at a time followed by the live data.
+//
+// Rows are not sorted. The mutex must be held.
+func (h *HistoryReader) scanHistorySteps(
+	ctx context.Context,
+	minStep int64,
+	maxStep int64,
+	visit func(rows []parquet.KeyValueList),
+) error {
+	for _, reader := range h.parquetReaders {
+		resultsForPartition, err := reader.ScanStepRange(ctx, minStep, maxStep)
+		if err != nil {
+			return err
+		}
+		visit(resultsForPartition)
+	}
+
+	selectAllColumns := len(h.keys) == 0
+	livehistory, err := h.getLiveData(ctx, minStep, maxStep, selectAllColumns)
+	if err != nil {
+		return err
+	}
+	visit(livehistory)
+
+	return nil
+}
+
 // Release calls the Release method on each partition's ParquetDataIterator
 // and frees any Rust resources.
 //
@@ -206,32 +339,47 @@ func (h *HistoryReader) getParquetFilePaths(
 		)
 	}
 
-	filePaths := make([]string, 0, len(signedUrls))
+	filePaths := make([]string, len(signedUrls))
+	downloads, downloadsCtx := errgroup.WithContext(ctx)
 	for i, url := range signedUrls {
 		fileName := fmt.Sprintf("%s_%s_%s_%d.runhistory.parquet", h.entity, h.project, h.runId, i)
 		parquetFilePath := filepath.Join(dir, fileName)
 
 		if _, err := os.Stat(parquetFilePath); useCache && err == nil {
-			filePaths = append(filePaths, parquetFilePath)
+			filePaths[i] = parquetFilePath
 		} else if len(h.keys) == 0 {
 			// When the user doesn't specify any keys,
 			// It is faster to download the entire parquet file
 			// and process it locally.
-			err = parquet.DownloadRunHistoryFile(
-				ctx,
-				h.httpClient,
-				url,
-				parquetFilePath,
-			)
-			if err != nil {
-				return nil, err
-			}
-			filePaths = append(filePaths, parquetFilePath)
+			downloads.Go(func() error {
+				select {
+				case h.downloadLimiter <- struct{}{}:
+				case <-downloadsCtx.Done():
+					return downloadsCtx.Err()
+				}
+				defer func() { <-h.downloadLimiter }()
+
+				err := parquet.DownloadRunHistoryFile(
+					downloadsCtx,
+					h.httpClient,
+					url,
+					parquetFilePath,
+				)
+				if err != nil {
+					return err
+				}
+				filePaths[i] = parquetFilePath
+				return nil
+			})
 		} else {
-			filePaths = append(filePaths, url)
+			filePaths[i] = url
 		}
 	}
 
+	if err := downloads.Wait(); err != nil {
+		return nil, err
+	}
+
 	return filePaths, nil
 }
 
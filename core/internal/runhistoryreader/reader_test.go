@@ -818,6 +818,78 @@ func TestHistoryReader_GetHistorySteps_MixedParquetAndLiveData(t *testing.T) {
 	})
 }
 
+func TestHistoryReader_GetSampledHistorySteps_MixedParquetAndLiveData(t *testing.T) {
+	ctx := t.Context()
+	t.Setenv("WANDB_CACHE_DIR", t.TempDir())
+
+	columns := []columnDef{
+		{name: "_step", colType: "int64"},
+		{name: "metric1", colType: "float64"},
+	}
+	data := []map[string]any{
+		{"_step": int64(0), "metric1": 1.0},
+		{"_step": int64(1), "metric1": 2.0},
+	}
+
+	server := createHttpServer(t, respondWithContent(t, createDummyFileContent()))
+	mockGQL := gqlmock.NewMockClient()
+	mockGQL.StubMatchOnce(
+		gqlmock.WithOpName("RunParquetHistory"),
+		fmt.Sprintf(`{
+			"project": {
+				"run": {
+					"parquetHistory": {
+						"parquetUrls": ["%s/test.parquet"],
+						"liveData": [{"_step": 2}]
+					}
+				}
+			}
+		}`, server.URL),
+	)
+	mockGQL.StubMatchOnce(
+		gqlmock.WithOpName("HistoryPage"),
+		`{
+			"project": {
+				"run": {
+					"history": [
+						"{\"_step\":2,\"metric1\":6.0}",
+						"{\"_step\":3,\"metric1\":8.0}"
+					]
+				}
+			}
+		}`,
+	)
+	rustWrapper := createMockRustArrowWrapper(
+		t,
+		columns,
+		map[uintptr][]map[string]any{1: data},
+	)
+
+	reader, err := New(
+		ctx,
+		"test-entity",
+		"test-project",
+		"test-run-id",
+		mockGQL,
+		retryablehttp.NewClient(),
+		[]string{},
+		true,
+		rustWrapper,
+	)
+	require.NoError(t, err)
+
+	results, err := reader.GetSampledHistorySteps(ctx, 0, 4,
+		Sampling{TargetPoints: 2, Aggregation: Mean})
+
+	assert.NoError(t, err)
+	assert.Equal(t,
+		[]parquet.KeyValueList{
+			{{Key: "_step", Value: int64(0)}, {Key: "metric1", Value: 1.5}},
+			{{Key: "_step", Value: int64(2)}, {Key: "metric1", Value: 7.0}},
+		},
+		results)
+}
+
 func TestHistoryReader_GetHistorySteps_ResultsSortedByStep(t *testing.T) {
 	ctx := t.Context()
 	tempDir := t.TempDir()
@@ -1058,3 +1130,108 @@ func TestHistoryReader_GetHistorySteps_ConvertsStepValueToInt(t *testing.T) {
 		})
 	}
 }
+
+func TestHistoryReader_GetHistoryStepsArrow(t *testing.T) {
+	ctx := t.Context()
+	t.Setenv("WANDB_CACHE_DIR", t.TempDir())
+
+	server := createHttpServer(t, respondWithContent(t, createDummyFileContent()))
+	mockGQL := gqlmock.NewMockClient()
+	mockGQL.StubMatchOnce(
+		gqlmock.WithOpName("RunParquetHistory"),
+		fmt.Sprintf(`{
+			"project": {
+				"run": {
+					"parquetHistory": {
+						"parquetUrls": ["%s/test.parquet"],
+						"liveData": [{"_step": 2}]
+					}
+				}
+			}
+		}`, server.URL),
+	)
+	mockGQL.StubMatchOnce(
+		gqlmock.WithOpName("HistoryPage"),
+		`{
+			"project": {
+				"run": {
+					"history": [
+						"{\"_step\":3,\"metric1\":4.0}",
+						"{\"_step\":2,\"metric1\":3.0}"
+					]
+				}
+			}
+		}`,
+	)
+
+	dataStore := newMockKVDataStore()
+	readerID := new(uintptr)
+	rustWrapper := ffi.RustArrowWrapperTesterWithIPC(
+		func(
+			filePath *byte,
+			columnNames **byte,
+			numColumns int,
+			outError **byte,
+		) unsafe.Pointer {
+			return unsafe.Pointer(readerID)
+		},
+		nil,
+		func(
+			readerPtr unsafe.Pointer,
+			minStep int64,
+			maxStep int64,
+			outResult *ffi.StepScanResult,
+		) *byte {
+			vecPtr, dataPtr := dataStore.store([]byte("ipc-stream"))
+			outResult.VecPtr = vecPtr
+			outResult.DataPtr = dataPtr
+			outResult.DataLen = uint64(len("ipc-stream"))
+			outResult.NumRowsReturned = 2
+			return nil
+		},
+	)
+
+	reader, err := New(
+		ctx,
+		"test-entity",
+		"test-project",
+		"test-run-id",
+		mockGQL,
+		retryablehttp.NewClient(),
+		[]string{},
+		true,
+		rustWrapper,
+	)
+	require.NoError(t, err)
+
+	history, err := reader.GetHistoryStepsArrow(ctx, 0, 4)
+
+	require.NoError(t, err)
+	assert.Equal(t, [][]byte{[]byte("ipc-stream")}, history.Streams)
+	require.Len(t, history.LiveRows, 2)
+	assert.Equal(t, int64(2), history.LiveRows[0].StepValue())
+	assert.Equal(t, int64(3), history.LiveRows[1].StepValue())
+}
+
+func TestHistoryReader_GetHistoryStepsArrow_Unsupported(t *testing.T) {
+	ctx := t.Context()
+	t.Setenv("WANDB_CACHE_DIR", t.TempDir())
+
+	server := createHttpServer(t, respondWithContent(t, createDummyFileContent()))
+	reader, err := New(
+		ctx,
+		"test-entity",
+		"test-project",
+		"test-run-id",
+		mockGraphQLWithParquetUrls([]string{server.URL + "/test.parquet"}),
+		retryablehttp.NewClient(),
+		[]string{},
+		true,
+		createMockRustArrowWrapper(t, nil, nil),
+	)
+	require.NoError(t, err)
+
+	_, err = reader.GetHistoryStepsArrow(ctx, 0, 4)
+
+	assert.ErrorContains(t, err, "does not support Arrow IPC")
+}
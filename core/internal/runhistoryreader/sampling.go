@@ -0,0 +1,531 @@
+package runhistoryreader
+
+import (
+	"cmp"
+	"math"
+	"slices"
+
+	"github.com/wandb/wandb/core/internal/runhistoryreader/parquet"
+)
+
+// Aggregation is the method used to downsample history rows.
+type Aggregation int
+
+const (
+	// EveryNth keeps the first row in each step bucket.
+	EveryNth Aggregation = iota
+
+	// MinMax keeps, for each metric, the points with the smallest and
+	// largest value in each step bucket.
+	MinMax
+
+	// Mean averages each metric over each step bucket.
+	Mean
+
+	// LTTB selects points for each metric using the
+	// Largest-Triangle-Three-Buckets algorithm.
+	LTTB
+)
+
+// Sampling configures how GetSampledHistorySteps downsamples rows.
+type Sampling struct {
+	// TargetPoints is the maximum number of points to return per metric.
+	TargetPoints int
+
+	// Aggregation is the downsampling method.
+	Aggregation Aggregation
+}
+
+// sampler reduces a stream of history rows to a bounded number of points.
+type sampler interface {
+	// Add consumes a row. Rows may be added in any order.
+	Add(row parquet.KeyValueList)
+
+	// Rows returns the downsampled rows sorted by step.
+	Rows() []parquet.KeyValueList
+}
+
+// newSampler returns a sampler for rows in the range [minStep, maxStep).
+func newSampler(sampling Sampling, minStep, maxStep int64) sampler {
+	switch sampling.Aggregation {
+	case MinMax:
+		// Each bucket produces up to two points per metric.
+		return newBucketSampler(
+			minStep, maxStep,
+			max(1, sampling.TargetPoints/2),
+			func() bucket { return &minMaxBucket{} },
+		)
+	case Mean:
+		return newBucketSampler(
+			minStep, maxStep,
+			sampling.TargetPoints,
+			func() bucket { return &meanBucket{} },
+		)
+	case LTTB:
+		return &lttbSampler{
+			minStep:      minStep,
+			maxStep:      maxStep,
+			targetPoints: sampling.TargetPoints,
+			series:       make(map[string][]point),
+		}
+	default:
+		return newBucketSampler(
+			minStep, maxStep,
+			sampling.TargetPoints,
+			func() bucket { return &firstRowBucket{} },
+		)
+	}
+}
+
+// bucket accumulates the rows that fall into a range of steps.
+type bucket interface {
+	add(step int64, row parquet.KeyValueList)
+
+	// merge folds another bucket of the same type into this one.
+	merge(other bucket)
+
+	// rows returns the bucket's output rows sorted by step.
+	rows() []parquet.KeyValueList
+}
+
+// bucketSampler groups rows into equal-width step buckets.
+//
+// The range of steps that actually contains data isn't known until the
+// scan finishes, so buckets start one step wide and double in width
+// whenever there are more than maxBuckets of them. Memory use is
+// proportional to maxBuckets rather than to the number of rows.
+type bucketSampler struct {
+	minStep    int64
+	maxStep    int64
+	maxBuckets int
+
+	// width is the number of steps covered by each bucket.
+	width int64
+
+	// buckets maps (step - minStep) / width to the bucket's data.
+	buckets map[int64]bucket
+
+	newBucket func() bucket
+}
+
+func newBucketSampler(
+	minStep, maxStep int64,
+	maxBuckets int,
+	newBucket func() bucket,
+) *bucketSampler {
+	return &bucketSampler{
+		minStep:    minStep,
+		maxStep:    maxStep,
+		maxBuckets: max(1, maxBuckets),
+		width:      1,
+		buckets:    make(map[int64]bucket),
+		newBucket:  newBucket,
+	}
+}
+
+// Add implements sampler.Add.
+func (s *bucketSampler) Add(row parquet.KeyValueList) {
+	step := row.StepValue()
+	if step < s.minStep || step >= s.maxStep {
+		return
+	}
+
+	index := (step - s.minStep) / s.width
+	b, ok := s.buckets[index]
+	if !ok {
+		b = s.newBucket()
+		s.buckets[index] = b
+	}
+	b.add(step, row)
+
+	for len(s.buckets) > s.maxBuckets {
+		s.coarsen()
+	}
+}
+
+// coarsen doubles the bucket width, merging pairs of adjacent buckets.
+func (s *bucketSampler) coarsen() {
+	s.width *= 2
+
+	merged := make(map[int64]bucket, len(s.buckets)/2+1)
+	for index, b := range s.buckets {
+		if existing, ok := merged[index/2]; ok {
+			existing.merge(b)
+		} else {
+			merged[index/2] = b
+		}
+	}
+	s.buckets = merged
+}
+
+// Rows implements sampler.Rows.
+func (s *bucketSampler) Rows() []parquet.KeyValueList {
+	indices := make([]int64, 0, len(s.buckets))
+	for index := range s.buckets {
+		indices = append(indices, index)
+	}
+	slices.Sort(indices)
+
+	results := []parquet.KeyValueList{}
+	for _, index := range indices {
+		results = append(results, s.buckets[index].rows()...)
+	}
+	return results
+}
+
+// firstRowBucket keeps the row with the smallest step.
+type firstRowBucket struct {
+	step int64
+	row  parquet.KeyValueList
+}
+
+func (b *firstRowBucket) add(step int64, row parquet.KeyValueList) {
+	if b.row == nil || step < b.step {
+		b.step = step
+		b.row = row
+	}
+}
+
+func (b *firstRowBucket) merge(other bucket) {
+	o := other.(*firstRowBucket)
+	if o.row != nil {
+		b.add(o.step, o.row)
+	}
+}
+
+func (b *firstRowBucket) rows() []parquet.KeyValueList {
+	if b.row == nil {
+		return nil
+	}
+	return []parquet.KeyValueList{b.row}
+}
+
+// point is a single value of a metric.
+type point struct {
+	step int64
+
+	// value is the metric's value converted to a float for comparisons.
+	value float64
+
+	// raw is the metric's original value, which is what gets returned.
+	raw any
+}
+
+// minMaxBucket keeps the extreme points of each metric.
+type minMaxBucket struct {
+	minimums map[string]point
+	maximums map[string]point
+}
+
+func (b *minMaxBucket) add(step int64, row parquet.KeyValueList) {
+	for _, kv := range row {
+		if kv.Key == parquet.StepKey {
+			continue
+		}
+		value, ok := numericValue(kv.Value)
+		if !ok {
+			continue
+		}
+		b.addPoint(kv.Key, point{step: step, value: value, raw: kv.Value})
+	}
+}
+
+func (b *minMaxBucket) addPoint(key string, p point) {
+	if b.minimums == nil {
+		b.minimums = make(map[string]point)
+		b.maximums = make(map[string]point)
+	}
+
+	if current, ok := b.minimums[key]; !ok || isMoreExtreme(p, current, -1) {
+		b.minimums[key] = p
+	}
+	if current, ok := b.maximums[key]; !ok || isMoreExtreme(p, current, 1) {
+		b.maximums[key] = p
+	}
+}
+
+// isMoreExtreme reports whether p is further than current in the direction
+// given by sign, which is -1 for minimums and 1 for maximums.
+//
+// Ties go to the earlier step so that the result doesn't depend on the
+// order rows are scanned in.
+func isMoreExtreme(p, current point, sign int) bool {
+	if p.value != current.value {
+		return cmp.Compare(p.value, current.value) == sign
+	}
+	return p.step < current.step
+}
+
+func (b *minMaxBucket) merge(other bucket) {
+	o := other.(*minMaxBucket)
+	for key, p := range o.minimums {
+		b.addPoint(key, p)
+	}
+	for key, p := range o.maximums {
+		b.addPoint(key, p)
+	}
+}
+
+func (b *minMaxBucket) rows() []parquet.KeyValueList {
+	points := make(map[string][]point, len(b.minimums))
+	for key, minimum := range b.minimums {
+		maximum := b.maximums[key]
+		if minimum.step == maximum.step {
+			points[key] = []point{minimum}
+		} else {
+			points[key] = []point{minimum, maximum}
+		}
+	}
+	return rowsFromPoints(points)
+}
+
+// meanBucket averages each metric.
+type meanBucket struct {
+	firstStep int64
+	hasStep   bool
+
+	sums   map[string]float64
+	counts map[string]int
+}
+
+func (b *meanBucket) add(step int64, row parquet.KeyValueList) {
+	b.addStep(step)
+
+	for _, kv := range row {
+		if kv.Key == parquet.StepKey {
+			continue
+		}
+		value, ok := numericValue(kv.Value)
+		if !ok {
+			continue
+		}
+		b.addSum(kv.Key, value, 1)
+	}
+}
+
+func (b *meanBucket) addStep(step int64) {
+	if !b.hasStep || step < b.firstStep {
+		b.firstStep = step
+		b.hasStep = true
+	}
+}
+
+func (b *meanBucket) addSum(key string, sum float64, count int) {
+	if b.sums == nil {
+		b.sums = make(map[string]float64)
+		b.counts = make(map[string]int)
+	}
+	b.sums[key] += sum
+	b.counts[key] += count
+}
+
+func (b *meanBucket) merge(other bucket) {
+	o := other.(*meanBucket)
+	if o.hasStep {
+		b.addStep(o.firstStep)
+	}
+	for key, sum := range o.sums {
+		b.addSum(key, sum, o.counts[key])
+	}
+}
+
+func (b *meanBucket) rows() []parquet.KeyValueList {
+	if !b.hasStep {
+		return nil
+	}
+
+	keys := make([]string, 0, len(b.sums))
+	for key := range b.sums {
+		keys = append(keys, key)
+	}
+	slices.Sort(keys)
+
+	row := make(parquet.KeyValueList, 0, len(keys)+1)
+	row = append(row, parquet.KeyValuePair{Key: parquet.StepKey, Value: b.firstStep})
+	for _, key := range keys {
+		row = append(row, parquet.KeyValuePair{
+			Key:   key,
+			Value: b.sums[key] / float64(b.counts[key]),
+		})
+	}
+	return []parquet.KeyValueList{row}
+}
+
+// lttbSampler downsamples each metric with Largest-Triangle-Three-Buckets.
+//
+// LTTB needs to see a metric's neighboring buckets to select a point, so
+// unlike the other samplers it holds every numeric point until Rows is
+// called. Points are much smaller than rows, and non-numeric values are
+// dropped as they are added.
+type lttbSampler struct {
+	minStep      int64
+	maxStep      int64
+	targetPoints int
+
+	series map[string][]point
+}
+
+// Add implements sampler.Add.
+func (s *lttbSampler) Add(row parquet.KeyValueList) {
+	step := row.StepValue()
+	if step < s.minStep || step >= s.maxStep {
+		return
+	}
+
+	for _, kv := range row {
+		if kv.Key == parquet.StepKey {
+			continue
+		}
+		value, ok := numericValue(kv.Value)
+		if !ok {
+			continue
+		}
+		s.series[kv.Key] = append(
+			s.series[kv.Key],
+			point{step: step, value: value, raw: kv.Value},
+		)
+	}
+}
+
+// Rows implements sampler.Rows.
+func (s *lttbSampler) Rows() []parquet.KeyValueList {
+	selected := make(map[string][]point, len(s.series))
+	for key, points := range s.series {
+		slices.SortFunc(points, func(a, b point) int {
+			return cmp.Compare(a.step, b.step)
+		})
+		selected[key] = largestTriangleThreeBuckets(points, s.targetPoints)
+	}
+	return rowsFromPoints(selected)
+}
+
+// largestTriangleThreeBuckets selects up to threshold points from a series
+// sorted by step, preserving its visual shape.
+//
+// The first and last points are always kept. The rest are split into
+// threshold-2 buckets, and from each bucket the point forming the largest
+// triangle with the previously selected point and the average of the next
+// bucket is kept.
+func largestTriangleThreeBuckets(points []point, threshold int) []point {
+	switch {
+	case threshold >= len(points):
+		return points
+	case threshold <= 0:
+		return nil
+	case threshold == 1:
+		return points[:1]
+	case threshold == 2:
+		return []point{points[0], points[len(points)-1]}
+	}
+
+	sampled := make([]point, 0, threshold)
+	sampled = append(sampled, points[0])
+
+	bucketSize := float64(len(points)-2) / float64(threshold-2)
+	previous := points[0]
+
+	for i := range threshold - 2 {
+		// The average of the next bucket, or the last point
+		// for the final bucket.
+		nextStart := int(float64(i+1)*bucketSize) + 1
+		nextEnd := min(int(float64(i+2)*bucketSize)+1, len(points))
+		if nextStart >= nextEnd {
+			nextStart, nextEnd = len(points)-1, len(points)
+		}
+		var avgX, avgY float64
+		for _, p := range points[nextStart:nextEnd] {
+			avgX += float64(p.step)
+			avgY += p.value
+		}
+		avgX /= float64(nextEnd - nextStart)
+		avgY /= float64(nextEnd - nextStart)
+
+		start := int(float64(i)*bucketSize) + 1
+		end := int(float64(i+1)*bucketSize) + 1
+
+		best := points[start]
+		bestArea := -1.0
+		for _, p := range points[start:end] {
+			area := math.Abs(
+				(float64(previous.step)-avgX)*(p.value-previous.value) -
+					(float64(previous.step)-float64(p.step))*(avgY-previous.value),
+			)
+			if area > bestArea {
+				best = p
+				bestArea = area
+			}
+		}
+
+		sampled = append(sampled, best)
+		previous = best
+	}
+
+	return append(sampled, points[len(points)-1])
+}
+
+// rowsFromPoints combines the selected points of each metric into rows,
+// one per step, sorted by step.
+func rowsFromPoints(points map[string][]point) []parquet.KeyValueList {
+	keys := make([]string, 0, len(points))
+	for key := range points {
+		keys = append(keys, key)
+	}
+	slices.Sort(keys)
+
+	rowsByStep := make(map[int64]parquet.KeyValueList)
+	for _, key := range keys {
+		for _, p := range points[key] {
+			row, ok := rowsByStep[p.step]
+			if !ok {
+				row = parquet.KeyValueList{{Key: parquet.StepKey, Value: p.step}}
+			}
+			rowsByStep[p.step] = append(row, parquet.KeyValuePair{
+				Key:   key,
+				Value: p.raw,
+			})
+		}
+	}
+
+	steps := make([]int64, 0, len(rowsByStep))
+	for step := range rowsByStep {
+		steps = append(steps, step)
+	}
+	slices.Sort(steps)
+
+	results := make([]parquet.KeyValueList, 0, len(steps))
+	for _, step := range steps {
+		results = append(results, rowsByStep[step])
+	}
+	return results
+}
+
+// numericValue converts a history value to a float64.
+//
+// It returns false for non-numeric values and NaN, which are ignored by
+// the aggregations that compare or average values.
+func numericValue(value any) (float64, bool) {
+	var f float64
+	switch v := value.(type) {
+	case int64:
+		f = float64(v)
+	case uint64:
+		f = float64(v)
+	case int32:
+		f = float64(v)
+	case uint32:
+		f = float64(v)
+	case int:
+		f = float64(v)
+	case float64:
+		f = v
+	case float32:
+		f = float64(v)
+	default:
+		return 0, false
+	}
+
+	if math.IsNaN(f) {
+		return 0, false
+	}
+	return f, true
+}
@@ -0,0 +1,125 @@
+package runhistoryreader
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/wandb/wandb/core/internal/runhistoryreader/parquet"
+)
+
+func row(step int64, pairs ...any) parquet.KeyValueList {
+	kvl := parquet.KeyValueList{{Key: parquet.StepKey, Value: step}}
+	for i := 0; i < len(pairs); i += 2 {
+		kvl = append(kvl, parquet.KeyValuePair{
+			Key:   pairs[i].(string),
+			Value: pairs[i+1],
+		})
+	}
+	return kvl
+}
+
+func addAll(s sampler, rows []parquet.KeyValueList) {
+	for _, r := range rows {
+		s.Add(r)
+	}
+}
+
+func TestSampler_EveryNth(t *testing.T) {
+	s := newSampler(Sampling{TargetPoints: 4, Aggregation: EveryNth}, 0, 100)
+
+	// Add rows out of order, as happens when combining partitions.
+	for step := int64(15); step >= 0; step-- {
+		s.Add(row(step, "loss", float64(step)))
+	}
+
+	assert.Equal(t,
+		[]parquet.KeyValueList{
+			row(0, "loss", 0.0),
+			row(4, "loss", 4.0),
+			row(8, "loss", 8.0),
+			row(12, "loss", 12.0),
+		},
+		s.Rows())
+}
+
+func TestSampler_EveryNth_IgnoresRowsOutsideRange(t *testing.T) {
+	s := newSampler(Sampling{TargetPoints: 10, Aggregation: EveryNth}, 5, 7)
+
+	addAll(s, []parquet.KeyValueList{row(4), row(5), row(6), row(7)})
+
+	assert.Equal(t, []parquet.KeyValueList{row(5), row(6)}, s.Rows())
+}
+
+func TestSampler_MinMax(t *testing.T) {
+	s := newSampler(Sampling{TargetPoints: 4, Aggregation: MinMax}, 0, 100)
+
+	addAll(s, []parquet.KeyValueList{
+		row(0, "loss", 5.0, "acc", int64(1)),
+		row(1, "loss", 1.0, "acc", int64(1)),
+		row(2, "loss", 9.0, "name", "text"),
+		row(3, "loss", 3.0, "acc", math.NaN()),
+		row(4, "loss", 7.0),
+	})
+
+	// 5 steps in at most 2 buckets: [0, 4) and [4, 8).
+	assert.Equal(t,
+		[]parquet.KeyValueList{
+			row(0, "acc", int64(1)),
+			row(1, "loss", 1.0),
+			row(2, "loss", 9.0),
+			row(4, "loss", 7.0),
+		},
+		s.Rows())
+}
+
+func TestSampler_Mean(t *testing.T) {
+	s := newSampler(Sampling{TargetPoints: 2, Aggregation: Mean}, 10, 100)
+
+	addAll(s, []parquet.KeyValueList{
+		row(13, "loss", 4.0),
+		row(10, "loss", 1.0, "acc", int64(2)),
+		row(11, "loss", 2.0),
+		row(12, "loss", 3.0, "name", "text"),
+	})
+
+	assert.Equal(t,
+		[]parquet.KeyValueList{
+			row(10, "acc", 2.0, "loss", 1.5),
+			row(12, "loss", 3.5),
+		},
+		s.Rows())
+}
+
+func TestSampler_LTTB(t *testing.T) {
+	s := newSampler(Sampling{TargetPoints: 4, Aggregation: LTTB}, 0, 100)
+
+	// A flat line with a single spike at step 3.
+	for step := int64(9); step >= 0; step-- {
+		value := 0.0
+		if step == 3 {
+			value = 10.0
+		}
+		s.Add(row(step, "loss", value))
+	}
+
+	rows := s.Rows()
+
+	assert.Len(t, rows, 4)
+	assert.Equal(t, row(0, "loss", 0.0), rows[0])
+	assert.Contains(t, rows, row(3, "loss", 10.0))
+	assert.Equal(t, row(9, "loss", 0.0), rows[3])
+}
+
+func TestLargestTriangleThreeBuckets_SmallThresholds(t *testing.T) {
+	points := []point{{step: 0}, {step: 1}, {step: 2}}
+
+	assert.Equal(t, points, largestTriangleThreeBuckets(points, 5))
+	assert.Equal(t,
+		[]point{{step: 0}, {step: 2}},
+		largestTriangleThreeBuckets(points, 2))
+	assert.Equal(t,
+		[]point{{step: 0}},
+		largestTriangleThreeBuckets(points, 1))
+}
@@ -32,6 +32,22 @@ func (mh *MetricHandler) Exists(key string) bool {
 	return exists
 }
 
+// BestSummaryType returns the summary statistic that is best for
+// a non-glob metric according to its goal.
+//
+// It is Min for minimized metrics, Max for maximized ones and
+// Unset if the metric has no goal.
+func (mh *MetricHandler) BestSummaryType(key string) runsummary.SummaryTypeFlags {
+	switch mh.definedMetrics[key].MetricGoal {
+	case metricGoalMinimize:
+		return runsummary.Min
+	case metricGoalMaximize:
+		return runsummary.Max
+	default:
+		return runsummary.Unset
+	}
+}
+
 // ProcessRecord updates metric definitions.
 func (mh *MetricHandler) ProcessRecord(record *spb.MetricRecord) error {
 	if record.StepMetric != "" {
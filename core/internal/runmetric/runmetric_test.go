@@ -2,6 +2,8 @@ package runmetric
 
 import (
 	"testing"
+
+	"github.com/wandb/wandb/core/internal/runsummary"
 )
 
 func TestGlobMetricWildcard(t *testing.T) {
@@ -55,3 +57,19 @@ func TestGlobMetricEndingWildcard(t *testing.T) {
 	}
 
 }
+
+func TestBestSummaryType(t *testing.T) {
+	mh := New()
+	mh.definedMetrics["loss"] = definedMetric{MetricGoal: metricGoalMinimize}
+	mh.definedMetrics["acc"] = definedMetric{MetricGoal: metricGoalMaximize}
+
+	if got := mh.BestSummaryType("loss"); got != runsummary.Min {
+		t.Errorf("Expected Min for loss, got %v", got)
+	}
+	if got := mh.BestSummaryType("acc"); got != runsummary.Max {
+		t.Errorf("Expected Max for acc, got %v", got)
+	}
+	if got := mh.BestSummaryType("other"); got != runsummary.Unset {
+		t.Errorf("Expected Unset for other, got %v", got)
+	}
+}
@@ -2,6 +2,8 @@ package runsummary
 
 import (
 	"errors"
+	"slices"
+	"strings"
 
 	"github.com/wandb/wandb/core/internal/pathtree"
 	"github.com/wandb/wandb/core/internal/runhistory"
@@ -164,6 +166,61 @@ func (rs *RunSummary) ToRecords() ([]*spb.SummaryItem, error) {
 	return records, errors.Join(errs...)
 }
 
+// MetricStats are the statistics tracked for a top-level metric.
+type MetricStats struct {
+	// Key is the metric's name.
+	Key string
+
+	// Latest is the metric's latest value.
+	Latest any
+
+	// Min, Max and Mean are computed over the metric's numeric history
+	// values, if Count is positive.
+	Min, Max, Mean float64
+
+	// Count is the number of numeric history values.
+	Count int
+
+	// Track is the set of summary statistics requested for the metric.
+	//
+	// If empty, the metric's summary is its latest value.
+	Track SummaryTypeFlags
+}
+
+// TopLevelStats returns statistics for each top-level metric that
+// has a summary, sorted by key.
+func (rs *RunSummary) TopLevelStats() []MetricStats {
+	var stats []MetricStats
+
+	rs.summaries.ForEachLeaf(
+		func(path pathtree.TreePath, summary *metricSummary) bool {
+			if path.Len() != 1 || summary.noSummary || !summary.hasData {
+				return true
+			}
+
+			metricStats := MetricStats{
+				Key:    path.End(),
+				Latest: summary.latest,
+				Min:    summary.min,
+				Max:    summary.max,
+				Count:  summary.count,
+				Track:  summary.track,
+			}
+			if summary.count > 0 {
+				metricStats.Mean = summary.total / float64(summary.count)
+			}
+
+			stats = append(stats, metricStats)
+			return true
+		})
+
+	slices.SortFunc(stats, func(a, b MetricStats) int {
+		return strings.Compare(a.Key, b.Key)
+	})
+
+	return stats
+}
+
 func (rs *RunSummary) toSummaryTree() *pathtree.PathTree[any] {
 	jsonTree := pathtree.New[any]()
 
@@ -186,3 +186,26 @@ func TestSerialize(t *testing.T) {
 		}`,
 		string(encoded))
 }
+
+func TestTopLevelStats(t *testing.T) {
+	rs := runsummary.New()
+	rh1 := runhistory.New()
+	rh2 := runhistory.New()
+	rh1.SetFloat(pathtree.PathOf("y"), 1)
+	rh1.SetFloat(pathtree.PathOf("nested", "x"), 1)
+	rh2.SetFloat(pathtree.PathOf("y"), 3)
+	rs.ConfigureMetric(pathtree.PathOf("y"), false, runsummary.Max)
+	rs.ConfigureMetric(pathtree.PathOf("hidden"), true, runsummary.Unset)
+	rs.Set(pathtree.PathOf("x"), "abc")
+	rs.Set(pathtree.PathOf("hidden"), 1)
+	_, _ = rs.UpdateSummaries(rh1)
+	_, _ = rs.UpdateSummaries(rh2)
+
+	assert.Equal(t,
+		[]runsummary.MetricStats{
+			{Key: "x", Latest: "abc"},
+			{Key: "y", Latest: 3.0, Min: 1, Max: 3, Mean: 2, Count: 2,
+				Track: runsummary.Max},
+		},
+		rs.TopLevelStats())
+}
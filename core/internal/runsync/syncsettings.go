@@ -21,13 +21,18 @@ func MakeSyncSettings(
 ) *settings.Settings {
 	syncSettings := proto.CloneOf(globalSettings)
 
-	// Syncing ignores offline mode, finish_timeout and init_timeout
+	// Syncing ignores offline mode, finish_timeout, init_timeout
+	// and offline_fallback_timeout
 	syncSettings.XOffline = nil
 	syncSettings.FinishTimeout = nil
 	syncSettings.InitTimeout = nil
+	syncSettings.OfflineFallbackTimeout = nil
 
 	// This determines files_dir.
 	syncSettings.SyncDir = wrapperspb.String(filepath.Dir(wandbFile))
 
+	// This locates GraphQL requests the run saved to retry.
+	syncSettings.SyncFile = wrapperspb.String(wandbFile)
+
 	return settings.From(syncSettings)
 }
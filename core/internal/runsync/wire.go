@@ -44,8 +44,11 @@ var runSyncerFactoryBindings = wire.NewSet(
 	sharedmode.RandomClientID,
 	stream.BaseURLFromSettings,
 	stream.CredentialsFromSettings,
+	stream.NewCircuitBreaker,
+	stream.NewCoreMetrics,
 	stream.NewFileTransferManager,
-	stream.NewGraphQLClient,
+	stream.MutationQueueClient,
+	stream.NewMutationQueue,
 	stream.RecordParserProviders,
 	stream.SenderProviders,
 	tensorboard.TBHandlerProviders,
@@ -30,10 +30,12 @@ func InjectRunSyncerFactory(settings2 *settings.Settings, logger *observability.
 	wandbOperations := wboperation.NewOperations()
 	printer := providePrinter()
 	wbBaseURL := stream.BaseURLFromSettings(logger, settings2)
+	breaker := stream.NewCircuitBreaker(wbBaseURL, logger, wandbOperations, printer, settings2)
 	clientID := sharedmode.RandomClientID()
 	credentialProvider := stream.CredentialsFromSettings(logger, settings2)
 	peeker := &observability.Peeker{}
-	client := stream.NewGraphQLClient(wbBaseURL, clientID, credentialProvider, logger, peeker, settings2)
+	queue := stream.NewMutationQueue(wbBaseURL, breaker, clientID, credentialProvider, logger, wandbOperations, peeker, settings2)
+	client := stream.MutationQueueClient(queue)
 	featureProvider := featurechecker.New(client, logger)
 	runHandle := runhandle.New()
 	recordParserFactory := &stream.RecordParserFactory{
@@ -41,6 +43,7 @@ func InjectRunSyncerFactory(settings2 *settings.Settings, logger *observability.
 		GraphqlClientOrNil: client,
 		Logger:             logger,
 		Operations:         wandbOperations,
+		Printer:            printer,
 		RunHandle:          runHandle,
 		ClientID:           clientID,
 		Settings:           settings2,
@@ -49,16 +52,18 @@ func InjectRunSyncerFactory(settings2 *settings.Settings, logger *observability.
 		Logger:     logger,
 		Operations: wandbOperations,
 	}
+	fileTransferStats := filetransfer.NewFileTransferStats()
+	registry := stream.NewCoreMetrics(fileTransferStats, settings2)
 	fileStreamFactory := &filestream.FileStreamFactory{
 		BaseURL:         wbBaseURL,
+		CoreMetrics:     registry,
 		FeatureProvider: featureProvider,
 		Logger:          logger,
 		Operations:      wandbOperations,
 		Printer:         printer,
 		Settings:        settings2,
 	}
-	fileTransferStats := filetransfer.NewFileTransferStats()
-	fileTransferManager := stream.NewFileTransferManager(wbBaseURL, fileTransferStats, logger, settings2)
+	fileTransferManager := stream.NewFileTransferManager(wbBaseURL, breaker, fileTransferStats, logger, settings2)
 	watcher := provideFileWatcher(logger)
 	uploaderFactory := &runfiles.UploaderFactory{
 		FileTransfer: fileTransferManager,
@@ -72,6 +77,7 @@ func InjectRunSyncerFactory(settings2 *settings.Settings, logger *observability.
 	mailboxMailbox := mailbox.New()
 	senderFactory := &stream.SenderFactory{
 		BaseURL:                 wbBaseURL,
+		CircuitBreaker:          breaker,
 		ClientID:                clientID,
 		CredentialProvider:      credentialProvider,
 		Logger:                  logger,
@@ -84,6 +90,7 @@ func InjectRunSyncerFactory(settings2 *settings.Settings, logger *observability.
 		FileWatcher:             watcher,
 		RunfilesUploaderFactory: uploaderFactory,
 		GraphqlClient:           client,
+		MutationQueue:           queue,
 		Peeker:                  peeker,
 		Printer:                 printer,
 		RunHandle:               runHandle,
@@ -111,7 +118,7 @@ func InjectRunSyncerFactory(settings2 *settings.Settings, logger *observability.
 
 var runSyncerFactoryBindings = wire.NewSet(wire.Bind(new(api.Peeker), new(*observability.Peeker)), wire.Struct(new(observability.Peeker)), featurechecker.New, filestream.FileStreamProviders, filetransfer.NewFileTransferStats, mailbox.New, provideFileWatcher,
 	providePrinter, runfiles.UploaderProviders, runhandle.New, runReaderProviders,
-	runSyncerProviders, sharedmode.RandomClientID, stream.BaseURLFromSettings, stream.CredentialsFromSettings, stream.NewFileTransferManager, stream.NewGraphQLClient, stream.RecordParserProviders, stream.SenderProviders, tensorboard.TBHandlerProviders, wboperation.NewOperations,
+	runSyncerProviders, sharedmode.RandomClientID, stream.BaseURLFromSettings, stream.CredentialsFromSettings, stream.NewCircuitBreaker, stream.NewCoreMetrics, stream.NewFileTransferManager, stream.MutationQueueClient, stream.NewMutationQueue, stream.RecordParserProviders, stream.SenderProviders, tensorboard.TBHandlerProviders, wboperation.NewOperations,
 )
 
 func providePrinter() *observability.Printer {
@@ -54,6 +54,7 @@ type RunUpdateWork struct {
 	FeatureProvider    *featurechecker.FeatureProvider
 	GraphqlClientOrNil graphql.Client
 	Logger             *observability.CoreLogger
+	PrinterOrNil       *observability.Printer
 	SyncStateStore     runsyncstate.Store
 }
 
@@ -100,6 +101,7 @@ func (w *RunUpdateWork) initRun(request *runwork.Request) {
 		FeatureProvider:    w.FeatureProvider,
 		GraphqlClientOrNil: w.GraphqlClientOrNil,
 		Logger:             w.Logger,
+		PrinterOrNil:       w.PrinterOrNil,
 		SyncStateStore:     w.SyncStateStore,
 	})
 
@@ -17,14 +17,17 @@ import (
 	"github.com/wandb/wandb/core/internal/featurechecker"
 	"github.com/wandb/wandb/core/internal/filestream"
 	"github.com/wandb/wandb/core/internal/gql"
+	"github.com/wandb/wandb/core/internal/mutationqueue"
 	"github.com/wandb/wandb/core/internal/nullify"
 	"github.com/wandb/wandb/core/internal/observability"
+	"github.com/wandb/wandb/core/internal/redact"
 	"github.com/wandb/wandb/core/internal/runbranch"
 	"github.com/wandb/wandb/core/internal/runconfig"
 	"github.com/wandb/wandb/core/internal/runenvironment"
 	"github.com/wandb/wandb/core/internal/runmetric"
 	"github.com/wandb/wandb/core/internal/runsyncstate"
 	"github.com/wandb/wandb/core/internal/settings"
+	"github.com/wandb/wandb/core/internal/sharedmode"
 	"github.com/wandb/wandb/core/internal/version"
 	"github.com/wandb/wandb/core/internal/wboperation"
 	spb "github.com/wandb/wandb/core/pkg/service_go_proto"
@@ -46,6 +49,7 @@ type RunUpserter struct {
 	operations         *wboperation.WandbOperations
 	graphqlClientOrNil graphql.Client
 	logger             *observability.CoreLogger
+	printerOrNil       *observability.Printer
 	syncStateStore     runsyncstate.Store
 
 	// done is closed when Finish is called.
@@ -62,6 +66,14 @@ type RunUpserter struct {
 	telemetry   *spb.TelemetryRecord
 	metrics     *runmetric.RunConfigMetrics
 	environment *runenvironment.RunEnvironment
+
+	// attributionOrNil tracks this writer's values in shared mode.
+	attributionOrNil *sharedmode.Attribution
+
+	// redactionPolicy removes or hashes values before they're uploaded.
+	//
+	// It is nil if no policy is configured.
+	redactionPolicy *redact.Policy
 }
 
 type RunUpserterParams struct {
@@ -75,6 +87,10 @@ type RunUpserterParams struct {
 	GraphqlClientOrNil graphql.Client
 	Logger             *observability.CoreLogger
 	SyncStateStore     runsyncstate.Store
+
+	// PrinterOrNil is used to warn the user about conflicting values
+	// set by other writers in shared mode.
+	PrinterOrNil *observability.Printer
 }
 
 func (params *RunUpserterParams) panicIfNotFilled() {
@@ -108,6 +124,20 @@ func InitRun(
 		panic("runupserter: RunRecord is nil")
 	}
 
+	// Refuse to start rather than upload values the policy is meant to hide.
+	redactionPolicy, err := redact.NewPolicy(
+		params.Settings.GetRedactDrop(),
+		params.Settings.GetRedactHash(),
+		params.Settings.GetRedactKeep(),
+	)
+	if err != nil {
+		return nil, &RunUpdateError{
+			Cause:       err,
+			UserMessage: fmt.Sprintf("Invalid redaction settings: %v", err),
+			Code:        spb.ErrorInfo_USAGE,
+		}
+	}
+
 	// Initialize run environment info.
 	environment := runenvironment.New(params.ClientID)
 
@@ -129,6 +159,20 @@ func InitRun(
 		environment.ToRunConfigData(),
 	)
 
+	// In shared mode, track which values this writer sets.
+	var attribution *sharedmode.Attribution
+	if params.Settings.IsSharedMode() {
+		attribution = sharedmode.NewAttribution(
+			sharedmode.Writer{
+				ID:    sharedmode.ClientID(params.ClientID),
+				Label: params.Settings.GetLabel(),
+			},
+			redactionPolicy,
+		)
+		attribution.RecordConfig(runRecord.Config)
+		config.AddWriterData(attribution.ToRunConfigData())
+	}
+
 	// Initialize other run metadata.
 	runParams := runbranch.NewRunParams(runRecord, params.Settings)
 
@@ -174,6 +218,7 @@ func InitRun(
 		operations:         params.Operations,
 		graphqlClientOrNil: params.GraphqlClientOrNil,
 		logger:             params.Logger,
+		printerOrNil:       params.PrinterOrNil,
 		syncStateStore:     params.SyncStateStore,
 
 		done:  make(chan struct{}),
@@ -184,6 +229,9 @@ func InitRun(
 		telemetry:   telemetry,
 		metrics:     metrics,
 		environment: environment,
+
+		attributionOrNil: attribution,
+		redactionPolicy:  redactionPolicy,
 	}
 
 	// If resuming, rewinding or forking, we need to modify metadata
@@ -283,6 +331,49 @@ func (upserter *RunUpserter) UpdateConfig(config *spb.ConfigRecord) {
 			)
 		})
 
+	// The attribution data is added to the config before uploading.
+	if upserter.attributionOrNil != nil {
+		upserter.attributionOrNil.RecordConfig(config)
+	}
+
+	upserter.isConfigDirty = true
+	upserter.signalDirty()
+}
+
+// UpdateSummary records this writer's summary values in shared mode.
+//
+// The summary itself is uploaded separately; this only updates the
+// attribution data in the config so that conflicts can be detected.
+// Changes to metrics logged to history don't trigger an upload by
+// themselves and are included in the next one.
+func (upserter *RunUpserter) UpdateSummary(summary *spb.SummaryRecord) {
+	if upserter.attributionOrNil == nil {
+		return
+	}
+
+	if upserter.attributionOrNil.RecordSummary(summary) {
+		upserter.signalDirty()
+	}
+}
+
+// UpdateHistory records the history keys logged by this writer in shared
+// mode, whose summary values are expected to differ between writers.
+func (upserter *RunUpserter) UpdateHistory(history *spb.HistoryRecord) {
+	if upserter.attributionOrNil == nil {
+		return
+	}
+
+	upserter.attributionOrNil.RecordHistory(history)
+}
+
+// UpdateHistoryThrottle schedules an update to the history throttling
+// data in the run's config.
+func (upserter *RunUpserter) UpdateHistoryThrottle(data map[string]any) {
+	upserter.mu.Lock()
+	defer upserter.mu.Unlock()
+
+	upserter.config.AddHistoryThrottleData(data)
+
 	upserter.isConfigDirty = true
 	upserter.signalDirty()
 }
@@ -383,17 +474,27 @@ func (upserter *RunUpserter) RunPath() runbranch.RunPath {
 }
 
 // ConfigYAML returns the run's config as a YAML string.
+//
+// The redaction policy is applied.
 func (upserter *RunUpserter) ConfigYAML() ([]byte, error) {
 	upserter.mu.Lock()
 	defer upserter.mu.Unlock()
-	return upserter.config.Serialize(runconfig.FormatYaml)
+	return upserter.lockedRedactedConfig().Serialize(runconfig.FormatYaml)
 }
 
 // ConfigMap returns a copy of the run's config as nested maps.
+//
+// The redaction policy is applied.
 func (upserter *RunUpserter) ConfigMap() map[string]any {
 	upserter.mu.Lock()
 	defer upserter.mu.Unlock()
-	return upserter.config.CloneTree()
+	return upserter.lockedRedactedConfig().CloneTree()
+}
+
+// RedactionPolicy returns the policy applied to uploaded config, summary
+// and metadata values, or nil if there is none.
+func (upserter *RunUpserter) RedactionPolicy() *redact.Policy {
+	return upserter.redactionPolicy
 }
 
 // EnvironmentJSON returns the run's environment info snapshot as a JSON string.
@@ -527,18 +628,27 @@ func (upserter *RunUpserter) debounce() {
 func (upserter *RunUpserter) debounceAndUploadChanges() {
 	operation := upserter.operations.New("updating run metadata")
 	defer operation.Finish()
-	ctx := operation.Context(upserter.beforeRunEndCtx)
+
+	// Updates are saved to retry if the server can't be reached, so that
+	// they're not lost when the network is down for a long time.
+	ctx := mutationqueue.Replayable(
+		operation.Context(upserter.beforeRunEndCtx))
 
 	upserter.debounce()
 
 	upserter.mu.Lock()
 	defer upserter.mu.Unlock()
 
+	if upserter.attributionOrNil != nil && upserter.attributionOrNil.TakeChanged() {
+		upserter.config.AddWriterData(upserter.attributionOrNil.ToRunConfigData())
+		upserter.isConfigDirty = true
+	}
+
 	if !upserter.isParamsDirty && !upserter.isConfigDirty {
 		return
 	}
 
-	_, err := upserter.lockedDoUpsert(
+	response, err := upserter.lockedDoUpsert(
 		ctx,
 		upserter.isParamsDirty,
 		upserter.isConfigDirty,
@@ -549,14 +659,18 @@ func (upserter *RunUpserter) debounceAndUploadChanges() {
 			"runupserter: failed to upload changes",
 			"error", err,
 		)
+		return
 	}
+
+	upserter.lockedCheckConflicts(response)
 }
 
 // serializeConfig returns the serialized run config.
 //
 // If an error happens, it is logged an an empty string is returned.
 func (upserter *RunUpserter) serializeConfig() string {
-	serializedConfig, err := upserter.config.Serialize(runconfig.FormatJson)
+	serializedConfig, err := upserter.lockedRedactedConfig().
+		Serialize(runconfig.FormatJson)
 
 	if err != nil {
 		upserter.logger.Error(
@@ -569,6 +683,56 @@ func (upserter *RunUpserter) serializeConfig() string {
 	}
 }
 
+// lockedRedactedConfig returns the config with the redaction policy applied.
+//
+// Environment info stored in the config under "_wandb.e" is treated as
+// metadata. The mutex must be held.
+func (upserter *RunUpserter) lockedRedactedConfig() *runconfig.RunConfig {
+	if upserter.redactionPolicy == nil {
+		return upserter.config
+	}
+
+	tree := upserter.redactionPolicy.Apply(
+		redact.RootConfig,
+		upserter.config.CloneTree(),
+	)
+
+	internal, _ := tree["_wandb"].(map[string]any)
+	environments, _ := internal["e"].(map[string]any)
+	for writerID, environment := range environments {
+		if environment, ok := environment.(map[string]any); ok {
+			environments[writerID] = upserter.redactionPolicy.Apply(
+				redact.RootMetadata,
+				environment,
+			)
+		}
+	}
+
+	return runconfig.NewFrom(tree)
+}
+
+// redactedParam applies the redaction policy to a run field that's also
+// recorded in the run's metadata at the given path.
+//
+// Returns nil if the value is empty or dropped.
+func (upserter *RunUpserter) redactedParam(value string, keyPath ...string) *string {
+	if value == "" {
+		return nil
+	}
+
+	redacted, ok := upserter.redactionPolicy.ApplyValue(
+		redact.RootMetadata,
+		keyPath,
+		value,
+	)
+	if !ok {
+		return nil
+	}
+
+	result, _ := redacted.(string)
+	return &result
+}
+
 // lockedDoUpsert performs an UpsertBucket request to upload the current
 // metadata.
 //
@@ -614,10 +778,10 @@ func (upserter *RunUpserter) lockedDoUpsert(
 		groupName = nullify.NilIfZero(upserter.params.GroupName)
 		displayName = nullify.NilIfZero(upserter.params.DisplayName)
 		notes = nullify.NilIfZero(upserter.params.Notes)
-		commit = nullify.NilIfZero(upserter.params.Commit)
-		host = nullify.NilIfZero(upserter.params.Host)
-		program = nullify.NilIfZero(upserter.params.Program)
-		repo = nullify.NilIfZero(upserter.params.RemoteURL)
+		commit = upserter.redactedParam(upserter.params.Commit, "git", "commit")
+		host = upserter.redactedParam(upserter.params.Host, "host")
+		program = upserter.redactedParam(upserter.params.Program, "program")
+		repo = upserter.redactedParam(upserter.params.RemoteURL, "git", "remote")
 		jobType = nullify.NilIfZero(upserter.params.JobType)
 		sweepID = nullify.NilIfZero(upserter.params.SweepID)
 		tags = slices.Clone(upserter.params.Tags)
@@ -698,4 +862,79 @@ func (upserter *RunUpserter) lockedUpdateFromUpsert(
 		upserter.params.Entity = entity.GetName()
 		upserter.params.Project = project.GetName()
 	}
+
+	upserter.lockedCheckConflicts(response)
+}
+
+// lockedCheckConflicts warns about keys that another shared-mode writer
+// set to a different value, based on the config returned by the server.
+//
+// The mutex must be held.
+func (upserter *RunUpserter) lockedCheckConflicts(
+	response *gql.UpsertBucketResponse,
+) {
+	if upserter.attributionOrNil == nil {
+		return
+	}
+
+	configJSON := response.GetUpsertBucket().GetBucket().GetConfig()
+	if configJSON == nil {
+		return
+	}
+
+	config, err := unwrapConfig(*configJSON)
+	if err != nil {
+		upserter.logger.Error(
+			"runupserter: failed to parse config from server",
+			"error", err,
+		)
+		return
+	}
+
+	conflicts := upserter.attributionOrNil.FindConflicts(config)
+	if len(conflicts) == 0 {
+		return
+	}
+
+	for _, conflict := range conflicts {
+		upserter.logger.Warn(
+			"runupserter: shared mode conflict",
+			"conflict", conflict.String(),
+		)
+
+		if upserter.printerOrNil != nil {
+			upserter.printerOrNil.Warnf(
+				"Writers disagree in shared mode: %s;"+
+					" the run shows the last value uploaded.",
+				conflict,
+			)
+		}
+	}
+
+	// Record the conflicts in the run.
+	upserter.config.AddWriterData(upserter.attributionOrNil.ToRunConfigData())
+	upserter.isConfigDirty = true
+	upserter.signalDirty()
+}
+
+// unwrapConfig parses a run config returned by the server, where each
+// top-level value is wrapped as {"value": ...}.
+func unwrapConfig(configJSON string) (map[string]any, error) {
+	parsed, err := simplejsonext.UnmarshalString(configJSON)
+	if err != nil {
+		return nil, err
+	}
+
+	wrapped, ok := parsed.(map[string]any)
+	if !ok {
+		return nil, nil
+	}
+
+	config := make(map[string]any, len(wrapped))
+	for key, value := range wrapped {
+		if valueDict, ok := value.(map[string]any); ok {
+			config[key] = valueDict["value"]
+		}
+	}
+	return config, nil
 }
@@ -2,6 +2,7 @@ package runupserter_test
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"path/filepath"
 	"testing"
@@ -18,6 +19,7 @@ import (
 	"github.com/wandb/wandb/core/internal/featurechecker"
 	"github.com/wandb/wandb/core/internal/filestream"
 	"github.com/wandb/wandb/core/internal/gqlmock"
+	"github.com/wandb/wandb/core/internal/observability"
 	"github.com/wandb/wandb/core/internal/observabilitytest"
 	"github.com/wandb/wandb/core/internal/runsyncstate"
 	"github.com/wandb/wandb/core/internal/runupserter"
@@ -126,6 +128,76 @@ func TestInitRun_MakesCorrectRequest(t *testing.T) {
 		gqlmock.GQLVar("tags", gomock.Eq([]any{"tag1", "tag2"})))
 }
 
+func TestInitRun_AppliesRedactionPolicy(t *testing.T) {
+	mockClient := gqlmock.NewMockClient()
+	params := testParams(t)
+	params.GraphqlClientOrNil = mockClient
+	params.Settings = settings.From(&spb.Settings{
+		RedactDrop: &spb.ListStringValue{
+			Value: []string{"config.db.password", "metadata.host", "*token*"},
+		},
+		RedactHash: &spb.ListStringValue{Value: []string{"metadata.git.remote"}},
+		RedactKeep: &spb.ListStringValue{Value: []string{"config.tokenizer"}},
+	})
+	runupsertertest.StubUpsertBucket(t, mockClient)
+
+	upserter, err := runupserter.InitRun(
+		runRecord(&spb.RunRecord{
+			Git:  &spb.GitRepoRecord{RemoteUrl: "https://tok@example.com/r"},
+			Host: "gpu-01",
+			Config: &spb.ConfigRecord{
+				Update: []*spb.ConfigItem{
+					{Key: "db", ValueJson: `{"password": "hunter2", "port": 1}`},
+					{Key: "hf_token", ValueJson: `"hf_abc"`},
+					{Key: "tokenizer", ValueJson: `"bpe"`},
+				},
+			},
+		}),
+		params,
+	)
+	require.NoError(t, err)
+	defer upserter.Finish()
+
+	requests := mockClient.AllRequests()
+	require.Len(t, requests, 1)
+	gqlmock.AssertVariables(
+		t,
+		requests[0],
+		gqlmock.GQLVar("host", gomock.Nil()),
+		gqlmock.GQLVar("repo", gomock.Eq(
+			"sha256:"+
+				"11e14d43634d3f8ab325e13bd1b8b417797a1cd0948be75ac6446f39564f1592")),
+		gqlmock.GQLVar("config", gqlmock.JSONEq(fmt.Sprintf(`
+				{
+					"db": {"value": {"port": 1}},
+					"tokenizer": {"value": "bpe"},
+					"_wandb": {"value": {
+						"m": [],
+						"t": {"12": "%s"}
+					}}
+				}
+			`, version.Version))),
+	)
+
+	assert.Equal(t,
+		map[string]any{"port": int64(1)},
+		upserter.ConfigMap()["db"])
+}
+
+func TestInitRun_InvalidRedactionPolicy(t *testing.T) {
+	params := testParams(t)
+	params.Settings = settings.From(&spb.Settings{
+		RedactDrop: &spb.ListStringValue{Value: []string{"config.[a"}},
+	})
+
+	_, err := runupserter.InitRun(runRecord(&spb.RunRecord{}), params)
+
+	runUpdateErr, ok := errors.AsType[*runupserter.RunUpdateError](err)
+	require.True(t, ok)
+	assert.Equal(t, spb.ErrorInfo_USAGE, runUpdateErr.Code)
+	assert.Contains(t, runUpdateErr.UserMessage, "Invalid redaction settings")
+}
+
 func TestInitRun_ReadsResponse(t *testing.T) {
 	mockClient := gqlmock.NewMockClient()
 	params := testParams(t)
@@ -519,6 +591,113 @@ func TestUpdateConfig_Uploads(t *testing.T) {
 	})
 }
 
+func TestInitRun_SharedMode_WarnsOnConflict(t *testing.T) {
+	mockClient := gqlmock.NewMockClient()
+	printer := observability.NewPrinter(10)
+	params := testParams(t)
+	params.GraphqlClientOrNil = mockClient
+	params.PrinterOrNil = printer
+	params.Settings = settings.From(&spb.Settings{
+		XShared: wrapperspb.Bool(true),
+		XLabel:  wrapperspb.String("node-0"),
+	})
+	mockClient.StubMatchOnce(
+		gqlmock.WithOpName("UpsertBucket"),
+		`{
+			"upsertBucket": {
+				"bucket": {
+					"config": "{\"_wandb\": {\"value\": {\"w\": {\"other\": {\"label\": \"node-1\", \"config\": {\"lr\": 0.2}}}}}}"
+				}
+			}
+		}`,
+	)
+
+	upserter, err := runupserter.InitRun(
+		runRecord(&spb.RunRecord{
+			Config: &spb.ConfigRecord{
+				Update: []*spb.ConfigItem{{Key: "lr", ValueJson: "0.1"}},
+			},
+		}),
+		params,
+	)
+	require.NoError(t, err)
+	upserter.Finish()
+
+	requests := mockClient.AllRequests()
+	require.NotEmpty(t, requests)
+	gqlmock.AssertVariables(t,
+		requests[0],
+		gqlmock.GQLVar("config", gqlmock.JSONEq(fmt.Sprintf(`
+				{
+					"lr": {"value": 0.1},
+					"_wandb": {"value": {
+						"m": [],
+						"t": {"12": "%s"},
+						"w": {"test": {
+							"label": "node-0",
+							"config": {"lr": 0.1},
+							"summary": {}
+						}}
+					}}
+				}
+			`, version.Version))))
+	messages := printer.Read()
+	require.Len(t, messages, 1)
+	assert.Contains(t, messages[0].Content, `config key "lr" is different`)
+	assert.Contains(t, messages[0].Content, `writer "node-1"`)
+	assert.NotContains(t, messages[0].Content, "0.2")
+}
+
+func TestInitRun_SharedMode_AppliesRedactionPolicy(t *testing.T) {
+	mockClient := gqlmock.NewMockClient()
+	params := testParams(t)
+	params.GraphqlClientOrNil = mockClient
+	params.Settings = settings.From(&spb.Settings{
+		XShared:    wrapperspb.Bool(true),
+		RedactDrop: &spb.ListStringValue{Value: []string{"config.db.password"}},
+		RedactHash: &spb.ListStringValue{Value: []string{"config.user"}},
+	})
+	runupsertertest.StubUpsertBucket(t, mockClient)
+
+	upserter, err := runupserter.InitRun(
+		runRecord(&spb.RunRecord{
+			Config: &spb.ConfigRecord{
+				Update: []*spb.ConfigItem{
+					{Key: "db", ValueJson: `{"password": "hunter2", "port": 1}`},
+					{NestedKey: []string{"db", "password"}, ValueJson: `"hunter3"`},
+					{NestedKey: []string{"user", "email"}, ValueJson: `"a@example.com"`},
+				},
+			},
+		}),
+		params,
+	)
+	require.NoError(t, err)
+	defer upserter.Finish()
+
+	requests := mockClient.AllRequests()
+	require.Len(t, requests, 1)
+	gqlmock.AssertVariables(t,
+		requests[0],
+		gqlmock.GQLVar("config", gqlmock.JSONEq(fmt.Sprintf(`
+				{
+					"db": {"value": {"port": 1}},
+					"user": {"value": "sha256:%[2]s"},
+					"_wandb": {"value": {
+						"m": [],
+						"t": {"12": "%[1]s"},
+						"w": {"test": {"config": {
+							"db": {"port": 1},
+							"user.email": "sha256:%[3]s"
+						}}}
+					}}
+				}
+			`,
+			version.Version,
+			"7f777fc16f227ee680879e727d3570771de182ef1de65af190f52b4dc878e6ed",
+			"08168cd80dfd534ab0f10af10f1303fe00af2d43ab5c1432360d137f8197e17a",
+		))))
+}
+
 func TestUpdateEnvironment_Uploads(t *testing.T) {
 	synctest.Test(t, func(t *testing.T) {
 		vars := setupUpdateTest(t)
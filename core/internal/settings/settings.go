@@ -59,6 +59,39 @@ func (s *Settings) IsSilent() bool {
 	return s.Proto.Silent.GetValue()
 }
 
+// Whether the client prints the end-of-run footer itself.
+//
+// Defaults to false, so that clients unaware of the setting get
+// the footer printed by wandb-core.
+func (s *Settings) IsClientPrintsFooter() bool {
+	return s.Proto.XClientPrintsFooter.GetValue()
+}
+
+// Whether to limit the output printed to the terminal.
+func (s *Settings) IsQuiet() bool {
+	return s.Proto.Quiet.GetValue()
+}
+
+// Maximum number of history sparklines to print at the end of a run.
+//
+// Defaults to 10 if not set.
+func (s *Settings) GetMaxEndOfRunHistoryMetrics() int {
+	if s.Proto.MaxEndOfRunHistoryMetrics == nil {
+		return 10
+	}
+	return int(s.Proto.MaxEndOfRunHistoryMetrics.GetValue())
+}
+
+// Maximum number of summary metrics to print at the end of a run.
+//
+// Defaults to 10 if not set.
+func (s *Settings) GetMaxEndOfRunSummaryMetrics() int {
+	if s.Proto.MaxEndOfRunSummaryMetrics == nil {
+		return 10
+	}
+	return int(s.Proto.MaxEndOfRunSummaryMetrics.GetValue())
+}
+
 // Whether we are in offline mode.
 func (s *Settings) IsOffline() bool {
 	return s.Proto.XOffline.GetValue()
@@ -132,6 +165,15 @@ func (s *Settings) GetFinishTimeout() time.Duration {
 	return time.Duration(timeoutMs) * time.Millisecond
 }
 
+// How long the W&B server can be unreachable before an online run switches
+// to offline mode.
+//
+// Zero or negative if the run should never switch.
+func (s *Settings) GetOfflineFallbackTimeout() time.Duration {
+	timeoutMs := int64(s.Proto.OfflineFallbackTimeout.GetValue() * 1000)
+	return time.Duration(timeoutMs) * time.Millisecond
+}
+
 // The time the client waits for the response to a run initialization
 // request, after which it gives up.
 //
@@ -196,6 +238,21 @@ func (s *Settings) GetIgnoreGlobs() []string {
 	return s.Proto.IgnoreGlobs.GetValue()
 }
 
+// Patterns of config, summary and metadata values to remove before upload.
+func (s *Settings) GetRedactDrop() []string {
+	return s.Proto.RedactDrop.GetValue()
+}
+
+// Patterns of config, summary and metadata values to hash before upload.
+func (s *Settings) GetRedactHash() []string {
+	return s.Proto.RedactHash.GetValue()
+}
+
+// Patterns of values exempt from GetRedactDrop and GetRedactHash.
+func (s *Settings) GetRedactKeep() []string {
+	return s.Proto.RedactKeep.GetValue()
+}
+
 // The directory for syncing the run from the transaction log.
 func (s *Settings) GetSyncDir() string {
 	return s.Proto.SyncDir.GetValue()
@@ -269,6 +326,17 @@ func (s *Settings) GetFileStreamMaxLineBytes() int32 {
 	return s.Proto.XFileStreamMaxLineBytes.GetValue()
 }
 
+// Number of history values per second above which uploaded history is
+// thinned, or zero to disable throttling.
+func (s *Settings) GetHistoryUploadBudget() int32 {
+	return s.Proto.XHistoryUploadBudget.GetValue()
+}
+
+// How history is thinned when over the upload budget.
+func (s *Settings) GetHistoryUploadThrottle() string {
+	return s.Proto.XHistoryUploadThrottle.GetValue()
+}
+
 // Maximum number of retries for file upload/download operations.
 func (s *Settings) GetFileTransferMaxRetries() int32 {
 	return s.Proto.XFileTransferRetryMax.GetValue()
@@ -479,6 +547,23 @@ func (s *Settings) GetConsoleChunkMaxSeconds() int32 {
 	return s.Proto.ConsoleChunkMaxSeconds.GetValue()
 }
 
+// Log formats to parse in console output, such as "python" or "json".
+func (s *Settings) GetConsoleLogParsers() []string {
+	return s.Proto.ConsoleLogParsers.GetValue()
+}
+
+// Whether to mask secrets in console output with the built-in detectors.
+//
+// Redaction is also enabled if GetConsoleRedactPatterns is not empty.
+func (s *Settings) IsConsoleRedactEnabled() bool {
+	return s.Proto.ConsoleRedact.GetValue()
+}
+
+// Additional regular expressions to redact from console output.
+func (s *Settings) GetConsoleRedactPatterns() []string {
+	return s.Proto.ConsoleRedactPatterns.GetValue()
+}
+
 // Whether to capture console logs in multipart format.
 //
 // This is used to make sure we don't overwrite the console log file if it
@@ -499,6 +584,16 @@ func (s *Settings) IsSaveCode() bool {
 	return s.Proto.SaveCode.GetValue()
 }
 
+// Whether to snapshot the full source tree into a code artifact.
+func (s *Settings) IsSaveCodeSnapshot() bool {
+	return s.Proto.SaveCodeSnapshot.GetValue()
+}
+
+// Maximum total size of a source snapshot, in bytes.
+func (s *Settings) GetSaveCodeSnapshotMaxBytes() int64 {
+	return s.Proto.SaveCodeSnapshotMaxBytes.GetValue()
+}
+
 // Whether to stop the run after an error that prevents further uploads.
 func (s *Settings) IsStopOnFatalError() bool {
 	return s.Proto.StopOnFatalError.GetValue()
@@ -656,6 +751,38 @@ func (s *Settings) GetStatsNoCgroup() bool {
 	return s.Proto.XStatsNoCgroup.GetValue()
 }
 
+// Glob patterns of network interfaces to report per-interface rates for.
+func (s *Settings) GetStatsNetworkInterfaces() []string {
+	return s.Proto.XStatsNetworkInterfaces.GetValue()
+}
+
+// Glob patterns of network interfaces to exclude from per-interface rates.
+func (s *Settings) GetStatsNetworkInterfacesExclude() []string {
+	return s.Proto.XStatsNetworkInterfacesExclude.GetValue()
+}
+
+// Glob patterns of disk devices to report per-device rates for.
+func (s *Settings) GetStatsDiskDevices() []string {
+	return s.Proto.XStatsDiskDevices.GetValue()
+}
+
+// Glob patterns of disk devices to exclude from per-device rates.
+func (s *Settings) GetStatsDiskDevicesExclude() []string {
+	return s.Proto.XStatsDiskDevicesExclude.GetValue()
+}
+
+// The number of processes to include in process table snapshots.
+//
+// Snapshots are disabled if this is not positive.
+func (s *Settings) GetStatsTopProcesses() int {
+	return int(s.Proto.XStatsTopProcesses.GetValue())
+}
+
+// Whether to log wandb-core's internal state as system metrics.
+func (s *Settings) GetStatsCoreMetrics() bool {
+	return s.Proto.XStatsCoreMetrics.GetValue()
+}
+
 // The label for the run namespacing for console output and system metrics.
 func (s *Settings) GetLabel() string {
 	return s.Proto.XLabel.GetValue()
@@ -0,0 +1,353 @@
+package sharedmode
+
+import (
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/wandb/simplejsonext"
+
+	"github.com/wandb/wandb/core/internal/redact"
+	spb "github.com/wandb/wandb/core/pkg/service_go_proto"
+)
+
+// ConflictKind is the part of the run in which a conflict happened.
+type ConflictKind string
+
+const (
+	ConfigConflict  ConflictKind = "config"
+	SummaryConflict ConflictKind = "summary"
+)
+
+// Conflict is a key set to different values by two writers.
+type Conflict struct {
+	Kind ConflictKind
+
+	// Key is the dot-separated path of the conflicting key.
+	Key string
+
+	// Other is the writer that set a different value.
+	Other Writer
+
+	// Value and OtherValue are the JSON-encoded values set by this writer
+	// and by the other writer.
+	Value      string
+	OtherValue string
+}
+
+// String describes the conflict for the user.
+//
+// Values are left out, since they may be secrets.
+func (c Conflict) String() string {
+	return fmt.Sprintf(
+		"%s key %q is different on writer %q",
+		c.Kind, c.Key, c.Other.Name(),
+	)
+}
+
+type conflictID struct {
+	kind  ConflictKind
+	key   string
+	other ClientID
+}
+
+// Attribution tracks the config and summary values set by one writer of
+// a shared-mode run.
+//
+// Without attribution, writers overwrite each other's values and the run
+// shows whichever was uploaded last. Attribution data is stored in the run
+// config under "_wandb.w" keyed by writer ID, like the environment info under
+// "_wandb.e", so every writer can see what the others set and report keys
+// that they disagree on.
+//
+// Values are tracked with the run's redaction policy applied, since they're
+// uploaded in the config.
+//
+// Keys starting with an underscore are internal and are not tracked. Summary
+// values of keys logged to history are tracked but are not reported as
+// conflicts, since metrics such as per-node losses usually differ between
+// writers.
+type Attribution struct {
+	mu sync.Mutex
+
+	writer Writer
+
+	// policy removes or hashes values before they're tracked.
+	policy *redact.Policy
+
+	// config and summary map dot-separated keys to JSON-encoded values.
+	config  map[string]string
+	summary map[string]string
+
+	// historyKeys are the dot-separated keys this writer logged to history.
+	historyKeys map[string]struct{}
+
+	// changed is whether values changed since the last call to TakeChanged.
+	changed bool
+
+	// conflicts are all conflicts found so far, in the order found.
+	conflicts []Conflict
+	reported  map[conflictID]struct{}
+}
+
+// NewAttribution returns an Attribution for the writer.
+//
+// The policy may be nil.
+func NewAttribution(writer Writer, policy *redact.Policy) *Attribution {
+	return &Attribution{
+		writer:      writer,
+		policy:      policy,
+		config:      make(map[string]string),
+		summary:     make(map[string]string),
+		historyKeys: make(map[string]struct{}),
+		reported:    make(map[conflictID]struct{}),
+	}
+}
+
+// RecordConfig tracks the config values set by this writer.
+func (a *Attribution) RecordConfig(record *spb.ConfigRecord) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	for _, item := range record.GetUpdate() {
+		path := keyPath(item.GetKey(), item.GetNestedKey())
+		if a.setValue(a.config, redact.RootConfig, path, item.GetValueJson()) {
+			a.changed = true
+		}
+	}
+	for _, item := range record.GetRemove() {
+		if removeValue(a.config, dottedKey(item.GetKey(), item.GetNestedKey())) {
+			a.changed = true
+		}
+	}
+}
+
+// RecordSummary tracks the summary values set by this writer.
+//
+// Returns whether a value that can conflict with other writers changed,
+// that is, one whose key was not logged to history.
+func (a *Attribution) RecordSummary(record *spb.SummaryRecord) bool {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	var canConflict bool
+	noteChange := func(key string, changed bool) {
+		if !changed {
+			return
+		}
+		a.changed = true
+		if _, isHistory := a.historyKeys[key]; !isHistory {
+			canConflict = true
+		}
+	}
+
+	for _, item := range record.GetUpdate() {
+		path := keyPath(item.GetKey(), item.GetNestedKey())
+		noteChange(
+			strings.Join(path, "."),
+			a.setValue(a.summary, redact.RootSummary, path, item.GetValueJson()),
+		)
+	}
+	for _, item := range record.GetRemove() {
+		key := dottedKey(item.GetKey(), item.GetNestedKey())
+		noteChange(key, removeValue(a.summary, key))
+	}
+
+	return canConflict
+}
+
+// RecordHistory tracks the history keys logged by this writer.
+func (a *Attribution) RecordHistory(record *spb.HistoryRecord) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	for _, item := range record.GetItem() {
+		key := dottedKey(item.GetKey(), item.GetNestedKey())
+		if key != "" && !strings.HasPrefix(key, "_") {
+			a.historyKeys[key] = struct{}{}
+		}
+	}
+}
+
+// TakeChanged reports whether any values changed since the last call.
+func (a *Attribution) TakeChanged() bool {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	changed := a.changed
+	a.changed = false
+	return changed
+}
+
+// ToRunConfigData returns the data to store in the "w" field of the run config.
+func (a *Attribution) ToRunConfigData() map[string]any {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	entry := map[string]any{
+		"config":  decodeValues(a.config),
+		"summary": decodeValues(a.summary),
+	}
+	if a.writer.Label != "" {
+		entry["label"] = a.writer.Label
+	}
+	if len(a.conflicts) > 0 {
+		conflicts := make([]any, 0, len(a.conflicts))
+		for _, c := range a.conflicts {
+			conflicts = append(conflicts, map[string]any{
+				"kind":   string(c.Kind),
+				"key":    c.Key,
+				"writer": string(c.Other.ID),
+			})
+		}
+		entry["conflicts"] = conflicts
+	}
+
+	return map[string]any{string(a.writer.ID): entry}
+}
+
+// FindConflicts compares this writer's values to those of other writers.
+//
+// runConfig is the run's config as last returned by the server, which
+// includes the attribution data uploaded by all writers. Only conflicts
+// not found by a previous call are returned.
+func (a *Attribution) FindConflicts(runConfig map[string]any) []Conflict {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	wandbData, _ := runConfig["_wandb"].(map[string]any)
+	writers, _ := wandbData["w"].(map[string]any)
+
+	var found []Conflict
+	for _, id := range slices.Sorted(maps.Keys(writers)) {
+		if id == string(a.writer.ID) {
+			continue
+		}
+
+		entry, ok := writers[id].(map[string]any)
+		if !ok {
+			continue
+		}
+		other := Writer{ID: ClientID(id)}
+		other.Label, _ = entry["label"].(string)
+
+		found = a.appendConflicts(found, ConfigConflict, other, a.config, entry["config"])
+		found = a.appendConflicts(found, SummaryConflict, other, a.summary, entry["summary"])
+	}
+
+	a.conflicts = append(a.conflicts, found...)
+	return found
+}
+
+func (a *Attribution) appendConflicts(
+	conflicts []Conflict,
+	kind ConflictKind,
+	other Writer,
+	ours map[string]string,
+	theirsData any,
+) []Conflict {
+	theirs, _ := theirsData.(map[string]any)
+
+	for _, key := range slices.Sorted(maps.Keys(ours)) {
+		if _, isHistory := a.historyKeys[key]; isHistory && kind == SummaryConflict {
+			continue
+		}
+
+		theirValue, ok := theirs[key]
+		if !ok {
+			continue
+		}
+
+		theirJSON, err := simplejsonext.MarshalToString(theirValue)
+		if err != nil || theirJSON == ours[key] {
+			continue
+		}
+
+		id := conflictID{kind: kind, key: key, other: other.ID}
+		if _, ok := a.reported[id]; ok {
+			continue
+		}
+		a.reported[id] = struct{}{}
+
+		conflicts = append(conflicts, Conflict{
+			Kind:       kind,
+			Key:        key,
+			Other:      other,
+			Value:      ours[key],
+			OtherValue: theirJSON,
+		})
+	}
+
+	return conflicts
+}
+
+// setValue stores a normalized and redacted copy of valueJSON unless the key
+// is internal or the value is invalid.
+//
+// A value dropped by the redaction policy is removed.
+//
+// Returns whether the stored value changed.
+func (a *Attribution) setValue(
+	values map[string]string,
+	root string,
+	keyPath []string,
+	valueJSON string,
+) bool {
+	key := strings.Join(keyPath, ".")
+	if key == "" || strings.HasPrefix(key, "_") {
+		return false
+	}
+
+	// Normalize the encoding so that values can be compared as strings.
+	value, err := simplejsonext.UnmarshalString(valueJSON)
+	if err != nil {
+		return false
+	}
+	value, ok := a.policy.ApplyValue(root, keyPath, value)
+	if !ok {
+		return removeValue(values, key)
+	}
+	normalized, err := simplejsonext.MarshalToString(value)
+	if err != nil {
+		return false
+	}
+
+	if old, ok := values[key]; ok && old == normalized {
+		return false
+	}
+	values[key] = normalized
+	return true
+}
+
+// removeValue deletes a key, returning whether it was present.
+func removeValue(values map[string]string, key string) bool {
+	if _, ok := values[key]; !ok {
+		return false
+	}
+	delete(values, key)
+	return true
+}
+
+func dottedKey(key string, nestedKey []string) string {
+	return strings.Join(keyPath(key, nestedKey), ".")
+}
+
+func keyPath(key string, nestedKey []string) []string {
+	if len(nestedKey) > 0 {
+		return nestedKey
+	}
+	return []string{key}
+}
+
+func decodeValues(values map[string]string) map[string]any {
+	decoded := make(map[string]any, len(values))
+	for key, valueJSON := range values {
+		value, err := simplejsonext.UnmarshalString(valueJSON)
+		if err == nil {
+			decoded[key] = value
+		}
+	}
+	return decoded
+}
@@ -0,0 +1,139 @@
+package sharedmode_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/wandb/wandb/core/internal/sharedmode"
+	spb "github.com/wandb/wandb/core/pkg/service_go_proto"
+)
+
+func TestToRunConfigData(t *testing.T) {
+	attribution := sharedmode.NewAttribution(
+		sharedmode.Writer{ID: "writer-a", Label: "node-0"}, nil)
+
+	attribution.RecordConfig(&spb.ConfigRecord{
+		Update: []*spb.ConfigItem{
+			{Key: "lr", ValueJson: "0.1"},
+			{NestedKey: []string{"opt", "name"}, ValueJson: `"adam"`},
+			{Key: "_wandb", ValueJson: `{"x": 1}`},
+			{Key: "removed", ValueJson: "1"},
+		},
+		Remove: []*spb.ConfigItem{{Key: "removed"}},
+	})
+	attribution.RecordSummary(&spb.SummaryRecord{
+		Update: []*spb.SummaryItem{
+			{Key: "acc", ValueJson: "0.9"},
+			{Key: "_runtime", ValueJson: "5"},
+		},
+	})
+
+	assert.Equal(t,
+		map[string]any{
+			"writer-a": map[string]any{
+				"label":   "node-0",
+				"config":  map[string]any{"lr": 0.1, "opt.name": "adam"},
+				"summary": map[string]any{"acc": 0.9},
+			},
+		},
+		attribution.ToRunConfigData())
+}
+
+func TestFindConflicts(t *testing.T) {
+	attribution := sharedmode.NewAttribution(sharedmode.Writer{ID: "writer-a"}, nil)
+	attribution.RecordConfig(&spb.ConfigRecord{
+		Update: []*spb.ConfigItem{
+			{Key: "lr", ValueJson: "0.1"},
+			{Key: "batch_size", ValueJson: "32"},
+		},
+	})
+	attribution.RecordSummary(&spb.SummaryRecord{
+		Update: []*spb.SummaryItem{{Key: "best", ValueJson: "1"}},
+	})
+	runConfig := map[string]any{
+		"_wandb": map[string]any{
+			"w": map[string]any{
+				"writer-a": map[string]any{
+					"config": map[string]any{"lr": 0.5},
+				},
+				"writer-b": map[string]any{
+					"label":   "node-1",
+					"config":  map[string]any{"lr": 0.2, "batch_size": int64(32)},
+					"summary": map[string]any{"best": int64(2)},
+				},
+			},
+		},
+	}
+
+	conflicts := attribution.FindConflicts(runConfig)
+	conflictsAgain := attribution.FindConflicts(runConfig)
+
+	other := sharedmode.Writer{ID: "writer-b", Label: "node-1"}
+	assert.Equal(t,
+		[]sharedmode.Conflict{
+			{
+				Kind:       sharedmode.ConfigConflict,
+				Key:        "lr",
+				Other:      other,
+				Value:      "0.1",
+				OtherValue: "0.2",
+			},
+			{
+				Kind:       sharedmode.SummaryConflict,
+				Key:        "best",
+				Other:      other,
+				Value:      "1",
+				OtherValue: "2",
+			},
+		},
+		conflicts)
+	assert.Empty(t, conflictsAgain)
+	assert.Equal(t,
+		[]any{
+			map[string]any{"kind": "config", "key": "lr", "writer": "writer-b"},
+			map[string]any{"kind": "summary", "key": "best", "writer": "writer-b"},
+		},
+		attribution.ToRunConfigData()["writer-a"].(map[string]any)["conflicts"])
+}
+
+func TestFindConflicts_IgnoresHistoryKeys(t *testing.T) {
+	attribution := sharedmode.NewAttribution(sharedmode.Writer{ID: "writer-a"}, nil)
+	attribution.RecordHistory(&spb.HistoryRecord{
+		Item: []*spb.HistoryItem{{Key: "loss", ValueJson: "0.5"}},
+	})
+
+	canConflict := attribution.RecordSummary(&spb.SummaryRecord{
+		Update: []*spb.SummaryItem{{Key: "loss", ValueJson: "0.5"}},
+	})
+
+	assert.False(t, canConflict)
+	assert.True(t, attribution.TakeChanged())
+	assert.False(t, attribution.TakeChanged())
+	assert.Empty(t, attribution.FindConflicts(map[string]any{
+		"_wandb": map[string]any{
+			"w": map[string]any{
+				"writer-b": map[string]any{
+					"summary": map[string]any{"loss": 0.7},
+				},
+			},
+		},
+	}))
+}
+
+func TestRecordSummary_ReportsChanges(t *testing.T) {
+	attribution := sharedmode.NewAttribution(sharedmode.Writer{ID: "writer-a"}, nil)
+	summary := &spb.SummaryRecord{
+		Update: []*spb.SummaryItem{{Key: "best", ValueJson: "1"}},
+	}
+
+	assert.True(t, attribution.RecordSummary(summary))
+	assert.False(t, attribution.RecordSummary(summary))
+}
+
+func TestWriterName(t *testing.T) {
+	assert.Equal(t, "node-0",
+		sharedmode.Writer{ID: "abcdefghijkl", Label: "node-0"}.Name())
+	assert.Equal(t, "abcdefgh",
+		sharedmode.Writer{ID: "abcdefghijkl"}.Name())
+}
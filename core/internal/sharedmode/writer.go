@@ -0,0 +1,30 @@
+package sharedmode
+
+// shortIDLength is the number of ID characters used to name an unlabeled
+// writer.
+const shortIDLength = 8
+
+// Writer is one of the processes writing to a run in "shared" mode.
+type Writer struct {
+	// ID uniquely identifies the writer.
+	ID ClientID
+
+	// Label is the user-provided label of the writer, such as a node name.
+	//
+	// It is empty if the x_label setting is not set.
+	Label string
+}
+
+// Name is a short, human-readable name for the writer.
+//
+// It is the writer's label if it has one, and a prefix of its ID otherwise.
+func (w Writer) Name() string {
+	if w.Label != "" {
+		return w.Label
+	}
+
+	if len(w.ID) > shortIDLength {
+		return string(w.ID[:shortIDLength])
+	}
+	return string(w.ID)
+}
@@ -5,6 +5,7 @@ import (
 
 	"github.com/google/wire"
 
+	"github.com/wandb/wandb/core/internal/coremetrics"
 	"github.com/wandb/wandb/core/internal/observability"
 	"github.com/wandb/wandb/core/internal/runhandle"
 	"github.com/wandb/wandb/core/internal/runwork"
@@ -16,8 +17,9 @@ var flowControlProviders = wire.NewSet(
 )
 
 type FlowControlFactory struct {
-	Logger    *observability.CoreLogger
-	RunHandle *runhandle.RunHandle
+	CoreMetrics *coremetrics.Registry
+	Logger      *observability.CoreLogger
+	RunHandle   *runhandle.RunHandle
 }
 
 // FlowControl is an infinite-buffered channel for Work.
@@ -32,6 +34,16 @@ type FlowControl struct {
 	flushWriter  func() error
 	logger       *observability.CoreLogger
 	recordParser RecordParser
+
+	// pause is FlowControlParams.Pause.
+	pause func() <-chan struct{}
+
+	// resumed is closed once held work may be forwarded, or nil if
+	// work isn't paused.
+	resumed <-chan struct{}
+
+	// held is saved work received while paused, in order.
+	held []FlowControlBufferItem
 }
 
 func (f *FlowControlFactory) New(
@@ -40,14 +52,19 @@ func (f *FlowControlFactory) New(
 	recordParser RecordParser,
 	params FlowControlParams,
 ) *FlowControl {
+	buffer := NewFlowControlBuffer(params, f.Logger, f.RunHandle)
+	buffer.RegisterMetrics(f.CoreMetrics)
+
 	return &FlowControl{
 		out:    make(chan runwork.Work),
-		buffer: NewFlowControlBuffer(params, f.Logger, f.RunHandle),
+		buffer: buffer,
 		reader: reader,
 
 		flushWriter:  flushWriter,
 		logger:       f.Logger,
 		recordParser: recordParser,
+
+		pause: params.Pause,
 	}
 }
 
@@ -80,25 +97,105 @@ func (fc *FlowControl) loopFlushBuffer() {
 	defer fc.reader.Close()
 
 	for {
-		item := fc.buffer.Get()
-		if item == nil {
+		if !fc.isPaused() {
+			fc.forwardHeld()
+		}
+
+		item, ok := fc.buffer.GetUntil(fc.resumed)
+		switch {
+		case !ok:
+			// Resumed, so held work is forwarded on the next iteration.
+
+		case item == nil:
+			fc.forwardHeld()
 			return
+
+		case fc.resumed != nil:
+			fc.holdOrForward(item)
+
+		default:
+			fc.forward(item)
+		}
+	}
+}
+
+// isPaused reports whether saved work must be held back.
+func (fc *FlowControl) isPaused() bool {
+	if fc.resumed != nil {
+		if !isClosed(fc.resumed) {
+			return true
 		}
 
-		item.Switch(
-			func(work runwork.Work) {
-				fc.out <- work
-			},
-			func(chunk *FlowControlBufferSavedChunk) {
-				err := fc.readSavedChunk(chunk)
-
-				if err != nil {
-					fc.logger.CaptureError("stream", err, "chunk", chunk)
-					fc.buffer.StopOffloading()
-				}
-			},
-		)
+		fc.resumed = nil
 	}
+
+	if fc.pause != nil {
+		fc.resumed = fc.pause()
+	}
+
+	return fc.resumed != nil
+}
+
+// holdOrForward holds back saved work and forwards the rest.
+//
+// Saved work is held as a chunk of the transaction log to be read later,
+// unless offloading was stopped, in which case it's kept in memory.
+func (fc *FlowControl) holdOrForward(item FlowControlBufferItem) {
+	switch x := item.(type) {
+	case *FlowControlBufferSavedChunk:
+		fc.holdChunk(x)
+
+	case *FlowControlBufferWork:
+		switch {
+		case x.Saved == nil:
+			fc.forward(x)
+		case fc.buffer.offloadingCancelled.Load():
+			fc.held = append(fc.held, x)
+		default:
+			fc.holdChunk(x.Saved)
+		}
+	}
+}
+
+// holdChunk appends saved records to the held work.
+func (fc *FlowControl) holdChunk(chunk *FlowControlBufferSavedChunk) {
+	if len(fc.held) > 0 {
+		last, isChunk := fc.held[len(fc.held)-1].(*FlowControlBufferSavedChunk)
+
+		if isChunk && chunk.InitialNumber == last.InitialNumber+last.Count {
+			last.Count += chunk.Count
+			return
+		}
+	}
+
+	fc.held = append(fc.held, chunk)
+}
+
+// forwardHeld forwards all held work.
+func (fc *FlowControl) forwardHeld() {
+	held := fc.held
+	fc.held = nil
+
+	for _, item := range held {
+		fc.forward(item)
+	}
+}
+
+// forward pushes an item's work to the output.
+func (fc *FlowControl) forward(item FlowControlBufferItem) {
+	item.Switch(
+		func(work runwork.Work) {
+			fc.out <- work
+		},
+		func(chunk *FlowControlBufferSavedChunk) {
+			err := fc.readSavedChunk(chunk)
+
+			if err != nil {
+				fc.logger.CaptureError("stream", err, "chunk", chunk)
+				fc.buffer.StopOffloading()
+			}
+		},
+	)
 }
 
 // readSavedChunk forwards data from the transaction log.
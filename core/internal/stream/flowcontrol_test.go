@@ -194,3 +194,45 @@ func TestStopsDiscardingOnStoreError(t *testing.T) {
 			"record 0 in chunk had number 123, not 1")
 	})
 }
+
+func TestPause_HoldsSavedWorkAndForwardsRequests(t *testing.T) {
+	r, w := transactionlogtest.ReaderWriter(t)
+	resumed := make(chan struct{})
+	isPaused := true
+	x := setup(t, r, w, stream.FlowControlParams{
+		InMemorySize: 32,
+		Limit:        10,
+		Pause: func() <-chan struct{} {
+			if !isPaused {
+				return nil
+			}
+			return resumed
+		},
+	})
+	savedWork := runwork.NoRequest(&runworktest.NoopWork{Value: "saved"})
+	request := runwork.NoRequest(runwork.WorkFromRecord(&spb.Record{
+		RecordType: &spb.Record_Request{Request: &spb.Request{
+			RequestType: &spb.Request_NetworkStatus{
+				NetworkStatus: &spb.NetworkStatusRequest{},
+			},
+		}},
+	}))
+	x.MockRecordParser.EXPECT().Parse(gomock.Any()).
+		Return(savedWork.WorkImpl).
+		Times(2)
+
+	x.Input <- savedWork
+	x.Input <- savedWork
+	x.Input <- request
+
+	assert.Equal(t, request, <-x.Output)
+
+	isPaused = false
+	close(resumed)
+	close(x.Input)
+	assert.Equal(t, savedWork, <-x.Output)
+	assert.Equal(t, savedWork, <-x.Output)
+	for range x.Output {
+		t.Error("unexpected output")
+	}
+}
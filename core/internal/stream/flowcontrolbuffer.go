@@ -4,6 +4,7 @@ import (
 	"sync"
 	"sync/atomic"
 
+	"github.com/wandb/wandb/core/internal/coremetrics"
 	"github.com/wandb/wandb/core/internal/observability"
 	"github.com/wandb/wandb/core/internal/runhandle"
 	"github.com/wandb/wandb/core/internal/runwork"
@@ -44,6 +45,13 @@ type FlowControlBuffer struct {
 	// It is reset to 0 once Get is called when the channel is empty.
 	backedUpCount atomic.Uint32
 
+	// inMemoryCount is the number of work items kept in memory.
+	inMemoryCount atomic.Int64
+
+	// offloadedCount is the number of saved records that were discarded
+	// and have yet to be read back from the transaction log.
+	offloadedCount atomic.Int64
+
 	// sentOverflowTelemetry is whether the flow control overflow telemetry
 	// flag has already been set.
 	sentOverflowTelemetry bool
@@ -54,6 +62,10 @@ type FlowControlBuffer struct {
 
 type FlowControlBufferWork struct {
 	Work runwork.Work
+
+	// Saved is the work's location in the transaction log, or nil if
+	// the work can't be read back from it.
+	Saved *FlowControlBufferSavedChunk
 }
 
 type FlowControlBufferSavedChunk struct {
@@ -109,6 +121,15 @@ type FlowControlParams struct {
 	// of the channel---if too much unsaved Work is received, calls to Add
 	// begin to block.
 	Limit int
+
+	// Pause is called to check whether saved Work must be held back.
+	//
+	// It returns nil if saved Work may be forwarded, or a channel that's
+	// closed once it may be forwarded again. Held back Work is read from
+	// the transaction log later, and unsaved Work is forwarded meanwhile.
+	//
+	// If nil, Work is never held back.
+	Pause func() <-chan struct{}
 }
 
 func NewFlowControlBuffer(
@@ -138,10 +159,20 @@ func (buf *FlowControlBuffer) Add(work runwork.MaybeSavedWork) {
 	if !work.IsSaved || work.Work.Request != nil ||
 		buf.offloadingCancelled.Load() ||
 		(len(buf.data) < buf.inMemorySize && buf.backedUpCount.Load() == 0) {
-		buf.push(&FlowControlBufferWork{Work: work.Work})
+		item := &FlowControlBufferWork{Work: work.Work}
+		if work.IsSaved && work.Work.Request == nil {
+			item.Saved = &FlowControlBufferSavedChunk{
+				InitialOffset: work.SavedOffset,
+				InitialNumber: work.RecordNumber,
+				Count:         1,
+			}
+		}
+
+		buf.push(item)
 		return
 	}
 
+	buf.offloadedCount.Add(1)
 	if buf.backedUpCount.Add(1) == 1 {
 		buf.logger.Info(
 			"flowcontrol: backed up, offloading to disk",
@@ -160,6 +191,16 @@ func (buf *FlowControlBuffer) Add(work runwork.MaybeSavedWork) {
 	})
 }
 
+// RegisterMetrics reports the size of the buffer to the registry.
+func (buf *FlowControlBuffer) RegisterMetrics(registry *coremetrics.Registry) {
+	registry.Gauge("flow_control.in_memory_work", func() float64 {
+		return float64(buf.inMemoryCount.Load())
+	})
+	registry.Gauge("flow_control.offloaded_records", func() float64 {
+		return float64(buf.offloadedCount.Load())
+	})
+}
+
 // setFlowControlOverflowTelemetry records that the run hit flow control
 // in its telemetry.
 func (buf *FlowControlBuffer) setFlowControlOverflowTelemetry() {
@@ -183,6 +224,10 @@ func (buf *FlowControlBuffer) push(workOrSavedChunk FlowControlBufferItem) {
 	buf.lastItem = workOrSavedChunk
 	buf.lastItemMu.Unlock()
 
+	if _, isWork := workOrSavedChunk.(*FlowControlBufferWork); isWork {
+		buf.inMemoryCount.Add(1)
+	}
+
 	buf.data <- workOrSavedChunk
 }
 
@@ -222,15 +267,33 @@ func (buf *FlowControlBuffer) Close() {
 // If the buffer is empty, this blocks until either it is closed or an item
 // is added. Returns nil if the buffer is closed.
 func (buf *FlowControlBuffer) Get() FlowControlBufferItem {
-	return buf.get(false)
+	return buf.get(false, nil)
 }
 
 // TryGet is like Get, but returns nil if the buffer is empty.
 func (buf *FlowControlBuffer) TryGet() FlowControlBufferItem {
-	return buf.get(true)
+	return buf.get(true, nil)
+}
+
+// GetUntil is like Get, but stops waiting once done is closed.
+//
+// The second return value is false if done was closed before an item
+// was available. A nil done channel is never closed.
+func (buf *FlowControlBuffer) GetUntil(
+	done <-chan struct{},
+) (FlowControlBufferItem, bool) {
+	item := buf.get(false, done)
+	if item == nil && isClosed(done) {
+		return nil, false
+	}
+
+	return item, true
 }
 
-func (buf *FlowControlBuffer) get(nonblocking bool) FlowControlBufferItem {
+func (buf *FlowControlBuffer) get(
+	nonblocking bool,
+	done <-chan struct{},
+) FlowControlBufferItem {
 	var item FlowControlBufferItem
 
 	select {
@@ -247,7 +310,11 @@ func (buf *FlowControlBuffer) get(nonblocking bool) FlowControlBufferItem {
 			return nil
 		}
 
-		item = <-buf.data
+		select {
+		case item = <-buf.data:
+		case <-done:
+			return nil
+		}
 	}
 
 	buf.lastItemMu.Lock()
@@ -256,5 +323,27 @@ func (buf *FlowControlBuffer) get(nonblocking bool) FlowControlBufferItem {
 	}
 	buf.lastItemMu.Unlock()
 
+	// The item can no longer grow, so its count is final.
+	switch x := item.(type) {
+	case *FlowControlBufferWork:
+		buf.inMemoryCount.Add(-1)
+	case *FlowControlBufferSavedChunk:
+		buf.offloadedCount.Add(-x.Count)
+	}
+
 	return item
 }
+
+// isClosed reports whether the channel is non-nil and closed.
+func isClosed(ch <-chan struct{}) bool {
+	if ch == nil {
+		return false
+	}
+
+	select {
+	case <-ch:
+		return true
+	default:
+		return false
+	}
+}
@@ -5,6 +5,7 @@ import (
 
 	"github.com/stretchr/testify/assert"
 
+	"github.com/wandb/wandb/core/internal/coremetrics"
 	"github.com/wandb/wandb/core/internal/observabilitytest"
 	"github.com/wandb/wandb/core/internal/runhandle"
 	"github.com/wandb/wandb/core/internal/runwork"
@@ -145,3 +146,25 @@ func TestBackedUp_AfterCleared_StoresInMemory(t *testing.T) {
 
 	assert.IsType(t, &stream.FlowControlBufferWork{}, buf.Get())
 }
+
+func TestRegisterMetrics_ReportsBacklog(t *testing.T) {
+	buf := stream.NewFlowControlBuffer(stream.FlowControlParams{
+		InMemorySize: 1,
+		Limit:        10,
+	}, observabilitytest.NewTestLogger(t), runhandle.New())
+	registry := coremetrics.New()
+	buf.RegisterMetrics(registry)
+
+	buf.Add(newSavedWork("saved 1", 1, 10))
+	buf.Add(newSavedWork("saved 2", 2, 20))
+	buf.Add(newSavedWork("saved 3", 3, 30))
+	beforeGet := registry.Sample()
+	buf.Get()
+	buf.Get()
+	afterGet := registry.Sample()
+
+	assert.EqualValues(t, 1, beforeGet["_wandb/core.flow_control.in_memory_work"])
+	assert.EqualValues(t, 2, beforeGet["_wandb/core.flow_control.offloaded_records"])
+	assert.EqualValues(t, 0, afterGet["_wandb/core.flow_control.in_memory_work"])
+	assert.EqualValues(t, 0, afterGet["_wandb/core.flow_control.offloaded_records"])
+}
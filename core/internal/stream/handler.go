@@ -6,6 +6,8 @@ import (
 	"os"
 	"path/filepath"
 	"strconv"
+	"sync"
+	"sync/atomic"
 	"time"
 
 	"golang.org/x/time/rate"
@@ -13,6 +15,7 @@ import (
 
 	"github.com/google/wire"
 
+	"github.com/wandb/wandb/core/internal/codesnapshot"
 	"github.com/wandb/wandb/core/internal/filetransfer"
 	"github.com/wandb/wandb/core/internal/fileutil"
 	"github.com/wandb/wandb/core/internal/gitops"
@@ -20,6 +23,9 @@ import (
 	"github.com/wandb/wandb/core/internal/monitor"
 	"github.com/wandb/wandb/core/internal/observability"
 	"github.com/wandb/wandb/core/internal/pathtree"
+	"github.com/wandb/wandb/core/internal/profilecapture"
+	"github.com/wandb/wandb/core/internal/randomid"
+	"github.com/wandb/wandb/core/internal/runfooter"
 	"github.com/wandb/wandb/core/internal/runhistory"
 	"github.com/wandb/wandb/core/internal/runmetric"
 	"github.com/wandb/wandb/core/internal/runsummary"
@@ -29,6 +35,7 @@ import (
 	"github.com/wandb/wandb/core/internal/version"
 	"github.com/wandb/wandb/core/internal/wboperation"
 
+	"github.com/wandb/wandb/core/pkg/launch"
 	spb "github.com/wandb/wandb/core/pkg/service_go_proto"
 )
 
@@ -63,6 +70,10 @@ type Handler struct {
 	// commit is the W&B Git commit hash
 	commit GitCommitHash
 
+	// extraWork accepts work produced in the background, such as captured
+	// profiles.
+	extraWork runwork.ExtraWork
+
 	// fileTransferStats reports file upload/download statistics
 	fileTransferStats filetransfer.FileTransferStats
 
@@ -90,6 +101,18 @@ type Handler struct {
 	// pollExitLogRateLimit limits log messages when handling PollExit requests
 	pollExitLogRateLimit *rate.Limiter
 
+	// profileCapturing is set while a profile is being captured.
+	profileCapturing atomic.Bool
+
+	// stagingDirsMu protects stagingDirs.
+	stagingDirsMu sync.Mutex
+
+	// stagingDirs are the staging directories of profiles and code snapshots
+	// sent as artifacts.
+	//
+	// They are removed by RemoveStagingDirs once the artifacts are uploaded.
+	stagingDirs []string
+
 	// runHistorySampler tracks samples of all metrics in the run's history.
 	//
 	// This is used to display the sparkline in the terminal at the end of
@@ -129,6 +152,7 @@ func (f *HandlerFactory) New(extraWork runwork.ExtraWork) *Handler {
 
 	return &Handler{
 		commit:               f.Commit,
+		extraWork:            extraWork,
 		fileTransferStats:    f.FileTransferStats,
 		fwdChan:              make(chan runwork.Work, BufferSize),
 		logger:               f.Logger,
@@ -327,6 +351,8 @@ func (h *Handler) handleRequest(
 		h.handleRequestOperations(record, request)
 	case *spb.Request_ProbeSystemInfo:
 		h.handleRequestProbeSystemInfo(record)
+	case *spb.Request_CaptureProfile:
+		h.handleRequestCaptureProfile(x.CaptureProfile)
 	case nil:
 		h.logger.CaptureFatalAndPanic(
 			"stream",
@@ -526,6 +552,7 @@ func (h *Handler) handleRequestRunStart(
 	if h.settings.IsSaveCode() && !h.settings.IsDisableMachineInfo() {
 		h.handleCodeSave()
 		h.handlePatchSave()
+		h.handleCodeSnapshot()
 	}
 
 	h.respond(request, &spb.Response{})
@@ -535,6 +562,136 @@ func (h *Handler) handleRequestProbeSystemInfo(record *spb.Record) {
 	h.systemMonitor.Probe()
 }
 
+// handleRequestCaptureProfile starts capturing a performance profile of the
+// run in the background and logs it as an artifact when done.
+//
+// Requests made while a profile is being captured are ignored.
+func (h *Handler) handleRequestCaptureProfile(request *spb.CaptureProfileRequest) {
+	if !h.profileCapturing.CompareAndSwap(false, true) {
+		h.logger.Warn("handleRequestCaptureProfile: a capture is already in progress")
+		return
+	}
+
+	// Clamp before converting, as large values overflow a time.Duration.
+	seconds := min(
+		request.GetDurationSeconds(),
+		profilecapture.MaxDuration.Seconds(),
+	)
+
+	startTime := time.Now().UTC()
+	params := profilecapture.Params{
+		Pid:      h.settings.GetStatsPid(),
+		Duration: time.Duration(seconds * float64(time.Second)),
+		Label:    request.GetLabel(),
+		RunID:    h.settings.GetRunID(),
+		Dir: filepath.Join(
+			h.settings.GetSyncDir(),
+			"tmp",
+			"profile-"+startTime.Format("20060102T150405Z"),
+		),
+		Logger: h.logger,
+	}
+
+	go func() {
+		defer h.profileCapturing.Store(false)
+		h.captureProfile(params)
+	}()
+}
+
+// captureProfile captures a profile and sends it as an artifact.
+//
+// The capture is abandoned if the run ends first.
+func (h *Handler) captureProfile(params profilecapture.Params) {
+	ctx := h.extraWork.BeforeEndCtx()
+
+	system := monitor.NewSystem(monitor.SystemParams{
+		Pid:                         params.Pid,
+		TrackProcessTree:            h.settings.GetStatsTrackProcessTree(),
+		DisableCgroupResourceLimits: h.settings.GetStatsNoCgroup(),
+		DiskPaths:                   h.settings.GetStatsDiskPaths(),
+		NetworkInterfaces:           h.settings.GetStatsNetworkInterfaces(),
+		NetworkInterfacesExclude:    h.settings.GetStatsNetworkInterfacesExclude(),
+		DiskDevices:                 h.settings.GetStatsDiskDevices(),
+		DiskDevicesExclude:          h.settings.GetStatsDiskDevicesExclude(),
+	})
+	params.Sample = system.Sample
+
+	h.logger.Info("captureProfile: started", "duration", params.Duration)
+	profile, err := profilecapture.Capture(ctx, params)
+	if err != nil {
+		h.logger.Error("captureProfile: failed to capture", "error", err)
+		h.removeStagingDir(params.Dir)
+		return
+	}
+
+	artifact, err := profile.Artifact(
+		h.newArtifactRecord(fmt.Sprintf("profile-%s", h.settings.GetRunID())),
+	)
+	if err != nil {
+		h.logger.Error("captureProfile: failed to build artifact", "error", err)
+		h.removeStagingDir(params.Dir)
+		return
+	}
+
+	h.addStagingDir(params.Dir)
+
+	h.extraWork.AddWorkOrCancel(
+		ctx.Done(),
+		runwork.NoRequest(runwork.WorkFromRecord(
+			&spb.Record{RecordType: &spb.Record_Artifact{Artifact: artifact}},
+		)),
+	)
+}
+
+// addStagingDir records a staging directory to remove after upload.
+func (h *Handler) addStagingDir(dir string) {
+	h.stagingDirsMu.Lock()
+	h.stagingDirs = append(h.stagingDirs, dir)
+	h.stagingDirsMu.Unlock()
+}
+
+// RemoveStagingDirs deletes the staging directories of captured profiles
+// and code snapshots.
+//
+// It must only be called after their artifacts have been uploaded.
+// Offline runs keep them so that the artifacts can be uploaded by a sync.
+func (h *Handler) RemoveStagingDirs() {
+	h.stagingDirsMu.Lock()
+	dirs := h.stagingDirs
+	h.stagingDirs = nil
+	h.stagingDirsMu.Unlock()
+
+	for _, dir := range dirs {
+		h.removeStagingDir(dir)
+	}
+}
+
+// removeStagingDir deletes an artifact's staging directory.
+func (h *Handler) removeStagingDir(dir string) {
+	if err := os.RemoveAll(dir); err != nil {
+		h.logger.Error(
+			"handler: failed to remove staging directory",
+			"dir", dir,
+			"error", err,
+		)
+	}
+}
+
+// newArtifactRecord returns a record for a new version of the run's
+// artifact with the given name, aliased as "latest".
+func (h *Handler) newArtifactRecord(name string) *spb.ArtifactRecord {
+	return &spb.ArtifactRecord{
+		RunId:            h.settings.GetRunID(),
+		Project:          h.settings.GetProject(),
+		Entity:           h.settings.GetEntity(),
+		Name:             launch.MakeArtifactNameSafe(name),
+		Aliases:          []string{"latest"},
+		Finalize:         true,
+		ClientId:         randomid.GenerateAlphanumericSequence(128),
+		SequenceClientId: randomid.GenerateAlphanumericSequence(128),
+	}
+}
+
 func (h *Handler) handleRequestPythonPackages(
 	_ *spb.Record,
 	request *spb.PythonPackagesRequest,
@@ -626,6 +783,88 @@ func (h *Handler) handleCodeSave() {
 	h.fwdRecord(record, nil)
 }
 
+// handleCodeSnapshot uploads the run's full source tree as a code artifact.
+//
+// The snapshot can be large, so it is captured in the background to avoid
+// delaying the start of the run.
+func (h *Handler) handleCodeSnapshot() {
+	if !h.settings.IsSaveCodeSnapshot() || !h.settings.IsPrimary() {
+		return
+	}
+
+	go h.captureCodeSnapshot()
+}
+
+// captureCodeSnapshot captures the source tree and sends it as an artifact.
+//
+// Files are copied into the run's directory first so that the artifact
+// reflects the code at the start of the run even if it is uploaded later,
+// as for offline runs. The snapshot is dropped if the run ends before it
+// is captured.
+func (h *Handler) captureCodeSnapshot() {
+	ctx := h.extraWork.BeforeEndCtx()
+
+	gitRoot := h.settings.GetGitRoot()
+	if h.settings.IsDisableGit() {
+		gitRoot = ""
+	}
+	var programDir string
+	if program := h.settings.GetProgramAbsolutePath(); program != "" {
+		programDir = filepath.Dir(program)
+	}
+
+	snapshot, err := codesnapshot.Capture(codesnapshot.Params{
+		GitRoot:     gitRoot,
+		ProgramDir:  programDir,
+		ExcludeDirs: []string{h.settings.GetWandbDir()},
+		MaxBytes:    h.settings.GetSaveCodeSnapshotMaxBytes(),
+		Logger:      h.logger,
+	})
+	if err != nil {
+		h.logger.Error("captureCodeSnapshot: failed to capture", "error", err)
+		return
+	}
+	if len(snapshot.Skipped) > 0 {
+		h.logger.Warn(
+			"captureCodeSnapshot: skipped files over the size cap",
+			"count", len(snapshot.Skipped),
+		)
+	}
+
+	stagingDir := filepath.Join(h.settings.GetSyncDir(), "tmp", "code_snapshot")
+	staged, err := snapshot.Stage(stagingDir)
+	if err != nil {
+		h.logger.Error("captureCodeSnapshot: failed to stage files", "error", err)
+		h.removeStagingDir(stagingDir)
+		return
+	}
+
+	nameSuffix := h.settings.GetProgramRelativePath()
+	if nameSuffix == "" {
+		nameSuffix = h.settings.GetRunID()
+	}
+	artifact, err := snapshot.Artifact(
+		h.newArtifactRecord(
+			fmt.Sprintf("source-%s-%s", h.settings.GetProject(), nameSuffix),
+		),
+		staged,
+	)
+	if err != nil {
+		h.logger.Error("captureCodeSnapshot: failed to build artifact", "error", err)
+		h.removeStagingDir(stagingDir)
+		return
+	}
+
+	h.addStagingDir(stagingDir)
+
+	h.extraWork.AddWorkOrCancel(
+		ctx.Done(),
+		runwork.NoRequest(runwork.WorkFromRecord(
+			&spb.Record{RecordType: &spb.Record_Artifact{Artifact: artifact}},
+		)),
+	)
+}
+
 func (h *Handler) handlePatchSave() {
 	// capture git state
 	if h.settings.IsDisableGit() || h.settings.IsDisableMachineInfo() || !h.settings.IsPrimary() {
@@ -1164,3 +1403,23 @@ func (h *Handler) handleRequestSampledHistory(
 		},
 	})
 }
+
+// FooterParams returns the run's data to show in the terminal footer.
+//
+// It must not be called while the handler is processing work.
+func (h *Handler) FooterParams() runfooter.Params {
+	params := runfooter.Params{
+		History:           h.runHistorySampler.Get(),
+		Summary:           h.runSummary.TopLevelStats(),
+		BestSummaryType:   h.metricHandler.BestSummaryType,
+		MaxHistoryMetrics: h.settings.GetMaxEndOfRunHistoryMetrics(),
+		MaxSummaryMetrics: h.settings.GetMaxEndOfRunSummaryMetrics(),
+	}
+
+	if h.fileTransferStats != nil {
+		params.FileCounts = h.fileTransferStats.GetFileCounts()
+		params.FileStats = h.fileTransferStats.GetFilesStats()
+	}
+
+	return params
+}
@@ -39,6 +39,7 @@ type RecordParserFactory struct {
 	GraphqlClientOrNil graphql.Client
 	Logger             *observability.CoreLogger
 	Operations         *wboperation.WandbOperations
+	Printer            *observability.Printer
 	RunHandle          *runhandle.RunHandle
 
 	ClientID sharedmode.ClientID
@@ -81,6 +82,7 @@ func (p *recordParser) Parse(record *spb.Record) runwork.WorkImpl {
 			FeatureProvider:    p.FeatureProvider,
 			GraphqlClientOrNil: p.GraphqlClientOrNil,
 			Logger:             p.Logger,
+			PrinterOrNil:       p.Printer,
 			ClientID:           string(p.ClientID),
 			SyncStateStore:     p.syncStateStore,
 		}
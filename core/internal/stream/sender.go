@@ -2,25 +2,36 @@ package stream
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
+	"maps"
 	"os"
 	"path/filepath"
+	"slices"
+	"strconv"
 	"sync"
 	"sync/atomic"
 	"time"
 
 	"github.com/Khan/genqlient/graphql"
 	"github.com/google/wire"
+	"github.com/wandb/simplejsonext"
+	"google.golang.org/protobuf/proto"
 
 	"github.com/wandb/wandb/core/internal/api"
+	"github.com/wandb/wandb/core/internal/circuitbreaker"
 	"github.com/wandb/wandb/core/internal/featurechecker"
 	fs "github.com/wandb/wandb/core/internal/filestream"
 	"github.com/wandb/wandb/core/internal/filetransfer"
 	"github.com/wandb/wandb/core/internal/gql"
+	"github.com/wandb/wandb/core/internal/historythrottle"
+	"github.com/wandb/wandb/core/internal/logparse"
 	"github.com/wandb/wandb/core/internal/mailbox"
+	"github.com/wandb/wandb/core/internal/mutationqueue"
 	"github.com/wandb/wandb/core/internal/observability"
 	"github.com/wandb/wandb/core/internal/paths"
+	"github.com/wandb/wandb/core/internal/redact"
 	"github.com/wandb/wandb/core/internal/runconsolelogs"
 	"github.com/wandb/wandb/core/internal/runfiles"
 	"github.com/wandb/wandb/core/internal/runhandle"
@@ -43,6 +54,7 @@ var SenderProviders = wire.NewSet(
 // SenderFactory constructs a Sender.
 type SenderFactory struct {
 	BaseURL                 api.WBBaseURL
+	CircuitBreaker          *circuitbreaker.Breaker
 	ClientID                sharedmode.ClientID
 	CredentialProvider      api.CredentialProvider
 	Logger                  *observability.CoreLogger
@@ -55,6 +67,7 @@ type SenderFactory struct {
 	FileWatcher             watcher.Watcher
 	RunfilesUploaderFactory *runfiles.UploaderFactory
 	GraphqlClient           graphql.Client
+	MutationQueue           *mutationqueue.Queue
 	Peeker                  *observability.Peeker
 	Printer                 *observability.Printer
 	RunHandle               *runhandle.RunHandle
@@ -83,6 +96,10 @@ type Sender struct {
 	// graphqlClient is the graphql client
 	graphqlClient graphql.Client
 
+	// mutationQueueOrNil saves GraphQL requests that fail while
+	// the server can't be reached. It is nil when offline.
+	mutationQueueOrNil *mutationqueue.Queue
+
 	// fileStream is the file stream
 	fileStream fs.FileStream
 
@@ -128,6 +145,14 @@ type Sender struct {
 
 	// consoleLogsSender uploads captured console output.
 	consoleLogsSender *runconsolelogs.Sender
+
+	// consoleSummaryCounts are the console output statistics last written
+	// to the run summary, such as the number of lines at each log level.
+	consoleSummaryCounts map[string]int64
+
+	// historyThrottleOrNil thins the uploaded history when the run logs
+	// faster than the configured budget.
+	historyThrottleOrNil *historythrottle.Throttler
 }
 
 // New returns a new Sender.
@@ -138,6 +163,7 @@ func (f *SenderFactory) New(runWork runwork.RunWork) *Sender {
 			runWork,
 			f.FileStreamFactory,
 			f.BaseURL,
+			f.CircuitBreaker,
 			f.ClientID,
 			f.CredentialProvider,
 			f.Logger,
@@ -186,6 +212,59 @@ func (f *SenderFactory) New(runWork runwork.RunWork) *Sender {
 		Structured:            structuredConsoleLogs,
 	}
 
+	logParser, err := logparse.FromNames(f.Settings.GetConsoleLogParsers())
+	if err != nil {
+		f.Logger.CaptureError(
+			"stream",
+			fmt.Errorf("sender: not parsing console logs: %v", err),
+		)
+	} else {
+		consoleLogsSenderParams.LogParser = logParser
+	}
+
+	redactPatterns := f.Settings.GetConsoleRedactPatterns()
+	if f.Settings.IsConsoleRedactEnabled() || len(redactPatterns) > 0 {
+		var detectors []redact.Detector
+		if f.Settings.IsConsoleRedactEnabled() {
+			detectors = redact.BuiltinDetectors
+		}
+
+		redactor, err := redact.New(detectors, redactPatterns)
+		if err != nil {
+			// Fall back to the built-in detectors rather than uploading
+			// output the user expected to be redacted.
+			f.Logger.CaptureError(
+				"stream",
+				fmt.Errorf("sender: ignoring console redaction patterns: %v", err),
+			)
+			f.Printer.Warnf(
+				"Ignoring console_redact_patterns: %v."+
+					" Console output is only redacted with the built-in patterns.",
+				err,
+			)
+			redactor, _ = redact.New(redact.BuiltinDetectors, nil)
+		}
+
+		consoleLogsSenderParams.Redactor = redactor
+	}
+
+	var historyThrottle *historythrottle.Throttler
+	if budget := f.Settings.GetHistoryUploadBudget(); budget > 0 {
+		mode, err := historythrottle.ParseMode(
+			f.Settings.GetHistoryUploadThrottle())
+		if err != nil {
+			f.Logger.CaptureError(
+				"stream",
+				fmt.Errorf("sender: using stride throttling: %v", err),
+			)
+		}
+
+		historyThrottle = historythrottle.New(
+			historythrottle.Policy{Mode: mode, Budget: float64(budget)},
+			time.Now,
+		)
+	}
+
 	s := &Sender{
 		runWork:             runWork,
 		logger:              f.Logger,
@@ -203,13 +282,16 @@ func (f *SenderFactory) New(runWork runwork.RunWork) *Sender {
 			f.FileTransferManager,
 			useArtifactProjectEntityInfo,
 		),
-		networkPeeker:     f.Peeker,
-		printer:           f.Printer,
-		graphqlClient:     f.GraphqlClient,
-		mailbox:           f.Mailbox,
-		runHandle:         f.RunHandle,
-		runSummary:        runsummary.New(),
-		consoleLogsSender: runconsolelogs.New(consoleLogsSenderParams),
+		networkPeeker:      f.Peeker,
+		printer:            f.Printer,
+		graphqlClient:      f.GraphqlClient,
+		mutationQueueOrNil: f.MutationQueue,
+		mailbox:            f.Mailbox,
+		runHandle:          f.RunHandle,
+		runSummary:         runsummary.New(),
+		consoleLogsSender:  runconsolelogs.New(consoleLogsSenderParams),
+
+		historyThrottleOrNil: historyThrottle,
 	}
 
 	if !s.settings.IsOffline() && !s.settings.IsJobCreationDisabled() {
@@ -452,6 +534,10 @@ func (s *Sender) sendRequestRunStart(_ *spb.RunStartRequest) {
 
 	s.updateSettings()
 
+	if s.historyThrottleOrNil != nil {
+		upserter.UpdateHistoryThrottle(s.historyThrottleOrNil.ToRunConfigData())
+	}
+
 	runPath := upserter.RunPath()
 
 	if s.fileStream != nil {
@@ -607,6 +693,7 @@ func (s *Sender) finishRunSync(
 
 	// Upload the run's finalized summary and config.
 	s.mu.Lock()
+	s.updateConsoleSummary()
 	s.uploadSummaryFile()
 
 	upserter, _ := s.runHandle.Upserter()
@@ -637,6 +724,17 @@ func (s *Sender) finishRunSync(
 		s.fileTransferManager.Close()
 	}
 
+	// Make a last attempt to send GraphQL requests that failed while
+	// the server couldn't be reached.
+	remaining := s.mutationQueueOrNil.Finish(s.runWork.BeforeEndCtx())
+	if remaining > 0 {
+		s.printer.Warnf(
+			"%d request(s) could not be sent and were saved to retry"+
+				" with `wandb sync`.",
+			remaining,
+		)
+	}
+
 	// Mark the run finished.
 	if s.fileStream != nil {
 		if exitRecord.NotComplete || !s.settings.ShouldUpdateFinishState() {
@@ -726,6 +824,12 @@ func (s *Sender) uploadMetadataFile() {
 	}
 
 	environment, err := upserter.EnvironmentJSON()
+	if err == nil {
+		environment, err = redactMetadataJSON(
+			upserter.RedactionPolicy(),
+			environment,
+		)
+	}
 	if err != nil {
 		s.logger.CaptureError(
 			"stream",
@@ -753,6 +857,21 @@ func (s *Sender) uploadMetadataFile() {
 	}
 }
 
+// redactMetadataJSON applies the redaction policy to the contents of
+// the run's metadata file.
+func redactMetadataJSON(policy *redact.Policy, metadata []byte) ([]byte, error) {
+	if policy == nil {
+		return metadata, nil
+	}
+
+	var tree map[string]any
+	if err := json.Unmarshal(metadata, &tree); err != nil {
+		return nil, err
+	}
+
+	return json.MarshalIndent(policy.Apply(redact.RootMetadata, tree), "", "  ")
+}
+
 func (s *Sender) sendPreempting(record *spb.RunPreemptingRecord) {
 	if s.receivedExit {
 		s.logCalledAfterExit("sendPreempting")
@@ -813,11 +932,55 @@ func (s *Sender) sendHistory(record *spb.HistoryRecord) {
 		return
 	}
 
+	if upserter, _ := s.runHandle.Upserter(); upserter != nil {
+		upserter.UpdateHistory(record)
+	}
+
 	if s.fileStream == nil {
 		return
 	}
 
-	s.fileStream.StreamUpdate(&fs.HistoryUpdate{Record: record})
+	if s.historyThrottleOrNil == nil {
+		s.fileStream.StreamUpdate(&fs.HistoryUpdate{Record: record})
+		return
+	}
+
+	wasThinned := s.historyThrottleOrNil.Stats().ThinnedRows > 0
+	s.streamHistory(s.historyThrottleOrNil.Add(record))
+
+	// Record the first time history is thinned; the final stats are
+	// recorded when the run finishes.
+	if !wasThinned && s.historyThrottleOrNil.Stats().ThinnedRows > 0 {
+		s.updateHistoryThrottleConfig()
+	}
+}
+
+// flushHistoryThrottle uploads any history rows held back by the throttle
+// and records its final stats.
+func (s *Sender) flushHistoryThrottle() {
+	if s.historyThrottleOrNil == nil {
+		return
+	}
+
+	if s.fileStream != nil {
+		s.streamHistory(s.historyThrottleOrNil.Flush())
+	}
+	s.updateHistoryThrottleConfig()
+}
+
+// streamHistory sends history rows to the server.
+func (s *Sender) streamHistory(records []*spb.HistoryRecord) {
+	for _, record := range records {
+		s.fileStream.StreamUpdate(&fs.HistoryUpdate{Record: record})
+	}
+}
+
+// updateHistoryThrottleConfig records the history throttle's policy and
+// stats in the run's config.
+func (s *Sender) updateHistoryThrottleConfig() {
+	if upserter, _ := s.runHandle.Upserter(); upserter != nil {
+		upserter.UpdateHistoryThrottle(s.historyThrottleOrNil.ToRunConfigData())
+	}
 }
 
 func (s *Sender) sendSummary(_ *spb.Record, summary *spb.SummaryRecord) {
@@ -826,6 +989,15 @@ func (s *Sender) sendSummary(_ *spb.Record, summary *spb.SummaryRecord) {
 		return
 	}
 
+	s.updateSummary(summary)
+}
+
+// updateSummary applies changes to the run's summary and uploads them.
+func (s *Sender) updateSummary(summary *spb.SummaryRecord) {
+	if upserter, _ := s.runHandle.Upserter(); upserter != nil {
+		summary = redactSummary(upserter.RedactionPolicy(), summary)
+	}
+
 	updates := runsummary.FromProto(summary)
 	if err := updates.Apply(s.runSummary); err != nil {
 		s.logger.CaptureError(
@@ -837,6 +1009,74 @@ func (s *Sender) sendSummary(_ *spb.Record, summary *spb.SummaryRecord) {
 	if s.fileStream != nil {
 		s.fileStream.StreamUpdate(&fs.SummaryUpdate{Updates: updates})
 	}
+
+	if upserter, _ := s.runHandle.Upserter(); upserter != nil {
+		upserter.UpdateSummary(summary)
+	}
+}
+
+// redactSummary returns a copy of the summary changes with the redaction
+// policy applied.
+func redactSummary(
+	policy *redact.Policy,
+	summary *spb.SummaryRecord,
+) *spb.SummaryRecord {
+	if policy == nil {
+		return summary
+	}
+
+	redacted := &spb.SummaryRecord{Remove: summary.GetRemove()}
+
+	for _, item := range summary.GetUpdate() {
+		keyPath := item.GetNestedKey()
+		if len(keyPath) == 0 {
+			keyPath = []string{item.GetKey()}
+		}
+
+		value, err := simplejsonext.UnmarshalString(item.GetValueJson())
+		if err != nil {
+			// Leave it to runsummary to report the error.
+			redacted.Update = append(redacted.Update, item)
+			continue
+		}
+
+		value, ok := policy.ApplyValue(redact.RootSummary, keyPath, value)
+		if !ok {
+			continue
+		}
+
+		valueJSON, err := simplejsonext.MarshalToString(value)
+		if err != nil {
+			continue
+		}
+
+		redactedItem := proto.CloneOf(item)
+		redactedItem.ValueJson = valueJSON
+		redacted.Update = append(redacted.Update, redactedItem)
+	}
+
+	return redacted
+}
+
+// updateConsoleSummary records statistics about the console output,
+// like the number of log lines at each level, in the run's summary
+// if they changed.
+func (s *Sender) updateConsoleSummary() {
+	counts := s.consoleLogsSender.SummaryCounts()
+	if counts == nil || maps.Equal(counts, s.consoleSummaryCounts) {
+		return
+	}
+
+	summary := &spb.SummaryRecord{}
+	for _, key := range slices.Sorted(maps.Keys(counts)) {
+		summary.Update = append(summary.Update, &spb.SummaryItem{
+			Key:       key,
+			ValueJson: strconv.FormatInt(counts[key], 10),
+		})
+	}
+
+	s.consoleSummaryCounts = counts
+	s.updateSummary(summary)
 }
 
 func (s *Sender) uploadSummaryFile() {
@@ -1011,6 +1251,7 @@ func (s *Sender) sendOutputRaw(_ *spb.Record, outputRaw *spb.OutputRawRecord) {
 	}
 
 	s.consoleLogsSender.StreamLogs(outputRaw)
+	s.updateConsoleSummary()
 }
 
 func (s *Sender) sendOutputLogger(_ *spb.Record, outputLogger *spb.OutputLoggerRecord) {
@@ -1020,6 +1261,7 @@ func (s *Sender) sendOutputLogger(_ *spb.Record, outputLogger *spb.OutputLoggerR
 	}
 
 	s.consoleLogsSender.StreamLoggerOutput(outputLogger)
+	s.updateConsoleSummary()
 }
 
 func (s *Sender) sendAlert(_ *spb.Record, alert *spb.AlertRecord) {
@@ -1082,6 +1324,7 @@ func (s *Sender) sendExit(
 
 	s.receivedExit = true
 
+	s.flushHistoryThrottle()
 	s.startFinishRun(record, request)
 }
 
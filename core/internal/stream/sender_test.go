@@ -56,6 +56,7 @@ func makeSender(t *testing.T, client graphql.Client) testFixtures {
 	}
 	fileTransferManager := stream.NewFileTransferManager(
 		baseURL,
+		/*breaker=*/ nil,
 		filetransfer.NewFileTransferStats(),
 		logger,
 		settings,
@@ -6,14 +6,17 @@ import (
 	"os"
 	"path/filepath"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/Khan/genqlient/graphql"
 
 	"github.com/wandb/wandb/core/internal/analytics"
+	"github.com/wandb/wandb/core/internal/circuitbreaker"
 	"github.com/wandb/wandb/core/internal/featurechecker"
 	"github.com/wandb/wandb/core/internal/observability"
 	"github.com/wandb/wandb/core/internal/pfxout"
+	"github.com/wandb/wandb/core/internal/runfooter"
 	"github.com/wandb/wandb/core/internal/runhandle"
 	"github.com/wandb/wandb/core/internal/runsyncstate"
 	"github.com/wandb/wandb/core/internal/runwork"
@@ -91,6 +94,24 @@ type Stream struct {
 
 	// clientID is a unique ID for the stream
 	clientID sharedmode.ClientID
+
+	// breakerOrNil tracks whether the W&B server is reachable.
+	//
+	// While it's open, work is kept in the transaction log instead of
+	// being sent. It is nil if the run can't switch to offline mode.
+	breakerOrNil *circuitbreaker.Breaker
+
+	// printer shows messages to the user.
+	printer *observability.Printer
+
+	// exitCtx is cancelled once the run's exit record is received,
+	// after which work is no longer paused while the server is unreachable.
+	exitCtx    context.Context
+	cancelExit context.CancelFunc
+
+	// finishedOffline is whether the run finished while the server was
+	// unreachable, so that some of its data was only saved locally.
+	finishedOffline atomic.Bool
 }
 
 // DebugCorePath is the absolute path to the debug-core.log file.
@@ -98,6 +119,7 @@ type DebugCorePath string
 
 // NewStream creates a new stream.
 func NewStream(
+	breakerOrNil *circuitbreaker.Breaker,
 	clientID sharedmode.ClientID,
 	debugCorePath DebugCorePath,
 	featureProvider *featurechecker.FeatureProvider,
@@ -108,6 +130,7 @@ func NewStream(
 	logger *observability.CoreLogger,
 	otelProxy *analytics.OpenTelemetryProxy,
 	operations *wboperation.WandbOperations,
+	printer *observability.Printer,
 	recordParserFactory *RecordParserFactory,
 	senderFactory *SenderFactory,
 	s *settings.Settings,
@@ -132,6 +155,8 @@ func NewStream(
 		syncStateStore,
 	)
 
+	exitCtx, cancelExit := context.WithCancel(runWork.BeforeEndCtx())
+
 	stream := &Stream{
 		runWork:            runWork,
 		runHandle:          runHandle,
@@ -148,6 +173,10 @@ func NewStream(
 		flowControlFactory: flowControlFactory,
 		sender:             senderFactory.New(runWork),
 		clientID:           clientID,
+		breakerOrNil:       breakerOrNil,
+		printer:            printer,
+		exitCtx:            exitCtx,
+		cancelExit:         cancelExit,
 	}
 
 	logger.Info("stream: created new stream", "id", stream.settings.GetRunID())
@@ -214,12 +243,18 @@ func (s *Stream) maybeSavingToTransactionLog(
 		return work
 	}
 
+	var pause func() <-chan struct{}
+	if s.breakerOrNil != nil {
+		pause = s.pauseWhileOffline
+	}
+
 	writer := s.writerFactory.New(w)
 	flowControl := s.flowControlFactory.New(
 		r, writer.Flush, s.recordParser,
 		FlowControlParams{
 			InMemorySize: 32,   // Max records before off-loading.
 			Limit:        1024, // Max unsaved records before blocking.
+			Pause:        pause,
 		},
 	)
 
@@ -238,6 +273,48 @@ func (s *Stream) maybeSavingToTransactionLog(
 	return flowControl.Chan()
 }
 
+// pauseWhileOffline holds back saved work while the W&B server is
+// unreachable.
+//
+// Held work stays in the transaction log, from which FlowControl reads it
+// once the server is reachable again. Requests are not held back.
+// If the run's exit record is received while paused, the run's uploads are
+// aborted so that it can finish, and the remaining data must be uploaded
+// with `wandb sync`.
+//
+// It implements FlowControlParams.Pause.
+func (s *Stream) pauseWhileOffline() <-chan struct{} {
+	if !s.breakerOrNil.IsOpen() {
+		return nil
+	}
+
+	if s.exitCtx.Err() != nil {
+		s.finishOffline()
+		return nil
+	}
+
+	resumed := make(chan struct{})
+	go func() {
+		_ = s.breakerOrNil.WaitClosed(s.exitCtx)
+		close(resumed)
+	}()
+
+	return resumed
+}
+
+// finishOffline aborts the run's uploads because it's finishing while
+// the W&B server is unreachable.
+func (s *Stream) finishOffline() {
+	if s.finishedOffline.Swap(true) {
+		return
+	}
+
+	s.logger.Warn("stream: finishing while offline")
+	s.printer.Warnf(
+		"W&B is still unreachable; finishing the run offline.")
+	s.runWork.Abort()
+}
+
 // HandleRecord ingests a record from the client.
 func (s *Stream) HandleRecord(record *spb.Record, request *runwork.Request) {
 	s.logger.Debug("handling record", "record", record.GetRecordType())
@@ -247,6 +324,10 @@ func (s *Stream) HandleRecord(record *spb.Record, request *runwork.Request) {
 		Request:  request,
 	}
 
+	if record.GetExit() != nil {
+		s.cancelExit()
+	}
+
 	work.Schedule(&sync.WaitGroup{}, func() { s.runWork.AddWork(work) })
 }
 
@@ -260,8 +341,15 @@ func (s *Stream) Close() {
 	s.logger.Info("stream: finishing up")
 	s.runWork.Close()
 	s.wg.Wait()
+	s.breakerOrNil.Close()
+	s.cancelExit()
 	s.logger.Info("stream: all finished")
 
+	// Uploads are done, unless the run's data is being kept for a sync.
+	if !s.settings.IsOffline() && !s.finishedOffline.Load() {
+		s.handler.RemoveStagingDirs()
+	}
+
 	// All of the stream's goroutines have finished, so no more analytics
 	// will be recorded; flush what's pending.
 	shutdownCtx, cancel := context.WithTimeout(
@@ -319,6 +407,33 @@ func (s *Stream) FinishAndClose(exitCode int32) {
 	s.printFooter()
 }
 
+// CaptureProfile starts capturing a performance profile of the run with
+// default settings, as if requested by the client.
+func (s *Stream) CaptureProfile() {
+	s.HandleRecord(&spb.Record{
+		RecordType: &spb.Record_Request{
+			Request: &spb.Request{
+				RequestType: &spb.Request_CaptureProfile{
+					CaptureProfile: &spb.CaptureProfileRequest{},
+				},
+			},
+		},
+		Control: &spb.Control{Local: true},
+	}, nil)
+}
+
+// PrintFooterIfNeeded prints a footer after the client finishes the run,
+// unless the client prints its own.
+//
+// It must be called after Close.
+func (s *Stream) PrintFooterIfNeeded() {
+	if s.settings.IsClientPrintsFooter() {
+		return
+	}
+
+	s.printFooter()
+}
+
 func (s *Stream) printFooter() {
 	// Silent mode disables any footer output
 	if s.settings.IsSilent() {
@@ -330,7 +445,11 @@ func (s *Stream) printFooter() {
 	)
 
 	formatter.Println("")
-	if s.settings.IsOffline() {
+	if !s.settings.IsQuiet() {
+		s.printRunSummary(formatter)
+	}
+
+	if s.settings.IsOffline() || s.finishedOffline.Load() {
 		formatter.Println("You can sync this run to the cloud by running:")
 		formatter.Println(
 			pfxout.WithStyle(
@@ -369,6 +488,24 @@ func (s *Stream) printFooter() {
 	)
 }
 
+// printRunSummary prints the run's history, summary and upload stats.
+//
+// It must be called after the stream is closed.
+func (s *Stream) printRunSummary(formatter *pfxout.Formatter) {
+	params := s.handler.FooterParams()
+	if s.settings.IsOffline() || s.finishedOffline.Load() {
+		params.FileCounts = nil
+	}
+
+	lines := runfooter.Lines(params)
+	for _, line := range lines {
+		formatter.Println(line)
+	}
+	if len(lines) > 0 {
+		formatter.Println("")
+	}
+}
+
 // runURL returns the URL for the run if available, or else an error.
 func (s *Stream) runURL() (string, error) {
 	upserter, err := s.runHandle.Upserter()
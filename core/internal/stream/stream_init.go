@@ -3,23 +3,30 @@ package stream
 // This file contains functions to construct the objects used by a Stream.
 
 import (
+	"context"
 	"fmt"
 	"maps"
 	"net/http"
 	"net/url"
+	"time"
 
 	"github.com/Khan/genqlient/graphql"
+	"github.com/hashicorp/go-retryablehttp"
 	"golang.org/x/time/rate"
 
 	"github.com/wandb/wandb/core/internal/api"
+	"github.com/wandb/wandb/core/internal/circuitbreaker"
 	"github.com/wandb/wandb/core/internal/clients"
+	"github.com/wandb/wandb/core/internal/coremetrics"
 	"github.com/wandb/wandb/core/internal/filestream"
 	"github.com/wandb/wandb/core/internal/filetransfer"
 	"github.com/wandb/wandb/core/internal/httplayers"
+	"github.com/wandb/wandb/core/internal/mutationqueue"
 	"github.com/wandb/wandb/core/internal/observability"
 	"github.com/wandb/wandb/core/internal/runwork"
 	"github.com/wandb/wandb/core/internal/settings"
 	"github.com/wandb/wandb/core/internal/sharedmode"
+	"github.com/wandb/wandb/core/internal/wboperation"
 )
 
 // BaseURLFromSettings extracts the W&B server URL from W&B settings.
@@ -61,8 +68,113 @@ func CredentialsFromSettings(
 	return credentialProvider
 }
 
+// NewCircuitBreaker creates the breaker that switches the run to offline
+// mode while the W&B server can't be reached.
+//
+// It returns nil if the run is offline, if it has no transaction log to
+// save data into, or if the offline fallback is disabled by settings.
+func NewCircuitBreaker(
+	baseURL api.WBBaseURL,
+	logger *observability.CoreLogger,
+	operations *wboperation.WandbOperations,
+	printer *observability.Printer,
+	s *settings.Settings,
+) *circuitbreaker.Breaker {
+	if s.IsOffline() || s.IsSkipTransactionLog() {
+		return nil
+	}
+
+	probeClient := api.NewClient(api.ClientOptions{
+		RetryMax:           0,
+		NonRetryTimeout:    30 * time.Second,
+		Proxy:              s.GetProxyFn(),
+		ProxyConnectHeader: s.GetProxyConnectHeader(),
+		InsecureDisableSSL: s.IsInsecureDisableSSL(),
+		Logger:             logger.Logger,
+		PreRetryLayers:     httplayers.DefaultHeaders(s.GetExtraHTTPHeaders()),
+	})
+	healthURL := (*url.URL)(baseURL).JoinPath("healthz").String()
+
+	return circuitbreaker.New(circuitbreaker.Params{
+		OpenAfter: s.GetOfflineFallbackTimeout(),
+		Probe: func(ctx context.Context) error {
+			req, err := retryablehttp.NewRequestWithContext(
+				ctx,
+				http.MethodGet,
+				healthURL,
+				http.NoBody,
+			)
+			if err != nil {
+				return err
+			}
+
+			resp, err := probeClient.Do(req)
+			if err != nil {
+				return err
+			}
+			_ = resp.Body.Close()
+
+			if resp.StatusCode >= http.StatusInternalServerError {
+				return fmt.Errorf("got status %s", resp.Status)
+			}
+
+			return nil
+		},
+		Logger:     logger,
+		Operations: operations,
+		Printer:    printer,
+	})
+}
+
+// NewMutationQueue creates the GraphQL client used for the W&B backend.
+//
+// Replayable requests that fail because the server can't be reached are
+// saved next to the transaction log and retried later.
+//
+// If the offline setting is true, it returns nil.
+func NewMutationQueue(
+	baseURL api.WBBaseURL,
+	breaker *circuitbreaker.Breaker,
+	clientID sharedmode.ClientID,
+	credentialProvider api.CredentialProvider,
+	logger *observability.CoreLogger,
+	operations *wboperation.WandbOperations,
+	peeker *observability.Peeker,
+	s *settings.Settings,
+) *mutationqueue.Queue {
+	if s.IsOffline() {
+		return nil
+	}
+
+	client := newGraphQLClient(
+		baseURL,
+		breaker,
+		clientID,
+		credentialProvider,
+		logger,
+		peeker,
+		s,
+	)
+
+	// Without a transaction log, there's nothing for `wandb sync` to find.
+	var journalPath string
+	if !s.IsSkipTransactionLog() && s.GetTransactionLogPath() != "" {
+		journalPath = mutationqueue.JournalPath(s.GetTransactionLogPath())
+	}
+
+	return mutationqueue.New(mutationqueue.Params{
+		Client:      client,
+		JournalPath: journalPath,
+		Logger:      logger,
+		Operations:  operations,
+	})
+}
+
 // NewGraphQLClient creates a new GraphQL client.
 //
+// The client sends requests directly, without the circuit breaker or the
+// mutation queue of a run stream. It's meant for read-only API access.
+//
 // If the offline setting is true, it returns nil.
 func NewGraphQLClient(
 	baseURL api.WBBaseURL,
@@ -76,6 +188,37 @@ func NewGraphQLClient(
 		return nil
 	}
 
+	return newGraphQLClient(
+		baseURL,
+		nil, /*breaker*/
+		clientID,
+		credentialProvider,
+		logger,
+		peeker,
+		s,
+	)
+}
+
+// MutationQueueClient returns the run stream's client for GraphQL requests.
+//
+// If the offline setting is true, it returns nil.
+func MutationQueueClient(queueOrNil *mutationqueue.Queue) graphql.Client {
+	if queueOrNil == nil {
+		return nil
+	}
+
+	return queueOrNil
+}
+
+func newGraphQLClient(
+	baseURL api.WBBaseURL,
+	breakerOrNil *circuitbreaker.Breaker,
+	clientID sharedmode.ClientID,
+	credentialProvider api.CredentialProvider,
+	logger *observability.CoreLogger,
+	peeker *observability.Peeker,
+	s *settings.Settings,
+) graphql.Client {
 	extraHeaders := http.Header{}
 	maps.Copy(extraHeaders, s.GetExtraHTTPHeaders())
 
@@ -100,6 +243,7 @@ func NewGraphQLClient(
 		peeker,
 		s,
 		extraHeaders,
+		breakerOrNil.Layer(),
 	)
 }
 
@@ -107,6 +251,7 @@ func NewFileStream(
 	extraWork runwork.ExtraWork,
 	factory *filestream.FileStreamFactory,
 	baseURL api.WBBaseURL,
+	breaker *circuitbreaker.Breaker,
 	clientID sharedmode.ClientID,
 	credentialProvider api.CredentialProvider,
 	logger *observability.CoreLogger,
@@ -139,14 +284,19 @@ func NewFileStream(
 		Logger:             logger.Logger,
 
 		PreRetryLayers: httplayers.Concat(
+			breaker.Layer(),
 			api.NetworkPeeker(peeker),
 			httplayers.DefaultHeaders(fileStreamHeaders),
 			httplayers.LimitTo(baseURL, httplayers.Concat(
 				credentialProvider,
-				api.ResponseBasedRateLimiter(),
+				api.ResponseBasedRateLimiter(factory.CoreMetrics),
 			)),
 		),
 	}
+	if factory.CoreMetrics != nil {
+		retries := factory.CoreMetrics.Counter("filestream.retries")
+		opts.OnRetry = func() { retries.Add(1) }
+	}
 	if retryMax := s.GetFileStreamMaxRetries(); retryMax > 0 {
 		opts.RetryMax = int(retryMax)
 	}
@@ -175,8 +325,33 @@ func NewFileStream(
 	)
 }
 
+// NewCoreMetrics returns the registry for wandb-core's internal metrics.
+//
+// Returns nil unless the run reports them as system metrics.
+func NewCoreMetrics(
+	fileTransferStats filetransfer.FileTransferStats,
+	s *settings.Settings,
+) *coremetrics.Registry {
+	if !s.GetStatsCoreMetrics() || s.IsDisableStats() {
+		return nil
+	}
+
+	registry := coremetrics.New()
+	registry.Gauge("file_transfer.uploaded_mb", func() float64 {
+		stats := fileTransferStats.GetFilesStats()
+		return float64(stats.GetUploadedBytes()) / 1024 / 1024
+	})
+	registry.Gauge("file_transfer.pending_mb", func() float64 {
+		stats := fileTransferStats.GetFilesStats()
+		pending := stats.GetTotalBytes() - stats.GetUploadedBytes()
+		return float64(max(0, pending)) / 1024 / 1024
+	})
+	return registry
+}
+
 func NewFileTransferManager(
 	baseURL api.WBBaseURL,
+	breaker *circuitbreaker.Breaker,
 	fileTransferStats filetransfer.FileTransferStats,
 	logger *observability.CoreLogger,
 	s *settings.Settings,
@@ -199,7 +374,10 @@ func NewFileTransferManager(
 
 		InsecureDisableSSL: s.IsInsecureDisableSSL(),
 
-		PreRetryLayers: httplayers.DefaultHeaders(s.GetExtraHTTPHeaders()),
+		PreRetryLayers: httplayers.Concat(
+			breaker.Layer(),
+			httplayers.DefaultHeaders(s.GetExtraHTTPHeaders()),
+		),
 	}
 
 	if retryMax := s.GetFileTransferMaxRetries(); retryMax > 0 {
@@ -69,6 +69,17 @@ func (sm *StreamMux) popAllStreams() []*Stream {
 	return streams
 }
 
+// CaptureProfiles starts capturing a performance profile in every stream.
+func (sm *StreamMux) CaptureProfiles() {
+	sm.mutex.RLock()
+	streams := slices.Collect(maps.Values(sm.mux))
+	sm.mutex.RUnlock()
+
+	for _, stream := range streams {
+		stream.CaptureProfile()
+	}
+}
+
 // FinishAndCloseAllStreams closes all streams in the mux.
 //
 // Blocks until all streams are done.
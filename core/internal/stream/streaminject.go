@@ -41,6 +41,7 @@ var streamProviders = wire.NewSet(
 	BaseURLFromSettings,
 	CredentialsFromSettings,
 	featurechecker.New,
+	NewCoreMetrics,
 	filestream.FileStreamProviders,
 	filetransfer.NewFileTransferStats,
 	flowControlProviders,
@@ -48,7 +49,9 @@ var streamProviders = wire.NewSet(
 	mailbox.New,
 	monitor.SystemMonitorProviders,
 	NewFileTransferManager,
-	NewGraphQLClient,
+	MutationQueueClient,
+	NewCircuitBreaker,
+	NewMutationQueue,
 	provideFileWatcher,
 	providePrinter,
 	RecordParserProviders,
@@ -29,24 +29,29 @@ import (
 
 // InjectStream returns a new Stream.
 func InjectStream(commit GitCommitHash, xpuResourceManager *monitor.XPUResourceManager, debugCorePath DebugCorePath, logLevel slog.Level, settings2 *settings.Settings) *Stream {
-	clientID := sharedmode.RandomClientID()
 	streamStreamLoggerFile := openStreamLoggerFile(settings2)
 	sentryContext := streamSentryContext(settings2)
 	openTelemetryProxy := streamOTelProxy(settings2)
 	coreLogger := streamLogger(streamStreamLoggerFile, sentryContext, openTelemetryProxy, settings2, logLevel)
 	wbBaseURL := BaseURLFromSettings(coreLogger, settings2)
+	wandbOperations := wboperation.NewOperations()
+	printer := providePrinter()
+	breaker := NewCircuitBreaker(wbBaseURL, coreLogger, wandbOperations, printer, settings2)
+	clientID := sharedmode.RandomClientID()
 	credentialProvider := CredentialsFromSettings(coreLogger, settings2)
 	peeker := &observability.Peeker{}
-	client := NewGraphQLClient(wbBaseURL, clientID, credentialProvider, coreLogger, peeker, settings2)
+	queue := NewMutationQueue(wbBaseURL, breaker, clientID, credentialProvider, coreLogger, wandbOperations, peeker, settings2)
+	client := MutationQueueClient(queue)
 	featureProvider := featurechecker.New(client, coreLogger)
+	fileTransferStats := filetransfer.NewFileTransferStats()
+	registry := NewCoreMetrics(fileTransferStats, settings2)
 	runHandle := runhandle.New()
 	flowControlFactory := &FlowControlFactory{
-		Logger:    coreLogger,
-		RunHandle: runHandle,
+		CoreMetrics: registry,
+		Logger:      coreLogger,
+		RunHandle:   runHandle,
 	}
-	fileTransferStats := filetransfer.NewFileTransferStats()
 	mailboxMailbox := mailbox.New()
-	wandbOperations := wboperation.NewOperations()
 	systemMonitorFactory := &monitor.SystemMonitorFactory{
 		Logger:             coreLogger,
 		RunHandle:          runHandle,
@@ -54,8 +59,9 @@ func InjectStream(commit GitCommitHash, xpuResourceManager *monitor.XPUResourceM
 		XPUResourceManager: xpuResourceManager,
 		GraphqlClient:      client,
 		WriterID:           clientID,
+		Printer:            printer,
+		CoreMetrics:        registry,
 	}
-	printer := providePrinter()
 	handlerFactory := &HandlerFactory{
 		Commit:               commit,
 		FileTransferStats:    fileTransferStats,
@@ -71,19 +77,21 @@ func InjectStream(commit GitCommitHash, xpuResourceManager *monitor.XPUResourceM
 		GraphqlClientOrNil: client,
 		Logger:             coreLogger,
 		Operations:         wandbOperations,
+		Printer:            printer,
 		RunHandle:          runHandle,
 		ClientID:           clientID,
 		Settings:           settings2,
 	}
 	fileStreamFactory := &filestream.FileStreamFactory{
 		BaseURL:         wbBaseURL,
+		CoreMetrics:     registry,
 		FeatureProvider: featureProvider,
 		Logger:          coreLogger,
 		Operations:      wandbOperations,
 		Printer:         printer,
 		Settings:        settings2,
 	}
-	fileTransferManager := NewFileTransferManager(wbBaseURL, fileTransferStats, coreLogger, settings2)
+	fileTransferManager := NewFileTransferManager(wbBaseURL, breaker, fileTransferStats, coreLogger, settings2)
 	watcher := provideFileWatcher(coreLogger)
 	uploaderFactory := &runfiles.UploaderFactory{
 		FileTransfer: fileTransferManager,
@@ -96,6 +104,7 @@ func InjectStream(commit GitCommitHash, xpuResourceManager *monitor.XPUResourceM
 	}
 	senderFactory := &SenderFactory{
 		BaseURL:                 wbBaseURL,
+		CircuitBreaker:          breaker,
 		ClientID:                clientID,
 		CredentialProvider:      credentialProvider,
 		Logger:                  coreLogger,
@@ -108,6 +117,7 @@ func InjectStream(commit GitCommitHash, xpuResourceManager *monitor.XPUResourceM
 		FileWatcher:             watcher,
 		RunfilesUploaderFactory: uploaderFactory,
 		GraphqlClient:           client,
+		MutationQueue:           queue,
 		Peeker:                  peeker,
 		Printer:                 printer,
 		RunHandle:               runHandle,
@@ -121,7 +131,7 @@ func InjectStream(commit GitCommitHash, xpuResourceManager *monitor.XPUResourceM
 		Logger:   coreLogger,
 		Settings: settings2,
 	}
-	stream := NewStream(clientID, debugCorePath, featureProvider, flowControlFactory, client, handlerFactory, streamStreamLoggerFile, coreLogger, openTelemetryProxy, wandbOperations, recordParserFactory, senderFactory, settings2, runHandle, tbHandlerFactory, writerFactory)
+	stream := NewStream(breaker, clientID, debugCorePath, featureProvider, flowControlFactory, client, handlerFactory, streamStreamLoggerFile, coreLogger, openTelemetryProxy, wandbOperations, printer, recordParserFactory, senderFactory, settings2, runHandle, tbHandlerFactory, writerFactory)
 	return stream
 }
 
@@ -129,9 +139,11 @@ func InjectStream(commit GitCommitHash, xpuResourceManager *monitor.XPUResourceM
 
 var streamProviders = wire.NewSet(
 	NewStream, wire.Bind(new(api.Peeker), new(*observability.Peeker)), wire.Struct(new(observability.Peeker)), BaseURLFromSettings,
-	CredentialsFromSettings, featurechecker.New, filestream.FileStreamProviders, filetransfer.NewFileTransferStats, flowControlProviders,
+	CredentialsFromSettings, featurechecker.New, NewCoreMetrics, filestream.FileStreamProviders, filetransfer.NewFileTransferStats, flowControlProviders,
 	handlerProviders, mailbox.New, monitor.SystemMonitorProviders, NewFileTransferManager,
-	NewGraphQLClient,
+	MutationQueueClient,
+	NewCircuitBreaker,
+	NewMutationQueue,
 	provideFileWatcher,
 	providePrinter,
 	RecordParserProviders, runfiles.UploaderProviders, runhandle.New, SenderProviders, sharedmode.RandomClientID, streamLoggerProviders, tensorboard.TBHandlerProviders, wboperation.NewOperations, WriterProviders,
@@ -1,7 +1,7 @@
 // Code generated by protoc-gen-go. DO NOT EDIT.
 // versions:
-// 	protoc-gen-go v1.33.0
-// 	protoc        v5.29.1
+// 	protoc-gen-go v1.36.11
+// 	protoc        v7.34.1
 // source: core/internal/tensorboard/tbproto/tfevent.proto
 
 package tbproto
@@ -11,6 +11,7 @@ import (
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	reflect "reflect"
 	sync "sync"
+	unsafe "unsafe"
 )
 
 const (
@@ -26,10 +27,7 @@ const (
 //
 // We only include fields that are relevant to us.
 type TFEvent struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
+	state protoimpl.MessageState `protogen:"open.v1"`
 	// Timestamp of the event.
 	WallTime float64 `protobuf:"fixed64,1,opt,name=wall_time,json=wallTime,proto3" json:"wall_time,omitempty"`
 	// An event-specific "step" number, often used as the X axis in charts.
@@ -43,19 +41,20 @@ type TFEvent struct {
 	// Because of this, consecutive events in a tfevents file may have
 	// unrelated step numbers.
 	Step int64 `protobuf:"varint,2,opt,name=step,proto3" json:"step,omitempty"`
-	// Types that are assignable to What:
+	// Types that are valid to be assigned to What:
 	//
+	//	*TFEvent_FileVersion
 	//	*TFEvent_Summary
-	What isTFEvent_What `protobuf_oneof:"what"`
+	What          isTFEvent_What `protobuf_oneof:"what"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *TFEvent) Reset() {
 	*x = TFEvent{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_core_internal_tensorboard_tbproto_tfevent_proto_msgTypes[0]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+	mi := &file_core_internal_tensorboard_tbproto_tfevent_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
 func (x *TFEvent) String() string {
@@ -66,7 +65,7 @@ func (*TFEvent) ProtoMessage() {}
 
 func (x *TFEvent) ProtoReflect() protoreflect.Message {
 	mi := &file_core_internal_tensorboard_tbproto_tfevent_proto_msgTypes[0]
-	if protoimpl.UnsafeEnabled && x != nil {
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -95,16 +94,27 @@ func (x *TFEvent) GetStep() int64 {
 	return 0
 }
 
-func (m *TFEvent) GetWhat() isTFEvent_What {
-	if m != nil {
-		return m.What
+func (x *TFEvent) GetWhat() isTFEvent_What {
+	if x != nil {
+		return x.What
 	}
 	return nil
 }
 
+func (x *TFEvent) GetFileVersion() string {
+	if x != nil {
+		if x, ok := x.What.(*TFEvent_FileVersion); ok {
+			return x.FileVersion
+		}
+	}
+	return ""
+}
+
 func (x *TFEvent) GetSummary() *Summary {
-	if x, ok := x.GetWhat().(*TFEvent_Summary); ok {
-		return x.Summary
+	if x != nil {
+		if x, ok := x.What.(*TFEvent_Summary); ok {
+			return x.Summary
+		}
 	}
 	return nil
 }
@@ -113,11 +123,20 @@ type isTFEvent_What interface {
 	isTFEvent_What()
 }
 
+type TFEvent_FileVersion struct {
+	// The version of the file format, like "brain.Event:2".
+	//
+	// This is the first event in every file.
+	FileVersion string `protobuf:"bytes,3,opt,name=file_version,json=fileVersion,proto3,oneof"`
+}
+
 type TFEvent_Summary struct {
 	// A summary was generated.
 	Summary *Summary `protobuf:"bytes,5,opt,name=summary,proto3,oneof"`
 }
 
+func (*TFEvent_FileVersion) isTFEvent_What() {}
+
 func (*TFEvent_Summary) isTFEvent_What() {}
 
 // A TensorBoard "summary" event.
@@ -126,21 +145,18 @@ func (*TFEvent_Summary) isTFEvent_What() {}
 //
 // We only include fields that are relevant to us.
 type Summary struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
+	state protoimpl.MessageState `protogen:"open.v1"`
 	// Set of values for the summary.
-	Value []*Summary_Value `protobuf:"bytes,1,rep,name=value,proto3" json:"value,omitempty"`
+	Value         []*Summary_Value `protobuf:"bytes,1,rep,name=value,proto3" json:"value,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *Summary) Reset() {
 	*x = Summary{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_core_internal_tensorboard_tbproto_tfevent_proto_msgTypes[1]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+	mi := &file_core_internal_tensorboard_tbproto_tfevent_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
 func (x *Summary) String() string {
@@ -151,7 +167,7 @@ func (*Summary) ProtoMessage() {}
 
 func (x *Summary) ProtoReflect() protoreflect.Message {
 	mi := &file_core_internal_tensorboard_tbproto_tfevent_proto_msgTypes[1]
-	if protoimpl.UnsafeEnabled && x != nil {
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -176,21 +192,18 @@ func (x *Summary) GetValue() []*Summary_Value {
 // Information about which plugins are able to make use of a certain
 // summary value.
 type SummaryMetadata struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
+	state protoimpl.MessageState `protogen:"open.v1"`
 	// Data that associates a summary with a certain plugin.
-	PluginData *SummaryMetadata_PluginData `protobuf:"bytes,1,opt,name=plugin_data,json=pluginData,proto3" json:"plugin_data,omitempty"`
+	PluginData    *SummaryMetadata_PluginData `protobuf:"bytes,1,opt,name=plugin_data,json=pluginData,proto3" json:"plugin_data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *SummaryMetadata) Reset() {
 	*x = SummaryMetadata{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_core_internal_tensorboard_tbproto_tfevent_proto_msgTypes[2]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+	mi := &file_core_internal_tensorboard_tbproto_tfevent_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
 func (x *SummaryMetadata) String() string {
@@ -201,7 +214,7 @@ func (*SummaryMetadata) ProtoMessage() {}
 
 func (x *SummaryMetadata) ProtoReflect() protoreflect.Message {
 	mi := &file_core_internal_tensorboard_tbproto_tfevent_proto_msgTypes[2]
-	if protoimpl.UnsafeEnabled && x != nil {
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -224,12 +237,9 @@ func (x *SummaryMetadata) GetPluginData() *SummaryMetadata_PluginData {
 }
 
 type Summary_Image struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	Height int32 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
-	Width  int32 `protobuf:"varint,2,opt,name=width,proto3" json:"width,omitempty"`
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Height int32                  `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	Width  int32                  `protobuf:"varint,2,opt,name=width,proto3" json:"width,omitempty"`
 	// Encoded image data.
 	//
 	// The most common type is PNG, but the TB images plugin seems to also
@@ -237,15 +247,15 @@ type Summary_Image struct {
 	//
 	// https://github.com/tensorflow/tensorboard/blob/b56c65521cbccf3097414cbd7e30e55902e08cab/tensorboard/plugins/image/images_plugin.py#L31-L37
 	EncodedImageString []byte `protobuf:"bytes,4,opt,name=encoded_image_string,json=encodedImageString,proto3" json:"encoded_image_string,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
 }
 
 func (x *Summary_Image) Reset() {
 	*x = Summary_Image{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_core_internal_tensorboard_tbproto_tfevent_proto_msgTypes[3]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+	mi := &file_core_internal_tensorboard_tbproto_tfevent_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
 func (x *Summary_Image) String() string {
@@ -256,7 +266,7 @@ func (*Summary_Image) ProtoMessage() {}
 
 func (x *Summary_Image) ProtoReflect() protoreflect.Message {
 	mi := &file_core_internal_tensorboard_tbproto_tfevent_proto_msgTypes[3]
-	if protoimpl.UnsafeEnabled && x != nil {
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -293,10 +303,7 @@ func (x *Summary_Image) GetEncodedImageString() []byte {
 }
 
 type Summary_Value struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
+	state protoimpl.MessageState `protogen:"open.v1"`
 	// Tag name for the data.
 	Tag string `protobuf:"bytes,1,opt,name=tag,proto3" json:"tag,omitempty"`
 	// Which plugins may use this data.
@@ -306,22 +313,22 @@ type Summary_Value struct {
 	Metadata *SummaryMetadata `protobuf:"bytes,9,opt,name=metadata,proto3" json:"metadata,omitempty"`
 	// Value associated with the tag.
 	//
-	// Types that are assignable to Value:
+	// Types that are valid to be assigned to Value:
 	//
 	//	*Summary_Value_SimpleValue
 	//	*Summary_Value_Image
 	//	*Summary_Value_Histo
 	//	*Summary_Value_Tensor
-	Value isSummary_Value_Value `protobuf_oneof:"value"`
+	Value         isSummary_Value_Value `protobuf_oneof:"value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *Summary_Value) Reset() {
 	*x = Summary_Value{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_core_internal_tensorboard_tbproto_tfevent_proto_msgTypes[4]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+	mi := &file_core_internal_tensorboard_tbproto_tfevent_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
 func (x *Summary_Value) String() string {
@@ -332,7 +339,7 @@ func (*Summary_Value) ProtoMessage() {}
 
 func (x *Summary_Value) ProtoReflect() protoreflect.Message {
 	mi := &file_core_internal_tensorboard_tbproto_tfevent_proto_msgTypes[4]
-	if protoimpl.UnsafeEnabled && x != nil {
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -361,37 +368,45 @@ func (x *Summary_Value) GetMetadata() *SummaryMetadata {
 	return nil
 }
 
-func (m *Summary_Value) GetValue() isSummary_Value_Value {
-	if m != nil {
-		return m.Value
+func (x *Summary_Value) GetValue() isSummary_Value_Value {
+	if x != nil {
+		return x.Value
 	}
 	return nil
 }
 
 func (x *Summary_Value) GetSimpleValue() float32 {
-	if x, ok := x.GetValue().(*Summary_Value_SimpleValue); ok {
-		return x.SimpleValue
+	if x != nil {
+		if x, ok := x.Value.(*Summary_Value_SimpleValue); ok {
+			return x.SimpleValue
+		}
 	}
 	return 0
 }
 
 func (x *Summary_Value) GetImage() *Summary_Image {
-	if x, ok := x.GetValue().(*Summary_Value_Image); ok {
-		return x.Image
+	if x != nil {
+		if x, ok := x.Value.(*Summary_Value_Image); ok {
+			return x.Image
+		}
 	}
 	return nil
 }
 
 func (x *Summary_Value) GetHisto() *HistogramProto {
-	if x, ok := x.GetValue().(*Summary_Value_Histo); ok {
-		return x.Histo
+	if x != nil {
+		if x, ok := x.Value.(*Summary_Value_Histo); ok {
+			return x.Histo
+		}
 	}
 	return nil
 }
 
 func (x *Summary_Value) GetTensor() *TensorProto {
-	if x, ok := x.GetValue().(*Summary_Value_Tensor); ok {
-		return x.Tensor
+	if x != nil {
+		if x, ok := x.Value.(*Summary_Value_Tensor); ok {
+			return x.Tensor
+		}
 	}
 	return nil
 }
@@ -425,21 +440,18 @@ func (*Summary_Value_Histo) isSummary_Value_Value() {}
 func (*Summary_Value_Tensor) isSummary_Value_Value() {}
 
 type SummaryMetadata_PluginData struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
+	state protoimpl.MessageState `protogen:"open.v1"`
 	// The name of the plugin this data pertains to.
-	PluginName string `protobuf:"bytes,1,opt,name=plugin_name,json=pluginName,proto3" json:"plugin_name,omitempty"`
+	PluginName    string `protobuf:"bytes,1,opt,name=plugin_name,json=pluginName,proto3" json:"plugin_name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *SummaryMetadata_PluginData) Reset() {
 	*x = SummaryMetadata_PluginData{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_core_internal_tensorboard_tbproto_tfevent_proto_msgTypes[5]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+	mi := &file_core_internal_tensorboard_tbproto_tfevent_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
 func (x *SummaryMetadata_PluginData) String() string {
@@ -450,7 +462,7 @@ func (*SummaryMetadata_PluginData) ProtoMessage() {}
 
 func (x *SummaryMetadata_PluginData) ProtoReflect() protoreflect.Message {
 	mi := &file_core_internal_tensorboard_tbproto_tfevent_proto_msgTypes[5]
-	if protoimpl.UnsafeEnabled && x != nil {
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -474,76 +486,51 @@ func (x *SummaryMetadata_PluginData) GetPluginName() string {
 
 var File_core_internal_tensorboard_tbproto_tfevent_proto protoreflect.FileDescriptor
 
-var file_core_internal_tensorboard_tbproto_tfevent_proto_rawDesc = []byte{
-	0x0a, 0x2f, 0x63, 0x6f, 0x72, 0x65, 0x2f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2f,
-	0x74, 0x65, 0x6e, 0x73, 0x6f, 0x72, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x2f, 0x74, 0x62, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x2f, 0x74, 0x66, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x1a, 0x31, 0x63, 0x6f, 0x72, 0x65, 0x2f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c,
-	0x2f, 0x74, 0x65, 0x6e, 0x73, 0x6f, 0x72, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x2f, 0x74, 0x62, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x67, 0x72, 0x61, 0x6d, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2f, 0x69, 0x6e, 0x74, 0x65, 0x72,
-	0x6e, 0x61, 0x6c, 0x2f, 0x74, 0x65, 0x6e, 0x73, 0x6f, 0x72, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x2f,
-	0x74, 0x62, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x74, 0x65, 0x6e, 0x73, 0x6f, 0x72, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x22, 0x68, 0x0a, 0x07, 0x54, 0x46, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12,
-	0x1b, 0x0a, 0x09, 0x77, 0x61, 0x6c, 0x6c, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x01, 0x52, 0x08, 0x77, 0x61, 0x6c, 0x6c, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04,
-	0x73, 0x74, 0x65, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x73, 0x74, 0x65, 0x70,
-	0x12, 0x24, 0x0a, 0x07, 0x73, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x08, 0x2e, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x48, 0x00, 0x52, 0x07, 0x73,
-	0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x42, 0x06, 0x0a, 0x04, 0x77, 0x68, 0x61, 0x74, 0x22, 0x89,
-	0x03, 0x0a, 0x07, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x12, 0x24, 0x0a, 0x05, 0x76, 0x61,
-	0x6c, 0x75, 0x65, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x53, 0x75, 0x6d, 0x6d,
-	0x61, 0x72, 0x79, 0x2e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
-	0x1a, 0x67, 0x0a, 0x05, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x69,
-	0x67, 0x68, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68,
-	0x74, 0x12, 0x14, 0x0a, 0x05, 0x77, 0x69, 0x64, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05,
-	0x52, 0x05, 0x77, 0x69, 0x64, 0x74, 0x68, 0x12, 0x30, 0x0a, 0x14, 0x65, 0x6e, 0x63, 0x6f, 0x64,
-	0x65, 0x64, 0x5f, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x18,
-	0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x12, 0x65, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x64, 0x49, 0x6d,
-	0x61, 0x67, 0x65, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x1a, 0xee, 0x01, 0x0a, 0x05, 0x56, 0x61,
-	0x6c, 0x75, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x74, 0x61, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x03, 0x74, 0x61, 0x67, 0x12, 0x2c, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74,
-	0x61, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72,
-	0x79, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64,
-	0x61, 0x74, 0x61, 0x12, 0x23, 0x0a, 0x0c, 0x73, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x5f, 0x76, 0x61,
-	0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x02, 0x48, 0x00, 0x52, 0x0b, 0x73, 0x69, 0x6d,
-	0x70, 0x6c, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x26, 0x0a, 0x05, 0x69, 0x6d, 0x61, 0x67,
-	0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72,
-	0x79, 0x2e, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x48, 0x00, 0x52, 0x05, 0x69, 0x6d, 0x61, 0x67, 0x65,
-	0x12, 0x27, 0x0a, 0x05, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x0f, 0x2e, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x67, 0x72, 0x61, 0x6d, 0x50, 0x72, 0x6f, 0x74, 0x6f,
-	0x48, 0x00, 0x52, 0x05, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x12, 0x26, 0x0a, 0x06, 0x74, 0x65, 0x6e,
-	0x73, 0x6f, 0x72, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x54, 0x65, 0x6e, 0x73,
-	0x6f, 0x72, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x48, 0x00, 0x52, 0x06, 0x74, 0x65, 0x6e, 0x73, 0x6f,
-	0x72, 0x42, 0x07, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x7e, 0x0a, 0x0f, 0x53, 0x75,
-	0x6d, 0x6d, 0x61, 0x72, 0x79, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x3c, 0x0a,
-	0x0b, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x4d, 0x65, 0x74, 0x61,
-	0x64, 0x61, 0x74, 0x61, 0x2e, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x52,
-	0x0a, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x1a, 0x2d, 0x0a, 0x0a, 0x50,
-	0x6c, 0x75, 0x67, 0x69, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x6c, 0x75,
-	0x67, 0x69, 0x6e, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a,
-	0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x4e, 0x61, 0x6d, 0x65, 0x42, 0x3a, 0x5a, 0x38, 0x67, 0x69,
-	0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x77, 0x61, 0x6e, 0x64, 0x62, 0x2f, 0x77,
-	0x61, 0x6e, 0x64, 0x62, 0x2f, 0x63, 0x6f, 0x72, 0x65, 0x2f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e,
-	0x61, 0x6c, 0x2f, 0x74, 0x65, 0x6e, 0x73, 0x6f, 0x72, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x2f, 0x74,
-	0x62, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
-}
+const file_core_internal_tensorboard_tbproto_tfevent_proto_rawDesc = "" +
+	"\n" +
+	"/core/internal/tensorboard/tbproto/tfevent.proto\x1a1core/internal/tensorboard/tbproto/histogram.proto\x1a.core/internal/tensorboard/tbproto/tensor.proto\"\x8d\x01\n" +
+	"\aTFEvent\x12\x1b\n" +
+	"\twall_time\x18\x01 \x01(\x01R\bwallTime\x12\x12\n" +
+	"\x04step\x18\x02 \x01(\x03R\x04step\x12#\n" +
+	"\ffile_version\x18\x03 \x01(\tH\x00R\vfileVersion\x12$\n" +
+	"\asummary\x18\x05 \x01(\v2\b.SummaryH\x00R\asummaryB\x06\n" +
+	"\x04what\"\x89\x03\n" +
+	"\aSummary\x12$\n" +
+	"\x05value\x18\x01 \x03(\v2\x0e.Summary.ValueR\x05value\x1ag\n" +
+	"\x05Image\x12\x16\n" +
+	"\x06height\x18\x01 \x01(\x05R\x06height\x12\x14\n" +
+	"\x05width\x18\x02 \x01(\x05R\x05width\x120\n" +
+	"\x14encoded_image_string\x18\x04 \x01(\fR\x12encodedImageString\x1a\xee\x01\n" +
+	"\x05Value\x12\x10\n" +
+	"\x03tag\x18\x01 \x01(\tR\x03tag\x12,\n" +
+	"\bmetadata\x18\t \x01(\v2\x10.SummaryMetadataR\bmetadata\x12#\n" +
+	"\fsimple_value\x18\x02 \x01(\x02H\x00R\vsimpleValue\x12&\n" +
+	"\x05image\x18\x04 \x01(\v2\x0e.Summary.ImageH\x00R\x05image\x12'\n" +
+	"\x05histo\x18\x05 \x01(\v2\x0f.HistogramProtoH\x00R\x05histo\x12&\n" +
+	"\x06tensor\x18\b \x01(\v2\f.TensorProtoH\x00R\x06tensorB\a\n" +
+	"\x05value\"~\n" +
+	"\x0fSummaryMetadata\x12<\n" +
+	"\vplugin_data\x18\x01 \x01(\v2\x1b.SummaryMetadata.PluginDataR\n" +
+	"pluginData\x1a-\n" +
+	"\n" +
+	"PluginData\x12\x1f\n" +
+	"\vplugin_name\x18\x01 \x01(\tR\n" +
+	"pluginNameB:Z8github.com/wandb/wandb/core/internal/tensorboard/tbprotob\x06proto3"
 
 var (
 	file_core_internal_tensorboard_tbproto_tfevent_proto_rawDescOnce sync.Once
-	file_core_internal_tensorboard_tbproto_tfevent_proto_rawDescData = file_core_internal_tensorboard_tbproto_tfevent_proto_rawDesc
+	file_core_internal_tensorboard_tbproto_tfevent_proto_rawDescData []byte
 )
 
 func file_core_internal_tensorboard_tbproto_tfevent_proto_rawDescGZIP() []byte {
 	file_core_internal_tensorboard_tbproto_tfevent_proto_rawDescOnce.Do(func() {
-		file_core_internal_tensorboard_tbproto_tfevent_proto_rawDescData = protoimpl.X.CompressGZIP(file_core_internal_tensorboard_tbproto_tfevent_proto_rawDescData)
+		file_core_internal_tensorboard_tbproto_tfevent_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_core_internal_tensorboard_tbproto_tfevent_proto_rawDesc), len(file_core_internal_tensorboard_tbproto_tfevent_proto_rawDesc)))
 	})
 	return file_core_internal_tensorboard_tbproto_tfevent_proto_rawDescData
 }
 
 var file_core_internal_tensorboard_tbproto_tfevent_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
-var file_core_internal_tensorboard_tbproto_tfevent_proto_goTypes = []interface{}{
+var file_core_internal_tensorboard_tbproto_tfevent_proto_goTypes = []any{
 	(*TFEvent)(nil),                    // 0: TFEvent
 	(*Summary)(nil),                    // 1: Summary
 	(*SummaryMetadata)(nil),            // 2: SummaryMetadata
@@ -575,84 +562,11 @@ func file_core_internal_tensorboard_tbproto_tfevent_proto_init() {
 	}
 	file_core_internal_tensorboard_tbproto_histogram_proto_init()
 	file_core_internal_tensorboard_tbproto_tensor_proto_init()
-	if !protoimpl.UnsafeEnabled {
-		file_core_internal_tensorboard_tbproto_tfevent_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TFEvent); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_core_internal_tensorboard_tbproto_tfevent_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Summary); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_core_internal_tensorboard_tbproto_tfevent_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SummaryMetadata); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_core_internal_tensorboard_tbproto_tfevent_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Summary_Image); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_core_internal_tensorboard_tbproto_tfevent_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Summary_Value); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_core_internal_tensorboard_tbproto_tfevent_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SummaryMetadata_PluginData); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-	}
-	file_core_internal_tensorboard_tbproto_tfevent_proto_msgTypes[0].OneofWrappers = []interface{}{
+	file_core_internal_tensorboard_tbproto_tfevent_proto_msgTypes[0].OneofWrappers = []any{
+		(*TFEvent_FileVersion)(nil),
 		(*TFEvent_Summary)(nil),
 	}
-	file_core_internal_tensorboard_tbproto_tfevent_proto_msgTypes[4].OneofWrappers = []interface{}{
+	file_core_internal_tensorboard_tbproto_tfevent_proto_msgTypes[4].OneofWrappers = []any{
 		(*Summary_Value_SimpleValue)(nil),
 		(*Summary_Value_Image)(nil),
 		(*Summary_Value_Histo)(nil),
@@ -662,7 +576,7 @@ func file_core_internal_tensorboard_tbproto_tfevent_proto_init() {
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
-			RawDescriptor: file_core_internal_tensorboard_tbproto_tfevent_proto_rawDesc,
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_core_internal_tensorboard_tbproto_tfevent_proto_rawDesc), len(file_core_internal_tensorboard_tbproto_tfevent_proto_rawDesc)),
 			NumEnums:      0,
 			NumMessages:   6,
 			NumExtensions: 0,
@@ -673,7 +587,6 @@ func file_core_internal_tensorboard_tbproto_tfevent_proto_init() {
 		MessageInfos:      file_core_internal_tensorboard_tbproto_tfevent_proto_msgTypes,
 	}.Build()
 	File_core_internal_tensorboard_tbproto_tfevent_proto = out.File
-	file_core_internal_tensorboard_tbproto_tfevent_proto_rawDesc = nil
 	file_core_internal_tensorboard_tbproto_tfevent_proto_goTypes = nil
 	file_core_internal_tensorboard_tbproto_tfevent_proto_depIdxs = nil
 }
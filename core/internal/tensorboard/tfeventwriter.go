@@ -0,0 +1,109 @@
+package tensorboard
+
+import (
+	"bufio"
+	"encoding/binary"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+
+	"github.com/wandb/wandb/core/internal/tensorboard/tbproto"
+)
+
+// fileVersion is the file format version written at the start of every
+// tfevents file, as TensorFlow does.
+const fileVersion = "brain.Event:2"
+
+// TFEventWriter writes TFEvent protos into a tfevents file.
+//
+// It is the inverse of TFEventReader. It is not safe for concurrent use.
+type TFEventWriter struct {
+	path   string
+	file   *os.File
+	buffer *bufio.Writer
+}
+
+// NewTFEventWriter creates a tfevents file in the given directory.
+//
+// The file is named like the ones TensorBoard writes, using the start time
+// and hostname, so that TensorBoard and TFEventsFileFilter recognize it.
+// The directory is created if it doesn't exist. The file starts with an event
+// holding the file format version, which TensorBoard expects.
+func NewTFEventWriter(
+	logDir string,
+	startTime time.Time,
+	hostname string,
+) (*TFEventWriter, error) {
+	if err := os.MkdirAll(logDir, 0o755); err != nil {
+		return nil, fmt.Errorf("tensorboard: failed to make log dir: %v", err)
+	}
+
+	path := filepath.Join(logDir,
+		fmt.Sprintf("events.out.tfevents.%d.%s", startTime.Unix(), hostname))
+	file, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0o644)
+	if err != nil {
+		return nil, fmt.Errorf("tensorboard: failed to create tfevents file: %v", err)
+	}
+
+	writer := &TFEventWriter{
+		path:   path,
+		file:   file,
+		buffer: bufio.NewWriter(file),
+	}
+
+	err = writer.Write(&tbproto.TFEvent{
+		WallTime: float64(startTime.UnixNano()) / 1e9,
+		What:     &tbproto.TFEvent_FileVersion{FileVersion: fileVersion},
+	})
+	if err != nil {
+		_ = writer.Close()
+		return nil, err
+	}
+
+	return writer, nil
+}
+
+// Path is the path to the tfevents file.
+func (w *TFEventWriter) Path() string {
+	return w.path
+}
+
+// Write appends an event to the file.
+func (w *TFEventWriter) Write(event *tbproto.TFEvent) error {
+	// FORMAT: see TFEventReader.NextEvent.
+	eventBytes, err := proto.Marshal(event)
+	if err != nil {
+		return fmt.Errorf("tensorboard: failed to marshal event: %v", err)
+	}
+
+	header := binary.LittleEndian.AppendUint64(nil, uint64(len(eventBytes)))
+
+	data := make([]byte, 0, len(header)+len(eventBytes)+8)
+	data = append(data, header...)
+	data = binary.LittleEndian.AppendUint32(data, MaskedCRC32C(header))
+	data = append(data, eventBytes...)
+	data = binary.LittleEndian.AppendUint32(data, MaskedCRC32C(eventBytes))
+
+	if _, err := w.buffer.Write(data); err != nil {
+		return fmt.Errorf("tensorboard: failed to write event: %v", err)
+	}
+	return nil
+}
+
+// Close flushes buffered events and closes the file.
+func (w *TFEventWriter) Close() error {
+	flushErr := w.buffer.Flush()
+	closeErr := w.file.Close()
+
+	switch {
+	case flushErr != nil:
+		return fmt.Errorf("tensorboard: failed to flush events: %v", flushErr)
+	case closeErr != nil:
+		return fmt.Errorf("tensorboard: failed to close tfevents file: %v", closeErr)
+	default:
+		return nil
+	}
+}
@@ -0,0 +1,64 @@
+package tensorboard_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/wandb/wandb/core/internal/observabilitytest"
+	"github.com/wandb/wandb/core/internal/tensorboard"
+	"github.com/wandb/wandb/core/internal/tensorboard/tbproto"
+)
+
+func TestTFEventWriter_ReadBack(t *testing.T) {
+	tmpdir := t.TempDir()
+	writer, err := tensorboard.NewTFEventWriter(tmpdir, time.Unix(9000, 0), "host")
+	require.NoError(t, err)
+	require.NoError(t, writer.Write(event1))
+	require.NoError(t, writer.Write(event2))
+	require.NoError(t, writer.Close())
+
+	filter := tensorboard.TFEventsFileFilter{StartTimeSec: 9000, Hostname: "host"}
+	assert.True(t, filter.Matches(writer.Path()))
+
+	tmpdirAsPath, err := tensorboard.ParseTBPath(tmpdir)
+	require.NoError(t, err)
+	reader := tensorboard.NewTFEventReader(
+		tmpdirAsPath,
+		filter,
+		observabilitytest.NewTestLogger(t),
+		func() time.Time { return time.Time{} },
+	)
+	defer reader.Close()
+	noop := func(*tensorboard.LocalOrCloudPath) {}
+
+	read0, err := reader.NextEvent(t.Context(), noop)
+	require.NoError(t, err)
+	read1, err := reader.NextEvent(t.Context(), noop)
+	require.NoError(t, err)
+	read2, err := reader.NextEvent(t.Context(), noop)
+	require.NoError(t, err)
+	read3, err := reader.NextEvent(t.Context(), noop)
+	require.NoError(t, err)
+
+	assert.Equal(t, "brain.Event:2", read0.GetFileVersion())
+	assert.EqualValues(t, 9000, read0.GetWallTime())
+	assert.True(t, proto.Equal(event1, read1))
+	assert.True(t, proto.Equal(event2, read2))
+	assert.Nil(t, read3)
+}
+
+func TestTFEventWriter_DoesNotOverwrite(t *testing.T) {
+	tmpdir := t.TempDir()
+	writer, err := tensorboard.NewTFEventWriter(tmpdir, time.Unix(1, 0), "host")
+	require.NoError(t, err)
+	require.NoError(t, writer.Write(&tbproto.TFEvent{Step: 1}))
+	require.NoError(t, writer.Close())
+
+	_, err = tensorboard.NewTFEventWriter(tmpdir, time.Unix(1, 0), "host")
+
+	assert.ErrorContains(t, err, "failed to create tfevents file")
+}
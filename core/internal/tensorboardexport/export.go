@@ -0,0 +1,381 @@
+package tensorboardexport
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"path/filepath"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/wandb/simplejsonext"
+
+	"github.com/wandb/wandb/core/internal/observability"
+	"github.com/wandb/wandb/core/internal/pathtree"
+	"github.com/wandb/wandb/core/internal/runconfig"
+	"github.com/wandb/wandb/core/internal/runhistoryreader/parquet"
+	"github.com/wandb/wandb/core/internal/transactionlog"
+	spb "github.com/wandb/wandb/core/pkg/service_go_proto"
+)
+
+// remoteHistoryPageSize is the number of steps to request at a time
+// when exporting a remote run.
+const remoteHistoryPageSize = 1000
+
+// Params are the options for exporting a run.
+type Params struct {
+	// LogDirs assigns the run its directory.
+	LogDirs *LogDirs
+
+	// Hostname is the hostname to put in tfevents file names.
+	Hostname string
+
+	Logger *observability.CoreLogger
+}
+
+// Result describes an exported run.
+type Result struct {
+	// Dir is the directory containing the run's tfevents file.
+	Dir string
+
+	// Steps is the number of history steps exported.
+	Steps int
+
+	// SkippedMedia is the number of media values that couldn't be exported.
+	SkippedMedia int
+}
+
+// HistoryStepReader pages through a remote run's history.
+//
+// Implemented by *runhistoryreader.HistoryReader.
+type HistoryStepReader interface {
+	GetHistorySteps(ctx context.Context, minStep, maxStep int64) ([]parquet.KeyValueList, error)
+}
+
+// RemoteRun identifies a run exported with ExportRemoteHistory.
+type RemoteRun struct {
+	ID          string
+	DisplayName string
+
+	// Notes are the run's notes, or empty if it has none.
+	Notes string
+
+	// Config is the run's config, or nil if unknown.
+	Config map[string]any
+
+	// LastStep is the run's last history step, or negative if unknown.
+	//
+	// If unknown, the export stops at the first empty page of steps.
+	LastStep int64
+}
+
+// ExportTransactionLog exports a run from its .wandb file.
+//
+// The run's history is written as summaries, and its config and notes
+// as text. Images are read from the "files" directory next to the
+// .wandb file, where the SDK saves them.
+//
+// Corrupt data in the file is skipped with a warning.
+func ExportTransactionLog(path string, params Params) (*Result, error) {
+	reader, err := transactionlog.OpenReader(path, params.Logger)
+	if err != nil {
+		return nil, err
+	}
+	defer reader.Close()
+
+	export := &runExport{
+		params:   params,
+		filesDir: filepath.Join(filepath.Dir(path), "files"),
+		config:   runconfig.New(),
+		fallbackID: strings.TrimPrefix(
+			strings.TrimSuffix(filepath.Base(path), filepath.Ext(path)),
+			"run-"),
+	}
+	defer export.abort()
+
+	for readAny := false; ; {
+		record, err := reader.Read()
+
+		switch {
+		case errors.Is(err, io.EOF), errors.Is(err, io.ErrUnexpectedEOF):
+			// An unexpected EOF is the end of a partially written file.
+			return export.finish()
+
+		case err != nil && !readAny:
+			return nil, err
+
+		case err != nil:
+			params.Logger.Warn(
+				"tensorboardexport: skipping corrupt data",
+				"path", path, "error", err)
+			continue
+		}
+
+		readAny = true
+		if err := export.processRecord(record); err != nil {
+			return nil, err
+		}
+	}
+}
+
+// ExportRemoteHistory exports a run's history from the W&B backend.
+//
+// The run's config and notes are written as text. Media isn't exported.
+func ExportRemoteHistory(
+	ctx context.Context,
+	reader HistoryStepReader,
+	run RemoteRun,
+	params Params,
+) (*Result, error) {
+	export := &runExport{
+		params:      params,
+		displayName: run.DisplayName,
+		runID:       run.ID,
+		notes:       run.Notes,
+	}
+	if run.Config != nil {
+		export.config = runconfig.NewFrom(run.Config)
+	}
+	defer export.abort()
+
+	for minStep := int64(0); run.LastStep < 0 || minStep <= run.LastStep; {
+		maxStep := minStep + remoteHistoryPageSize
+		rows, err := reader.GetHistorySteps(ctx, minStep, maxStep)
+		if err != nil {
+			return nil, err
+		}
+
+		if len(rows) == 0 && run.LastStep < 0 {
+			break
+		}
+
+		for _, row := range rows {
+			values := make(map[string]any, len(row))
+			for _, kv := range row {
+				values[kv.Key] = kv.Value
+			}
+
+			wallTime, _ := asFloat(values[parquet.TimestampKey])
+			if err := export.writeHistory(row.StepValue(), wallTime, values); err != nil {
+				return nil, err
+			}
+		}
+
+		minStep = maxStep
+	}
+
+	return export.finish()
+}
+
+// runExport is the state of a single run's export.
+type runExport struct {
+	params Params
+
+	// filesDir is the run's files directory, or empty if unknown.
+	filesDir string
+
+	// fallbackID names the run if its ID isn't known.
+	fallbackID string
+
+	displayName string
+	runID       string
+	notes       string
+	startTime   time.Time
+
+	// config is the run's config, or nil if unknown.
+	config *runconfig.RunConfig
+
+	// exporter is created on first use, once the run's name is known.
+	exporter *Exporter
+	steps    int
+}
+
+func (r *runExport) processRecord(record *spb.Record) error {
+	switch x := record.RecordType.(type) {
+	case *spb.Record_Run:
+		// The first Run record starts the run, and later ones update it.
+		if r.runID == "" {
+			r.runID = x.Run.GetRunId()
+			r.startTime = x.Run.GetStartTime().AsTime()
+		}
+		if name := x.Run.GetDisplayName(); name != "" {
+			r.displayName = name
+		}
+		if x.Run.Notes != "" {
+			r.notes = x.Run.Notes
+		}
+		r.config.ApplyChangeRecord(x.Run.GetConfig(), r.warnConfigError)
+
+	case *spb.Record_Config:
+		r.config.ApplyChangeRecord(x.Config, r.warnConfigError)
+
+	case *spb.Record_History:
+		return r.processHistory(x.History)
+	}
+
+	return nil
+}
+
+func (r *runExport) processHistory(history *spb.HistoryRecord) error {
+	tree := pathtree.New[any]()
+	for _, item := range history.GetItem() {
+		var path pathtree.TreePath
+		switch {
+		case len(item.NestedKey) > 0:
+			path = pathtree.PathOf(item.NestedKey[0], item.NestedKey[1:]...)
+		case item.Key != "":
+			path = pathtree.PathOf(item.Key)
+		default:
+			continue
+		}
+
+		value, err := simplejsonext.UnmarshalString(item.ValueJson)
+		if err != nil {
+			r.params.Logger.Warn(
+				"tensorboardexport: failed to parse history value",
+				"key", path.Labels(), "error", err)
+			continue
+		}
+
+		if subtree, ok := value.(map[string]any); ok {
+			pathtree.SetSubtree(tree, path, subtree)
+		} else {
+			tree.Set(path, value)
+		}
+	}
+
+	row := tree.CloneTree()
+	wallTime, _ := asFloat(row["_timestamp"])
+	return r.writeHistory(history.GetStep().GetNum(), wallTime, row)
+}
+
+func (r *runExport) writeHistory(step int64, wallTime float64, row map[string]any) error {
+	if r.startTime.IsZero() && wallTime > 0 {
+		r.startTime = time.Unix(int64(wallTime), 0)
+	}
+
+	exporter, err := r.openExporter()
+	if err != nil {
+		return err
+	}
+
+	r.steps++
+	return exporter.WriteHistory(step, wallTime, row)
+}
+
+// openExporter returns the run's Exporter, creating it if necessary.
+func (r *runExport) openExporter() (*Exporter, error) {
+	if r.exporter != nil {
+		return r.exporter, nil
+	}
+
+	runID := r.runID
+	if runID == "" {
+		runID = r.fallbackID
+	}
+	startTime := r.startTime
+	if startTime.IsZero() {
+		startTime = time.Now()
+	}
+
+	exporter, err := NewExporter(ExporterParams{
+		LogDir:    r.params.LogDirs.ForRun(r.displayName, runID),
+		StartTime: startTime,
+		Hostname:  r.params.Hostname,
+		FilesDir:  r.filesDir,
+		Logger:    r.params.Logger,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	r.exporter = exporter
+	return exporter, nil
+}
+
+// finish writes the run's text summaries and closes its tfevents file.
+func (r *runExport) finish() (*Result, error) {
+	exporter, err := r.openExporter()
+	if err != nil {
+		return nil, err
+	}
+
+	wallTime := float64(r.startTime.Unix())
+	if r.startTime.IsZero() {
+		wallTime = 0
+	}
+
+	if r.notes != "" {
+		if err := exporter.WriteText("notes", 0, wallTime, r.notes); err != nil {
+			return nil, err
+		}
+	}
+
+	if r.config != nil {
+		config := r.config.CloneTree()
+		delete(config, "_wandb")
+		if len(config) > 0 {
+			err := exporter.WriteText("config", 0, wallTime, configMarkdown(config))
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	r.exporter = nil
+	if err := exporter.Close(); err != nil {
+		return nil, err
+	}
+
+	return &Result{
+		Dir:          filepath.Dir(exporter.Path()),
+		Steps:        r.steps,
+		SkippedMedia: exporter.SkippedMedia(),
+	}, nil
+}
+
+// abort closes the tfevents file if finish wasn't reached.
+func (r *runExport) abort() {
+	if r.exporter != nil {
+		_ = r.exporter.Close()
+	}
+}
+
+func (r *runExport) warnConfigError(err error) {
+	r.params.Logger.Warn(
+		"tensorboardexport: failed to parse config value", "error", err)
+}
+
+// configMarkdown renders a config as a Markdown table of its flattened
+// keys and JSON-encoded values.
+func configMarkdown(config map[string]any) string {
+	var rows []string
+	var addRows func(prefix string, value any)
+	addRows = func(prefix string, value any) {
+		if object, ok := value.(map[string]any); ok && len(object) > 0 {
+			for _, key := range sortedKeys(object) {
+				addRows(prefix+"."+key, object[key])
+			}
+			return
+		}
+
+		valueJSON, err := simplejsonext.Marshal(value)
+		if err != nil {
+			valueJSON = fmt.Appendf(nil, "%v", value)
+		}
+		rows = append(rows, fmt.Sprintf("| %s | %s |",
+			escapeMarkdownCell(strings.TrimPrefix(prefix, ".")),
+			escapeMarkdownCell(string(valueJSON))))
+	}
+	addRows("", config)
+
+	return strings.Join(
+		slices.Concat([]string{"| key | value |", "| --- | --- |"}, rows),
+		"\n")
+}
+
+func escapeMarkdownCell(s string) string {
+	return strings.NewReplacer("|", `\|`, "\n", " ").Replace(s)
+}
@@ -0,0 +1,257 @@
+package tensorboardexport_test
+
+import (
+	"bytes"
+	"context"
+	"image"
+	"image/png"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/wandb/wandb/core/internal/observabilitytest"
+	"github.com/wandb/wandb/core/internal/runhistoryreader/parquet"
+	"github.com/wandb/wandb/core/internal/tensorboard"
+	"github.com/wandb/wandb/core/internal/tensorboard/tbproto"
+	"github.com/wandb/wandb/core/internal/tensorboardexport"
+	"github.com/wandb/wandb/core/internal/transactionlog"
+	spb "github.com/wandb/wandb/core/pkg/service_go_proto"
+)
+
+// readEvents reads all events in a directory's tfevents files.
+func readEvents(t *testing.T, dir string) []*tbproto.TFEvent {
+	t.Helper()
+
+	path, err := tensorboard.ParseTBPath(dir)
+	require.NoError(t, err)
+	reader := tensorboard.NewTFEventReader(
+		path,
+		tensorboard.TFEventsFileFilter{},
+		observabilitytest.NewTestLogger(t),
+		time.Now,
+	)
+	defer reader.Close()
+
+	var events []*tbproto.TFEvent
+	for {
+		event, err := reader.NextEvent(t.Context(), func(*tensorboard.LocalOrCloudPath) {})
+		require.NoError(t, err)
+		if event == nil {
+			return events
+		}
+		events = append(events, event)
+	}
+}
+
+// valuesByTag indexes the summary values in events by their tags.
+func valuesByTag(events []*tbproto.TFEvent) map[string]*tbproto.Summary_Value {
+	values := make(map[string]*tbproto.Summary_Value)
+	for _, event := range events {
+		for _, value := range event.GetSummary().GetValue() {
+			values[value.Tag] = value
+		}
+	}
+	return values
+}
+
+func writeTransactionLog(t *testing.T, path string, records ...*spb.Record) {
+	t.Helper()
+
+	writer, err := transactionlog.OpenWriter(path)
+	require.NoError(t, err)
+	for _, record := range records {
+		require.NoError(t, writer.Write(record))
+	}
+	require.NoError(t, writer.Close())
+}
+
+func historyRecord(step int64, items ...*spb.HistoryItem) *spb.Record {
+	return &spb.Record{RecordType: &spb.Record_History{
+		History: &spb.HistoryRecord{
+			Step: &spb.HistoryStep{Num: step},
+			Item: items,
+		},
+	}}
+}
+
+func testParams(t *testing.T, root string) tensorboardexport.Params {
+	return tensorboardexport.Params{
+		LogDirs:  tensorboardexport.NewLogDirs(root),
+		Hostname: "host",
+		Logger:   observabilitytest.NewTestLogger(t),
+	}
+}
+
+func TestExportTransactionLog(t *testing.T) {
+	runDir := t.TempDir()
+	imagePath := filepath.Join(runDir, "files", "media", "images", "img_1.png")
+	require.NoError(t, os.MkdirAll(filepath.Dir(imagePath), 0o755))
+	var imageData bytes.Buffer
+	require.NoError(t, png.Encode(&imageData, image.NewGray(image.Rect(0, 0, 3, 2))))
+	require.NoError(t, os.WriteFile(imagePath, imageData.Bytes(), 0o644))
+
+	wandbFile := filepath.Join(runDir, "run-abc.wandb")
+	writeTransactionLog(t, wandbFile,
+		&spb.Record{RecordType: &spb.Record_Run{Run: &spb.RunRecord{
+			RunId:       "abc",
+			DisplayName: "my/run",
+			StartTime:   timestamppb.New(time.Unix(1000, 0)),
+			Config: &spb.ConfigRecord{Update: []*spb.ConfigItem{
+				{Key: "lr", ValueJson: "0.1"},
+				{Key: "_wandb", ValueJson: `{"x": 1}`},
+			}},
+		}}},
+		&spb.Record{RecordType: &spb.Record_Config{Config: &spb.ConfigRecord{
+			Update: []*spb.ConfigItem{{NestedKey: []string{"model", "depth"}, ValueJson: "4"}},
+		}}},
+		historyRecord(1,
+			&spb.HistoryItem{Key: "_step", ValueJson: "1"},
+			&spb.HistoryItem{Key: "_timestamp", ValueJson: "1001.5"},
+			&spb.HistoryItem{Key: "loss", ValueJson: "0.5"},
+			&spb.HistoryItem{NestedKey: []string{"eval", "acc"}, ValueJson: "0.9"},
+			&spb.HistoryItem{Key: "text", ValueJson: `"ignored"`},
+		),
+		historyRecord(2,
+			&spb.HistoryItem{
+				Key:       "weights",
+				ValueJson: `{"_type": "histogram", "values": [1, 2], "bins": [0, 1, 2]}`,
+			},
+			&spb.HistoryItem{
+				Key:       "packed",
+				ValueJson: `{"_type": "histogram", "values": [3], "packedBins": {"min": -1, "size": 2, "count": 1}}`,
+			},
+			&spb.HistoryItem{NestedKey: []string{"sample", "_type"}, ValueJson: `"image-file"`},
+			&spb.HistoryItem{NestedKey: []string{"sample", "path"}, ValueJson: `"media/images/img_1.png"`},
+			&spb.HistoryItem{
+				Key:       "missing",
+				ValueJson: `{"_type": "image-file", "path": "media/images/nope.png"}`,
+			},
+		),
+		&spb.Record{RecordType: &spb.Record_Run{Run: &spb.RunRecord{
+			RunId: "abc",
+			Notes: "some notes",
+		}}},
+	)
+
+	logDir := t.TempDir()
+	result, err := tensorboardexport.ExportTransactionLog(wandbFile, testParams(t, logDir))
+	require.NoError(t, err)
+
+	assert.Equal(t, filepath.Join(logDir, "my_run"), result.Dir)
+	assert.Equal(t, 2, result.Steps)
+	assert.Equal(t, 1, result.SkippedMedia)
+
+	events := readEvents(t, result.Dir)
+	values := valuesByTag(events)
+	assert.Equal(t, "brain.Event:2", events[0].GetFileVersion())
+	assert.Equal(t, int64(1), events[1].Step)
+	assert.Equal(t, 1001.5, events[1].WallTime)
+	assert.Equal(t, float32(0.5), values["loss"].GetSimpleValue())
+	assert.Equal(t, float32(0.9), values["eval.acc"].GetSimpleValue())
+	assert.NotContains(t, values, "text")
+	assert.NotContains(t, values, "_step")
+
+	weights := values["weights"]
+	assert.Equal(t, "histograms", weights.GetMetadata().GetPluginData().GetPluginName())
+	assert.Equal(t, []float64{0, 1, 1, 1, 2, 2}, weights.GetTensor().GetDoubleVal())
+	assert.Equal(t, []float64{-1, 1, 3}, values["packed"].GetTensor().GetDoubleVal())
+
+	sample := values["sample"].GetImage()
+	assert.Equal(t, int32(3), sample.GetWidth())
+	assert.Equal(t, int32(2), sample.GetHeight())
+	assert.Equal(t, imageData.Bytes(), sample.GetEncodedImageString())
+
+	assert.Equal(t,
+		[][]byte{[]byte("some notes")},
+		values["notes"].GetTensor().GetStringVal())
+	assert.Equal(t,
+		[][]byte{[]byte("| key | value |\n| --- | --- |\n| lr | 0.1 |\n| model.depth | 4 |")},
+		values["config"].GetTensor().GetStringVal())
+	assert.Equal(t, "text", values["config"].GetMetadata().GetPluginData().GetPluginName())
+}
+
+func TestExportTransactionLog_BadFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "run-abc.wandb")
+	require.NoError(t, os.WriteFile(path, []byte("not a transaction log"), 0o644))
+	logDir := t.TempDir()
+
+	_, err := tensorboardexport.ExportTransactionLog(path, testParams(t, logDir))
+
+	assert.Error(t, err)
+	entries, err := os.ReadDir(logDir)
+	require.NoError(t, err)
+	assert.Empty(t, entries)
+}
+
+type fakeHistoryReader struct {
+	rows     []parquet.KeyValueList
+	requests [][2]int64
+}
+
+func (r *fakeHistoryReader) GetHistorySteps(
+	_ context.Context,
+	minStep, maxStep int64,
+) ([]parquet.KeyValueList, error) {
+	r.requests = append(r.requests, [2]int64{minStep, maxStep})
+
+	var rows []parquet.KeyValueList
+	for _, row := range r.rows {
+		if step := row.StepValue(); step >= minStep && step < maxStep {
+			rows = append(rows, row)
+		}
+	}
+	return rows, nil
+}
+
+func TestExportRemoteHistory(t *testing.T) {
+	reader := &fakeHistoryReader{rows: []parquet.KeyValueList{
+		{{Key: "_step", Value: int64(0)}, {Key: "_timestamp", Value: 50.0}, {Key: "loss", Value: 2.0}},
+		{{Key: "_step", Value: int64(1500)}, {Key: "loss", Value: int64(1)}},
+	}}
+	logDir := t.TempDir()
+
+	result, err := tensorboardexport.ExportRemoteHistory(
+		t.Context(),
+		reader,
+		tensorboardexport.RemoteRun{
+			ID:          "abc",
+			DisplayName: "remote",
+			Notes:       "remote notes",
+			Config:      map[string]any{"lr": 0.1},
+			LastStep:    1500,
+		},
+		testParams(t, logDir),
+	)
+	require.NoError(t, err)
+
+	assert.Equal(t, filepath.Join(logDir, "remote"), result.Dir)
+	assert.Equal(t, [][2]int64{{0, 1000}, {1000, 2000}}, reader.requests)
+	events := readEvents(t, result.Dir)
+	require.Len(t, events, 5)
+	assert.Equal(t, "brain.Event:2", events[0].GetFileVersion())
+	assert.Equal(t, int64(1500), events[2].Step)
+	assert.Equal(t, float32(1), events[2].GetSummary().GetValue()[0].GetSimpleValue())
+	values := valuesByTag(events)
+	assert.Equal(t,
+		[][]byte{[]byte("remote notes")},
+		values["notes"].GetTensor().GetStringVal())
+	assert.Equal(t,
+		[][]byte{[]byte("| key | value |\n| --- | --- |\n| lr | 0.1 |")},
+		values["config"].GetTensor().GetStringVal())
+}
+
+func TestLogDirs_DisambiguatesRuns(t *testing.T) {
+	root := t.TempDir()
+	require.NoError(t, os.Mkdir(filepath.Join(root, "existing"), 0o755))
+	dirs := tensorboardexport.NewLogDirs(root)
+
+	assert.Equal(t, filepath.Join(root, "name"), dirs.ForRun("name", "a"))
+	assert.Equal(t, filepath.Join(root, "name-b"), dirs.ForRun("name", "b"))
+	assert.Equal(t, filepath.Join(root, "existing-c"), dirs.ForRun("existing", "c"))
+	assert.Equal(t, filepath.Join(root, "d"), dirs.ForRun("..", "d"))
+}
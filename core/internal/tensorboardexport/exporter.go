@@ -0,0 +1,352 @@
+// Package tensorboardexport writes W&B runs as TensorBoard tfevents files.
+//
+// It is the reverse of the tensorboard package, which converts tfevents
+// files into W&B history.
+package tensorboardexport
+
+import (
+	"bytes"
+	"fmt"
+	"image"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/wandb/wandb/core/internal/observability"
+	"github.com/wandb/wandb/core/internal/tensorboard"
+	"github.com/wandb/wandb/core/internal/tensorboard/tbproto"
+	"github.com/wandb/wandb/core/internal/wbvalue"
+
+	// Import image codecs for reading image sizes.
+	//
+	// NOTE: These imports are used for their side-effects.
+	_ "image/gif"
+	_ "image/jpeg"
+	_ "image/png"
+)
+
+// Exporter writes one run's data into a tfevents file.
+type Exporter struct {
+	writer *tensorboard.TFEventWriter
+
+	// filesDir is the run's files directory, relative to which media
+	// paths in the history are resolved.
+	//
+	// If empty, media is skipped.
+	filesDir string
+
+	logger *observability.CoreLogger
+
+	// skippedMedia counts media that couldn't be exported.
+	skippedMedia int
+}
+
+// ExporterParams are used to create an Exporter.
+type ExporterParams struct {
+	// LogDir is the directory in which to create the tfevents file.
+	LogDir string
+
+	// StartTime is the run's start time, used in the tfevents file name.
+	StartTime time.Time
+
+	// Hostname is the hostname to put in the tfevents file name.
+	Hostname string
+
+	// FilesDir is the run's files directory, or empty to skip media.
+	FilesDir string
+
+	Logger *observability.CoreLogger
+}
+
+func NewExporter(params ExporterParams) (*Exporter, error) {
+	writer, err := tensorboard.NewTFEventWriter(
+		params.LogDir,
+		params.StartTime,
+		params.Hostname,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Exporter{
+		writer:   writer,
+		filesDir: params.FilesDir,
+		logger:   params.Logger,
+	}, nil
+}
+
+// Path is the path to the tfevents file being written.
+func (e *Exporter) Path() string {
+	return e.writer.Path()
+}
+
+// SkippedMedia is the number of media values that couldn't be exported,
+// such as images whose files are missing.
+func (e *Exporter) SkippedMedia() int {
+	return e.skippedMedia
+}
+
+// WriteHistory writes a history row as a summary event.
+//
+// The row maps metric names to decoded JSON values, with nested
+// dictionaries as maps. Numbers become scalars, W&B histograms become
+// histograms and W&B images become images. Nested metrics are tagged with
+// their dot-separated path. Other values, and metrics starting with an
+// underscore such as _step, are skipped.
+func (e *Exporter) WriteHistory(step int64, wallTime float64, row map[string]any) error {
+	var values []*tbproto.Summary_Value
+	for _, key := range sortedKeys(row) {
+		if strings.HasPrefix(key, "_") {
+			continue
+		}
+		values = e.appendSummaryValues(values, key, row[key])
+	}
+
+	if len(values) == 0 {
+		return nil
+	}
+
+	return e.writer.Write(&tbproto.TFEvent{
+		WallTime: wallTime,
+		Step:     step,
+		What: &tbproto.TFEvent_Summary{
+			Summary: &tbproto.Summary{Value: values},
+		},
+	})
+}
+
+// WriteText writes Markdown text for TensorBoard's text plugin.
+func (e *Exporter) WriteText(tag string, step int64, wallTime float64, text string) error {
+	return e.writer.Write(&tbproto.TFEvent{
+		WallTime: wallTime,
+		Step:     step,
+		What: &tbproto.TFEvent_Summary{
+			Summary: &tbproto.Summary{Value: []*tbproto.Summary_Value{{
+				Tag:      tag,
+				Metadata: pluginMetadata("text"),
+				Value: &tbproto.Summary_Value_Tensor{
+					Tensor: &tbproto.TensorProto{
+						Dtype:       tbproto.DataType_DT_STRING,
+						TensorShape: &tbproto.TensorShapeProto{},
+						StringVal:   [][]byte{[]byte(text)},
+					},
+				},
+			}}},
+		},
+	})
+}
+
+// Close finishes writing the tfevents file.
+func (e *Exporter) Close() error {
+	return e.writer.Close()
+}
+
+// appendSummaryValues appends the summary values for a history value.
+func (e *Exporter) appendSummaryValues(
+	values []*tbproto.Summary_Value,
+	tag string,
+	value any,
+) []*tbproto.Summary_Value {
+	if x, ok := asFloat(value); ok {
+		return append(values, &tbproto.Summary_Value{
+			Tag:   tag,
+			Value: &tbproto.Summary_Value_SimpleValue{SimpleValue: float32(x)},
+		})
+	}
+
+	object, ok := value.(map[string]any)
+	if !ok {
+		return values
+	}
+
+	switch object["_type"] {
+	case nil:
+		for _, key := range sortedKeys(object) {
+			values = e.appendSummaryValues(values, tag+"."+key, object[key])
+		}
+		return values
+
+	case "histogram":
+		if histogram, ok := histogramFromJSON(object); ok {
+			values = append(values, histogramValue(tag, histogram))
+		} else {
+			e.skippedMedia++
+		}
+		return values
+
+	case "image-file":
+		path, _ := object["path"].(string)
+		if value, ok := e.imageValue(tag, path); ok {
+			values = append(values, value)
+		}
+		return values
+
+	case "images/separated":
+		filenames, _ := object["filenames"].([]any)
+		for i, filename := range filenames {
+			path, _ := filename.(string)
+			if value, ok := e.imageValue(fmt.Sprintf("%s/%d", tag, i), path); ok {
+				values = append(values, value)
+			}
+		}
+		return values
+
+	default:
+		// Tables, audio, videos and other media have no TensorBoard
+		// equivalent.
+		return values
+	}
+}
+
+// imageValue reads an image file logged to the run.
+//
+// Returns false and counts the image as skipped if it can't be read.
+func (e *Exporter) imageValue(tag, path string) (*tbproto.Summary_Value, bool) {
+	if e.filesDir == "" || path == "" {
+		e.skippedMedia++
+		return nil, false
+	}
+
+	data, err := os.ReadFile(filepath.Join(e.filesDir, filepath.FromSlash(path)))
+	if err != nil {
+		e.logger.Warn("tensorboardexport: failed to read image",
+			"path", path, "error", err)
+		e.skippedMedia++
+		return nil, false
+	}
+
+	config, _, err := image.DecodeConfig(bytes.NewReader(data))
+	if err != nil {
+		e.logger.Warn("tensorboardexport: failed to decode image",
+			"path", path, "error", err)
+		e.skippedMedia++
+		return nil, false
+	}
+
+	return &tbproto.Summary_Value{
+		Tag: tag,
+		Value: &tbproto.Summary_Value_Image{
+			Image: &tbproto.Summary_Image{
+				Height:             int32(config.Height),
+				Width:              int32(config.Width),
+				EncodedImageString: data,
+			},
+		},
+	}, true
+}
+
+// histogramFromJSON parses a histogram logged with wandb.Histogram.
+//
+// Bins are either listed explicitly or "packed" as a start, a bin width
+// and a bin count.
+func histogramFromJSON(object map[string]any) (wbvalue.Histogram, bool) {
+	weights, ok := asFloats(object["values"])
+	if !ok || len(weights) == 0 {
+		return wbvalue.Histogram{}, false
+	}
+
+	var edges []float64
+	if packed, isPacked := object["packedBins"].(map[string]any); isPacked {
+		start, ok1 := asFloat(packed["min"])
+		width, ok2 := asFloat(packed["size"])
+		count, ok3 := asFloat(packed["count"])
+		if !ok1 || !ok2 || !ok3 {
+			return wbvalue.Histogram{}, false
+		}
+		for i := range int(count) + 1 {
+			edges = append(edges, start+float64(i)*width)
+		}
+	} else if edges, ok = asFloats(object["bins"]); !ok {
+		return wbvalue.Histogram{}, false
+	}
+
+	if len(edges) != len(weights)+1 {
+		return wbvalue.Histogram{}, false
+	}
+
+	return wbvalue.Histogram{BinEdges: edges, BinWeights: weights}, true
+}
+
+// histogramValue encodes a histogram in the format of TensorBoard's
+// histograms plugin: a k-by-3 tensor of left edges, right edges and counts.
+func histogramValue(tag string, histogram wbvalue.Histogram) *tbproto.Summary_Value {
+	k := len(histogram.BinWeights)
+	data := make([]float64, 0, 3*k)
+	for i, weight := range histogram.BinWeights {
+		data = append(data,
+			histogram.BinEdges[i],
+			histogram.BinEdges[i+1],
+			weight,
+		)
+	}
+
+	return &tbproto.Summary_Value{
+		Tag:      tag,
+		Metadata: pluginMetadata("histograms"),
+		Value: &tbproto.Summary_Value_Tensor{
+			Tensor: &tbproto.TensorProto{
+				Dtype: tbproto.DataType_DT_DOUBLE,
+				TensorShape: &tbproto.TensorShapeProto{
+					Dim: []*tbproto.TensorShapeProto_Dim{
+						{Size: int64(k)},
+						{Size: 3},
+					},
+				},
+				DoubleVal: data,
+			},
+		},
+	}
+}
+
+func pluginMetadata(pluginName string) *tbproto.SummaryMetadata {
+	return &tbproto.SummaryMetadata{
+		PluginData: &tbproto.SummaryMetadata_PluginData{PluginName: pluginName},
+	}
+}
+
+// asFloat converts a decoded JSON or parquet number to a float64.
+func asFloat(value any) (float64, bool) {
+	switch x := value.(type) {
+	case float64:
+		return x, true
+	case float32:
+		return float64(x), true
+	case int64:
+		return float64(x), true
+	case int32:
+		return float64(x), true
+	case int:
+		return float64(x), true
+	case uint64:
+		return float64(x), true
+	default:
+		return 0, false
+	}
+}
+
+// asFloats converts a decoded JSON list of numbers to float64s.
+func asFloats(value any) ([]float64, bool) {
+	list, ok := value.([]any)
+	if !ok {
+		return nil, false
+	}
+
+	floats := make([]float64, len(list))
+	for i, item := range list {
+		if floats[i], ok = asFloat(item); !ok {
+			return nil, false
+		}
+	}
+	return floats, true
+}
+
+func sortedKeys(m map[string]any) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	slices.Sort(keys)
+	return keys
+}
@@ -0,0 +1,74 @@
+package tensorboardexport
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// LogDirs assigns exported runs their directories in a TensorBoard
+// log directory.
+//
+// TensorBoard shows each subdirectory as a run, so runs are exported to
+// subdirectories named after their display names.
+type LogDirs struct {
+	root string
+	used map[string]struct{}
+}
+
+func NewLogDirs(root string) *LogDirs {
+	return &LogDirs{root: root, used: make(map[string]struct{})}
+}
+
+// ForRun returns the directory to export a run to.
+//
+// The directory is named after the run's display name, or its ID if it
+// has none. If that directory was already assigned or exists, the run ID
+// is appended so that runs with the same name, or repeated exports,
+// don't mix their data.
+func (d *LogDirs) ForRun(displayName, runID string) string {
+	name := sanitizeDirName(displayName)
+	if name == "" {
+		name = sanitizeDirName(runID)
+	}
+	if name == "" {
+		name = "run"
+	}
+
+	dir := filepath.Join(d.root, name)
+	if d.isTaken(dir) && runID != "" {
+		dir = filepath.Join(d.root, name+"-"+sanitizeDirName(runID))
+	}
+
+	d.used[dir] = struct{}{}
+	return dir
+}
+
+func (d *LogDirs) isTaken(dir string) bool {
+	if _, ok := d.used[dir]; ok {
+		return true
+	}
+
+	_, err := os.Stat(dir)
+	return err == nil
+}
+
+// sanitizeDirName makes a run name usable as a single path component.
+func sanitizeDirName(name string) string {
+	name = strings.Map(func(r rune) rune {
+		switch r {
+		case '/', '\\', ':', '*', '?', '"', '<', '>', '|':
+			return '_'
+		}
+		if r < ' ' {
+			return -1
+		}
+		return r
+	}, name)
+
+	name = strings.TrimSpace(name)
+	if strings.Trim(name, ".") == "" {
+		return ""
+	}
+	return name
+}
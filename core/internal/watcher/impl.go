@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"path/filepath"
+	"slices"
 	"sync"
 	"time"
 
@@ -19,6 +20,7 @@ type watcher struct {
 	delegate   *poller.Watcher
 	wg         *sync.WaitGroup
 	handlers   map[string]func(string)
+	trees      []*treeWatch
 	isFinished bool
 
 	pollingPeriod time.Duration
@@ -46,18 +48,37 @@ func (w *watcher) WatchDir(path string, onChange func(string)) error {
 	return w.watchFileOrDir(path, onChange)
 }
 
-func (w *watcher) watchFileOrDir(path string, onChange func(string)) error {
+func (w *watcher) WatchTree(
+	path string,
+	patterns []string,
+	onChange func(string),
+) error {
+	tree, err := newTreeWatch(path, patterns, onChange)
+	if err != nil {
+		return err
+	}
+
 	w.Lock()
 	defer w.Unlock()
 
-	if w.isFinished {
-		return fmt.Errorf("watcher: tried to call Watch() after Finish()")
+	if err := w.startIfNotFinished(); err != nil {
+		return err
 	}
 
-	if w.delegate == nil {
-		if err := w.startWatcher(); err != nil {
-			return err
-		}
+	if err := w.delegate.AddRecursive(tree.realRoot); err != nil {
+		return err
+	}
+	w.trees = append(w.trees, tree)
+
+	return nil
+}
+
+func (w *watcher) watchFileOrDir(path string, onChange func(string)) error {
+	w.Lock()
+	defer w.Unlock()
+
+	if err := w.startIfNotFinished(); err != nil {
+		return err
 	}
 
 	if err := w.delegate.Add(path); err != nil {
@@ -68,6 +89,21 @@ func (w *watcher) watchFileOrDir(path string, onChange func(string)) error {
 	return nil
 }
 
+// startIfNotFinished starts polling unless Finish() was called.
+//
+// The mutex must be held.
+func (w *watcher) startIfNotFinished() error {
+	if w.isFinished {
+		return fmt.Errorf("watcher: tried to call Watch() after Finish()")
+	}
+
+	if w.delegate == nil {
+		return w.startWatcher()
+	}
+
+	return nil
+}
+
 func (w *watcher) Finish() {
 	var delegate *poller.Watcher
 
@@ -180,6 +216,7 @@ func (w *watcher) onChange(evt poller.Event) {
 	w.Lock()
 	handler := w.handlers[evt.Path]
 	parentHandler := w.handlers[filepath.Dir(evt.Path)]
+	trees := slices.Clone(w.trees)
 	w.Unlock()
 
 	if handler != nil {
@@ -188,6 +225,10 @@ func (w *watcher) onChange(evt poller.Event) {
 		parentHandler(evt.Path)
 	}
 
+	for _, tree := range trees {
+		tree.notify(evt.Path)
+	}
+
 	// This shouldn't happen since we don't remove handlers,
 	// but we should fail gracefully just in case.
 }
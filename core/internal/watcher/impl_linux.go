@@ -0,0 +1,481 @@
+package watcher
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"sync"
+	"syscall"
+	"time"
+	"unsafe"
+
+	"github.com/wandb/wandb/core/internal/observability"
+)
+
+// inotifyMask selects the inotify events that indicate a file may have
+// been created or changed.
+const inotifyMask = syscall.IN_CREATE |
+	syscall.IN_MODIFY |
+	syscall.IN_CLOSE_WRITE |
+	syscall.IN_MOVED_TO |
+	syscall.IN_ONLYDIR
+
+// inotifyBufferSize fits many events with names of the maximum length.
+const inotifyBufferSize = 64 * (syscall.SizeofInotifyEvent + syscall.NAME_MAX + 1)
+
+// coalesceDelay is how long to collect events before invoking callbacks.
+//
+// A single write usually produces several events, such as IN_CREATE,
+// IN_MODIFY and IN_CLOSE_WRITE, and a file being appended to produces
+// one per write. Callbacks are invoked once per file for each batch.
+const coalesceDelay = 50 * time.Millisecond
+
+// Magic numbers of filesystems on which inotify doesn't see changes made
+// by other machines, from linux/magic.h.
+var networkFilesystems = map[uint32]string{
+	0x6969:     "nfs",
+	0x517b:     "smb",
+	0xff534d42: "cifs",
+	0xfe534d42: "smb2",
+	0x65735546: "fuse",
+	0x01021997: "9p",
+	0x00c36400: "ceph",
+	0x5346414f: "afs",
+	0x0bd00bd0: "lustre",
+	0x47504653: "gpfs",
+}
+
+// newPlatformWatcher returns a watcher that uses inotify.
+func newPlatformWatcher(params Params) Watcher {
+	return newInotifyWatcher(params)
+}
+
+// inotifyWatcher is a Watcher backed by Linux's inotify API.
+//
+// Files are watched through their parent directories so that changes are
+// seen even if a file is replaced by renaming another over it.
+//
+// Paths on network filesystems, where inotify only sees changes made by
+// this machine, are polled instead, as are paths inotify fails to watch,
+// such as when the per-user watch limit is reached.
+type inotifyWatcher struct {
+	mu     sync.Mutex
+	logger *observability.CoreLogger
+	params Params
+
+	// inotify is the inotify instance, or nil if not yet created.
+	inotify *os.File
+
+	// inotifyFd is the inotify instance's file descriptor.
+	//
+	// It's stored separately because inotify.Fd() would put the
+	// descriptor into blocking mode.
+	inotifyFd int
+
+	// inotifyFailed is true if the inotify instance couldn't be created.
+	inotifyFailed bool
+
+	// dirs maps watch descriptors to the real paths of their directories.
+	dirs map[int32]string
+
+	// fileHandlers maps the real paths of watched files to callbacks.
+	fileHandlers map[string]func(string)
+
+	// dirHandlers maps the real paths of watched directories to callbacks.
+	dirHandlers map[string]func(string)
+
+	// trees are the directories watched recursively.
+	trees []*treeWatch
+
+	// polling watches the paths that inotify can't, and is nil
+	// until needed.
+	polling *watcher
+
+	// pending are the changed files whose callbacks haven't run yet,
+	// in the order they changed.
+	pending    []string
+	pendingSet map[string]struct{}
+
+	// hasPending is signalled when pending becomes non-empty.
+	hasPending chan struct{}
+
+	// done is closed by Finish().
+	done chan struct{}
+
+	isFinished bool
+	wg         sync.WaitGroup
+}
+
+func newInotifyWatcher(params Params) *inotifyWatcher {
+	return &inotifyWatcher{
+		logger:       params.Logger,
+		params:       params,
+		dirs:         make(map[int32]string),
+		fileHandlers: make(map[string]func(string)),
+		dirHandlers:  make(map[string]func(string)),
+		pendingSet:   make(map[string]struct{}),
+		hasPending:   make(chan struct{}, 1),
+		done:         make(chan struct{}),
+	}
+}
+
+func (w *inotifyWatcher) Watch(path string, onChange func()) error {
+	realPath, err := realPathOf(path)
+	if err != nil {
+		return err
+	}
+	info, err := os.Stat(realPath)
+	if err != nil {
+		return err
+	}
+	if info.IsDir() {
+		// Like polling, treat a watched directory as watching its children.
+		return w.WatchDir(path, func(string) { onChange() })
+	}
+
+	if err := w.lockIfNotFinished(); err != nil {
+		return err
+	}
+	defer w.mu.Unlock()
+
+	if err := w.addWatch(filepath.Dir(realPath)); err != nil {
+		return w.pollingLocked().Watch(path, onChange)
+	}
+	w.fileHandlers[realPath] = func(string) { onChange() }
+
+	return nil
+}
+
+func (w *inotifyWatcher) WatchDir(path string, onChange func(string)) error {
+	realDir, err := realPathOf(path)
+	if err != nil {
+		return err
+	}
+
+	if err := w.lockIfNotFinished(); err != nil {
+		return err
+	}
+	defer w.mu.Unlock()
+
+	if err := w.addWatch(realDir); err != nil {
+		return w.pollingLocked().WatchDir(path, onChange)
+	}
+	w.dirHandlers[realDir] = func(realPath string) {
+		onChange(filepath.Join(path, filepath.Base(realPath)))
+	}
+
+	return nil
+}
+
+func (w *inotifyWatcher) WatchTree(
+	path string,
+	patterns []string,
+	onChange func(string),
+) error {
+	tree, err := newTreeWatch(path, patterns, onChange)
+	if err != nil {
+		return err
+	}
+
+	if err := w.lockIfNotFinished(); err != nil {
+		return err
+	}
+	defer w.mu.Unlock()
+
+	if err := walkSubdirs(tree.realRoot, w.addWatch); err != nil {
+		// Directories watched so far are harmless: their events are
+		// ignored unless a handler is registered.
+		return w.pollingLocked().WatchTree(path, patterns, onChange)
+	}
+	w.trees = append(w.trees, tree)
+
+	return nil
+}
+
+func (w *inotifyWatcher) Finish() {
+	w.mu.Lock()
+	if w.isFinished {
+		w.mu.Unlock()
+		return
+	}
+	w.isFinished = true
+	inotify := w.inotify
+	polling := w.polling
+	w.mu.Unlock()
+
+	close(w.done)
+	if inotify != nil {
+		// This interrupts the blocked Read() in the event loop.
+		_ = inotify.Close()
+	}
+	w.wg.Wait()
+
+	if polling != nil {
+		polling.Finish()
+	}
+}
+
+// lockIfNotFinished locks the mutex unless Finish() was called.
+//
+// On success, the caller must unlock the mutex.
+func (w *inotifyWatcher) lockIfNotFinished() error {
+	w.mu.Lock()
+
+	if w.isFinished {
+		w.mu.Unlock()
+		return fmt.Errorf("watcher: tried to call Watch() after Finish()")
+	}
+
+	return nil
+}
+
+// pollingLocked returns the polling watcher, creating it if necessary.
+//
+// The mutex must be held.
+func (w *inotifyWatcher) pollingLocked() *watcher {
+	if w.polling == nil {
+		w.polling = newWatcher(w.params)
+	}
+	return w.polling
+}
+
+// addWatch adds an inotify watch for a directory.
+//
+// Returns an error if the directory should be polled instead.
+// The mutex must be held.
+func (w *inotifyWatcher) addWatch(dir string) error {
+	if fsName, isNetwork := networkFilesystem(dir); isNetwork {
+		w.logger.Info(
+			"watcher: polling directory on network filesystem",
+			"path", dir, "filesystem", fsName)
+		return fmt.Errorf("watcher: %s is on a network filesystem", dir)
+	}
+
+	if err := w.startInotify(); err != nil {
+		return err
+	}
+
+	wd, err := syscall.InotifyAddWatch(w.inotifyFd, dir, inotifyMask)
+	if err != nil {
+		w.logger.Warn(
+			"watcher: failed to add inotify watch, polling instead",
+			"path", dir, "error", err)
+		return err
+	}
+
+	w.dirs[int32(wd)] = dir
+	return nil
+}
+
+// startInotify creates the inotify instance and starts reading events.
+//
+// The mutex must be held.
+func (w *inotifyWatcher) startInotify() error {
+	switch {
+	case w.inotify != nil:
+		return nil
+	case w.inotifyFailed:
+		return errors.New("watcher: inotify unavailable")
+	}
+
+	fd, err := syscall.InotifyInit1(syscall.IN_CLOEXEC | syscall.IN_NONBLOCK)
+	if err != nil {
+		w.inotifyFailed = true
+		w.logger.Warn(
+			"watcher: failed to initialize inotify, polling instead",
+			"error", err)
+		return err
+	}
+
+	// Because the descriptor is non-blocking, reads go through the runtime
+	// poller and are interrupted by Close().
+	inotify := os.NewFile(uintptr(fd), "inotify")
+	w.inotify = inotify
+	w.inotifyFd = fd
+
+	w.wg.Add(2)
+	go func() {
+		defer w.wg.Done()
+		w.loopReadEvents(inotify)
+	}()
+	go func() {
+		defer w.wg.Done()
+		w.loopDispatch()
+	}()
+
+	return nil
+}
+
+// loopReadEvents reads and dispatches inotify events until closed.
+func (w *inotifyWatcher) loopReadEvents(inotify *os.File) {
+	buf := make([]byte, inotifyBufferSize)
+
+	for {
+		n, err := inotify.Read(buf)
+		switch {
+		case errors.Is(err, os.ErrClosed):
+			return
+		case err != nil:
+			w.logger.CaptureError(
+				"watcher",
+				fmt.Errorf("watcher: error reading inotify events: %v", err))
+			return
+		}
+
+		for offset := 0; offset+syscall.SizeofInotifyEvent <= n; {
+			event := (*syscall.InotifyEvent)(unsafe.Pointer(&buf[offset]))
+			nameStart := offset + syscall.SizeofInotifyEvent
+			nameEnd := nameStart + int(event.Len)
+			name := string(bytes.TrimRight(buf[nameStart:nameEnd], "\x00"))
+			offset = nameEnd
+
+			w.handleEvent(event.Wd, event.Mask, name)
+		}
+	}
+}
+
+func (w *inotifyWatcher) handleEvent(wd int32, mask uint32, name string) {
+	if mask&syscall.IN_Q_OVERFLOW != 0 {
+		w.logger.Warn("watcher: inotify queue overflowed, changes may be missed")
+		return
+	}
+
+	w.mu.Lock()
+	dir, ok := w.dirs[wd]
+	if mask&syscall.IN_IGNORED != 0 {
+		// The directory was deleted or unmounted.
+		delete(w.dirs, wd)
+	}
+	w.mu.Unlock()
+
+	if !ok || name == "" {
+		return
+	}
+
+	path := filepath.Join(dir, name)
+	if mask&syscall.IN_ISDIR != 0 {
+		if mask&(syscall.IN_CREATE|syscall.IN_MOVED_TO) != 0 {
+			w.onNewDir(path)
+		}
+		return
+	}
+
+	w.enqueue(path)
+}
+
+// onNewDir starts watching a directory created in a watched tree.
+//
+// Files may be created in the directory before it is watched, so all
+// files in it are reported as changed.
+func (w *inotifyWatcher) onNewDir(dir string) {
+	w.mu.Lock()
+	inTree := slices.ContainsFunc(w.trees, func(tree *treeWatch) bool {
+		return tree.contains(dir)
+	})
+	if inTree && !w.isFinished {
+		_ = walkSubdirs(dir, w.addWatch)
+	}
+	w.mu.Unlock()
+
+	if !inTree {
+		return
+	}
+
+	_ = walkSubdirs(dir, func(subdir string) error {
+		entries, _ := os.ReadDir(subdir)
+		for _, entry := range entries {
+			if entry.Type().IsRegular() {
+				w.enqueue(filepath.Join(subdir, entry.Name()))
+			}
+		}
+		return nil
+	})
+}
+
+// enqueue schedules the callbacks for a changed file.
+func (w *inotifyWatcher) enqueue(path string) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	if _, ok := w.pendingSet[path]; ok {
+		return
+	}
+	w.pendingSet[path] = struct{}{}
+	w.pending = append(w.pending, path)
+
+	select {
+	case w.hasPending <- struct{}{}:
+	default:
+	}
+}
+
+// loopDispatch invokes callbacks for batches of changed files until
+// Finish() is called.
+func (w *inotifyWatcher) loopDispatch() {
+	for {
+		select {
+		case <-w.hasPending:
+		case <-w.done:
+			return
+		}
+
+		select {
+		case <-time.After(coalesceDelay):
+		case <-w.done:
+			return
+		}
+
+		w.mu.Lock()
+		paths := w.pending
+		w.pending = nil
+		clear(w.pendingSet)
+		w.mu.Unlock()
+
+		for _, path := range paths {
+			w.onChange(path)
+		}
+	}
+}
+
+// onChange invokes the callbacks for a changed file.
+func (w *inotifyWatcher) onChange(path string) {
+	w.mu.Lock()
+	handler := w.fileHandlers[path]
+	parentHandler := w.dirHandlers[filepath.Dir(path)]
+	trees := slices.Clone(w.trees)
+	w.mu.Unlock()
+
+	if handler != nil {
+		handler(path)
+	} else if parentHandler != nil {
+		parentHandler(path)
+	}
+
+	for _, tree := range trees {
+		tree.notify(path)
+	}
+}
+
+// networkFilesystem returns the name of the network filesystem the path
+// is on, if it is on one.
+func networkFilesystem(path string) (string, bool) {
+	var stat syscall.Statfs_t
+	if err := syscall.Statfs(path, &stat); err != nil {
+		return "", false
+	}
+
+	name, ok := networkFilesystems[uint32(stat.Type)]
+	return name, ok
+}
+
+// realPathOf returns the absolute path with symlinks resolved.
+func realPathOf(path string) (string, error) {
+	realPath, err := filepath.EvalSymlinks(path)
+	if err != nil {
+		return "", err
+	}
+	return filepath.Abs(realPath)
+}
@@ -0,0 +1,9 @@
+//go:build !linux
+
+package watcher
+
+// newPlatformWatcher returns a polling watcher, since filesystem events
+// are only supported on Linux.
+func newPlatformWatcher(params Params) Watcher {
+	return newWatcher(params)
+}
@@ -0,0 +1,166 @@
+package watcher
+
+import (
+	"fmt"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+)
+
+// treeWatch is a directory watched recursively using WatchTree.
+type treeWatch struct {
+	// root is the directory path passed to WatchTree.
+	root string
+
+	// realRoot is the absolute path to the directory with symlinks
+	// resolved.
+	//
+	// Watching and walking happen in the real directory, since neither
+	// follows a symlinked root, and paths are mapped back under root
+	// when reported.
+	realRoot string
+
+	// patterns filter the reported files, if not empty.
+	patterns []string
+
+	onChange func(string)
+}
+
+func newTreeWatch(
+	root string,
+	patterns []string,
+	onChange func(string),
+) (*treeWatch, error) {
+	for _, pattern := range patterns {
+		if _, err := path.Match(pattern, ""); err != nil {
+			return nil, fmt.Errorf("watcher: bad pattern %q: %v", pattern, err)
+		}
+	}
+
+	info, err := os.Stat(root)
+	if err != nil {
+		return nil, err
+	}
+	if !info.IsDir() {
+		return nil, fmt.Errorf("watcher: not a directory: %s", root)
+	}
+
+	realRoot, err := filepath.EvalSymlinks(root)
+	if err != nil {
+		return nil, err
+	}
+	realRoot, err = filepath.Abs(realRoot)
+	if err != nil {
+		return nil, err
+	}
+
+	return &treeWatch{
+		root:     root,
+		realRoot: realRoot,
+		patterns: patterns,
+		onChange: onChange,
+	}, nil
+}
+
+// contains reports whether the real path is in the watched tree.
+func (t *treeWatch) contains(realPath string) bool {
+	_, ok := t.relPath(realPath)
+	return ok
+}
+
+// notify invokes the callback for a changed file if it's in the tree
+// and matches the patterns.
+func (t *treeWatch) notify(realPath string) {
+	rel, ok := t.relPath(realPath)
+	if !ok || rel == "." {
+		return
+	}
+
+	if len(t.patterns) > 0 && !matchesAny(t.patterns, filepath.ToSlash(rel)) {
+		return
+	}
+
+	t.onChange(filepath.Join(t.root, rel))
+}
+
+// relPath returns a real path's path relative to the tree's root.
+func (t *treeWatch) relPath(realPath string) (string, bool) {
+	rel, err := filepath.Rel(t.realRoot, realPath)
+	if err != nil || !filepath.IsLocal(rel) && rel != "." {
+		return "", false
+	}
+	return rel, true
+}
+
+// walkSubdirs calls fn for a directory and each directory under it.
+//
+// The directory itself may be a symlink, but symlinks under it aren't
+// followed. Directories that can't be read are skipped.
+func walkSubdirs(dir string, fn func(dir string) error) error {
+	if err := fn(dir); err != nil {
+		return err
+	}
+
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil
+	}
+
+	for _, entry := range entries {
+		if !entry.IsDir() {
+			continue
+		}
+		if err := walkSubdirs(filepath.Join(dir, entry.Name()), fn); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func matchesAny(patterns []string, name string) bool {
+	for _, pattern := range patterns {
+		if MatchGlob(pattern, name) {
+			return true
+		}
+	}
+	return false
+}
+
+// MatchGlob reports whether a slash-separated path matches a WatchTree
+// pattern.
+//
+// Each component of the pattern is matched against one component of the
+// path using path.Match, except that "**" matches any number of
+// components, including none.
+func MatchGlob(pattern, name string) bool {
+	return matchComponents(
+		strings.Split(pattern, "/"),
+		strings.Split(name, "/"),
+	)
+}
+
+func matchComponents(pattern, name []string) bool {
+	for len(pattern) > 0 {
+		if pattern[0] == "**" {
+			for i := range len(name) + 1 {
+				if matchComponents(pattern[1:], name[i:]) {
+					return true
+				}
+			}
+			return false
+		}
+
+		if len(name) == 0 {
+			return false
+		}
+		if ok, _ := path.Match(pattern[0], name[0]); !ok {
+			return false
+		}
+
+		pattern, name = pattern[1:], name[1:]
+	}
+
+	return len(name) == 0
+}
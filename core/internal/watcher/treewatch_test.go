@@ -0,0 +1,32 @@
+package watcher
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMatchGlob(t *testing.T) {
+	testCases := []struct {
+		pattern string
+		name    string
+		match   bool
+	}{
+		{"*.txt", "a.txt", true},
+		{"*.txt", "dir/a.txt", false},
+		{"dir/*.txt", "dir/a.txt", true},
+		{"**/*.txt", "a.txt", true},
+		{"**/*.txt", "x/y/a.txt", true},
+		{"**/*.txt", "x/y/a.log", false},
+		{"ckpt/**", "ckpt/a/b", true},
+		{"ckpt/**", "other/a", false},
+		{"a/**/b/*.pt", "a/b/m.pt", true},
+		{"a/**/b/*.pt", "a/x/y/b/m.pt", true},
+		{"a/**/b/*.pt", "a/x/y/c/m.pt", false},
+	}
+
+	for _, tc := range testCases {
+		assert.Equal(t, tc.match, MatchGlob(tc.pattern, tc.name),
+			"pattern %q, name %q", tc.pattern, tc.name)
+	}
+}
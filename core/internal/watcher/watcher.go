@@ -30,6 +30,21 @@ type Watcher interface {
 	// The directory must exist, or an error is returned.
 	WatchDir(path string, onChange func(string)) error
 
+	// WatchTree begins watching the directory at the path recursively.
+	//
+	// `onChange` is invoked with a file path if any file in the directory
+	// or its subdirectories is changed or created, including in
+	// subdirectories created after this call. The paths are under `path`
+	// even if it is a symlink.
+	//
+	// If `patterns` is not empty, only files whose slash-separated paths
+	// relative to the directory match at least one pattern are reported.
+	// Patterns use the syntax of path.Match, and a "**" component matches
+	// any number of nested directories.
+	//
+	// The directory must exist, or an error is returned.
+	WatchTree(path string, patterns []string, onChange func(string)) error
+
 	// Finish stops the watcher from emitting any more change events.
 	Finish()
 }
@@ -40,9 +55,27 @@ type Params struct {
 	// How often to poll files for updates.
 	//
 	// If unset, this uses a default value.
+	//
+	// Polling is used where filesystem events aren't available, such as
+	// on network filesystems or on platforms other than Linux.
 	PollingPeriod time.Duration
+
+	// Whether to always poll instead of using filesystem events.
+	ForcePolling bool
 }
 
+// New returns a Watcher for the current platform.
+//
+// On Linux, the Watcher uses inotify, falling back to polling for paths
+// where inotify doesn't work. Elsewhere, it polls.
 func New(params Params) Watcher {
-	return newWatcher(params)
+	if params.Logger == nil {
+		params.Logger = observability.NewNoOpLogger()
+	}
+
+	if params.ForcePolling {
+		return newWatcher(params)
+	}
+
+	return newPlatformWatcher(params)
 }
@@ -10,6 +10,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	"github.com/wandb/wandb/core/internal/observabilitytest"
 	"github.com/wandb/wandb/core/internal/watcher"
 )
 
@@ -65,11 +66,26 @@ func TestWatcher(t *testing.T) {
 	// cycle for someone working on this package. So you should try to write
 	// bug-free code :)
 
-	newTestWatcher := func() watcher.Watcher {
-		return watcher.New(watcher.Params{
-			PollingPeriod: 10 * time.Millisecond,
+	for _, backend := range []struct {
+		name         string
+		forcePolling bool
+	}{
+		{name: "default", forcePolling: false},
+		{name: "polling", forcePolling: true},
+	} {
+		t.Run(backend.name, func(t *testing.T) {
+			testWatcher(t, func() watcher.Watcher {
+				return watcher.New(watcher.Params{
+					Logger:        observabilitytest.NewTestLogger(t),
+					PollingPeriod: 10 * time.Millisecond,
+					ForcePolling:  backend.forcePolling,
+				})
+			})
 		})
 	}
+}
+
+func testWatcher(t *testing.T, newTestWatcher func() watcher.Watcher) {
 	finishWithDeadline := func(t *testing.T, w watcher.Watcher) {
 		finished := make(chan struct{})
 
@@ -84,7 +100,7 @@ func TestWatcher(t *testing.T) {
 	t.Run("runs callback on file write", func(t *testing.T) {
 		t.Parallel()
 
-		onChangeChan := make(chan struct{})
+		onChangeChan := make(chan struct{}, 10)
 		file := filepath.Join(t.TempDir(), "file.txt")
 		t1 := writeFileAndGetModTime(t, file, "")
 
@@ -114,7 +130,7 @@ func TestWatcher(t *testing.T) {
 	t.Run("runs callback on new file in directory", func(t *testing.T) {
 		t.Parallel()
 
-		onChangeChan := make(chan string)
+		onChangeChan := make(chan string, 10)
 		dir := filepath.Join(t.TempDir(), "dir")
 		file := filepath.Join(dir, "file.txt")
 		mkdir(t, dir)
@@ -154,4 +170,76 @@ func TestWatcher(t *testing.T) {
 
 		require.ErrorContains(t, err, "tried to call Watch() after Finish()")
 	})
+
+	t.Run("runs tree callback on file in new subdirectory", func(t *testing.T) {
+		t.Parallel()
+
+		onChangeChan := make(chan string, 10)
+		dir := filepath.Join(t.TempDir(), "dir")
+		file := filepath.Join(dir, "a", "b", "file.txt")
+		mkdir(t, dir)
+
+		w := newTestWatcher()
+		defer finishWithDeadline(t, w)
+		require.NoError(t,
+			w.WatchTree(dir, nil, func(s string) { onChangeChan <- s }))
+		writeFile(t, file, "")
+
+		result := waitWithDeadline(t, onChangeChan,
+			"expected tree callback to be called")
+		assert.Equal(t, file, result)
+	})
+
+	t.Run("filters tree callback by patterns", func(t *testing.T) {
+		t.Parallel()
+
+		onChangeChan := make(chan string, 10)
+		dir := filepath.Join(t.TempDir(), "dir")
+		mkdir(t, filepath.Join(dir, "logs"))
+
+		w := newTestWatcher()
+		defer finishWithDeadline(t, w)
+		require.NoError(t,
+			w.WatchTree(dir, []string{"**/*.ckpt"},
+				func(s string) { onChangeChan <- s }))
+		writeFile(t, filepath.Join(dir, "logs", "skipped.txt"), "")
+		writeFile(t, filepath.Join(dir, "logs", "model.ckpt"), "")
+
+		result := waitWithDeadline(t, onChangeChan,
+			"expected tree callback to be called")
+		assert.Equal(t, filepath.Join(dir, "logs", "model.ckpt"), result)
+	})
+
+	t.Run("reports tree paths under symlinked root", func(t *testing.T) {
+		t.Parallel()
+
+		onChangeChan := make(chan string, 10)
+		target := filepath.Join(t.TempDir(), "target")
+		link := filepath.Join(t.TempDir(), "link")
+		mkdir(t, target)
+		require.NoError(t, os.Symlink(target, link))
+
+		w := newTestWatcher()
+		defer finishWithDeadline(t, w)
+		require.NoError(t,
+			w.WatchTree(link, nil, func(s string) { onChangeChan <- s }))
+		writeFile(t, filepath.Join(target, "sub", "file.txt"), "")
+
+		result := waitWithDeadline(t, onChangeChan,
+			"expected tree callback to be called")
+		assert.Equal(t, filepath.Join(link, "sub", "file.txt"), result)
+	})
+
+	t.Run("fails to watch tree that is a file", func(t *testing.T) {
+		t.Parallel()
+
+		file := filepath.Join(t.TempDir(), "file.txt")
+		writeFile(t, file, "")
+
+		w := newTestWatcher()
+		defer finishWithDeadline(t, w)
+		err := w.WatchTree(file, nil, func(string) {})
+
+		require.ErrorContains(t, err, "not a directory")
+	})
 }
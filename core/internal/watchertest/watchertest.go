@@ -14,6 +14,12 @@ type FakeWatcher struct {
 	mu sync.Mutex
 
 	handlers map[string]func(string)
+	trees    map[string]fakeTree
+}
+
+type fakeTree struct {
+	patterns []string
+	onChange func(string)
 }
 
 var _ watcher.Watcher = &FakeWatcher{}
@@ -21,14 +27,24 @@ var _ watcher.Watcher = &FakeWatcher{}
 func NewFakeWatcher() *FakeWatcher {
 	return &FakeWatcher{
 		handlers: make(map[string]func(string)),
+		trees:    make(map[string]fakeTree),
 	}
 }
 
-// OnChange invokes the change callback registered for the path, if any.
+// OnChange invokes the change callbacks registered for the path, if any.
+//
+// Callbacks registered with WatchTree are invoked for any path under the
+// tree, regardless of their patterns.
 func (w *FakeWatcher) OnChange(path string) {
 	w.mu.Lock()
 	handler := w.handlers[path]
 	parentHandler := w.handlers[filepath.Dir(path)]
+	var treeHandlers []func(string)
+	for root, tree := range w.trees {
+		if rel, err := filepath.Rel(root, path); err == nil && filepath.IsLocal(rel) {
+			treeHandlers = append(treeHandlers, tree.onChange)
+		}
+	}
 	w.mu.Unlock()
 
 	if handler != nil {
@@ -36,6 +52,10 @@ func (w *FakeWatcher) OnChange(path string) {
 	} else if parentHandler != nil {
 		parentHandler(path)
 	}
+
+	for _, treeHandler := range treeHandlers {
+		treeHandler(path)
+	}
 }
 
 // IsWatching reports whether a callback is registered for the path.
@@ -46,6 +66,16 @@ func (w *FakeWatcher) IsWatching(path string) bool {
 	return w.handlers[w.toAbs(path)] != nil
 }
 
+// TreePatterns returns the patterns of the tree watched at the path,
+// and whether the path is watched recursively.
+func (w *FakeWatcher) TreePatterns(path string) ([]string, bool) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	tree, ok := w.trees[w.toAbs(path)]
+	return tree.patterns, ok
+}
+
 func (w *FakeWatcher) Watch(path string, callback func()) error {
 	return w.watchFileOrDir(path, func(string) { callback() })
 }
@@ -54,6 +84,23 @@ func (w *FakeWatcher) WatchDir(path string, callback func(string)) error {
 	return w.watchFileOrDir(path, callback)
 }
 
+func (w *FakeWatcher) WatchTree(
+	path string,
+	patterns []string,
+	callback func(string),
+) error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	_, err := os.Stat(path)
+	if err != nil {
+		return err
+	}
+
+	w.trees[w.toAbs(path)] = fakeTree{patterns: patterns, onChange: callback}
+	return nil
+}
+
 func (w *FakeWatcher) watchFileOrDir(path string, callback func(string)) error {
 	w.mu.Lock()
 	defer w.mu.Unlock()
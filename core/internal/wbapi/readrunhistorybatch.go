@@ -0,0 +1,267 @@
+package wbapi
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/wandb/wandb/core/internal/runhistoryreader"
+	spb "github.com/wandb/wandb/core/pkg/service_go_proto"
+)
+
+// historyBatchIdleTimeout is how long a batch is kept without requests
+// for its results before it is released.
+//
+// It covers clients that abandon a batch without cleaning it up.
+const historyBatchIdleTimeout = 10 * time.Minute
+
+// historyBatch is an ongoing read of many runs' history.
+type historyBatch struct {
+	// cancel stops the read.
+	cancel context.CancelFunc
+
+	// results receives each run's history and is closed when all runs
+	// are done.
+	results <-chan runhistoryreader.RunHistory
+
+	// idleTimer releases the batch if its results aren't requested for
+	// historyBatchIdleTimeout.
+	//
+	// It is stopped while a request for the results is in progress.
+	idleTimer *time.Timer
+}
+
+// handleBatchRunHistoryInit starts reading the history of many runs.
+//
+// The runs are read in the background, outliving the request.
+// It returns an ID for collecting the results.
+func (f *RunHistoryAPIHandler) handleBatchRunHistoryInit(
+	ctx context.Context,
+	request *spb.BatchRunHistoryInit,
+) *spb.ApiResponse {
+	f.logger.RecordTelemetry(
+		"batch_run_history_init",
+		map[string]string{
+			"numRuns": fmt.Sprint(len(request.GetRuns())),
+		},
+	)
+
+	if err := f.initRustArrow(); err != nil {
+		return &spb.ApiResponse{
+			Response: &spb.ApiResponse_ApiErrorResponse{
+				ApiErrorResponse: &spb.ApiErrorResponse{
+					Message: err.Error(),
+				},
+			},
+		}
+	}
+
+	runs := make([]runhistoryreader.RunPath, 0, len(request.GetRuns()))
+	for _, run := range request.GetRuns() {
+		runs = append(runs, runhistoryreader.RunPath{
+			Entity:  run.GetEntity(),
+			Project: run.GetProject(),
+			RunID:   run.GetRunId(),
+		})
+	}
+
+	batchRequest := runhistoryreader.BatchRequest{
+		Runs:     runs,
+		Keys:     request.GetKeys(),
+		MinStep:  request.GetMinStep(),
+		MaxStep:  request.GetMaxStep(),
+		UseCache: request.GetUseCache(),
+	}
+	if sampling := request.GetSampling(); sampling.GetTargetPoints() > 0 {
+		batchSampling := samplingFromProto(sampling)
+		batchRequest.Sampling = &batchSampling
+	}
+
+	batchCtx, cancel := context.WithCancel(context.WithoutCancel(ctx))
+	batch := &historyBatch{
+		cancel:  cancel,
+		results: f.batchReader.Read(batchCtx, batchRequest),
+	}
+
+	requestId := f.currentRequestId.Add(1)
+	batch.idleTimer = time.AfterFunc(historyBatchIdleTimeout, func() {
+		f.removeHistoryBatch(requestId)
+	})
+
+	f.mu.Lock()
+	f.historyBatches[requestId] = batch
+	f.mu.Unlock()
+
+	return &spb.ApiResponse{
+		Response: &spb.ApiResponse_ReadRunHistoryResponse{
+			ReadRunHistoryResponse: &spb.ReadRunHistoryResponse{
+				Response: &spb.ReadRunHistoryResponse_BatchRunHistoryInit{
+					BatchRunHistoryInit: &spb.BatchRunHistoryInitResponse{
+						RequestId: requestId,
+					},
+				},
+			},
+		},
+	}
+}
+
+// handleBatchRunHistoryNext returns the results of a batch that
+// became available since the previous call.
+//
+// It blocks until at least one run is done or all results have been
+// returned, in which case the batch is released.
+func (f *RunHistoryAPIHandler) handleBatchRunHistoryNext(
+	ctx context.Context,
+	request *spb.BatchRunHistoryNext,
+) *spb.ApiResponse {
+	requestId := request.GetRequestId()
+
+	f.mu.RLock()
+	batch, ok := f.historyBatches[requestId]
+	f.mu.RUnlock()
+
+	if !ok || batch == nil {
+		return &spb.ApiResponse{
+			Response: &spb.ApiResponse_ApiErrorResponse{
+				ApiErrorResponse: &spb.ApiErrorResponse{
+					Message: "Run history batch not found.",
+				},
+			},
+		}
+	}
+
+	var runHistories []runhistoryreader.RunHistory
+	done := false
+
+	batch.idleTimer.Stop()
+	defer func() {
+		if !done {
+			batch.idleTimer.Reset(historyBatchIdleTimeout)
+		}
+	}()
+
+	select {
+	case runHistory, ok := <-batch.results:
+		if ok {
+			runHistories = append(runHistories, runHistory)
+		} else {
+			done = true
+		}
+	case <-ctx.Done():
+		return &spb.ApiResponse{
+			Response: &spb.ApiResponse_ApiErrorResponse{
+				ApiErrorResponse: &spb.ApiErrorResponse{
+					Message: ctx.Err().Error(),
+				},
+			},
+		}
+	}
+
+	// Include any other results that are already available.
+drain:
+	for !done {
+		select {
+		case runHistory, ok := <-batch.results:
+			if ok {
+				runHistories = append(runHistories, runHistory)
+			} else {
+				done = true
+			}
+		default:
+			break drain
+		}
+	}
+
+	if done {
+		f.removeHistoryBatch(requestId)
+	}
+
+	results := make([]*spb.RunHistoryResult, 0, len(runHistories))
+	for _, runHistory := range runHistories {
+		results = append(results, runHistoryResultToProto(runHistory))
+	}
+
+	return &spb.ApiResponse{
+		Response: &spb.ApiResponse_ReadRunHistoryResponse{
+			ReadRunHistoryResponse: &spb.ReadRunHistoryResponse{
+				Response: &spb.ReadRunHistoryResponse_BatchRunHistory{
+					BatchRunHistory: &spb.BatchRunHistoryResponse{
+						Results: results,
+						Done:    done,
+					},
+				},
+			},
+		},
+	}
+}
+
+// handleBatchRunHistoryCleanup stops a batch and releases its resources.
+func (f *RunHistoryAPIHandler) handleBatchRunHistoryCleanup(
+	request *spb.BatchRunHistoryCleanup,
+) *spb.ApiResponse {
+	f.removeHistoryBatch(request.GetRequestId())
+
+	return &spb.ApiResponse{
+		Response: &spb.ApiResponse_ReadRunHistoryResponse{
+			ReadRunHistoryResponse: &spb.ReadRunHistoryResponse{
+				Response: &spb.ReadRunHistoryResponse_BatchRunHistoryCleanup{
+					BatchRunHistoryCleanup: &spb.BatchRunHistoryCleanupResponse{},
+				},
+			},
+		},
+	}
+}
+
+// removeHistoryBatch cancels and forgets a batch, if it exists.
+func (f *RunHistoryAPIHandler) removeHistoryBatch(requestId int32) {
+	f.mu.Lock()
+	batch, ok := f.historyBatches[requestId]
+	delete(f.historyBatches, requestId)
+	f.mu.Unlock()
+
+	if ok {
+		batch.idleTimer.Stop()
+		batch.cancel()
+	}
+}
+
+// Shutdown stops and releases all batches.
+func (f *RunHistoryAPIHandler) Shutdown() {
+	f.mu.Lock()
+	batches := f.historyBatches
+	f.historyBatches = make(map[int32]*historyBatch)
+	f.mu.Unlock()
+
+	for _, batch := range batches {
+		batch.idleTimer.Stop()
+		batch.cancel()
+	}
+}
+
+// runHistoryResultToProto converts a run's result in a batch to its
+// proto representation.
+func runHistoryResultToProto(
+	runHistory runhistoryreader.RunHistory,
+) *spb.RunHistoryResult {
+	result := &spb.RunHistoryResult{
+		Run: &spb.HistoryRunPath{
+			Entity:  runHistory.Run.Entity,
+			Project: runHistory.Run.Project,
+			RunId:   runHistory.Run.RunID,
+		},
+	}
+
+	if runHistory.Err != nil {
+		result.Error = runHistory.Err.Error()
+		return result
+	}
+
+	historyRows, err := historyRowsToProto(runHistory.Rows)
+	if err != nil {
+		result.Error = err.Error()
+		return result
+	}
+	result.HistoryRows = historyRows
+
+	return result
+}
@@ -0,0 +1,59 @@
+package wbapi
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/wandb/wandb/core/internal/observability"
+	"github.com/wandb/wandb/core/internal/runhistoryreader"
+)
+
+// addTestHistoryBatch registers a batch whose results never arrive.
+func addTestHistoryBatch(
+	handler *RunHistoryAPIHandler,
+	requestId int32,
+	idleTimeout time.Duration,
+) context.Context {
+	ctx, cancel := context.WithCancel(context.Background())
+	batch := &historyBatch{
+		cancel:  cancel,
+		results: make(chan runhistoryreader.RunHistory),
+	}
+	batch.idleTimer = time.AfterFunc(idleTimeout, func() {
+		handler.removeHistoryBatch(requestId)
+	})
+
+	handler.mu.Lock()
+	handler.historyBatches[requestId] = batch
+	handler.mu.Unlock()
+
+	return ctx
+}
+
+func TestHistoryBatch_ReleasedWhenIdle(t *testing.T) {
+	handler := NewRunHistoryAPIHandler(nil, nil, observability.NewNoOpLogger())
+
+	batchCtx := addTestHistoryBatch(handler, 1, time.Millisecond)
+
+	select {
+	case <-batchCtx.Done():
+	case <-time.After(5 * time.Second):
+		t.Fatal("idle batch was not cancelled")
+	}
+	handler.mu.RLock()
+	defer handler.mu.RUnlock()
+	assert.Empty(t, handler.historyBatches)
+}
+
+func TestHistoryBatch_ReleasedOnShutdown(t *testing.T) {
+	handler := NewRunHistoryAPIHandler(nil, nil, observability.NewNoOpLogger())
+	batchCtx := addTestHistoryBatch(handler, 1, time.Hour)
+
+	handler.Shutdown()
+
+	assert.ErrorIs(t, batchCtx.Err(), context.Canceled)
+	assert.Empty(t, handler.historyBatches)
+}
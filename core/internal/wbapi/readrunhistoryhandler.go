@@ -2,6 +2,7 @@ package wbapi
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"os"
 	"sync"
@@ -58,6 +59,14 @@ type RunHistoryAPIHandler struct {
 	//
 	// It allows tracking the status of downloads for run history files.
 	downloadOperations map[int32]*parquet.RunHistoryDownloadOperation
+
+	// batchReader reads the history of many runs for batch requests.
+	//
+	// It is created together with rustArrowWrapper.
+	batchReader *runhistoryreader.BatchReader
+
+	// historyBatches is a map of request ids to ongoing batch reads.
+	historyBatches map[int32]*historyBatch
 }
 
 func NewRunHistoryAPIHandler(
@@ -73,6 +82,7 @@ func NewRunHistoryAPIHandler(
 		currentRequestId:   atomic.Int32{},
 		scanHistoryReaders: make(map[int32]*runhistoryreader.HistoryReader),
 		downloadOperations: make(map[int32]*parquet.RunHistoryDownloadOperation),
+		historyBatches:     make(map[int32]*historyBatch),
 	}
 }
 
@@ -93,11 +103,39 @@ func (f *RunHistoryAPIHandler) HandleRequest(
 		return f.handleDownloadRunHistory(ctx, request.GetDownloadRunHistory())
 	case *spb.ReadRunHistoryRequest_DownloadRunHistoryStatus:
 		return f.handleDownloadRunHistoryStatus(request.GetDownloadRunHistoryStatus())
+	case *spb.ReadRunHistoryRequest_BatchRunHistoryInit:
+		return f.handleBatchRunHistoryInit(ctx, request.GetBatchRunHistoryInit())
+	case *spb.ReadRunHistoryRequest_BatchRunHistoryNext:
+		return f.handleBatchRunHistoryNext(ctx, request.GetBatchRunHistoryNext())
+	case *spb.ReadRunHistoryRequest_BatchRunHistoryCleanup:
+		return f.handleBatchRunHistoryCleanup(request.GetBatchRunHistoryCleanup())
 	}
 
 	return nil
 }
 
+// initRustArrow loads the Rust Arrow library on first use.
+func (f *RunHistoryAPIHandler) initRustArrow() error {
+	f.rustArrowOnce.Do(func() {
+		f.rustArrowWrapper, f.rustArrowInitializationErr = ffi.NewRustArrowWrapper()
+		if f.rustArrowInitializationErr == nil {
+			f.batchReader = runhistoryreader.NewBatchReader(
+				f.graphqlClient,
+				f.httpClient,
+				f.rustArrowWrapper,
+			)
+		}
+	})
+
+	if f.rustArrowInitializationErr != nil {
+		return fmt.Errorf(
+			"RustArrowWrapper initialization failed: %v",
+			f.rustArrowInitializationErr,
+		)
+	}
+	return nil
+}
+
 // handleScanRunHistoryInit handles a request to initialize
 // a scan over a run's history.
 //
@@ -118,17 +156,11 @@ func (f *RunHistoryAPIHandler) handleScanRunHistoryInit(
 		},
 	)
 
-	f.rustArrowOnce.Do(func() {
-		f.rustArrowWrapper, f.rustArrowInitializationErr = ffi.NewRustArrowWrapper()
-	})
-	if f.rustArrowInitializationErr != nil {
+	if err := f.initRustArrow(); err != nil {
 		return &spb.ApiResponse{
 			Response: &spb.ApiResponse_ApiErrorResponse{
 				ApiErrorResponse: &spb.ApiErrorResponse{
-					Message: fmt.Sprintf(
-						"RustArrowWrapper initialization failed: %v",
-						f.rustArrowInitializationErr,
-					),
+					Message: err.Error(),
 				},
 			},
 		}
@@ -210,12 +242,27 @@ func (f *RunHistoryAPIHandler) handleScanRunHistoryRead(
 	minStep := request.MinStep
 	maxStep := request.MaxStep
 
+	if request.GetFormat() != spb.ScanRunHistory_HISTORY_ROWS {
+		return f.handleScanRunHistoryReadArrow(ctx, historyReader, request)
+	}
+
 	getHistoryStepsStart := time.Now()
-	historySteps, err := historyReader.GetHistorySteps(
-		ctx,
-		minStep,
-		maxStep,
-	)
+	var historySteps []parquet.KeyValueList
+	var err error
+	if sampling := request.GetSampling(); sampling.GetTargetPoints() > 0 {
+		historySteps, err = historyReader.GetSampledHistorySteps(
+			ctx,
+			minStep,
+			maxStep,
+			samplingFromProto(sampling),
+		)
+	} else {
+		historySteps, err = historyReader.GetHistorySteps(
+			ctx,
+			minStep,
+			maxStep,
+		)
+	}
 	if err != nil {
 		return &spb.ApiResponse{
 			Response: &spb.ApiResponse_ApiErrorResponse{
@@ -242,19 +289,141 @@ func (f *RunHistoryAPIHandler) handleScanRunHistoryRead(
 		)
 	})
 
+	historyRows, err := historyRowsToProto(historySteps)
+	if err != nil {
+		return &spb.ApiResponse{
+			Response: &spb.ApiResponse_ApiErrorResponse{
+				ApiErrorResponse: &spb.ApiErrorResponse{
+					Message: err.Error(),
+				},
+			},
+		}
+	}
+
+	return &spb.ApiResponse{
+		Response: &spb.ApiResponse_ReadRunHistoryResponse{
+			ReadRunHistoryResponse: &spb.ReadRunHistoryResponse{
+				Response: &spb.ReadRunHistoryResponse_RunHistory{
+					RunHistory: &spb.RunHistoryResponse{
+						HistoryRows: historyRows,
+					},
+				},
+			},
+		},
+	}
+}
+
+// handleScanRunHistoryReadArrow handles a request to scan over
+// a portion of a run's history in an Arrow IPC format.
+func (f *RunHistoryAPIHandler) handleScanRunHistoryReadArrow(
+	ctx context.Context,
+	historyReader *runhistoryreader.HistoryReader,
+	request *spb.ScanRunHistory,
+) *spb.ApiResponse {
+	errorResponse := func(err error) *spb.ApiResponse {
+		return &spb.ApiResponse{
+			Response: &spb.ApiResponse_ApiErrorResponse{
+				ApiErrorResponse: &spb.ApiErrorResponse{
+					Message: err.Error(),
+				},
+			},
+		}
+	}
+
+	if request.GetSampling().GetTargetPoints() > 0 {
+		return errorResponse(errors.New(
+			"sampling is not supported with Arrow IPC output"))
+	}
+
+	history, err := historyReader.GetHistoryStepsArrow(
+		ctx,
+		request.GetMinStep(),
+		request.GetMaxStep(),
+	)
+	if err != nil {
+		return errorResponse(err)
+	}
+
+	liveRows, err := historyRowsToProto(history.LiveRows)
+	if err != nil {
+		return errorResponse(err)
+	}
+	runHistory := &spb.RunHistoryResponse{HistoryRows: liveRows}
+
+	if request.GetFormat() == spb.ScanRunHistory_ARROW_IPC_FILE {
+		paths, err := writeArrowIPCFiles(history.Streams)
+		if err != nil {
+			return errorResponse(err)
+		}
+		runHistory.ArrowIpcFiles = paths
+	} else {
+		runHistory.ArrowIpcStreams = history.Streams
+	}
+
+	return &spb.ApiResponse{
+		Response: &spb.ApiResponse_ReadRunHistoryResponse{
+			ReadRunHistoryResponse: &spb.ReadRunHistoryResponse{
+				Response: &spb.ReadRunHistoryResponse_RunHistory{
+					RunHistory: runHistory,
+				},
+			},
+		},
+	}
+}
+
+// writeArrowIPCFiles writes each Arrow IPC stream to a new temporary file
+// and returns their paths.
+//
+// If any write fails, the files already written are removed.
+func writeArrowIPCFiles(streams [][]byte) ([]string, error) {
+	paths := make([]string, 0, len(streams))
+
+	for _, stream := range streams {
+		path, err := writeArrowIPCFile(stream)
+		if err != nil {
+			for _, written := range paths {
+				_ = os.Remove(written)
+			}
+			return nil, err
+		}
+		paths = append(paths, path)
+	}
+
+	return paths, nil
+}
+
+func writeArrowIPCFile(stream []byte) (path string, err error) {
+	file, err := os.CreateTemp("", "wandb-run-history-*.arrows")
+	if err != nil {
+		return "", err
+	}
+	defer func() {
+		if closeErr := file.Close(); closeErr != nil && err == nil {
+			err = closeErr
+		}
+		if err != nil {
+			_ = os.Remove(file.Name())
+		}
+	}()
+
+	if _, err = file.Write(stream); err != nil {
+		return "", err
+	}
+	return file.Name(), nil
+}
+
+// historyRowsToProto converts history rows to their proto representation,
+// encoding each value as JSON.
+func historyRowsToProto(
+	historySteps []parquet.KeyValueList,
+) ([]*spb.HistoryRow, error) {
 	historyRows := make([]*spb.HistoryRow, 0, len(historySteps))
 	for _, historyStep := range historySteps {
 		historyItems := make([]*spb.ParquetHistoryItem, 0, len(historyStep))
 		for _, historyItem := range historyStep {
 			valueJson, err := simplejsonext.MarshalToString(historyItem.Value)
 			if err != nil {
-				return &spb.ApiResponse{
-					Response: &spb.ApiResponse_ApiErrorResponse{
-						ApiErrorResponse: &spb.ApiErrorResponse{
-							Message: err.Error(),
-						},
-					},
-				}
+				return nil, err
 			}
 
 			historyItems = append(historyItems, &spb.ParquetHistoryItem{
@@ -266,17 +435,27 @@ func (f *RunHistoryAPIHandler) handleScanRunHistoryRead(
 			HistoryItems: historyItems,
 		})
 	}
+	return historyRows, nil
+}
 
-	return &spb.ApiResponse{
-		Response: &spb.ApiResponse_ReadRunHistoryResponse{
-			ReadRunHistoryResponse: &spb.ReadRunHistoryResponse{
-				Response: &spb.ReadRunHistoryResponse_RunHistory{
-					RunHistory: &spb.RunHistoryResponse{
-						HistoryRows: historyRows,
-					},
-				},
-			},
-		},
+// samplingFromProto converts a HistorySampling request to its
+// runhistoryreader equivalent.
+func samplingFromProto(sampling *spb.HistorySampling) runhistoryreader.Sampling {
+	var aggregation runhistoryreader.Aggregation
+	switch sampling.GetAggregation() {
+	case spb.HistorySampling_MIN_MAX:
+		aggregation = runhistoryreader.MinMax
+	case spb.HistorySampling_MEAN:
+		aggregation = runhistoryreader.Mean
+	case spb.HistorySampling_LTTB:
+		aggregation = runhistoryreader.LTTB
+	default:
+		aggregation = runhistoryreader.EveryNth
+	}
+
+	return runhistoryreader.Sampling{
+		TargetPoints: int(sampling.GetTargetPoints()),
+		Aggregation:  aggregation,
 	}
 }
 
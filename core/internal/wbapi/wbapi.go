@@ -72,6 +72,7 @@ func New(
 		&observability.Peeker{},
 		s,
 		s.GetExtraHTTPHeaders(),
+		/*extraLayers=*/ nil,
 	)
 
 	httpClient := retryablehttp.NewClient()
@@ -222,6 +223,8 @@ func (p *WandbAPI) HandleRequest(
 //
 // It should be called once when the API is no longer needed.
 func (p *WandbAPI) Shutdown(ctx context.Context) {
+	p.runHistoryApiHandler.Shutdown()
+
 	if err := p.opentelemetryHandler.Shutdown(ctx); err != nil {
 		p.logger.Error(
 			"wbapi: error shutting down OpenTelemetry handler",
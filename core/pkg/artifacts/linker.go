@@ -7,6 +7,7 @@ import (
 	"github.com/Khan/genqlient/graphql"
 
 	"github.com/wandb/wandb/core/internal/gql"
+	"github.com/wandb/wandb/core/internal/mutationqueue"
 	"github.com/wandb/wandb/core/internal/observability"
 	spb "github.com/wandb/wandb/core/pkg/service_go_proto"
 )
@@ -18,6 +19,10 @@ type ArtifactLinker struct {
 	GraphqlClient graphql.Client
 }
 
+// Link links the artifact to a portfolio.
+//
+// If the server can't be reached, the request is saved to be sent later
+// and a nil response is returned with no error.
 func (al *ArtifactLinker) Link() (response *gql.LinkArtifactResponse, err error) {
 	clientId := al.LinkArtifact.ClientId
 	serverId := al.LinkArtifact.ServerId
@@ -45,7 +50,7 @@ func (al *ArtifactLinker) Link() (response *gql.LinkArtifactResponse, err error)
 	switch {
 	case serverId != "":
 		response, err = gql.LinkArtifact(
-			al.Ctx,
+			mutationqueue.Replayable(al.Ctx),
 			al.GraphqlClient,
 			portfolioName,
 			portfolioEntity,
@@ -56,7 +61,7 @@ func (al *ArtifactLinker) Link() (response *gql.LinkArtifactResponse, err error)
 		)
 	case clientId != "":
 		response, err = gql.LinkArtifact(
-			al.Ctx,
+			mutationqueue.Replayable(al.Ctx),
 			al.GraphqlClient,
 			portfolioName,
 			portfolioEntity,
@@ -69,6 +74,15 @@ func (al *ArtifactLinker) Link() (response *gql.LinkArtifactResponse, err error)
 		err = fmt.Errorf("artifact must have either server id or client id")
 	}
 
+	if mutationqueue.IsSaved(err) {
+		al.Logger.Warn(
+			"artifacts: link saved to retry later",
+			"portfolio", portfolioName,
+			"error", err,
+		)
+		return nil, nil
+	}
+
 	if err != nil {
 		err = fmt.Errorf(
 			"LinkArtifact: %s, error: %w",
@@ -0,0 +1,39 @@
+package artifacts
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/wandb/wandb/core/internal/gqlmock"
+	"github.com/wandb/wandb/core/internal/mutationqueue"
+	"github.com/wandb/wandb/core/internal/observabilitytest"
+	spb "github.com/wandb/wandb/core/pkg/service_go_proto"
+)
+
+func TestLink_SavedLinkIsNotAnError(t *testing.T) {
+	mockGQL := gqlmock.NewMockClient()
+	mockGQL.StubMatchWithError(
+		gqlmock.WithOpName("LinkArtifact"),
+		&mutationqueue.SavedError{Cause: errors.New("server unreachable")},
+	)
+	linker := ArtifactLinker{
+		Ctx:    context.Background(),
+		Logger: observabilitytest.NewTestLogger(t),
+		LinkArtifact: &spb.LinkArtifactRequest{
+			ServerId:         "artifact-id",
+			PortfolioName:    "portfolio",
+			PortfolioEntity:  "test-entity",
+			PortfolioProject: "test-project",
+		},
+		GraphqlClient: mockGQL,
+	}
+
+	response, err := linker.Link()
+
+	assert.NoError(t, err)
+	assert.Nil(t, response)
+	assert.True(t, mockGQL.AllStubsUsed())
+}
@@ -18,6 +18,7 @@ import (
 	"github.com/wandb/wandb/core/internal/filetransfer"
 	"github.com/wandb/wandb/core/internal/gql"
 	"github.com/wandb/wandb/core/internal/hashencode"
+	"github.com/wandb/wandb/core/internal/mutationqueue"
 	"github.com/wandb/wandb/core/internal/namedgoroutines"
 	"github.com/wandb/wandb/core/internal/nullify"
 	"github.com/wandb/wandb/core/internal/observability"
@@ -701,12 +702,24 @@ func (as *ArtifactSaver) uploadManifest(
 	return task.Err
 }
 
+// commitArtifact finalizes the artifact version.
+//
+// If the server can't be reached, the commit is saved to be sent later
+// and no error is returned.
 func (as *ArtifactSaver) commitArtifact(artifactID string) error {
 	_, err := gql.CommitArtifact(
-		as.ctx,
+		mutationqueue.Replayable(as.ctx),
 		as.graphqlClient,
 		artifactID,
 	)
+	if mutationqueue.IsSaved(err) {
+		as.logger.Warn(
+			"artifacts: commit saved to retry later",
+			"artifactID", artifactID,
+			"error", err,
+		)
+		return nil
+	}
 	return err
 }
 
@@ -835,13 +848,14 @@ func (as *ArtifactSaver) Save() (artifactID string, rerr error) {
 		}
 
 		if as.artifact.UseAfterCommit {
+			// Replayable so that it's sent after a saved commit.
 			_, err = gql.UseArtifact(
-				as.ctx,
+				mutationqueue.Replayable(as.ctx),
 				as.graphqlClient,
 				useArtifactInput,
 			)
 
-			if err != nil {
+			if err != nil && !mutationqueue.IsSaved(err) {
 				return "", fmt.Errorf("gql.UseArtifact: %w", err)
 			}
 		}
@@ -2,6 +2,7 @@ package artifacts
 
 import (
 	"context"
+	"errors"
 	"os"
 	"testing"
 
@@ -10,6 +11,7 @@ import (
 
 	"github.com/wandb/wandb/core/internal/filetransfertest"
 	"github.com/wandb/wandb/core/internal/gqlmock"
+	"github.com/wandb/wandb/core/internal/mutationqueue"
 	"github.com/wandb/wandb/core/internal/observability"
 	"github.com/wandb/wandb/core/internal/observabilitytest"
 	spb "github.com/wandb/wandb/core/pkg/service_go_proto"
@@ -136,3 +138,64 @@ func TestSave_CleansUpManifestFileInStagingDir(t *testing.T) {
 	assert.Empty(t, entries,
 		"manifest temp file should be removed, leaving the staging dir clean")
 }
+
+func TestSave_SavedCommitIsNotAnError(t *testing.T) {
+	mockGQL := gqlmock.NewMockClient()
+	mockGQL.StubMatchOnce(
+		gqlmock.WithOpName("CreateArtifact"),
+		`{
+			"createArtifact": {
+				"artifact": {
+					"id": "artifact-id",
+					"state": "PENDING"
+				}
+			}
+		}`,
+	)
+	mockGQL.StubMatchOnce( // first createManifest request
+		gqlmock.WithOpName("CreateArtifactManifest"),
+		`{"createArtifactManifest": {}}`,
+	)
+	mockGQL.StubMatchOnce( // second one, before uploading the manifest
+		gqlmock.WithOpName("CreateArtifactManifest"),
+		`{
+			"createArtifactManifest": {
+				"artifactManifest": {
+					"file": {
+						"uploadUrl": "test-url"
+					}
+				}
+			}
+		}`,
+	)
+	mockGQL.StubMatchWithError(
+		gqlmock.WithOpName("CommitArtifact"),
+		&mutationqueue.SavedError{Cause: errors.New("server unreachable")},
+	)
+	ftm := filetransfertest.NewFakeFileTransferManager()
+	ftm.ShouldCompleteImmediately = true
+	saver := NewArtifactSaveManager(
+		observabilitytest.NewTestLogger(t),
+		observability.NewPrinter(0),
+		mockGQL,
+		ftm,
+		func() bool { return true },
+	)
+
+	result := <-saver.Save(
+		context.Background(),
+		&spb.ArtifactRecord{
+			Entity:   "test-entity",
+			Finalize: true,
+			Manifest: &spb.ArtifactManifest{
+				Version: 1,
+			},
+		},
+		0,
+		"",
+	)
+
+	assert.NoError(t, result.Err)
+	assert.Equal(t, "artifact-id", result.ArtifactID)
+	assert.True(t, mockGQL.AllStubsUsed())
+}
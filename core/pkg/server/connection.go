@@ -1,11 +1,10 @@
 package server
 
 import (
-	"bufio"
 	"context"
-	"encoding/binary"
 	"errors"
 	"fmt"
+	"io"
 	"log/slog"
 	"net"
 	"net/url"
@@ -26,16 +25,9 @@ import (
 	"github.com/wandb/wandb/core/internal/stream"
 	"github.com/wandb/wandb/core/internal/wbapi"
 
-	"google.golang.org/protobuf/proto"
-
 	spb "github.com/wandb/wandb/core/pkg/service_go_proto"
 )
 
-const (
-	messageSize    = 1024 * 1024            // 1MB message size
-	maxMessageSize = 2 * 1024 * 1024 * 1024 // 2GB max message size
-)
-
 type ConnectionParams struct {
 	StreamMux          *stream.StreamMux
 	RunSyncManager     *runsync.RunSyncManager
@@ -71,9 +63,9 @@ type Connection struct {
 	// connections.
 	stopServer context.CancelFunc
 
-	// The underlying network connection. This represents the raw TCP connection
-	// layer that facilitates communication between the client and the server.
-	conn net.Conn
+	// transport sends and receives messages on the underlying connection,
+	// such as a socket or a gRPC stream.
+	transport transport
 
 	// A map that associates stream IDs with active streams (or runs). This helps
 	// track the streams associated with this connection.
@@ -115,6 +107,23 @@ func NewConnection(
 	serverLifetimeCtx context.Context,
 	stopServer context.CancelFunc,
 	params ConnectionParams,
+) *Connection {
+	return newConnection(
+		serverLifetimeCtx,
+		stopServer,
+		params,
+		newSocketTransport(params.Conn),
+	)
+}
+
+// newConnection creates a connection that communicates over the transport.
+//
+// params.Conn is ignored.
+func newConnection(
+	serverLifetimeCtx context.Context,
+	stopServer context.CancelFunc,
+	params ConnectionParams,
+	transport transport,
 ) *Connection {
 	connLifetimeCtx, stopConnection := context.WithCancel(serverLifetimeCtx)
 
@@ -126,7 +135,7 @@ func NewConnection(
 		streamMux:          params.StreamMux,
 		runSyncManager:     params.RunSyncManager,
 		xpuResourceManager: params.XPUResourceManager,
-		conn:               params.Conn,
+		transport:          transport,
 		commit:             params.Commit,
 		id:                 params.ID,
 		inChan:             make(chan *spb.ServerRequest, BufferSize),
@@ -198,44 +207,19 @@ func (nc *Connection) processOutgoingData() {
 		case msg = <-nc.outChan:
 		}
 
-		// Marshal the message to protobuf format
-		out, err := proto.Marshal(msg)
-		if err != nil {
-			slog.Error("processOutgoingData: marshalling error", "error", err, "id", nc.id)
-			return
-		}
-
-		writer := bufio.NewWriter(nc.conn)
-		// Write header with message length
-		header := Header{
-			Magic:      byte('W'),
-			DataLength: uint32(len(out)),
-		}
-		if err = binary.Write(writer, binary.LittleEndian, &header); err != nil {
-			slog.Error("processOutgoingData: header writing error", "error", err, "id", nc.id)
-			return
-		}
-
-		// Write the message body
-		if _, err = writer.Write(out); err != nil {
-			slog.Error("processOutgoingData: message writing error", "error", err, "id", nc.id)
-			return
-		}
-
-		// Flush the writer buffer to ensure data is sent
-		if err = writer.Flush(); err != nil {
-			slog.Error("processOutgoingData: flush error", "error", err, "id", nc.id)
+		if err := nc.transport.Send(msg); err != nil {
+			slog.Error("processOutgoingData: send error", "error", err, "id", nc.id)
 			return
 		}
 	}
 }
 
-// processIncomingData reads and processes messages from a network connection.
+// processIncomingData reads and processes messages from the transport.
 //
-// This function listens for incoming data on the network connection, parses it
-// into protobuf messages, and sends those messages to the `inChan` channel for
-// further handling. When the connection closes, the `inChan` channel is also
-// closed to signal that no more data will be received.
+// This function listens for incoming requests on the transport and sends
+// them to the `inChan` channel for further handling. When the connection
+// closes, the `inChan` channel is also closed to signal that no more data
+// will be received.
 //
 // If an error occurs during message parsing or reading from the connection,
 // it will be logged with relevant details. Expected failure scenarios, such as
@@ -245,73 +229,67 @@ func (nc *Connection) processOutgoingData() {
 // provides error logging for unexpected situations that may arise during
 // communication.
 func (nc *Connection) processIncomingData() {
-
-	scanner := bufio.NewScanner(nc.conn)
-	// TODO: on 32-bit systems, we need to use a smaller buffer size
-	scanner.Buffer(make([]byte, messageSize), maxMessageSize)
-	scanner.Split(ScanWBRecords)
-
-	for scanner.Scan() {
-		msg := &spb.ServerRequest{}
-		if err := proto.Unmarshal(scanner.Bytes(), msg); err != nil {
-			dataLen := len(scanner.Bytes())
-			dataTrunc := scanner.Bytes()
-			if len(dataTrunc) > 1<<10 {
-				dataTrunc = dataTrunc[:1<<10]
-			}
-
-			slog.Error(
-				"connection: unmarshalling error, breaking connection",
-				"error", err,
-				"id", nc.id,
-				"token_len", dataLen,
-				"token_1kb", dataTrunc,
-			)
-
-			// Stop the server because a client is misbehaving, and it is no
-			// longer guaranteed that the server will receive a teardown
-			// request.
-			//
-			// The failsafe mechanism that shuts down the server if the parent
-			// process exits is not reliable here, as the client may be waiting
-			// for the server to shut down before exiting.
-			nc.stopServer()
+	var err error
+	for {
+		var msg *spb.ServerRequest
+		if msg, err = nc.transport.Recv(); err != nil {
 			break
-		} else {
-			nc.inChan <- msg
 		}
+		nc.inChan <- msg
 	}
 
 	close(nc.inChan)
 
-	if scanner.Err() != nil {
-		switch {
-		case errors.Is(scanner.Err(), net.ErrClosed):
-			// All good! The connection closed normally.
+	var malformed *malformedRequestError
+	switch {
+	case errors.Is(err, io.EOF), errors.Is(err, net.ErrClosed):
+		// All good! The connection closed normally.
 
-		default:
-			// This can happen if:
-			//
-			// A) The client process dies
-			// B) The input is corrupted
-			// C) The client process exits before finishing socket operations
-			//
-			// Case (A) is an expected failure mode. Case (B) should be
-			// extremely rare or the result of a bug.
-			//
-			// Case (C) is subtle and is unavoidable by design. Unfortunately,
-			// data may be lost. This happens when a child process started
-			// using Python's multiprocessing exits without any completion
-			// signal (e.g. run.finish()). `atexit` hooks do not run in
-			// multiprocessing, so there's no way to wait for sockets to
-			// flush.
-
-			slog.Error(
-				"connection: fatal error reading connection",
-				"error", scanner.Err(),
-				"id", nc.id,
-			)
+	case errors.As(err, &malformed):
+		dataTrunc := malformed.data
+		if len(dataTrunc) > 1<<10 {
+			dataTrunc = dataTrunc[:1<<10]
 		}
+
+		slog.Error(
+			"connection: unmarshalling error, breaking connection",
+			"error", malformed.err,
+			"id", nc.id,
+			"token_len", len(malformed.data),
+			"token_1kb", dataTrunc,
+		)
+
+		// Stop the server because a client is misbehaving, and it is no
+		// longer guaranteed that the server will receive a teardown
+		// request.
+		//
+		// The failsafe mechanism that shuts down the server if the parent
+		// process exits is not reliable here, as the client may be waiting
+		// for the server to shut down before exiting.
+		nc.stopServer()
+
+	default:
+		// This can happen if:
+		//
+		// A) The client process dies
+		// B) The input is corrupted
+		// C) The client process exits before finishing socket operations
+		//
+		// Case (A) is an expected failure mode. Case (B) should be
+		// extremely rare or the result of a bug.
+		//
+		// Case (C) is subtle and is unavoidable by design. Unfortunately,
+		// data may be lost. This happens when a child process started
+		// using Python's multiprocessing exits without any completion
+		// signal (e.g. run.finish()). `atexit` hooks do not run in
+		// multiprocessing, so there's no way to wait for sockets to
+		// flush.
+
+		slog.Error(
+			"connection: fatal error reading connection",
+			"error", err,
+			"id", nc.id,
+		)
 	}
 }
 
@@ -615,6 +593,7 @@ func (nc *Connection) handleInformFinish(msg *spb.ServerInformFinishRequest) {
 
 	// Safely close the stream
 	strm.Close()
+	strm.PrintFooterIfNeeded()
 	slog.Info("handleInformFinish: stream closed", "streamId", streamId, "id", nc.id)
 }
 
@@ -687,6 +666,39 @@ func (nc *Connection) handleSyncStatus(
 
 // handleApiInit sets up a new wandbAPI instance.
 func (nc *Connection) handleApiInit(id string, request *spb.ServerApiInitRequest) {
+	wbapiInstance, err := newWandbAPI(nc.connLifetimeCtx, request)
+	if err != nil {
+		nc.Respond(&spb.ServerResponse{
+			RequestId: id,
+			ServerResponseType: &spb.ServerResponse_ErrorResponse{
+				ErrorResponse: &spb.ServerErrorResponse{
+					Message: err.Error(),
+				},
+			},
+		})
+
+		return
+	}
+
+	wbApiId := nc.apiManager.AddWandbAPI(wbapiInstance)
+
+	nc.Respond(&spb.ServerResponse{
+		RequestId: id,
+		ServerResponseType: &spb.ServerResponse_ApiInitResponse{
+			ApiInitResponse: &spb.ServerApiInitResponse{
+				ApiId: wbApiId,
+			},
+		},
+	})
+}
+
+// newWandbAPI creates a wandbAPI instance for a ServerApiInitRequest.
+//
+// Its telemetry is flushed once lifetimeCtx is done.
+func newWandbAPI(
+	lifetimeCtx context.Context,
+	request *spb.ServerApiInitRequest,
+) (*wbapi.WandbAPI, error) {
 	s := settings.From(request.GetSettings())
 
 	telemetryProxy := analytics.NewOpenTelemetryProxy(
@@ -695,7 +707,7 @@ func (nc *Connection) handleApiInit(id string, request *spb.ServerApiInitRequest
 		"wandb-core",
 	)
 	go func() {
-		<-nc.connLifetimeCtx.Done()
+		<-lifetimeCtx.Done()
 		if telemetryProxy != nil {
 			shutdownCtx, cancel := context.WithTimeout(
 				context.Background(),
@@ -722,30 +734,7 @@ func (nc *Connection) handleApiInit(id string, request *spb.ServerApiInitRequest
 			analytics.NewTelemetryContext(),
 		),
 	)
-	wbapiInstance, err := wbapi.New(s, request.GetServiceName(), logger)
-	if err != nil {
-		nc.Respond(&spb.ServerResponse{
-			RequestId: id,
-			ServerResponseType: &spb.ServerResponse_ErrorResponse{
-				ErrorResponse: &spb.ServerErrorResponse{
-					Message: err.Error(),
-				},
-			},
-		})
-
-		return
-	}
-
-	wbApiId := nc.apiManager.AddWandbAPI(wbapiInstance)
-
-	nc.Respond(&spb.ServerResponse{
-		RequestId: id,
-		ServerResponseType: &spb.ServerResponse_ApiInitResponse{
-			ApiInitResponse: &spb.ServerApiInitResponse{
-				ApiId: wbApiId,
-			},
-		},
-	})
+	return wbapi.New(s, request.GetServiceName(), logger)
 }
 
 // handleApiCleanup cleans up a wandbAPI instance related to the provided id.
@@ -804,13 +793,13 @@ func (nc *Connection) handleApi(
 	})
 }
 
-// Close closes the underlying TCP connection.
+// Close closes the underlying connection.
 //
 // Any blocked reads or writes will return an error.
 func (nc *Connection) Close() {
 	slog.Info("connection: closing", "id", nc.id)
 
-	if err := nc.conn.Close(); err != nil {
+	if err := nc.transport.Close(); err != nil {
 		slog.Error("connection: error closing", "error", err, "id", nc.id)
 	} else {
 		slog.Info("connection: closed successfully", "id", nc.id)
@@ -0,0 +1,274 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"log/slog"
+	"net"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/wandb/wandb/core/internal/wbapi"
+	spb "github.com/wandb/wandb/core/pkg/service_go_proto"
+)
+
+// grpcService implements the WandbCoreService gRPC service.
+//
+// Connect sessions are handled like socket connections, while the unary
+// methods share the server's sync operations and a set of API instances
+// that live as long as the server.
+type grpcService struct {
+	spb.UnimplementedWandbCoreServiceServer
+
+	server *Server
+
+	// apiManager holds API instances created by ApiInit.
+	apiManager *wbapi.WandbAPIManager
+}
+
+// newGRPCServer returns a gRPC server for the WandbCoreService.
+func (s *Server) newGRPCServer() (*grpc.Server, *grpcService) {
+	service := &grpcService{
+		server:     s,
+		apiManager: wbapi.NewManager(),
+	}
+
+	grpcServer := grpc.NewServer(
+		grpc.MaxRecvMsgSize(maxMessageSize-1),
+		grpc.MaxSendMsgSize(maxMessageSize-1),
+		grpc.UnaryInterceptor(s.trackUnaryCall),
+	)
+	spb.RegisterWandbCoreServiceServer(grpcServer, service)
+
+	return grpcServer, service
+}
+
+// trackUnaryCall counts a unary call as an active connection while it runs,
+// so that a detached server isn't stopped by its idle timeout in the middle
+// of a long call such as Sync.
+func (s *Server) trackUnaryCall(
+	ctx context.Context,
+	request any,
+	_ *grpc.UnaryServerInfo,
+	handler grpc.UnaryHandler,
+) (any, error) {
+	s.onConnectionStart()
+	defer s.onConnectionEnd()
+
+	return handler(ctx, request)
+}
+
+// serveGRPC serves gRPC requests on the listener until the server shuts down.
+//
+// It blocks until in-flight requests finish.
+func (s *Server) serveGRPC(listener net.Listener) {
+	grpcServer, service := s.newGRPCServer()
+
+	var wg sync.WaitGroup
+	wg.Go(func() {
+		<-s.serverLifetimeCtx.Done()
+		grpcServer.GracefulStop()
+	})
+
+	slog.Info("server: serving gRPC", "addr", listener.Addr())
+	if err := grpcServer.Serve(listener); err != nil {
+		slog.Error("server: gRPC server failed", "error", err)
+		s.stopServer()
+	}
+	wg.Wait()
+
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+	defer cancel()
+	service.apiManager.Shutdown(shutdownCtx)
+}
+
+// Connect implements WandbCoreServiceServer.Connect.
+func (g *grpcService) Connect(
+	stream grpc.BidiStreamingServer[spb.ServerRequest, spb.ServerResponse],
+) error {
+	s := g.server
+	s.onConnectionStart()
+	defer s.onConnectionEnd()
+
+	newConnection(
+		s.serverLifetimeCtx,
+		s.stopServer,
+		ConnectionParams{
+			ID:                 fmt.Sprintf("%d(grpc)", s.connNumber.Add(1)),
+			StreamMux:          s.streamMux,
+			RunSyncManager:     s.runSyncManager,
+			XPUResourceManager: s.xpuResourceManager,
+			Commit:             s.commit,
+			LoggerPath:         s.loggerPath,
+			LogLevel:           s.logLevel,
+		},
+		newGRPCTransport(stream),
+	).ManageConnectionData()
+
+	return nil
+}
+
+// InitSync implements WandbCoreServiceServer.InitSync.
+func (g *grpcService) InitSync(
+	_ context.Context,
+	request *spb.ServerInitSyncRequest,
+) (*spb.ServerInitSyncResponse, error) {
+	return g.server.runSyncManager.InitSync(request), nil
+}
+
+// Sync implements WandbCoreServiceServer.Sync.
+func (g *grpcService) Sync(
+	ctx context.Context,
+	request *spb.ServerSyncRequest,
+) (*spb.ServerSyncResponse, error) {
+	return g.server.runSyncManager.DoSync(ctx, request), nil
+}
+
+// SyncStatus implements WandbCoreServiceServer.SyncStatus.
+func (g *grpcService) SyncStatus(
+	_ context.Context,
+	request *spb.ServerSyncStatusRequest,
+) (*spb.ServerSyncStatusResponse, error) {
+	return g.server.runSyncManager.SyncStatus(request), nil
+}
+
+// ApiInit implements WandbCoreServiceServer.ApiInit.
+func (g *grpcService) ApiInit(
+	_ context.Context,
+	request *spb.ServerApiInitRequest,
+) (*spb.ServerApiInitResponse, error) {
+	wbapiInstance, err := newWandbAPI(g.server.serverLifetimeCtx, request)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return &spb.ServerApiInitResponse{
+		ApiId: g.apiManager.AddWandbAPI(wbapiInstance),
+	}, nil
+}
+
+// ApiCleanup implements WandbCoreServiceServer.ApiCleanup.
+func (g *grpcService) ApiCleanup(
+	ctx context.Context,
+	request *spb.ServerApiCleanupRequest,
+) (*spb.ServerApiCleanupResponse, error) {
+	wbapiInstance := g.apiManager.RemoveWandbAPI(request.GetApiId())
+	if wbapiInstance != nil {
+		ctx, cancel := context.WithTimeout(
+			context.WithoutCancel(ctx),
+			2*time.Second,
+		)
+		defer cancel()
+		wbapiInstance.Shutdown(ctx)
+	}
+
+	return &spb.ServerApiCleanupResponse{}, nil
+}
+
+// Api implements WandbCoreServiceServer.Api.
+func (g *grpcService) Api(
+	ctx context.Context,
+	request *spb.ApiRequest,
+) (*spb.ApiResponse, error) {
+	wbapiInstance, err := g.apiManager.GetWandbAPI(request.GetApiId())
+	if err != nil {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+
+	response := wbapiInstance.HandleRequest(ctx, "", request)
+	if response == nil {
+		response = &spb.ApiResponse{}
+	}
+	return response, nil
+}
+
+// grpcTransport exchanges messages on a Connect stream.
+type grpcTransport struct {
+	stream grpc.BidiStreamingServer[spb.ServerRequest, spb.ServerResponse]
+
+	// requests receives the results of reading the stream.
+	requests chan grpcRecvResult
+
+	// closed is closed by Close.
+	closed    chan struct{}
+	closeOnce sync.Once
+}
+
+type grpcRecvResult struct {
+	request *spb.ServerRequest
+	err     error
+}
+
+func newGRPCTransport(
+	stream grpc.BidiStreamingServer[spb.ServerRequest, spb.ServerResponse],
+) *grpcTransport {
+	t := &grpcTransport{
+		stream:   stream,
+		requests: make(chan grpcRecvResult),
+		closed:   make(chan struct{}),
+	}
+
+	// The stream can't be closed from the server side, so it is read in
+	// a separate goroutine that Close doesn't need to wait for. It exits
+	// once the RPC completes.
+	go t.readStream()
+
+	return t
+}
+
+func (t *grpcTransport) readStream() {
+	for {
+		request, err := t.stream.Recv()
+
+		select {
+		case t.requests <- grpcRecvResult{request, err}:
+		case <-t.closed:
+			return
+		}
+
+		if err != nil {
+			return
+		}
+	}
+}
+
+// Recv implements transport.Recv.
+func (t *grpcTransport) Recv() (*spb.ServerRequest, error) {
+	select {
+	case result := <-t.requests:
+		// A client may end the session either by closing its side of
+		// the stream or by cancelling the call.
+		if result.err == io.EOF || status.Code(result.err) == codes.Canceled {
+			return nil, io.EOF
+		}
+		if result.err != nil {
+			return nil, fmt.Errorf("grpc: %w", result.err)
+		}
+		return result.request, nil
+
+	case <-t.closed:
+		return nil, net.ErrClosed
+	}
+}
+
+// Send implements transport.Send.
+func (t *grpcTransport) Send(msg *spb.ServerResponse) error {
+	select {
+	case <-t.closed:
+		return net.ErrClosed
+	default:
+	}
+
+	return t.stream.Send(msg)
+}
+
+// Close implements transport.Close.
+func (t *grpcTransport) Close() error {
+	t.closeOnce.Do(func() { close(t.closed) })
+	return nil
+}
@@ -0,0 +1,139 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+
+	spb "github.com/wandb/wandb/core/pkg/service_go_proto"
+)
+
+var grpcPortRe = regexp.MustCompile(`(?m)^grpc=(\d+)$`)
+
+// startGRPCServerForTest starts a server with a gRPC listener and returns
+// a client connected to it.
+func startGRPCServerForTest(t *testing.T) spb.WandbCoreServiceClient {
+	t.Helper()
+
+	tempRoot := t.TempDir()
+	t.Setenv("TMPDIR", tempRoot)
+	portFile := filepath.Join(tempRoot, "port.txt")
+
+	s := NewServer(ServerParams{
+		Detached: true,
+		GRPCAddr: "127.0.0.1:0",
+	})
+	srvCh := make(chan error, 1)
+	go func() { srvCh <- s.Serve(portFile) }()
+	t.Cleanup(func() {
+		s.stopServer()
+		select {
+		case err := <-srvCh:
+			assert.NoError(t, err)
+		case <-time.After(5 * time.Second):
+			t.Error("timed out waiting for Serve() to return")
+		}
+	})
+
+	var port string
+	require.Eventually(t, func() bool {
+		data, err := os.ReadFile(portFile)
+		if err != nil {
+			return false
+		}
+		match := grpcPortRe.FindSubmatch(data)
+		if match == nil {
+			return false
+		}
+		port = string(match[1])
+		return true
+	}, 5*time.Second, 10*time.Millisecond)
+
+	conn, err := grpc.NewClient(
+		fmt.Sprintf("127.0.0.1:%s", port),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = conn.Close() })
+
+	return spb.NewWandbCoreServiceClient(conn)
+}
+
+func TestGRPC_Connect(t *testing.T) {
+	client := startGRPCServerForTest(t)
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	stream, err := client.Connect(ctx)
+	require.NoError(t, err)
+	require.NoError(t, stream.Send(&spb.ServerRequest{
+		RequestId: "req-1",
+		ServerRequestType: &spb.ServerRequest_ApiRequest{
+			ApiRequest: &spb.ApiRequest{ApiId: "unknown"},
+		},
+	}))
+
+	response, err := stream.Recv()
+	require.NoError(t, err)
+	assert.Equal(t, "req-1", response.RequestId)
+	assert.Equal(t,
+		"WandbAPI is not initialized",
+		response.GetApiResponse().GetApiErrorResponse().GetMessage())
+
+	require.NoError(t, stream.CloseSend())
+	_, err = stream.Recv()
+	assert.ErrorIs(t, err, io.EOF)
+}
+
+func TestGRPC_Api_UnknownID(t *testing.T) {
+	client := startGRPCServerForTest(t)
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	_, err := client.Api(ctx, &spb.ApiRequest{ApiId: "unknown"})
+
+	assert.Equal(t, codes.NotFound, status.Code(err))
+}
+
+func TestGRPC_ApiCleanup_UnknownID(t *testing.T) {
+	client := startGRPCServerForTest(t)
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	_, err := client.ApiCleanup(ctx, &spb.ServerApiCleanupRequest{ApiId: "unknown"})
+
+	assert.NoError(t, err)
+}
+
+func TestGRPC_UnaryCallStopsIdleTimer(t *testing.T) {
+	s := newDetachedServerForTest(t, time.Hour)
+	s.startIdleTimer()
+
+	var hasTimerDuringCall bool
+	_, err := s.trackUnaryCall(
+		context.Background(),
+		nil,
+		&grpc.UnaryServerInfo{},
+		func(context.Context, any) (any, error) {
+			hasTimerDuringCall, _ = idleTimerState(s)
+			return nil, nil
+		},
+	)
+	require.NoError(t, err)
+
+	hasTimerAfterCall, _ := idleTimerState(s)
+	assert.False(t, hasTimerDuringCall)
+	assert.True(t, hasTimerAfterCall)
+}
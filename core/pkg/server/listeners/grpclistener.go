@@ -0,0 +1,21 @@
+package listeners
+
+import (
+	"fmt"
+	"net"
+)
+
+// MakeGRPCListener starts listening on a TCP address for gRPC clients.
+//
+// The address may use port 0 to pick a free port, which is recorded in
+// the port info.
+func MakeGRPCListener(addr string, portInfo *PortInfo) (net.Listener, error) {
+	listener, err := net.Listen("tcp", addr)
+	if err != nil {
+		return nil, fmt.Errorf(
+			"server/listeners: failed to listen for gRPC on %q: %v", addr, err)
+	}
+
+	portInfo.GRPCPort = listener.Addr().(*net.TCPAddr).Port
+	return listener, nil
+}
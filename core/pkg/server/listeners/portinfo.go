@@ -16,6 +16,11 @@ type PortInfo struct {
 	//
 	// The zero value means localhost cannot be used to connect.
 	LocalhostPort int
+
+	// GRPCPort is the port of the gRPC listener, if one was opened.
+	//
+	// The zero value means gRPC is not served.
+	GRPCPort int
 }
 
 // WriteToFile saves the port information to the file at the given path.
@@ -62,6 +67,13 @@ func (info PortInfo) writeToNewFile(path string) (err error) {
 		}
 	}
 
+	if info.GRPCPort != 0 {
+		if _, err = fmt.Fprintf(f, "grpc=%d\n", info.GRPCPort); err != nil {
+			return fmt.Errorf(
+				"server/listeners: write gRPC port to port file: %v", err)
+		}
+	}
+
 	if _, err = f.WriteString("EOF"); err != nil {
 		return fmt.Errorf("server/listeners: write EOF to port file: %v", err)
 	}
@@ -92,6 +92,11 @@ type Server struct {
 	// sockets are supported.
 	listenOnLocalhost bool
 
+	// grpcAddr is the address on which to serve the gRPC API.
+	//
+	// If empty, gRPC is not served.
+	grpcAddr string
+
 	// loggerPath is the default logger path
 	loggerPath string
 
@@ -105,10 +110,16 @@ func (s *Server) ForceStop() {
 	s.stopServer()
 }
 
+// CaptureProfiles captures a performance profile of every active run.
+func (s *Server) CaptureProfiles() {
+	s.streamMux.CaptureProfiles()
+}
+
 type ServerParams struct {
 	Commit              string
 	EnableDCGMProfiling bool
 	ListenOnLocalhost   bool
+	GRPCAddr            string
 	LoggerPath          string
 	LogLevel            slog.Level
 	ParentPID           int
@@ -134,6 +145,7 @@ func NewServer(params ServerParams) *Server {
 		idleTimeout:         params.IdleTimeout,
 		commit:              params.Commit,
 		listenOnLocalhost:   params.ListenOnLocalhost,
+		grpcAddr:            params.GRPCAddr,
 		loggerPath:          params.LoggerPath,
 		logLevel:            params.LogLevel,
 	}
@@ -178,7 +190,18 @@ func (s *Server) Serve(portFile string) error {
 	}
 	defer closeListeners()
 
+	var grpcListener net.Listener
+	if s.grpcAddr != "" {
+		grpcListener, err = listeners.MakeGRPCListener(s.grpcAddr, &portInfo)
+		if err != nil {
+			return err
+		}
+	}
+
 	if err := portInfo.WriteToFile(portFile); err != nil {
+		if grpcListener != nil {
+			_ = grpcListener.Close()
+		}
 		return err
 	}
 
@@ -197,6 +220,12 @@ func (s *Server) Serve(portFile string) error {
 		})
 	}
 
+	if grpcListener != nil {
+		s.connectionsWG.Go(func() {
+			s.serveGRPC(grpcListener)
+		})
+	}
+
 	// Wait for the signal to shut down.
 	<-s.serverLifetimeCtx.Done()
 	slog.Info("server: is shutting down")
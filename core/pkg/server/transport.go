@@ -0,0 +1,121 @@
+package server
+
+import (
+	"bufio"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"net"
+
+	"google.golang.org/protobuf/proto"
+
+	spb "github.com/wandb/wandb/core/pkg/service_go_proto"
+)
+
+const (
+	messageSize    = 1024 * 1024            // 1MB message size
+	maxMessageSize = 2 * 1024 * 1024 * 1024 // 2GB max message size
+)
+
+// transport sends and receives messages for a Connection.
+type transport interface {
+	// Recv blocks until the next request is received.
+	//
+	// It returns io.EOF if the client is done sending requests and
+	// net.ErrClosed if the transport was closed. Requests that cannot be
+	// parsed produce a *malformedRequestError.
+	Recv() (*spb.ServerRequest, error)
+
+	// Send writes a response.
+	//
+	// It is not called concurrently.
+	Send(*spb.ServerResponse) error
+
+	// Close closes the transport, unblocking any Recv and Send calls.
+	Close() error
+}
+
+// malformedRequestError is returned by a transport for data that is not
+// a valid ServerRequest.
+type malformedRequestError struct {
+	err  error
+	data []byte
+}
+
+func (e *malformedRequestError) Error() string {
+	return fmt.Sprintf("malformed request: %v", e.err)
+}
+
+func (e *malformedRequestError) Unwrap() error {
+	return e.err
+}
+
+// socketTransport exchanges length-prefixed messages on a socket.
+//
+// Each message is preceded by a Header.
+type socketTransport struct {
+	conn    net.Conn
+	scanner *bufio.Scanner
+}
+
+func newSocketTransport(conn net.Conn) *socketTransport {
+	scanner := bufio.NewScanner(conn)
+	// TODO: on 32-bit systems, we need to use a smaller buffer size
+	scanner.Buffer(make([]byte, messageSize), maxMessageSize)
+	scanner.Split(ScanWBRecords)
+
+	return &socketTransport{conn: conn, scanner: scanner}
+}
+
+// Recv implements transport.Recv.
+func (t *socketTransport) Recv() (*spb.ServerRequest, error) {
+	if !t.scanner.Scan() {
+		if err := t.scanner.Err(); err != nil {
+			return nil, err
+		}
+		return nil, io.EOF
+	}
+
+	msg := &spb.ServerRequest{}
+	if err := proto.Unmarshal(t.scanner.Bytes(), msg); err != nil {
+		return nil, &malformedRequestError{err: err, data: t.scanner.Bytes()}
+	}
+
+	return msg, nil
+}
+
+// Send implements transport.Send.
+func (t *socketTransport) Send(msg *spb.ServerResponse) error {
+	// Marshal the message to protobuf format
+	out, err := proto.Marshal(msg)
+	if err != nil {
+		return fmt.Errorf("marshalling error: %v", err)
+	}
+
+	writer := bufio.NewWriter(t.conn)
+	// Write header with message length
+	header := Header{
+		Magic:      byte('W'),
+		DataLength: uint32(len(out)),
+	}
+	if err = binary.Write(writer, binary.LittleEndian, &header); err != nil {
+		return fmt.Errorf("header writing error: %v", err)
+	}
+
+	// Write the message body
+	if _, err = writer.Write(out); err != nil {
+		return fmt.Errorf("message writing error: %v", err)
+	}
+
+	// Flush the writer buffer to ensure data is sent
+	if err = writer.Flush(); err != nil {
+		return fmt.Errorf("flush error: %v", err)
+	}
+
+	return nil
+}
+
+// Close implements transport.Close.
+func (t *socketTransport) Close() error {
+	return t.conn.Close()
+}
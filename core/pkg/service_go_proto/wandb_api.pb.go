@@ -67,6 +67,125 @@ func (ErrorType) EnumDescriptor() ([]byte, []int) {
 	return file_wandb_proto_wandb_api_proto_rawDescGZIP(), []int{0}
 }
 
+type ScanRunHistory_Format int32
+
+const (
+	// Return history_rows with JSON-encoded values.
+	ScanRunHistory_HISTORY_ROWS ScanRunHistory_Format = 0
+	// Return rows from exported history files as Arrow IPC streams
+	// in arrow_ipc_streams.
+	//
+	// Rows that haven't been exported yet are returned in history_rows.
+	ScanRunHistory_ARROW_IPC ScanRunHistory_Format = 1
+	// Like ARROW_IPC, but write each stream to a temporary file
+	// and return the paths in arrow_ipc_files.
+	//
+	// The caller is responsible for deleting the files.
+	ScanRunHistory_ARROW_IPC_FILE ScanRunHistory_Format = 2
+)
+
+// Enum value maps for ScanRunHistory_Format.
+var (
+	ScanRunHistory_Format_name = map[int32]string{
+		0: "HISTORY_ROWS",
+		1: "ARROW_IPC",
+		2: "ARROW_IPC_FILE",
+	}
+	ScanRunHistory_Format_value = map[string]int32{
+		"HISTORY_ROWS":   0,
+		"ARROW_IPC":      1,
+		"ARROW_IPC_FILE": 2,
+	}
+)
+
+func (x ScanRunHistory_Format) Enum() *ScanRunHistory_Format {
+	p := new(ScanRunHistory_Format)
+	*p = x
+	return p
+}
+
+func (x ScanRunHistory_Format) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ScanRunHistory_Format) Descriptor() protoreflect.EnumDescriptor {
+	return file_wandb_proto_wandb_api_proto_enumTypes[1].Descriptor()
+}
+
+func (ScanRunHistory_Format) Type() protoreflect.EnumType {
+	return &file_wandb_proto_wandb_api_proto_enumTypes[1]
+}
+
+func (x ScanRunHistory_Format) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ScanRunHistory_Format.Descriptor instead.
+func (ScanRunHistory_Format) EnumDescriptor() ([]byte, []int) {
+	return file_wandb_proto_wandb_api_proto_rawDescGZIP(), []int{45, 0}
+}
+
+type HistorySampling_Aggregation int32
+
+const (
+	// Return the first row in each step bucket.
+	HistorySampling_EVERY_NTH HistorySampling_Aggregation = 0
+	// For each metric, return the rows holding the smallest and largest
+	// value in each step bucket.
+	//
+	// Each bucket contributes up to two points per metric.
+	HistorySampling_MIN_MAX HistorySampling_Aggregation = 1
+	// For each metric, return the mean value in each step bucket,
+	// at the bucket's first step.
+	HistorySampling_MEAN HistorySampling_Aggregation = 2
+	// For each metric, select points using the
+	// Largest-Triangle-Three-Buckets algorithm.
+	HistorySampling_LTTB HistorySampling_Aggregation = 3
+)
+
+// Enum value maps for HistorySampling_Aggregation.
+var (
+	HistorySampling_Aggregation_name = map[int32]string{
+		0: "EVERY_NTH",
+		1: "MIN_MAX",
+		2: "MEAN",
+		3: "LTTB",
+	}
+	HistorySampling_Aggregation_value = map[string]int32{
+		"EVERY_NTH": 0,
+		"MIN_MAX":   1,
+		"MEAN":      2,
+		"LTTB":      3,
+	}
+)
+
+func (x HistorySampling_Aggregation) Enum() *HistorySampling_Aggregation {
+	p := new(HistorySampling_Aggregation)
+	*p = x
+	return p
+}
+
+func (x HistorySampling_Aggregation) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (HistorySampling_Aggregation) Descriptor() protoreflect.EnumDescriptor {
+	return file_wandb_proto_wandb_api_proto_enumTypes[2].Descriptor()
+}
+
+func (HistorySampling_Aggregation) Type() protoreflect.EnumType {
+	return &file_wandb_proto_wandb_api_proto_enumTypes[2]
+}
+
+func (x HistorySampling_Aggregation) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use HistorySampling_Aggregation.Descriptor instead.
+func (HistorySampling_Aggregation) EnumDescriptor() ([]byte, []int) {
+	return file_wandb_proto_wandb_api_proto_rawDescGZIP(), []int{46, 0}
+}
+
 // Initialize an API instance for the given settings.
 //
 // ServerApiCleanupRequest should be used once the instance is no longer needed.
@@ -3155,6 +3274,9 @@ type ReadRunHistoryRequest struct {
 	//	*ReadRunHistoryRequest_DownloadRunHistoryInit
 	//	*ReadRunHistoryRequest_DownloadRunHistory
 	//	*ReadRunHistoryRequest_DownloadRunHistoryStatus
+	//	*ReadRunHistoryRequest_BatchRunHistoryInit
+	//	*ReadRunHistoryRequest_BatchRunHistoryNext
+	//	*ReadRunHistoryRequest_BatchRunHistoryCleanup
 	Request       isReadRunHistoryRequest_Request `protobuf_oneof:"request"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
@@ -3251,6 +3373,33 @@ func (x *ReadRunHistoryRequest) GetDownloadRunHistoryStatus() *DownloadRunHistor
 	return nil
 }
 
+func (x *ReadRunHistoryRequest) GetBatchRunHistoryInit() *BatchRunHistoryInit {
+	if x != nil {
+		if x, ok := x.Request.(*ReadRunHistoryRequest_BatchRunHistoryInit); ok {
+			return x.BatchRunHistoryInit
+		}
+	}
+	return nil
+}
+
+func (x *ReadRunHistoryRequest) GetBatchRunHistoryNext() *BatchRunHistoryNext {
+	if x != nil {
+		if x, ok := x.Request.(*ReadRunHistoryRequest_BatchRunHistoryNext); ok {
+			return x.BatchRunHistoryNext
+		}
+	}
+	return nil
+}
+
+func (x *ReadRunHistoryRequest) GetBatchRunHistoryCleanup() *BatchRunHistoryCleanup {
+	if x != nil {
+		if x, ok := x.Request.(*ReadRunHistoryRequest_BatchRunHistoryCleanup); ok {
+			return x.BatchRunHistoryCleanup
+		}
+	}
+	return nil
+}
+
 type isReadRunHistoryRequest_Request interface {
 	isReadRunHistoryRequest_Request()
 }
@@ -3279,6 +3428,18 @@ type ReadRunHistoryRequest_DownloadRunHistoryStatus struct {
 	DownloadRunHistoryStatus *DownloadRunHistoryStatus `protobuf:"bytes,6,opt,name=download_run_history_status,json=downloadRunHistoryStatus,proto3,oneof"`
 }
 
+type ReadRunHistoryRequest_BatchRunHistoryInit struct {
+	BatchRunHistoryInit *BatchRunHistoryInit `protobuf:"bytes,7,opt,name=batch_run_history_init,json=batchRunHistoryInit,proto3,oneof"`
+}
+
+type ReadRunHistoryRequest_BatchRunHistoryNext struct {
+	BatchRunHistoryNext *BatchRunHistoryNext `protobuf:"bytes,8,opt,name=batch_run_history_next,json=batchRunHistoryNext,proto3,oneof"`
+}
+
+type ReadRunHistoryRequest_BatchRunHistoryCleanup struct {
+	BatchRunHistoryCleanup *BatchRunHistoryCleanup `protobuf:"bytes,9,opt,name=batch_run_history_cleanup,json=batchRunHistoryCleanup,proto3,oneof"`
+}
+
 func (*ReadRunHistoryRequest_ScanRunHistoryInit) isReadRunHistoryRequest_Request() {}
 
 func (*ReadRunHistoryRequest_ScanRunHistory) isReadRunHistoryRequest_Request() {}
@@ -3291,6 +3452,12 @@ func (*ReadRunHistoryRequest_DownloadRunHistory) isReadRunHistoryRequest_Request
 
 func (*ReadRunHistoryRequest_DownloadRunHistoryStatus) isReadRunHistoryRequest_Request() {}
 
+func (*ReadRunHistoryRequest_BatchRunHistoryInit) isReadRunHistoryRequest_Request() {}
+
+func (*ReadRunHistoryRequest_BatchRunHistoryNext) isReadRunHistoryRequest_Request() {}
+
+func (*ReadRunHistoryRequest_BatchRunHistoryCleanup) isReadRunHistoryRequest_Request() {}
+
 type ReadRunHistoryResponse struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// Types that are valid to be assigned to Response:
@@ -3301,6 +3468,9 @@ type ReadRunHistoryResponse struct {
 	//	*ReadRunHistoryResponse_DownloadRunHistoryInit
 	//	*ReadRunHistoryResponse_DownloadRunHistory
 	//	*ReadRunHistoryResponse_DownloadRunHistoryStatus
+	//	*ReadRunHistoryResponse_BatchRunHistoryInit
+	//	*ReadRunHistoryResponse_BatchRunHistory
+	//	*ReadRunHistoryResponse_BatchRunHistoryCleanup
 	Response      isReadRunHistoryResponse_Response `protobuf_oneof:"response"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
@@ -3397,6 +3567,33 @@ func (x *ReadRunHistoryResponse) GetDownloadRunHistoryStatus() *DownloadRunHisto
 	return nil
 }
 
+func (x *ReadRunHistoryResponse) GetBatchRunHistoryInit() *BatchRunHistoryInitResponse {
+	if x != nil {
+		if x, ok := x.Response.(*ReadRunHistoryResponse_BatchRunHistoryInit); ok {
+			return x.BatchRunHistoryInit
+		}
+	}
+	return nil
+}
+
+func (x *ReadRunHistoryResponse) GetBatchRunHistory() *BatchRunHistoryResponse {
+	if x != nil {
+		if x, ok := x.Response.(*ReadRunHistoryResponse_BatchRunHistory); ok {
+			return x.BatchRunHistory
+		}
+	}
+	return nil
+}
+
+func (x *ReadRunHistoryResponse) GetBatchRunHistoryCleanup() *BatchRunHistoryCleanupResponse {
+	if x != nil {
+		if x, ok := x.Response.(*ReadRunHistoryResponse_BatchRunHistoryCleanup); ok {
+			return x.BatchRunHistoryCleanup
+		}
+	}
+	return nil
+}
+
 type isReadRunHistoryResponse_Response interface {
 	isReadRunHistoryResponse_Response()
 }
@@ -3425,6 +3622,18 @@ type ReadRunHistoryResponse_DownloadRunHistoryStatus struct {
 	DownloadRunHistoryStatus *DownloadRunHistoryStatusResponse `protobuf:"bytes,6,opt,name=download_run_history_status,json=downloadRunHistoryStatus,proto3,oneof"`
 }
 
+type ReadRunHistoryResponse_BatchRunHistoryInit struct {
+	BatchRunHistoryInit *BatchRunHistoryInitResponse `protobuf:"bytes,7,opt,name=batch_run_history_init,json=batchRunHistoryInit,proto3,oneof"`
+}
+
+type ReadRunHistoryResponse_BatchRunHistory struct {
+	BatchRunHistory *BatchRunHistoryResponse `protobuf:"bytes,8,opt,name=batch_run_history,json=batchRunHistory,proto3,oneof"`
+}
+
+type ReadRunHistoryResponse_BatchRunHistoryCleanup struct {
+	BatchRunHistoryCleanup *BatchRunHistoryCleanupResponse `protobuf:"bytes,9,opt,name=batch_run_history_cleanup,json=batchRunHistoryCleanup,proto3,oneof"`
+}
+
 func (*ReadRunHistoryResponse_ScanRunHistoryInit) isReadRunHistoryResponse_Response() {}
 
 func (*ReadRunHistoryResponse_RunHistory) isReadRunHistoryResponse_Response() {}
@@ -3437,6 +3646,12 @@ func (*ReadRunHistoryResponse_DownloadRunHistory) isReadRunHistoryResponse_Respo
 
 func (*ReadRunHistoryResponse_DownloadRunHistoryStatus) isReadRunHistoryResponse_Response() {}
 
+func (*ReadRunHistoryResponse_BatchRunHistoryInit) isReadRunHistoryResponse_Response() {}
+
+func (*ReadRunHistoryResponse_BatchRunHistory) isReadRunHistoryResponse_Response() {}
+
+func (*ReadRunHistoryResponse_BatchRunHistoryCleanup) isReadRunHistoryResponse_Response() {}
+
 // ScanRunHistoryInit is a request to initialize
 // a scan over a run's history.
 //
@@ -3566,10 +3781,16 @@ func (x *ScanRunHistoryInitResponse) GetRequestId() int32 {
 // ScanRunHistory is a request to scan
 // over a portion of a run's history.
 type ScanRunHistory struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	MinStep       int64                  `protobuf:"varint,1,opt,name=min_step,json=minStep,proto3" json:"min_step,omitempty"`
-	MaxStep       int64                  `protobuf:"varint,2,opt,name=max_step,json=maxStep,proto3" json:"max_step,omitempty"`
-	RequestId     int32                  `protobuf:"varint,3,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	MinStep   int64                  `protobuf:"varint,1,opt,name=min_step,json=minStep,proto3" json:"min_step,omitempty"`
+	MaxStep   int64                  `protobuf:"varint,2,opt,name=max_step,json=maxStep,proto3" json:"max_step,omitempty"`
+	RequestId int32                  `protobuf:"varint,3,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	// If set, the rows in the step range are downsampled
+	// instead of being returned in full.
+	//
+	// Sampling is only supported with the HISTORY_ROWS format.
+	Sampling      *HistorySampling      `protobuf:"bytes,4,opt,name=sampling,proto3" json:"sampling,omitempty"`
+	Format        ScanRunHistory_Format `protobuf:"varint,5,opt,name=format,proto3,enum=wandb_internal.ScanRunHistory_Format" json:"format,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -3625,16 +3846,95 @@ func (x *ScanRunHistory) GetRequestId() int32 {
 	return 0
 }
 
+func (x *ScanRunHistory) GetSampling() *HistorySampling {
+	if x != nil {
+		return x.Sampling
+	}
+	return nil
+}
+
+func (x *ScanRunHistory) GetFormat() ScanRunHistory_Format {
+	if x != nil {
+		return x.Format
+	}
+	return ScanRunHistory_HISTORY_ROWS
+}
+
+// HistorySampling reduces a range of history rows
+// to a bounded number of points.
+type HistorySampling struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The maximum number of points to return for each metric.
+	//
+	// If zero or negative, the rows are not downsampled.
+	TargetPoints  int32                       `protobuf:"varint,1,opt,name=target_points,json=targetPoints,proto3" json:"target_points,omitempty"`
+	Aggregation   HistorySampling_Aggregation `protobuf:"varint,2,opt,name=aggregation,proto3,enum=wandb_internal.HistorySampling_Aggregation" json:"aggregation,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HistorySampling) Reset() {
+	*x = HistorySampling{}
+	mi := &file_wandb_proto_wandb_api_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HistorySampling) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HistorySampling) ProtoMessage() {}
+
+func (x *HistorySampling) ProtoReflect() protoreflect.Message {
+	mi := &file_wandb_proto_wandb_api_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HistorySampling.ProtoReflect.Descriptor instead.
+func (*HistorySampling) Descriptor() ([]byte, []int) {
+	return file_wandb_proto_wandb_api_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *HistorySampling) GetTargetPoints() int32 {
+	if x != nil {
+		return x.TargetPoints
+	}
+	return 0
+}
+
+func (x *HistorySampling) GetAggregation() HistorySampling_Aggregation {
+	if x != nil {
+		return x.Aggregation
+	}
+	return HistorySampling_EVERY_NTH
+}
+
 type RunHistoryResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	HistoryRows   []*HistoryRow          `protobuf:"bytes,1,rep,name=history_rows,json=historyRows,proto3" json:"history_rows,omitempty"`
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	HistoryRows []*HistoryRow          `protobuf:"bytes,1,rep,name=history_rows,json=historyRows,proto3" json:"history_rows,omitempty"`
+	// Arrow IPC streams, one per exported history file with rows in the
+	// requested range. Only set for the ARROW_IPC format.
+	//
+	// Each stream has its file's schema, which may differ between files.
+	ArrowIpcStreams [][]byte `protobuf:"bytes,2,rep,name=arrow_ipc_streams,json=arrowIpcStreams,proto3" json:"arrow_ipc_streams,omitempty"`
+	// Paths to Arrow IPC stream files, one per exported history file
+	// with rows in the requested range. Only set for the ARROW_IPC_FILE format.
+	ArrowIpcFiles []string `protobuf:"bytes,3,rep,name=arrow_ipc_files,json=arrowIpcFiles,proto3" json:"arrow_ipc_files,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *RunHistoryResponse) Reset() {
 	*x = RunHistoryResponse{}
-	mi := &file_wandb_proto_wandb_api_proto_msgTypes[46]
+	mi := &file_wandb_proto_wandb_api_proto_msgTypes[47]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3646,7 +3946,7 @@ func (x *RunHistoryResponse) String() string {
 func (*RunHistoryResponse) ProtoMessage() {}
 
 func (x *RunHistoryResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_wandb_proto_wandb_api_proto_msgTypes[46]
+	mi := &file_wandb_proto_wandb_api_proto_msgTypes[47]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3659,7 +3959,7 @@ func (x *RunHistoryResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RunHistoryResponse.ProtoReflect.Descriptor instead.
 func (*RunHistoryResponse) Descriptor() ([]byte, []int) {
-	return file_wandb_proto_wandb_api_proto_rawDescGZIP(), []int{46}
+	return file_wandb_proto_wandb_api_proto_rawDescGZIP(), []int{47}
 }
 
 func (x *RunHistoryResponse) GetHistoryRows() []*HistoryRow {
@@ -3669,6 +3969,20 @@ func (x *RunHistoryResponse) GetHistoryRows() []*HistoryRow {
 	return nil
 }
 
+func (x *RunHistoryResponse) GetArrowIpcStreams() [][]byte {
+	if x != nil {
+		return x.ArrowIpcStreams
+	}
+	return nil
+}
+
+func (x *RunHistoryResponse) GetArrowIpcFiles() []string {
+	if x != nil {
+		return x.ArrowIpcFiles
+	}
+	return nil
+}
+
 type HistoryRow struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	HistoryItems  []*ParquetHistoryItem  `protobuf:"bytes,1,rep,name=history_items,json=historyItems,proto3" json:"history_items,omitempty"`
@@ -3678,7 +3992,7 @@ type HistoryRow struct {
 
 func (x *HistoryRow) Reset() {
 	*x = HistoryRow{}
-	mi := &file_wandb_proto_wandb_api_proto_msgTypes[47]
+	mi := &file_wandb_proto_wandb_api_proto_msgTypes[48]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3690,7 +4004,7 @@ func (x *HistoryRow) String() string {
 func (*HistoryRow) ProtoMessage() {}
 
 func (x *HistoryRow) ProtoReflect() protoreflect.Message {
-	mi := &file_wandb_proto_wandb_api_proto_msgTypes[47]
+	mi := &file_wandb_proto_wandb_api_proto_msgTypes[48]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3703,7 +4017,7 @@ func (x *HistoryRow) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use HistoryRow.ProtoReflect.Descriptor instead.
 func (*HistoryRow) Descriptor() ([]byte, []int) {
-	return file_wandb_proto_wandb_api_proto_rawDescGZIP(), []int{47}
+	return file_wandb_proto_wandb_api_proto_rawDescGZIP(), []int{48}
 }
 
 func (x *HistoryRow) GetHistoryItems() []*ParquetHistoryItem {
@@ -3723,7 +4037,7 @@ type ParquetHistoryItem struct {
 
 func (x *ParquetHistoryItem) Reset() {
 	*x = ParquetHistoryItem{}
-	mi := &file_wandb_proto_wandb_api_proto_msgTypes[48]
+	mi := &file_wandb_proto_wandb_api_proto_msgTypes[49]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3735,7 +4049,7 @@ func (x *ParquetHistoryItem) String() string {
 func (*ParquetHistoryItem) ProtoMessage() {}
 
 func (x *ParquetHistoryItem) ProtoReflect() protoreflect.Message {
-	mi := &file_wandb_proto_wandb_api_proto_msgTypes[48]
+	mi := &file_wandb_proto_wandb_api_proto_msgTypes[49]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3748,7 +4062,7 @@ func (x *ParquetHistoryItem) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ParquetHistoryItem.ProtoReflect.Descriptor instead.
 func (*ParquetHistoryItem) Descriptor() ([]byte, []int) {
-	return file_wandb_proto_wandb_api_proto_rawDescGZIP(), []int{48}
+	return file_wandb_proto_wandb_api_proto_rawDescGZIP(), []int{49}
 }
 
 func (x *ParquetHistoryItem) GetKey() string {
@@ -3776,7 +4090,7 @@ type ScanRunHistoryCleanup struct {
 
 func (x *ScanRunHistoryCleanup) Reset() {
 	*x = ScanRunHistoryCleanup{}
-	mi := &file_wandb_proto_wandb_api_proto_msgTypes[49]
+	mi := &file_wandb_proto_wandb_api_proto_msgTypes[50]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3788,7 +4102,7 @@ func (x *ScanRunHistoryCleanup) String() string {
 func (*ScanRunHistoryCleanup) ProtoMessage() {}
 
 func (x *ScanRunHistoryCleanup) ProtoReflect() protoreflect.Message {
-	mi := &file_wandb_proto_wandb_api_proto_msgTypes[49]
+	mi := &file_wandb_proto_wandb_api_proto_msgTypes[50]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3801,7 +4115,7 @@ func (x *ScanRunHistoryCleanup) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ScanRunHistoryCleanup.ProtoReflect.Descriptor instead.
 func (*ScanRunHistoryCleanup) Descriptor() ([]byte, []int) {
-	return file_wandb_proto_wandb_api_proto_rawDescGZIP(), []int{49}
+	return file_wandb_proto_wandb_api_proto_rawDescGZIP(), []int{50}
 }
 
 func (x *ScanRunHistoryCleanup) GetRequestId() int32 {
@@ -3819,7 +4133,7 @@ type ScanRunHistoryCleanupResponse struct {
 
 func (x *ScanRunHistoryCleanupResponse) Reset() {
 	*x = ScanRunHistoryCleanupResponse{}
-	mi := &file_wandb_proto_wandb_api_proto_msgTypes[50]
+	mi := &file_wandb_proto_wandb_api_proto_msgTypes[51]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3831,7 +4145,7 @@ func (x *ScanRunHistoryCleanupResponse) String() string {
 func (*ScanRunHistoryCleanupResponse) ProtoMessage() {}
 
 func (x *ScanRunHistoryCleanupResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_wandb_proto_wandb_api_proto_msgTypes[50]
+	mi := &file_wandb_proto_wandb_api_proto_msgTypes[51]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3844,42 +4158,34 @@ func (x *ScanRunHistoryCleanupResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ScanRunHistoryCleanupResponse.ProtoReflect.Descriptor instead.
 func (*ScanRunHistoryCleanupResponse) Descriptor() ([]byte, []int) {
-	return file_wandb_proto_wandb_api_proto_rawDescGZIP(), []int{50}
+	return file_wandb_proto_wandb_api_proto_rawDescGZIP(), []int{51}
 }
 
-type DownloadRunHistoryInit struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// The entity who owns the run.
-	Entity string `protobuf:"bytes,1,opt,name=entity,proto3" json:"entity,omitempty"`
-	// The project that the run belongs to.
-	Project string `protobuf:"bytes,2,opt,name=project,proto3" json:"project,omitempty"`
-	// The ID of the run.
-	RunId string `protobuf:"bytes,3,opt,name=run_id,json=runId,proto3" json:"run_id,omitempty"`
-	// The directory to store the downloaded history files within.
-	DownloadDir string `protobuf:"bytes,4,opt,name=download_dir,json=downloadDir,proto3" json:"download_dir,omitempty"`
-	// Whether to require the complete history to be downloaded.
-	// If the run contains data that has not been exported to parquet files yet,
-	// the run history will be considered incomplete.
-	RequireCompleteHistory bool `protobuf:"varint,5,opt,name=require_complete_history,json=requireCompleteHistory,proto3" json:"require_complete_history,omitempty"`
-	unknownFields          protoimpl.UnknownFields
-	sizeCache              protoimpl.SizeCache
+// HistoryRunPath identifies a run whose history to read.
+type HistoryRunPath struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Entity        string                 `protobuf:"bytes,1,opt,name=entity,proto3" json:"entity,omitempty"`
+	Project       string                 `protobuf:"bytes,2,opt,name=project,proto3" json:"project,omitempty"`
+	RunId         string                 `protobuf:"bytes,3,opt,name=run_id,json=runId,proto3" json:"run_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DownloadRunHistoryInit) Reset() {
-	*x = DownloadRunHistoryInit{}
-	mi := &file_wandb_proto_wandb_api_proto_msgTypes[51]
+func (x *HistoryRunPath) Reset() {
+	*x = HistoryRunPath{}
+	mi := &file_wandb_proto_wandb_api_proto_msgTypes[52]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DownloadRunHistoryInit) String() string {
+func (x *HistoryRunPath) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DownloadRunHistoryInit) ProtoMessage() {}
+func (*HistoryRunPath) ProtoMessage() {}
 
-func (x *DownloadRunHistoryInit) ProtoReflect() protoreflect.Message {
-	mi := &file_wandb_proto_wandb_api_proto_msgTypes[51]
+func (x *HistoryRunPath) ProtoReflect() protoreflect.Message {
+	mi := &file_wandb_proto_wandb_api_proto_msgTypes[52]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3890,49 +4196,502 @@ func (x *DownloadRunHistoryInit) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DownloadRunHistoryInit.ProtoReflect.Descriptor instead.
-func (*DownloadRunHistoryInit) Descriptor() ([]byte, []int) {
-	return file_wandb_proto_wandb_api_proto_rawDescGZIP(), []int{51}
+// Deprecated: Use HistoryRunPath.ProtoReflect.Descriptor instead.
+func (*HistoryRunPath) Descriptor() ([]byte, []int) {
+	return file_wandb_proto_wandb_api_proto_rawDescGZIP(), []int{52}
 }
 
-func (x *DownloadRunHistoryInit) GetEntity() string {
+func (x *HistoryRunPath) GetEntity() string {
 	if x != nil {
 		return x.Entity
 	}
 	return ""
 }
 
-func (x *DownloadRunHistoryInit) GetProject() string {
+func (x *HistoryRunPath) GetProject() string {
 	if x != nil {
 		return x.Project
 	}
 	return ""
 }
 
-func (x *DownloadRunHistoryInit) GetRunId() string {
+func (x *HistoryRunPath) GetRunId() string {
 	if x != nil {
 		return x.RunId
 	}
 	return ""
 }
 
-func (x *DownloadRunHistoryInit) GetDownloadDir() string {
-	if x != nil {
-		return x.DownloadDir
-	}
-	return ""
+// BatchRunHistoryInit is a request to start reading
+// the history of many runs at once.
+//
+// Runs are read concurrently in the background. Their results are
+// collected with BatchRunHistoryNext requests as they become available.
+type BatchRunHistoryInit struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Runs  []*HistoryRunPath      `protobuf:"bytes,1,rep,name=runs,proto3" json:"runs,omitempty"`
+	// The metrics to read. If empty, all metrics are read.
+	Keys []string `protobuf:"bytes,2,rep,name=keys,proto3" json:"keys,omitempty"`
+	// The range of steps to read, including min_step and excluding max_step.
+	MinStep  int64 `protobuf:"varint,3,opt,name=min_step,json=minStep,proto3" json:"min_step,omitempty"`
+	MaxStep  int64 `protobuf:"varint,4,opt,name=max_step,json=maxStep,proto3" json:"max_step,omitempty"`
+	UseCache bool  `protobuf:"varint,5,opt,name=use_cache,json=useCache,proto3" json:"use_cache,omitempty"`
+	// If set, each run's rows are downsampled.
+	Sampling      *HistorySampling `protobuf:"bytes,6,opt,name=sampling,proto3" json:"sampling,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DownloadRunHistoryInit) GetRequireCompleteHistory() bool {
-	if x != nil {
-		return x.RequireCompleteHistory
-	}
-	return false
+func (x *BatchRunHistoryInit) Reset() {
+	*x = BatchRunHistoryInit{}
+	mi := &file_wandb_proto_wandb_api_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-type DownloadRunHistoryInitResponse struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// The request id of the download operation.
+func (x *BatchRunHistoryInit) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchRunHistoryInit) ProtoMessage() {}
+
+func (x *BatchRunHistoryInit) ProtoReflect() protoreflect.Message {
+	mi := &file_wandb_proto_wandb_api_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchRunHistoryInit.ProtoReflect.Descriptor instead.
+func (*BatchRunHistoryInit) Descriptor() ([]byte, []int) {
+	return file_wandb_proto_wandb_api_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *BatchRunHistoryInit) GetRuns() []*HistoryRunPath {
+	if x != nil {
+		return x.Runs
+	}
+	return nil
+}
+
+func (x *BatchRunHistoryInit) GetKeys() []string {
+	if x != nil {
+		return x.Keys
+	}
+	return nil
+}
+
+func (x *BatchRunHistoryInit) GetMinStep() int64 {
+	if x != nil {
+		return x.MinStep
+	}
+	return 0
+}
+
+func (x *BatchRunHistoryInit) GetMaxStep() int64 {
+	if x != nil {
+		return x.MaxStep
+	}
+	return 0
+}
+
+func (x *BatchRunHistoryInit) GetUseCache() bool {
+	if x != nil {
+		return x.UseCache
+	}
+	return false
+}
+
+func (x *BatchRunHistoryInit) GetSampling() *HistorySampling {
+	if x != nil {
+		return x.Sampling
+	}
+	return nil
+}
+
+type BatchRunHistoryInitResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RequestId     int32                  `protobuf:"varint,1,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchRunHistoryInitResponse) Reset() {
+	*x = BatchRunHistoryInitResponse{}
+	mi := &file_wandb_proto_wandb_api_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchRunHistoryInitResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchRunHistoryInitResponse) ProtoMessage() {}
+
+func (x *BatchRunHistoryInitResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_wandb_proto_wandb_api_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchRunHistoryInitResponse.ProtoReflect.Descriptor instead.
+func (*BatchRunHistoryInitResponse) Descriptor() ([]byte, []int) {
+	return file_wandb_proto_wandb_api_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *BatchRunHistoryInitResponse) GetRequestId() int32 {
+	if x != nil {
+		return x.RequestId
+	}
+	return 0
+}
+
+// BatchRunHistoryNext is a request for the results of a batch
+// that have become available since the previous request.
+//
+// It blocks until at least one run is done or the batch is complete.
+type BatchRunHistoryNext struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RequestId     int32                  `protobuf:"varint,1,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchRunHistoryNext) Reset() {
+	*x = BatchRunHistoryNext{}
+	mi := &file_wandb_proto_wandb_api_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchRunHistoryNext) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchRunHistoryNext) ProtoMessage() {}
+
+func (x *BatchRunHistoryNext) ProtoReflect() protoreflect.Message {
+	mi := &file_wandb_proto_wandb_api_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchRunHistoryNext.ProtoReflect.Descriptor instead.
+func (*BatchRunHistoryNext) Descriptor() ([]byte, []int) {
+	return file_wandb_proto_wandb_api_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *BatchRunHistoryNext) GetRequestId() int32 {
+	if x != nil {
+		return x.RequestId
+	}
+	return 0
+}
+
+type BatchRunHistoryResponse struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Results []*RunHistoryResult    `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	// Whether all results in the batch have been returned.
+	//
+	// Once set, the batch's resources are released and
+	// its request_id is no longer valid.
+	Done          bool `protobuf:"varint,2,opt,name=done,proto3" json:"done,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchRunHistoryResponse) Reset() {
+	*x = BatchRunHistoryResponse{}
+	mi := &file_wandb_proto_wandb_api_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchRunHistoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchRunHistoryResponse) ProtoMessage() {}
+
+func (x *BatchRunHistoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_wandb_proto_wandb_api_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchRunHistoryResponse.ProtoReflect.Descriptor instead.
+func (*BatchRunHistoryResponse) Descriptor() ([]byte, []int) {
+	return file_wandb_proto_wandb_api_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *BatchRunHistoryResponse) GetResults() []*RunHistoryResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+func (x *BatchRunHistoryResponse) GetDone() bool {
+	if x != nil {
+		return x.Done
+	}
+	return false
+}
+
+// RunHistoryResult is the history of one run in a batch.
+type RunHistoryResult struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Run         *HistoryRunPath        `protobuf:"bytes,1,opt,name=run,proto3" json:"run,omitempty"`
+	HistoryRows []*HistoryRow          `protobuf:"bytes,2,rep,name=history_rows,json=historyRows,proto3" json:"history_rows,omitempty"`
+	// Set if the run's history could not be read.
+	Error         string `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RunHistoryResult) Reset() {
+	*x = RunHistoryResult{}
+	mi := &file_wandb_proto_wandb_api_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RunHistoryResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RunHistoryResult) ProtoMessage() {}
+
+func (x *RunHistoryResult) ProtoReflect() protoreflect.Message {
+	mi := &file_wandb_proto_wandb_api_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RunHistoryResult.ProtoReflect.Descriptor instead.
+func (*RunHistoryResult) Descriptor() ([]byte, []int) {
+	return file_wandb_proto_wandb_api_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *RunHistoryResult) GetRun() *HistoryRunPath {
+	if x != nil {
+		return x.Run
+	}
+	return nil
+}
+
+func (x *RunHistoryResult) GetHistoryRows() []*HistoryRow {
+	if x != nil {
+		return x.HistoryRows
+	}
+	return nil
+}
+
+func (x *RunHistoryResult) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+// BatchRunHistoryCleanup is a request to stop a batch
+// and release its resources.
+type BatchRunHistoryCleanup struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RequestId     int32                  `protobuf:"varint,1,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchRunHistoryCleanup) Reset() {
+	*x = BatchRunHistoryCleanup{}
+	mi := &file_wandb_proto_wandb_api_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchRunHistoryCleanup) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchRunHistoryCleanup) ProtoMessage() {}
+
+func (x *BatchRunHistoryCleanup) ProtoReflect() protoreflect.Message {
+	mi := &file_wandb_proto_wandb_api_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchRunHistoryCleanup.ProtoReflect.Descriptor instead.
+func (*BatchRunHistoryCleanup) Descriptor() ([]byte, []int) {
+	return file_wandb_proto_wandb_api_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *BatchRunHistoryCleanup) GetRequestId() int32 {
+	if x != nil {
+		return x.RequestId
+	}
+	return 0
+}
+
+type BatchRunHistoryCleanupResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchRunHistoryCleanupResponse) Reset() {
+	*x = BatchRunHistoryCleanupResponse{}
+	mi := &file_wandb_proto_wandb_api_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchRunHistoryCleanupResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchRunHistoryCleanupResponse) ProtoMessage() {}
+
+func (x *BatchRunHistoryCleanupResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_wandb_proto_wandb_api_proto_msgTypes[59]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchRunHistoryCleanupResponse.ProtoReflect.Descriptor instead.
+func (*BatchRunHistoryCleanupResponse) Descriptor() ([]byte, []int) {
+	return file_wandb_proto_wandb_api_proto_rawDescGZIP(), []int{59}
+}
+
+type DownloadRunHistoryInit struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The entity who owns the run.
+	Entity string `protobuf:"bytes,1,opt,name=entity,proto3" json:"entity,omitempty"`
+	// The project that the run belongs to.
+	Project string `protobuf:"bytes,2,opt,name=project,proto3" json:"project,omitempty"`
+	// The ID of the run.
+	RunId string `protobuf:"bytes,3,opt,name=run_id,json=runId,proto3" json:"run_id,omitempty"`
+	// The directory to store the downloaded history files within.
+	DownloadDir string `protobuf:"bytes,4,opt,name=download_dir,json=downloadDir,proto3" json:"download_dir,omitempty"`
+	// Whether to require the complete history to be downloaded.
+	// If the run contains data that has not been exported to parquet files yet,
+	// the run history will be considered incomplete.
+	RequireCompleteHistory bool `protobuf:"varint,5,opt,name=require_complete_history,json=requireCompleteHistory,proto3" json:"require_complete_history,omitempty"`
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *DownloadRunHistoryInit) Reset() {
+	*x = DownloadRunHistoryInit{}
+	mi := &file_wandb_proto_wandb_api_proto_msgTypes[60]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DownloadRunHistoryInit) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DownloadRunHistoryInit) ProtoMessage() {}
+
+func (x *DownloadRunHistoryInit) ProtoReflect() protoreflect.Message {
+	mi := &file_wandb_proto_wandb_api_proto_msgTypes[60]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DownloadRunHistoryInit.ProtoReflect.Descriptor instead.
+func (*DownloadRunHistoryInit) Descriptor() ([]byte, []int) {
+	return file_wandb_proto_wandb_api_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *DownloadRunHistoryInit) GetEntity() string {
+	if x != nil {
+		return x.Entity
+	}
+	return ""
+}
+
+func (x *DownloadRunHistoryInit) GetProject() string {
+	if x != nil {
+		return x.Project
+	}
+	return ""
+}
+
+func (x *DownloadRunHistoryInit) GetRunId() string {
+	if x != nil {
+		return x.RunId
+	}
+	return ""
+}
+
+func (x *DownloadRunHistoryInit) GetDownloadDir() string {
+	if x != nil {
+		return x.DownloadDir
+	}
+	return ""
+}
+
+func (x *DownloadRunHistoryInit) GetRequireCompleteHistory() bool {
+	if x != nil {
+		return x.RequireCompleteHistory
+	}
+	return false
+}
+
+type DownloadRunHistoryInitResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The request id of the download operation.
 	//
 	// This is used to identify the download operation,
 	// and request status updates of the download operation.
@@ -3945,7 +4704,7 @@ type DownloadRunHistoryInitResponse struct {
 
 func (x *DownloadRunHistoryInitResponse) Reset() {
 	*x = DownloadRunHistoryInitResponse{}
-	mi := &file_wandb_proto_wandb_api_proto_msgTypes[52]
+	mi := &file_wandb_proto_wandb_api_proto_msgTypes[61]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3957,7 +4716,7 @@ func (x *DownloadRunHistoryInitResponse) String() string {
 func (*DownloadRunHistoryInitResponse) ProtoMessage() {}
 
 func (x *DownloadRunHistoryInitResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_wandb_proto_wandb_api_proto_msgTypes[52]
+	mi := &file_wandb_proto_wandb_api_proto_msgTypes[61]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3970,7 +4729,7 @@ func (x *DownloadRunHistoryInitResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DownloadRunHistoryInitResponse.ProtoReflect.Descriptor instead.
 func (*DownloadRunHistoryInitResponse) Descriptor() ([]byte, []int) {
-	return file_wandb_proto_wandb_api_proto_rawDescGZIP(), []int{52}
+	return file_wandb_proto_wandb_api_proto_rawDescGZIP(), []int{61}
 }
 
 func (x *DownloadRunHistoryInitResponse) GetRequestId() int32 {
@@ -3998,7 +4757,7 @@ type DownloadRunHistory struct {
 
 func (x *DownloadRunHistory) Reset() {
 	*x = DownloadRunHistory{}
-	mi := &file_wandb_proto_wandb_api_proto_msgTypes[53]
+	mi := &file_wandb_proto_wandb_api_proto_msgTypes[62]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4010,7 +4769,7 @@ func (x *DownloadRunHistory) String() string {
 func (*DownloadRunHistory) ProtoMessage() {}
 
 func (x *DownloadRunHistory) ProtoReflect() protoreflect.Message {
-	mi := &file_wandb_proto_wandb_api_proto_msgTypes[53]
+	mi := &file_wandb_proto_wandb_api_proto_msgTypes[62]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4023,7 +4782,7 @@ func (x *DownloadRunHistory) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DownloadRunHistory.ProtoReflect.Descriptor instead.
 func (*DownloadRunHistory) Descriptor() ([]byte, []int) {
-	return file_wandb_proto_wandb_api_proto_rawDescGZIP(), []int{53}
+	return file_wandb_proto_wandb_api_proto_rawDescGZIP(), []int{62}
 }
 
 func (x *DownloadRunHistory) GetRequestId() int32 {
@@ -4046,7 +4805,7 @@ type DownloadRunHistoryResponse struct {
 
 func (x *DownloadRunHistoryResponse) Reset() {
 	*x = DownloadRunHistoryResponse{}
-	mi := &file_wandb_proto_wandb_api_proto_msgTypes[54]
+	mi := &file_wandb_proto_wandb_api_proto_msgTypes[63]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4058,7 +4817,7 @@ func (x *DownloadRunHistoryResponse) String() string {
 func (*DownloadRunHistoryResponse) ProtoMessage() {}
 
 func (x *DownloadRunHistoryResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_wandb_proto_wandb_api_proto_msgTypes[54]
+	mi := &file_wandb_proto_wandb_api_proto_msgTypes[63]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4071,7 +4830,7 @@ func (x *DownloadRunHistoryResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DownloadRunHistoryResponse.ProtoReflect.Descriptor instead.
 func (*DownloadRunHistoryResponse) Descriptor() ([]byte, []int) {
-	return file_wandb_proto_wandb_api_proto_rawDescGZIP(), []int{54}
+	return file_wandb_proto_wandb_api_proto_rawDescGZIP(), []int{63}
 }
 
 func (x *DownloadRunHistoryResponse) GetDownloadedFiles() []string {
@@ -4098,7 +4857,7 @@ type IncompleteRunHistoryError struct {
 
 func (x *IncompleteRunHistoryError) Reset() {
 	*x = IncompleteRunHistoryError{}
-	mi := &file_wandb_proto_wandb_api_proto_msgTypes[55]
+	mi := &file_wandb_proto_wandb_api_proto_msgTypes[64]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4110,7 +4869,7 @@ func (x *IncompleteRunHistoryError) String() string {
 func (*IncompleteRunHistoryError) ProtoMessage() {}
 
 func (x *IncompleteRunHistoryError) ProtoReflect() protoreflect.Message {
-	mi := &file_wandb_proto_wandb_api_proto_msgTypes[55]
+	mi := &file_wandb_proto_wandb_api_proto_msgTypes[64]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4123,7 +4882,7 @@ func (x *IncompleteRunHistoryError) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use IncompleteRunHistoryError.ProtoReflect.Descriptor instead.
 func (*IncompleteRunHistoryError) Descriptor() ([]byte, []int) {
-	return file_wandb_proto_wandb_api_proto_rawDescGZIP(), []int{55}
+	return file_wandb_proto_wandb_api_proto_rawDescGZIP(), []int{64}
 }
 
 // DownloadRunHistoryStatus requests the status of an ongoing download operation.
@@ -4137,7 +4896,7 @@ type DownloadRunHistoryStatus struct {
 
 func (x *DownloadRunHistoryStatus) Reset() {
 	*x = DownloadRunHistoryStatus{}
-	mi := &file_wandb_proto_wandb_api_proto_msgTypes[56]
+	mi := &file_wandb_proto_wandb_api_proto_msgTypes[65]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4149,7 +4908,7 @@ func (x *DownloadRunHistoryStatus) String() string {
 func (*DownloadRunHistoryStatus) ProtoMessage() {}
 
 func (x *DownloadRunHistoryStatus) ProtoReflect() protoreflect.Message {
-	mi := &file_wandb_proto_wandb_api_proto_msgTypes[56]
+	mi := &file_wandb_proto_wandb_api_proto_msgTypes[65]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4162,7 +4921,7 @@ func (x *DownloadRunHistoryStatus) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DownloadRunHistoryStatus.ProtoReflect.Descriptor instead.
 func (*DownloadRunHistoryStatus) Descriptor() ([]byte, []int) {
-	return file_wandb_proto_wandb_api_proto_rawDescGZIP(), []int{56}
+	return file_wandb_proto_wandb_api_proto_rawDescGZIP(), []int{65}
 }
 
 func (x *DownloadRunHistoryStatus) GetRequestId() int32 {
@@ -4182,7 +4941,7 @@ type DownloadRunHistoryStatusResponse struct {
 
 func (x *DownloadRunHistoryStatusResponse) Reset() {
 	*x = DownloadRunHistoryStatusResponse{}
-	mi := &file_wandb_proto_wandb_api_proto_msgTypes[57]
+	mi := &file_wandb_proto_wandb_api_proto_msgTypes[66]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4194,7 +4953,7 @@ func (x *DownloadRunHistoryStatusResponse) String() string {
 func (*DownloadRunHistoryStatusResponse) ProtoMessage() {}
 
 func (x *DownloadRunHistoryStatusResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_wandb_proto_wandb_api_proto_msgTypes[57]
+	mi := &file_wandb_proto_wandb_api_proto_msgTypes[66]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4207,7 +4966,7 @@ func (x *DownloadRunHistoryStatusResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DownloadRunHistoryStatusResponse.ProtoReflect.Descriptor instead.
 func (*DownloadRunHistoryStatusResponse) Descriptor() ([]byte, []int) {
-	return file_wandb_proto_wandb_api_proto_rawDescGZIP(), []int{57}
+	return file_wandb_proto_wandb_api_proto_rawDescGZIP(), []int{66}
 }
 
 func (x *DownloadRunHistoryStatusResponse) GetOperationStats() *OperationStats {
@@ -4430,15 +5189,18 @@ const file_wandb_proto_wandb_api_proto_rawDesc = "" +
 	"\x13_client_mutation_id\"y\n" +
 	"\x16UpsertRunQueueResponse\x12\x18\n" +
 	"\asuccess\x18\x01 \x01(\bR\asuccess\x12E\n" +
-	"\x1fconfig_schema_validation_errors\x18\x02 \x03(\tR\x1cconfigSchemaValidationErrors\"\xd1\x04\n" +
+	"\x1fconfig_schema_validation_errors\x18\x02 \x03(\tR\x1cconfigSchemaValidationErrors\"\xee\x06\n" +
 	"\x15ReadRunHistoryRequest\x12W\n" +
 	"\x15scan_run_history_init\x18\x01 \x01(\v2\".wandb_internal.ScanRunHistoryInitH\x00R\x12scanRunHistoryInit\x12J\n" +
 	"\x10scan_run_history\x18\x02 \x01(\v2\x1e.wandb_internal.ScanRunHistoryH\x00R\x0escanRunHistory\x12`\n" +
 	"\x18scan_run_history_cleanup\x18\x03 \x01(\v2%.wandb_internal.ScanRunHistoryCleanupH\x00R\x15scanRunHistoryCleanup\x12c\n" +
 	"\x19download_run_history_init\x18\x04 \x01(\v2&.wandb_internal.DownloadRunHistoryInitH\x00R\x16downloadRunHistoryInit\x12V\n" +
 	"\x14download_run_history\x18\x05 \x01(\v2\".wandb_internal.DownloadRunHistoryH\x00R\x12downloadRunHistory\x12i\n" +
-	"\x1bdownload_run_history_status\x18\x06 \x01(\v2(.wandb_internal.DownloadRunHistoryStatusH\x00R\x18downloadRunHistoryStatusB\t\n" +
-	"\arequest\"\xf6\x04\n" +
+	"\x1bdownload_run_history_status\x18\x06 \x01(\v2(.wandb_internal.DownloadRunHistoryStatusH\x00R\x18downloadRunHistoryStatus\x12Z\n" +
+	"\x16batch_run_history_init\x18\a \x01(\v2#.wandb_internal.BatchRunHistoryInitH\x00R\x13batchRunHistoryInit\x12Z\n" +
+	"\x16batch_run_history_next\x18\b \x01(\v2#.wandb_internal.BatchRunHistoryNextH\x00R\x13batchRunHistoryNext\x12c\n" +
+	"\x19batch_run_history_cleanup\x18\t \x01(\v2&.wandb_internal.BatchRunHistoryCleanupH\x00R\x16batchRunHistoryCleanupB\t\n" +
+	"\arequest\"\x9e\a\n" +
 	"\x16ReadRunHistoryResponse\x12_\n" +
 	"\x15scan_run_history_init\x18\x01 \x01(\v2*.wandb_internal.ScanRunHistoryInitResponseH\x00R\x12scanRunHistoryInit\x12E\n" +
 	"\vrun_history\x18\x02 \x01(\v2\".wandb_internal.RunHistoryResponseH\x00R\n" +
@@ -4446,7 +5208,10 @@ const file_wandb_proto_wandb_api_proto_rawDesc = "" +
 	"\x18scan_run_history_cleanup\x18\x03 \x01(\v2-.wandb_internal.ScanRunHistoryCleanupResponseH\x00R\x15scanRunHistoryCleanup\x12k\n" +
 	"\x19download_run_history_init\x18\x04 \x01(\v2..wandb_internal.DownloadRunHistoryInitResponseH\x00R\x16downloadRunHistoryInit\x12^\n" +
 	"\x14download_run_history\x18\x05 \x01(\v2*.wandb_internal.DownloadRunHistoryResponseH\x00R\x12downloadRunHistory\x12q\n" +
-	"\x1bdownload_run_history_status\x18\x06 \x01(\v20.wandb_internal.DownloadRunHistoryStatusResponseH\x00R\x18downloadRunHistoryStatusB\n" +
+	"\x1bdownload_run_history_status\x18\x06 \x01(\v20.wandb_internal.DownloadRunHistoryStatusResponseH\x00R\x18downloadRunHistoryStatus\x12b\n" +
+	"\x16batch_run_history_init\x18\a \x01(\v2+.wandb_internal.BatchRunHistoryInitResponseH\x00R\x13batchRunHistoryInit\x12U\n" +
+	"\x11batch_run_history\x18\b \x01(\v2'.wandb_internal.BatchRunHistoryResponseH\x00R\x0fbatchRunHistory\x12k\n" +
+	"\x19batch_run_history_cleanup\x18\t \x01(\v2..wandb_internal.BatchRunHistoryCleanupResponseH\x00R\x16batchRunHistoryCleanupB\n" +
 	"\n" +
 	"\bresponse\"\x8e\x01\n" +
 	"\x12ScanRunHistoryInit\x12\x16\n" +
@@ -4457,14 +5222,30 @@ const file_wandb_proto_wandb_api_proto_rawDesc = "" +
 	"\tuse_cache\x18\x05 \x01(\bR\buseCache\";\n" +
 	"\x1aScanRunHistoryInitResponse\x12\x1d\n" +
 	"\n" +
-	"request_id\x18\x01 \x01(\x05R\trequestId\"e\n" +
+	"request_id\x18\x01 \x01(\x05R\trequestId\"\xa0\x02\n" +
 	"\x0eScanRunHistory\x12\x19\n" +
 	"\bmin_step\x18\x01 \x01(\x03R\aminStep\x12\x19\n" +
 	"\bmax_step\x18\x02 \x01(\x03R\amaxStep\x12\x1d\n" +
 	"\n" +
-	"request_id\x18\x03 \x01(\x05R\trequestId\"S\n" +
+	"request_id\x18\x03 \x01(\x05R\trequestId\x12;\n" +
+	"\bsampling\x18\x04 \x01(\v2\x1f.wandb_internal.HistorySamplingR\bsampling\x12=\n" +
+	"\x06format\x18\x05 \x01(\x0e2%.wandb_internal.ScanRunHistory.FormatR\x06format\"=\n" +
+	"\x06Format\x12\x10\n" +
+	"\fHISTORY_ROWS\x10\x00\x12\r\n" +
+	"\tARROW_IPC\x10\x01\x12\x12\n" +
+	"\x0eARROW_IPC_FILE\x10\x02\"\xc4\x01\n" +
+	"\x0fHistorySampling\x12#\n" +
+	"\rtarget_points\x18\x01 \x01(\x05R\ftargetPoints\x12M\n" +
+	"\vaggregation\x18\x02 \x01(\x0e2+.wandb_internal.HistorySampling.AggregationR\vaggregation\"=\n" +
+	"\vAggregation\x12\r\n" +
+	"\tEVERY_NTH\x10\x00\x12\v\n" +
+	"\aMIN_MAX\x10\x01\x12\b\n" +
+	"\x04MEAN\x10\x02\x12\b\n" +
+	"\x04LTTB\x10\x03\"\xa7\x01\n" +
 	"\x12RunHistoryResponse\x12=\n" +
-	"\fhistory_rows\x18\x01 \x03(\v2\x1a.wandb_internal.HistoryRowR\vhistoryRows\"U\n" +
+	"\fhistory_rows\x18\x01 \x03(\v2\x1a.wandb_internal.HistoryRowR\vhistoryRows\x12*\n" +
+	"\x11arrow_ipc_streams\x18\x02 \x03(\fR\x0farrowIpcStreams\x12&\n" +
+	"\x0farrow_ipc_files\x18\x03 \x03(\tR\rarrowIpcFiles\"U\n" +
 	"\n" +
 	"HistoryRow\x12G\n" +
 	"\rhistory_items\x18\x01 \x03(\v2\".wandb_internal.ParquetHistoryItemR\fhistoryItems\"E\n" +
@@ -4475,7 +5256,35 @@ const file_wandb_proto_wandb_api_proto_rawDesc = "" +
 	"\x15ScanRunHistoryCleanup\x12\x1d\n" +
 	"\n" +
 	"request_id\x18\x01 \x01(\x05R\trequestId\"\x1f\n" +
-	"\x1dScanRunHistoryCleanupResponse\"\xbe\x01\n" +
+	"\x1dScanRunHistoryCleanupResponse\"Y\n" +
+	"\x0eHistoryRunPath\x12\x16\n" +
+	"\x06entity\x18\x01 \x01(\tR\x06entity\x12\x18\n" +
+	"\aproject\x18\x02 \x01(\tR\aproject\x12\x15\n" +
+	"\x06run_id\x18\x03 \x01(\tR\x05runId\"\xed\x01\n" +
+	"\x13BatchRunHistoryInit\x122\n" +
+	"\x04runs\x18\x01 \x03(\v2\x1e.wandb_internal.HistoryRunPathR\x04runs\x12\x12\n" +
+	"\x04keys\x18\x02 \x03(\tR\x04keys\x12\x19\n" +
+	"\bmin_step\x18\x03 \x01(\x03R\aminStep\x12\x19\n" +
+	"\bmax_step\x18\x04 \x01(\x03R\amaxStep\x12\x1b\n" +
+	"\tuse_cache\x18\x05 \x01(\bR\buseCache\x12;\n" +
+	"\bsampling\x18\x06 \x01(\v2\x1f.wandb_internal.HistorySamplingR\bsampling\"<\n" +
+	"\x1bBatchRunHistoryInitResponse\x12\x1d\n" +
+	"\n" +
+	"request_id\x18\x01 \x01(\x05R\trequestId\"4\n" +
+	"\x13BatchRunHistoryNext\x12\x1d\n" +
+	"\n" +
+	"request_id\x18\x01 \x01(\x05R\trequestId\"i\n" +
+	"\x17BatchRunHistoryResponse\x12:\n" +
+	"\aresults\x18\x01 \x03(\v2 .wandb_internal.RunHistoryResultR\aresults\x12\x12\n" +
+	"\x04done\x18\x02 \x01(\bR\x04done\"\x99\x01\n" +
+	"\x10RunHistoryResult\x120\n" +
+	"\x03run\x18\x01 \x01(\v2\x1e.wandb_internal.HistoryRunPathR\x03run\x12=\n" +
+	"\fhistory_rows\x18\x02 \x03(\v2\x1a.wandb_internal.HistoryRowR\vhistoryRows\x12\x14\n" +
+	"\x05error\x18\x03 \x01(\tR\x05error\"7\n" +
+	"\x16BatchRunHistoryCleanup\x12\x1d\n" +
+	"\n" +
+	"request_id\x18\x01 \x01(\x05R\trequestId\" \n" +
+	"\x1eBatchRunHistoryCleanupResponse\"\xbe\x01\n" +
 	"\x16DownloadRunHistoryInit\x12\x16\n" +
 	"\x06entity\x18\x01 \x01(\tR\x06entity\x12\x18\n" +
 	"\aproject\x18\x02 \x01(\tR\aproject\x12\x15\n" +
@@ -4517,145 +5326,170 @@ func file_wandb_proto_wandb_api_proto_rawDescGZIP() []byte {
 	return file_wandb_proto_wandb_api_proto_rawDescData
 }
 
-var file_wandb_proto_wandb_api_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_wandb_proto_wandb_api_proto_msgTypes = make([]protoimpl.MessageInfo, 62)
+var file_wandb_proto_wandb_api_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
+var file_wandb_proto_wandb_api_proto_msgTypes = make([]protoimpl.MessageInfo, 71)
 var file_wandb_proto_wandb_api_proto_goTypes = []any{
 	(ErrorType)(0),                              // 0: wandb_internal.ErrorType
-	(*ServerApiInitRequest)(nil),                // 1: wandb_internal.ServerApiInitRequest
-	(*ServerApiInitResponse)(nil),               // 2: wandb_internal.ServerApiInitResponse
-	(*ApiRequest)(nil),                          // 3: wandb_internal.ApiRequest
-	(*ApiResponse)(nil),                         // 4: wandb_internal.ApiResponse
-	(*ApiErrorResponse)(nil),                    // 5: wandb_internal.ApiErrorResponse
-	(*ServerApiCleanupRequest)(nil),             // 6: wandb_internal.ServerApiCleanupRequest
-	(*FeaturesRequest)(nil),                     // 7: wandb_internal.FeaturesRequest
-	(*FeaturesResponse)(nil),                    // 8: wandb_internal.FeaturesResponse
-	(*ServerFeaturesRequest)(nil),               // 9: wandb_internal.ServerFeaturesRequest
-	(*ServerFeaturesResponse)(nil),              // 10: wandb_internal.ServerFeaturesResponse
-	(*OrgFeaturesRequest)(nil),                  // 11: wandb_internal.OrgFeaturesRequest
-	(*OrgFeaturesResponse)(nil),                 // 12: wandb_internal.OrgFeaturesResponse
-	(*GraphQLRequest)(nil),                      // 13: wandb_internal.GraphQLRequest
-	(*GraphQLResponse)(nil),                     // 14: wandb_internal.GraphQLResponse
-	(*DownloadFileRequest)(nil),                 // 15: wandb_internal.DownloadFileRequest
-	(*DownloadFileResponse)(nil),                // 16: wandb_internal.DownloadFileResponse
-	(*UploadFileRequest)(nil),                   // 17: wandb_internal.UploadFileRequest
-	(*UploadFileResponse)(nil),                  // 18: wandb_internal.UploadFileResponse
-	(*MarkRunFilesUploadedRequest)(nil),         // 19: wandb_internal.MarkRunFilesUploadedRequest
-	(*MarkRunFilesUploadedResponse)(nil),        // 20: wandb_internal.MarkRunFilesUploadedResponse
-	(*AuthRequest)(nil),                         // 21: wandb_internal.AuthRequest
-	(*AuthResponse)(nil),                        // 22: wandb_internal.AuthResponse
-	(*AuthenticateRequest)(nil),                 // 23: wandb_internal.AuthenticateRequest
-	(*AuthenticateResponse)(nil),                // 24: wandb_internal.AuthenticateResponse
-	(*GetAccessTokenRequest)(nil),               // 25: wandb_internal.GetAccessTokenRequest
-	(*GetAccessTokenResponse)(nil),              // 26: wandb_internal.GetAccessTokenResponse
-	(*StopRunRequest)(nil),                      // 27: wandb_internal.StopRunRequest
-	(*StopRunResponse)(nil),                     // 28: wandb_internal.StopRunResponse
-	(*ReadRunConsoleLogsRequest)(nil),           // 29: wandb_internal.ReadRunConsoleLogsRequest
-	(*ReadRunConsoleLogsResponse)(nil),          // 30: wandb_internal.ReadRunConsoleLogsResponse
-	(*RunConsoleLogLine)(nil),                   // 31: wandb_internal.RunConsoleLogLine
-	(*CreateCustomChartRequest)(nil),            // 32: wandb_internal.CreateCustomChartRequest
-	(*CreateCustomChartResponse)(nil),           // 33: wandb_internal.CreateCustomChartResponse
-	(*RunQueueOperationRequest)(nil),            // 34: wandb_internal.RunQueueOperationRequest
-	(*RunQueueOperationResponse)(nil),           // 35: wandb_internal.RunQueueOperationResponse
-	(*CreateDefaultResourceConfigRequest)(nil),  // 36: wandb_internal.CreateDefaultResourceConfigRequest
-	(*CreateDefaultResourceConfigResponse)(nil), // 37: wandb_internal.CreateDefaultResourceConfigResponse
-	(*CreateRunQueueRequest)(nil),               // 38: wandb_internal.CreateRunQueueRequest
-	(*CreateRunQueueResponse)(nil),              // 39: wandb_internal.CreateRunQueueResponse
-	(*UpsertRunQueueRequest)(nil),               // 40: wandb_internal.UpsertRunQueueRequest
-	(*UpsertRunQueueResponse)(nil),              // 41: wandb_internal.UpsertRunQueueResponse
-	(*ReadRunHistoryRequest)(nil),               // 42: wandb_internal.ReadRunHistoryRequest
-	(*ReadRunHistoryResponse)(nil),              // 43: wandb_internal.ReadRunHistoryResponse
-	(*ScanRunHistoryInit)(nil),                  // 44: wandb_internal.ScanRunHistoryInit
-	(*ScanRunHistoryInitResponse)(nil),          // 45: wandb_internal.ScanRunHistoryInitResponse
-	(*ScanRunHistory)(nil),                      // 46: wandb_internal.ScanRunHistory
-	(*RunHistoryResponse)(nil),                  // 47: wandb_internal.RunHistoryResponse
-	(*HistoryRow)(nil),                          // 48: wandb_internal.HistoryRow
-	(*ParquetHistoryItem)(nil),                  // 49: wandb_internal.ParquetHistoryItem
-	(*ScanRunHistoryCleanup)(nil),               // 50: wandb_internal.ScanRunHistoryCleanup
-	(*ScanRunHistoryCleanupResponse)(nil),       // 51: wandb_internal.ScanRunHistoryCleanupResponse
-	(*DownloadRunHistoryInit)(nil),              // 52: wandb_internal.DownloadRunHistoryInit
-	(*DownloadRunHistoryInitResponse)(nil),      // 53: wandb_internal.DownloadRunHistoryInitResponse
-	(*DownloadRunHistory)(nil),                  // 54: wandb_internal.DownloadRunHistory
-	(*DownloadRunHistoryResponse)(nil),          // 55: wandb_internal.DownloadRunHistoryResponse
-	(*IncompleteRunHistoryError)(nil),           // 56: wandb_internal.IncompleteRunHistoryError
-	(*DownloadRunHistoryStatus)(nil),            // 57: wandb_internal.DownloadRunHistoryStatus
-	(*DownloadRunHistoryStatusResponse)(nil),    // 58: wandb_internal.DownloadRunHistoryStatusResponse
-	nil,                                         // 59: wandb_internal.OrgFeaturesResponse.FeaturesEntry
-	nil,                                         // 60: wandb_internal.GraphQLRequest.RenameFieldsEntry
-	nil,                                         // 61: wandb_internal.UploadFileRequest.HeadersEntry
-	nil,                                         // 62: wandb_internal.DownloadRunHistoryResponse.ErrorsEntry
-	(*Settings)(nil),                            // 63: wandb_internal.Settings
-	(*OpenTelemetryRequest)(nil),                // 64: wandb_internal.OpenTelemetryRequest
-	(ServerFeature)(0),                          // 65: wandb_internal.ServerFeature
-	(*OperationStats)(nil),                      // 66: wandb_internal.OperationStats
+	(ScanRunHistory_Format)(0),                  // 1: wandb_internal.ScanRunHistory.Format
+	(HistorySampling_Aggregation)(0),            // 2: wandb_internal.HistorySampling.Aggregation
+	(*ServerApiInitRequest)(nil),                // 3: wandb_internal.ServerApiInitRequest
+	(*ServerApiInitResponse)(nil),               // 4: wandb_internal.ServerApiInitResponse
+	(*ApiRequest)(nil),                          // 5: wandb_internal.ApiRequest
+	(*ApiResponse)(nil),                         // 6: wandb_internal.ApiResponse
+	(*ApiErrorResponse)(nil),                    // 7: wandb_internal.ApiErrorResponse
+	(*ServerApiCleanupRequest)(nil),             // 8: wandb_internal.ServerApiCleanupRequest
+	(*FeaturesRequest)(nil),                     // 9: wandb_internal.FeaturesRequest
+	(*FeaturesResponse)(nil),                    // 10: wandb_internal.FeaturesResponse
+	(*ServerFeaturesRequest)(nil),               // 11: wandb_internal.ServerFeaturesRequest
+	(*ServerFeaturesResponse)(nil),              // 12: wandb_internal.ServerFeaturesResponse
+	(*OrgFeaturesRequest)(nil),                  // 13: wandb_internal.OrgFeaturesRequest
+	(*OrgFeaturesResponse)(nil),                 // 14: wandb_internal.OrgFeaturesResponse
+	(*GraphQLRequest)(nil),                      // 15: wandb_internal.GraphQLRequest
+	(*GraphQLResponse)(nil),                     // 16: wandb_internal.GraphQLResponse
+	(*DownloadFileRequest)(nil),                 // 17: wandb_internal.DownloadFileRequest
+	(*DownloadFileResponse)(nil),                // 18: wandb_internal.DownloadFileResponse
+	(*UploadFileRequest)(nil),                   // 19: wandb_internal.UploadFileRequest
+	(*UploadFileResponse)(nil),                  // 20: wandb_internal.UploadFileResponse
+	(*MarkRunFilesUploadedRequest)(nil),         // 21: wandb_internal.MarkRunFilesUploadedRequest
+	(*MarkRunFilesUploadedResponse)(nil),        // 22: wandb_internal.MarkRunFilesUploadedResponse
+	(*AuthRequest)(nil),                         // 23: wandb_internal.AuthRequest
+	(*AuthResponse)(nil),                        // 24: wandb_internal.AuthResponse
+	(*AuthenticateRequest)(nil),                 // 25: wandb_internal.AuthenticateRequest
+	(*AuthenticateResponse)(nil),                // 26: wandb_internal.AuthenticateResponse
+	(*GetAccessTokenRequest)(nil),               // 27: wandb_internal.GetAccessTokenRequest
+	(*GetAccessTokenResponse)(nil),              // 28: wandb_internal.GetAccessTokenResponse
+	(*StopRunRequest)(nil),                      // 29: wandb_internal.StopRunRequest
+	(*StopRunResponse)(nil),                     // 30: wandb_internal.StopRunResponse
+	(*ReadRunConsoleLogsRequest)(nil),           // 31: wandb_internal.ReadRunConsoleLogsRequest
+	(*ReadRunConsoleLogsResponse)(nil),          // 32: wandb_internal.ReadRunConsoleLogsResponse
+	(*RunConsoleLogLine)(nil),                   // 33: wandb_internal.RunConsoleLogLine
+	(*CreateCustomChartRequest)(nil),            // 34: wandb_internal.CreateCustomChartRequest
+	(*CreateCustomChartResponse)(nil),           // 35: wandb_internal.CreateCustomChartResponse
+	(*RunQueueOperationRequest)(nil),            // 36: wandb_internal.RunQueueOperationRequest
+	(*RunQueueOperationResponse)(nil),           // 37: wandb_internal.RunQueueOperationResponse
+	(*CreateDefaultResourceConfigRequest)(nil),  // 38: wandb_internal.CreateDefaultResourceConfigRequest
+	(*CreateDefaultResourceConfigResponse)(nil), // 39: wandb_internal.CreateDefaultResourceConfigResponse
+	(*CreateRunQueueRequest)(nil),               // 40: wandb_internal.CreateRunQueueRequest
+	(*CreateRunQueueResponse)(nil),              // 41: wandb_internal.CreateRunQueueResponse
+	(*UpsertRunQueueRequest)(nil),               // 42: wandb_internal.UpsertRunQueueRequest
+	(*UpsertRunQueueResponse)(nil),              // 43: wandb_internal.UpsertRunQueueResponse
+	(*ReadRunHistoryRequest)(nil),               // 44: wandb_internal.ReadRunHistoryRequest
+	(*ReadRunHistoryResponse)(nil),              // 45: wandb_internal.ReadRunHistoryResponse
+	(*ScanRunHistoryInit)(nil),                  // 46: wandb_internal.ScanRunHistoryInit
+	(*ScanRunHistoryInitResponse)(nil),          // 47: wandb_internal.ScanRunHistoryInitResponse
+	(*ScanRunHistory)(nil),                      // 48: wandb_internal.ScanRunHistory
+	(*HistorySampling)(nil),                     // 49: wandb_internal.HistorySampling
+	(*RunHistoryResponse)(nil),                  // 50: wandb_internal.RunHistoryResponse
+	(*HistoryRow)(nil),                          // 51: wandb_internal.HistoryRow
+	(*ParquetHistoryItem)(nil),                  // 52: wandb_internal.ParquetHistoryItem
+	(*ScanRunHistoryCleanup)(nil),               // 53: wandb_internal.ScanRunHistoryCleanup
+	(*ScanRunHistoryCleanupResponse)(nil),       // 54: wandb_internal.ScanRunHistoryCleanupResponse
+	(*HistoryRunPath)(nil),                      // 55: wandb_internal.HistoryRunPath
+	(*BatchRunHistoryInit)(nil),                 // 56: wandb_internal.BatchRunHistoryInit
+	(*BatchRunHistoryInitResponse)(nil),         // 57: wandb_internal.BatchRunHistoryInitResponse
+	(*BatchRunHistoryNext)(nil),                 // 58: wandb_internal.BatchRunHistoryNext
+	(*BatchRunHistoryResponse)(nil),             // 59: wandb_internal.BatchRunHistoryResponse
+	(*RunHistoryResult)(nil),                    // 60: wandb_internal.RunHistoryResult
+	(*BatchRunHistoryCleanup)(nil),              // 61: wandb_internal.BatchRunHistoryCleanup
+	(*BatchRunHistoryCleanupResponse)(nil),      // 62: wandb_internal.BatchRunHistoryCleanupResponse
+	(*DownloadRunHistoryInit)(nil),              // 63: wandb_internal.DownloadRunHistoryInit
+	(*DownloadRunHistoryInitResponse)(nil),      // 64: wandb_internal.DownloadRunHistoryInitResponse
+	(*DownloadRunHistory)(nil),                  // 65: wandb_internal.DownloadRunHistory
+	(*DownloadRunHistoryResponse)(nil),          // 66: wandb_internal.DownloadRunHistoryResponse
+	(*IncompleteRunHistoryError)(nil),           // 67: wandb_internal.IncompleteRunHistoryError
+	(*DownloadRunHistoryStatus)(nil),            // 68: wandb_internal.DownloadRunHistoryStatus
+	(*DownloadRunHistoryStatusResponse)(nil),    // 69: wandb_internal.DownloadRunHistoryStatusResponse
+	nil,                                         // 70: wandb_internal.OrgFeaturesResponse.FeaturesEntry
+	nil,                                         // 71: wandb_internal.GraphQLRequest.RenameFieldsEntry
+	nil,                                         // 72: wandb_internal.UploadFileRequest.HeadersEntry
+	nil,                                         // 73: wandb_internal.DownloadRunHistoryResponse.ErrorsEntry
+	(*Settings)(nil),                            // 74: wandb_internal.Settings
+	(*OpenTelemetryRequest)(nil),                // 75: wandb_internal.OpenTelemetryRequest
+	(ServerFeature)(0),                          // 76: wandb_internal.ServerFeature
+	(*OperationStats)(nil),                      // 77: wandb_internal.OperationStats
 }
 var file_wandb_proto_wandb_api_proto_depIdxs = []int32{
-	63, // 0: wandb_internal.ServerApiInitRequest.settings:type_name -> wandb_internal.Settings
-	42, // 1: wandb_internal.ApiRequest.read_run_history_request:type_name -> wandb_internal.ReadRunHistoryRequest
-	7,  // 2: wandb_internal.ApiRequest.features_request:type_name -> wandb_internal.FeaturesRequest
-	13, // 3: wandb_internal.ApiRequest.graphql_request:type_name -> wandb_internal.GraphQLRequest
-	15, // 4: wandb_internal.ApiRequest.download_file_request:type_name -> wandb_internal.DownloadFileRequest
-	17, // 5: wandb_internal.ApiRequest.upload_file_request:type_name -> wandb_internal.UploadFileRequest
-	19, // 6: wandb_internal.ApiRequest.mark_run_files_uploaded_request:type_name -> wandb_internal.MarkRunFilesUploadedRequest
-	27, // 7: wandb_internal.ApiRequest.stop_run_request:type_name -> wandb_internal.StopRunRequest
-	21, // 8: wandb_internal.ApiRequest.auth_request:type_name -> wandb_internal.AuthRequest
-	32, // 9: wandb_internal.ApiRequest.create_custom_chart_request:type_name -> wandb_internal.CreateCustomChartRequest
-	34, // 10: wandb_internal.ApiRequest.run_queue_operation_request:type_name -> wandb_internal.RunQueueOperationRequest
-	64, // 11: wandb_internal.ApiRequest.open_telemetry_request:type_name -> wandb_internal.OpenTelemetryRequest
-	29, // 12: wandb_internal.ApiRequest.read_run_console_logs_request:type_name -> wandb_internal.ReadRunConsoleLogsRequest
-	43, // 13: wandb_internal.ApiResponse.read_run_history_response:type_name -> wandb_internal.ReadRunHistoryResponse
-	8,  // 14: wandb_internal.ApiResponse.features_response:type_name -> wandb_internal.FeaturesResponse
-	14, // 15: wandb_internal.ApiResponse.graphql_response:type_name -> wandb_internal.GraphQLResponse
-	16, // 16: wandb_internal.ApiResponse.download_file_response:type_name -> wandb_internal.DownloadFileResponse
-	18, // 17: wandb_internal.ApiResponse.upload_file_response:type_name -> wandb_internal.UploadFileResponse
-	20, // 18: wandb_internal.ApiResponse.mark_run_files_uploaded_response:type_name -> wandb_internal.MarkRunFilesUploadedResponse
-	28, // 19: wandb_internal.ApiResponse.stop_run_response:type_name -> wandb_internal.StopRunResponse
-	22, // 20: wandb_internal.ApiResponse.auth_response:type_name -> wandb_internal.AuthResponse
-	33, // 21: wandb_internal.ApiResponse.create_custom_chart_response:type_name -> wandb_internal.CreateCustomChartResponse
-	35, // 22: wandb_internal.ApiResponse.run_queue_operation_response:type_name -> wandb_internal.RunQueueOperationResponse
-	30, // 23: wandb_internal.ApiResponse.read_run_console_logs_response:type_name -> wandb_internal.ReadRunConsoleLogsResponse
-	5,  // 24: wandb_internal.ApiResponse.api_error_response:type_name -> wandb_internal.ApiErrorResponse
+	74, // 0: wandb_internal.ServerApiInitRequest.settings:type_name -> wandb_internal.Settings
+	44, // 1: wandb_internal.ApiRequest.read_run_history_request:type_name -> wandb_internal.ReadRunHistoryRequest
+	9,  // 2: wandb_internal.ApiRequest.features_request:type_name -> wandb_internal.FeaturesRequest
+	15, // 3: wandb_internal.ApiRequest.graphql_request:type_name -> wandb_internal.GraphQLRequest
+	17, // 4: wandb_internal.ApiRequest.download_file_request:type_name -> wandb_internal.DownloadFileRequest
+	19, // 5: wandb_internal.ApiRequest.upload_file_request:type_name -> wandb_internal.UploadFileRequest
+	21, // 6: wandb_internal.ApiRequest.mark_run_files_uploaded_request:type_name -> wandb_internal.MarkRunFilesUploadedRequest
+	29, // 7: wandb_internal.ApiRequest.stop_run_request:type_name -> wandb_internal.StopRunRequest
+	23, // 8: wandb_internal.ApiRequest.auth_request:type_name -> wandb_internal.AuthRequest
+	34, // 9: wandb_internal.ApiRequest.create_custom_chart_request:type_name -> wandb_internal.CreateCustomChartRequest
+	36, // 10: wandb_internal.ApiRequest.run_queue_operation_request:type_name -> wandb_internal.RunQueueOperationRequest
+	75, // 11: wandb_internal.ApiRequest.open_telemetry_request:type_name -> wandb_internal.OpenTelemetryRequest
+	31, // 12: wandb_internal.ApiRequest.read_run_console_logs_request:type_name -> wandb_internal.ReadRunConsoleLogsRequest
+	45, // 13: wandb_internal.ApiResponse.read_run_history_response:type_name -> wandb_internal.ReadRunHistoryResponse
+	10, // 14: wandb_internal.ApiResponse.features_response:type_name -> wandb_internal.FeaturesResponse
+	16, // 15: wandb_internal.ApiResponse.graphql_response:type_name -> wandb_internal.GraphQLResponse
+	18, // 16: wandb_internal.ApiResponse.download_file_response:type_name -> wandb_internal.DownloadFileResponse
+	20, // 17: wandb_internal.ApiResponse.upload_file_response:type_name -> wandb_internal.UploadFileResponse
+	22, // 18: wandb_internal.ApiResponse.mark_run_files_uploaded_response:type_name -> wandb_internal.MarkRunFilesUploadedResponse
+	30, // 19: wandb_internal.ApiResponse.stop_run_response:type_name -> wandb_internal.StopRunResponse
+	24, // 20: wandb_internal.ApiResponse.auth_response:type_name -> wandb_internal.AuthResponse
+	35, // 21: wandb_internal.ApiResponse.create_custom_chart_response:type_name -> wandb_internal.CreateCustomChartResponse
+	37, // 22: wandb_internal.ApiResponse.run_queue_operation_response:type_name -> wandb_internal.RunQueueOperationResponse
+	32, // 23: wandb_internal.ApiResponse.read_run_console_logs_response:type_name -> wandb_internal.ReadRunConsoleLogsResponse
+	7,  // 24: wandb_internal.ApiResponse.api_error_response:type_name -> wandb_internal.ApiErrorResponse
 	0,  // 25: wandb_internal.ApiErrorResponse.error_type:type_name -> wandb_internal.ErrorType
-	9,  // 26: wandb_internal.FeaturesRequest.server:type_name -> wandb_internal.ServerFeaturesRequest
-	11, // 27: wandb_internal.FeaturesRequest.org:type_name -> wandb_internal.OrgFeaturesRequest
-	10, // 28: wandb_internal.FeaturesResponse.server:type_name -> wandb_internal.ServerFeaturesResponse
-	12, // 29: wandb_internal.FeaturesResponse.org:type_name -> wandb_internal.OrgFeaturesResponse
-	65, // 30: wandb_internal.ServerFeaturesRequest.features:type_name -> wandb_internal.ServerFeature
-	65, // 31: wandb_internal.ServerFeaturesResponse.enabled:type_name -> wandb_internal.ServerFeature
-	59, // 32: wandb_internal.OrgFeaturesResponse.features:type_name -> wandb_internal.OrgFeaturesResponse.FeaturesEntry
-	60, // 33: wandb_internal.GraphQLRequest.rename_fields:type_name -> wandb_internal.GraphQLRequest.RenameFieldsEntry
-	61, // 34: wandb_internal.UploadFileRequest.headers:type_name -> wandb_internal.UploadFileRequest.HeadersEntry
-	23, // 35: wandb_internal.AuthRequest.authenticate_request:type_name -> wandb_internal.AuthenticateRequest
-	25, // 36: wandb_internal.AuthRequest.get_access_token_request:type_name -> wandb_internal.GetAccessTokenRequest
-	24, // 37: wandb_internal.AuthResponse.authenticate_response:type_name -> wandb_internal.AuthenticateResponse
-	26, // 38: wandb_internal.AuthResponse.get_access_token_response:type_name -> wandb_internal.GetAccessTokenResponse
-	31, // 39: wandb_internal.ReadRunConsoleLogsResponse.lines:type_name -> wandb_internal.RunConsoleLogLine
-	36, // 40: wandb_internal.RunQueueOperationRequest.create_default_resource_config_request:type_name -> wandb_internal.CreateDefaultResourceConfigRequest
-	38, // 41: wandb_internal.RunQueueOperationRequest.create_run_queue_request:type_name -> wandb_internal.CreateRunQueueRequest
-	40, // 42: wandb_internal.RunQueueOperationRequest.upsert_run_queue_request:type_name -> wandb_internal.UpsertRunQueueRequest
-	37, // 43: wandb_internal.RunQueueOperationResponse.create_default_resource_config_response:type_name -> wandb_internal.CreateDefaultResourceConfigResponse
-	39, // 44: wandb_internal.RunQueueOperationResponse.create_run_queue_response:type_name -> wandb_internal.CreateRunQueueResponse
-	41, // 45: wandb_internal.RunQueueOperationResponse.upsert_run_queue_response:type_name -> wandb_internal.UpsertRunQueueResponse
-	44, // 46: wandb_internal.ReadRunHistoryRequest.scan_run_history_init:type_name -> wandb_internal.ScanRunHistoryInit
-	46, // 47: wandb_internal.ReadRunHistoryRequest.scan_run_history:type_name -> wandb_internal.ScanRunHistory
-	50, // 48: wandb_internal.ReadRunHistoryRequest.scan_run_history_cleanup:type_name -> wandb_internal.ScanRunHistoryCleanup
-	52, // 49: wandb_internal.ReadRunHistoryRequest.download_run_history_init:type_name -> wandb_internal.DownloadRunHistoryInit
-	54, // 50: wandb_internal.ReadRunHistoryRequest.download_run_history:type_name -> wandb_internal.DownloadRunHistory
-	57, // 51: wandb_internal.ReadRunHistoryRequest.download_run_history_status:type_name -> wandb_internal.DownloadRunHistoryStatus
-	45, // 52: wandb_internal.ReadRunHistoryResponse.scan_run_history_init:type_name -> wandb_internal.ScanRunHistoryInitResponse
-	47, // 53: wandb_internal.ReadRunHistoryResponse.run_history:type_name -> wandb_internal.RunHistoryResponse
-	51, // 54: wandb_internal.ReadRunHistoryResponse.scan_run_history_cleanup:type_name -> wandb_internal.ScanRunHistoryCleanupResponse
-	53, // 55: wandb_internal.ReadRunHistoryResponse.download_run_history_init:type_name -> wandb_internal.DownloadRunHistoryInitResponse
-	55, // 56: wandb_internal.ReadRunHistoryResponse.download_run_history:type_name -> wandb_internal.DownloadRunHistoryResponse
-	58, // 57: wandb_internal.ReadRunHistoryResponse.download_run_history_status:type_name -> wandb_internal.DownloadRunHistoryStatusResponse
-	48, // 58: wandb_internal.RunHistoryResponse.history_rows:type_name -> wandb_internal.HistoryRow
-	49, // 59: wandb_internal.HistoryRow.history_items:type_name -> wandb_internal.ParquetHistoryItem
-	62, // 60: wandb_internal.DownloadRunHistoryResponse.errors:type_name -> wandb_internal.DownloadRunHistoryResponse.ErrorsEntry
-	66, // 61: wandb_internal.DownloadRunHistoryStatusResponse.operation_stats:type_name -> wandb_internal.OperationStats
-	62, // [62:62] is the sub-list for method output_type
-	62, // [62:62] is the sub-list for method input_type
-	62, // [62:62] is the sub-list for extension type_name
-	62, // [62:62] is the sub-list for extension extendee
-	0,  // [0:62] is the sub-list for field type_name
+	11, // 26: wandb_internal.FeaturesRequest.server:type_name -> wandb_internal.ServerFeaturesRequest
+	13, // 27: wandb_internal.FeaturesRequest.org:type_name -> wandb_internal.OrgFeaturesRequest
+	12, // 28: wandb_internal.FeaturesResponse.server:type_name -> wandb_internal.ServerFeaturesResponse
+	14, // 29: wandb_internal.FeaturesResponse.org:type_name -> wandb_internal.OrgFeaturesResponse
+	76, // 30: wandb_internal.ServerFeaturesRequest.features:type_name -> wandb_internal.ServerFeature
+	76, // 31: wandb_internal.ServerFeaturesResponse.enabled:type_name -> wandb_internal.ServerFeature
+	70, // 32: wandb_internal.OrgFeaturesResponse.features:type_name -> wandb_internal.OrgFeaturesResponse.FeaturesEntry
+	71, // 33: wandb_internal.GraphQLRequest.rename_fields:type_name -> wandb_internal.GraphQLRequest.RenameFieldsEntry
+	72, // 34: wandb_internal.UploadFileRequest.headers:type_name -> wandb_internal.UploadFileRequest.HeadersEntry
+	25, // 35: wandb_internal.AuthRequest.authenticate_request:type_name -> wandb_internal.AuthenticateRequest
+	27, // 36: wandb_internal.AuthRequest.get_access_token_request:type_name -> wandb_internal.GetAccessTokenRequest
+	26, // 37: wandb_internal.AuthResponse.authenticate_response:type_name -> wandb_internal.AuthenticateResponse
+	28, // 38: wandb_internal.AuthResponse.get_access_token_response:type_name -> wandb_internal.GetAccessTokenResponse
+	33, // 39: wandb_internal.ReadRunConsoleLogsResponse.lines:type_name -> wandb_internal.RunConsoleLogLine
+	38, // 40: wandb_internal.RunQueueOperationRequest.create_default_resource_config_request:type_name -> wandb_internal.CreateDefaultResourceConfigRequest
+	40, // 41: wandb_internal.RunQueueOperationRequest.create_run_queue_request:type_name -> wandb_internal.CreateRunQueueRequest
+	42, // 42: wandb_internal.RunQueueOperationRequest.upsert_run_queue_request:type_name -> wandb_internal.UpsertRunQueueRequest
+	39, // 43: wandb_internal.RunQueueOperationResponse.create_default_resource_config_response:type_name -> wandb_internal.CreateDefaultResourceConfigResponse
+	41, // 44: wandb_internal.RunQueueOperationResponse.create_run_queue_response:type_name -> wandb_internal.CreateRunQueueResponse
+	43, // 45: wandb_internal.RunQueueOperationResponse.upsert_run_queue_response:type_name -> wandb_internal.UpsertRunQueueResponse
+	46, // 46: wandb_internal.ReadRunHistoryRequest.scan_run_history_init:type_name -> wandb_internal.ScanRunHistoryInit
+	48, // 47: wandb_internal.ReadRunHistoryRequest.scan_run_history:type_name -> wandb_internal.ScanRunHistory
+	53, // 48: wandb_internal.ReadRunHistoryRequest.scan_run_history_cleanup:type_name -> wandb_internal.ScanRunHistoryCleanup
+	63, // 49: wandb_internal.ReadRunHistoryRequest.download_run_history_init:type_name -> wandb_internal.DownloadRunHistoryInit
+	65, // 50: wandb_internal.ReadRunHistoryRequest.download_run_history:type_name -> wandb_internal.DownloadRunHistory
+	68, // 51: wandb_internal.ReadRunHistoryRequest.download_run_history_status:type_name -> wandb_internal.DownloadRunHistoryStatus
+	56, // 52: wandb_internal.ReadRunHistoryRequest.batch_run_history_init:type_name -> wandb_internal.BatchRunHistoryInit
+	58, // 53: wandb_internal.ReadRunHistoryRequest.batch_run_history_next:type_name -> wandb_internal.BatchRunHistoryNext
+	61, // 54: wandb_internal.ReadRunHistoryRequest.batch_run_history_cleanup:type_name -> wandb_internal.BatchRunHistoryCleanup
+	47, // 55: wandb_internal.ReadRunHistoryResponse.scan_run_history_init:type_name -> wandb_internal.ScanRunHistoryInitResponse
+	50, // 56: wandb_internal.ReadRunHistoryResponse.run_history:type_name -> wandb_internal.RunHistoryResponse
+	54, // 57: wandb_internal.ReadRunHistoryResponse.scan_run_history_cleanup:type_name -> wandb_internal.ScanRunHistoryCleanupResponse
+	64, // 58: wandb_internal.ReadRunHistoryResponse.download_run_history_init:type_name -> wandb_internal.DownloadRunHistoryInitResponse
+	66, // 59: wandb_internal.ReadRunHistoryResponse.download_run_history:type_name -> wandb_internal.DownloadRunHistoryResponse
+	69, // 60: wandb_internal.ReadRunHistoryResponse.download_run_history_status:type_name -> wandb_internal.DownloadRunHistoryStatusResponse
+	57, // 61: wandb_internal.ReadRunHistoryResponse.batch_run_history_init:type_name -> wandb_internal.BatchRunHistoryInitResponse
+	59, // 62: wandb_internal.ReadRunHistoryResponse.batch_run_history:type_name -> wandb_internal.BatchRunHistoryResponse
+	62, // 63: wandb_internal.ReadRunHistoryResponse.batch_run_history_cleanup:type_name -> wandb_internal.BatchRunHistoryCleanupResponse
+	49, // 64: wandb_internal.ScanRunHistory.sampling:type_name -> wandb_internal.HistorySampling
+	1,  // 65: wandb_internal.ScanRunHistory.format:type_name -> wandb_internal.ScanRunHistory.Format
+	2,  // 66: wandb_internal.HistorySampling.aggregation:type_name -> wandb_internal.HistorySampling.Aggregation
+	51, // 67: wandb_internal.RunHistoryResponse.history_rows:type_name -> wandb_internal.HistoryRow
+	52, // 68: wandb_internal.HistoryRow.history_items:type_name -> wandb_internal.ParquetHistoryItem
+	55, // 69: wandb_internal.BatchRunHistoryInit.runs:type_name -> wandb_internal.HistoryRunPath
+	49, // 70: wandb_internal.BatchRunHistoryInit.sampling:type_name -> wandb_internal.HistorySampling
+	60, // 71: wandb_internal.BatchRunHistoryResponse.results:type_name -> wandb_internal.RunHistoryResult
+	55, // 72: wandb_internal.RunHistoryResult.run:type_name -> wandb_internal.HistoryRunPath
+	51, // 73: wandb_internal.RunHistoryResult.history_rows:type_name -> wandb_internal.HistoryRow
+	73, // 74: wandb_internal.DownloadRunHistoryResponse.errors:type_name -> wandb_internal.DownloadRunHistoryResponse.ErrorsEntry
+	77, // 75: wandb_internal.DownloadRunHistoryStatusResponse.operation_stats:type_name -> wandb_internal.OperationStats
+	76, // [76:76] is the sub-list for method output_type
+	76, // [76:76] is the sub-list for method input_type
+	76, // [76:76] is the sub-list for extension type_name
+	76, // [76:76] is the sub-list for extension extendee
+	0,  // [0:76] is the sub-list for field type_name
 }
 
 func init() { file_wandb_proto_wandb_api_proto_init() }
@@ -4732,6 +5566,9 @@ func file_wandb_proto_wandb_api_proto_init() {
 		(*ReadRunHistoryRequest_DownloadRunHistoryInit)(nil),
 		(*ReadRunHistoryRequest_DownloadRunHistory)(nil),
 		(*ReadRunHistoryRequest_DownloadRunHistoryStatus)(nil),
+		(*ReadRunHistoryRequest_BatchRunHistoryInit)(nil),
+		(*ReadRunHistoryRequest_BatchRunHistoryNext)(nil),
+		(*ReadRunHistoryRequest_BatchRunHistoryCleanup)(nil),
 	}
 	file_wandb_proto_wandb_api_proto_msgTypes[42].OneofWrappers = []any{
 		(*ReadRunHistoryResponse_ScanRunHistoryInit)(nil),
@@ -4740,14 +5577,17 @@ func file_wandb_proto_wandb_api_proto_init() {
 		(*ReadRunHistoryResponse_DownloadRunHistoryInit)(nil),
 		(*ReadRunHistoryResponse_DownloadRunHistory)(nil),
 		(*ReadRunHistoryResponse_DownloadRunHistoryStatus)(nil),
+		(*ReadRunHistoryResponse_BatchRunHistoryInit)(nil),
+		(*ReadRunHistoryResponse_BatchRunHistory)(nil),
+		(*ReadRunHistoryResponse_BatchRunHistoryCleanup)(nil),
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_wandb_proto_wandb_api_proto_rawDesc), len(file_wandb_proto_wandb_api_proto_rawDesc)),
-			NumEnums:      1,
-			NumMessages:   62,
+			NumEnums:      3,
+			NumMessages:   71,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -0,0 +1,153 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        v7.34.1
+// source: wandb/proto/wandb_core_service.proto
+
+package service_go_proto
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type ServerApiCleanupResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ServerApiCleanupResponse) Reset() {
+	*x = ServerApiCleanupResponse{}
+	mi := &file_wandb_proto_wandb_core_service_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ServerApiCleanupResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ServerApiCleanupResponse) ProtoMessage() {}
+
+func (x *ServerApiCleanupResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_wandb_proto_wandb_core_service_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ServerApiCleanupResponse.ProtoReflect.Descriptor instead.
+func (*ServerApiCleanupResponse) Descriptor() ([]byte, []int) {
+	return file_wandb_proto_wandb_core_service_proto_rawDescGZIP(), []int{0}
+}
+
+var File_wandb_proto_wandb_core_service_proto protoreflect.FileDescriptor
+
+const file_wandb_proto_wandb_core_service_proto_rawDesc = "" +
+	"\n" +
+	"$wandb/proto/wandb_core_service.proto\x12\x0ewandb_internal\x1a\x1bwandb/proto/wandb_api.proto\x1a\x1ewandb/proto/wandb_server.proto\x1a\x1cwandb/proto/wandb_sync.proto\"\x1a\n" +
+	"\x18ServerApiCleanupResponse2\xf2\x04\n" +
+	"\x10WandbCoreService\x12N\n" +
+	"\aConnect\x12\x1d.wandb_internal.ServerRequest\x1a\x1e.wandb_internal.ServerResponse\"\x00(\x010\x01\x12[\n" +
+	"\bInitSync\x12%.wandb_internal.ServerInitSyncRequest\x1a&.wandb_internal.ServerInitSyncResponse\"\x00\x12O\n" +
+	"\x04Sync\x12!.wandb_internal.ServerSyncRequest\x1a\".wandb_internal.ServerSyncResponse\"\x00\x12a\n" +
+	"\n" +
+	"SyncStatus\x12'.wandb_internal.ServerSyncStatusRequest\x1a(.wandb_internal.ServerSyncStatusResponse\"\x00\x12X\n" +
+	"\aApiInit\x12$.wandb_internal.ServerApiInitRequest\x1a%.wandb_internal.ServerApiInitResponse\"\x00\x12a\n" +
+	"\n" +
+	"ApiCleanup\x12'.wandb_internal.ServerApiCleanupRequest\x1a(.wandb_internal.ServerApiCleanupResponse\"\x00\x12@\n" +
+	"\x03Api\x12\x1a.wandb_internal.ApiRequest\x1a\x1b.wandb_internal.ApiResponse\"\x00B\x1bZ\x19core/pkg/service_go_protob\x06proto3"
+
+var (
+	file_wandb_proto_wandb_core_service_proto_rawDescOnce sync.Once
+	file_wandb_proto_wandb_core_service_proto_rawDescData []byte
+)
+
+func file_wandb_proto_wandb_core_service_proto_rawDescGZIP() []byte {
+	file_wandb_proto_wandb_core_service_proto_rawDescOnce.Do(func() {
+		file_wandb_proto_wandb_core_service_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_wandb_proto_wandb_core_service_proto_rawDesc), len(file_wandb_proto_wandb_core_service_proto_rawDesc)))
+	})
+	return file_wandb_proto_wandb_core_service_proto_rawDescData
+}
+
+var file_wandb_proto_wandb_core_service_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_wandb_proto_wandb_core_service_proto_goTypes = []any{
+	(*ServerApiCleanupResponse)(nil), // 0: wandb_internal.ServerApiCleanupResponse
+	(*ServerRequest)(nil),            // 1: wandb_internal.ServerRequest
+	(*ServerInitSyncRequest)(nil),    // 2: wandb_internal.ServerInitSyncRequest
+	(*ServerSyncRequest)(nil),        // 3: wandb_internal.ServerSyncRequest
+	(*ServerSyncStatusRequest)(nil),  // 4: wandb_internal.ServerSyncStatusRequest
+	(*ServerApiInitRequest)(nil),     // 5: wandb_internal.ServerApiInitRequest
+	(*ServerApiCleanupRequest)(nil),  // 6: wandb_internal.ServerApiCleanupRequest
+	(*ApiRequest)(nil),               // 7: wandb_internal.ApiRequest
+	(*ServerResponse)(nil),           // 8: wandb_internal.ServerResponse
+	(*ServerInitSyncResponse)(nil),   // 9: wandb_internal.ServerInitSyncResponse
+	(*ServerSyncResponse)(nil),       // 10: wandb_internal.ServerSyncResponse
+	(*ServerSyncStatusResponse)(nil), // 11: wandb_internal.ServerSyncStatusResponse
+	(*ServerApiInitResponse)(nil),    // 12: wandb_internal.ServerApiInitResponse
+	(*ApiResponse)(nil),              // 13: wandb_internal.ApiResponse
+}
+var file_wandb_proto_wandb_core_service_proto_depIdxs = []int32{
+	1,  // 0: wandb_internal.WandbCoreService.Connect:input_type -> wandb_internal.ServerRequest
+	2,  // 1: wandb_internal.WandbCoreService.InitSync:input_type -> wandb_internal.ServerInitSyncRequest
+	3,  // 2: wandb_internal.WandbCoreService.Sync:input_type -> wandb_internal.ServerSyncRequest
+	4,  // 3: wandb_internal.WandbCoreService.SyncStatus:input_type -> wandb_internal.ServerSyncStatusRequest
+	5,  // 4: wandb_internal.WandbCoreService.ApiInit:input_type -> wandb_internal.ServerApiInitRequest
+	6,  // 5: wandb_internal.WandbCoreService.ApiCleanup:input_type -> wandb_internal.ServerApiCleanupRequest
+	7,  // 6: wandb_internal.WandbCoreService.Api:input_type -> wandb_internal.ApiRequest
+	8,  // 7: wandb_internal.WandbCoreService.Connect:output_type -> wandb_internal.ServerResponse
+	9,  // 8: wandb_internal.WandbCoreService.InitSync:output_type -> wandb_internal.ServerInitSyncResponse
+	10, // 9: wandb_internal.WandbCoreService.Sync:output_type -> wandb_internal.ServerSyncResponse
+	11, // 10: wandb_internal.WandbCoreService.SyncStatus:output_type -> wandb_internal.ServerSyncStatusResponse
+	12, // 11: wandb_internal.WandbCoreService.ApiInit:output_type -> wandb_internal.ServerApiInitResponse
+	0,  // 12: wandb_internal.WandbCoreService.ApiCleanup:output_type -> wandb_internal.ServerApiCleanupResponse
+	13, // 13: wandb_internal.WandbCoreService.Api:output_type -> wandb_internal.ApiResponse
+	7,  // [7:14] is the sub-list for method output_type
+	0,  // [0:7] is the sub-list for method input_type
+	0,  // [0:0] is the sub-list for extension type_name
+	0,  // [0:0] is the sub-list for extension extendee
+	0,  // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_wandb_proto_wandb_core_service_proto_init() }
+func file_wandb_proto_wandb_core_service_proto_init() {
+	if File_wandb_proto_wandb_core_service_proto != nil {
+		return
+	}
+	file_wandb_proto_wandb_api_proto_init()
+	file_wandb_proto_wandb_server_proto_init()
+	file_wandb_proto_wandb_sync_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_wandb_proto_wandb_core_service_proto_rawDesc), len(file_wandb_proto_wandb_core_service_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   1,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_wandb_proto_wandb_core_service_proto_goTypes,
+		DependencyIndexes: file_wandb_proto_wandb_core_service_proto_depIdxs,
+		MessageInfos:      file_wandb_proto_wandb_core_service_proto_msgTypes,
+	}.Build()
+	File_wandb_proto_wandb_core_service_proto = out.File
+	file_wandb_proto_wandb_core_service_proto_goTypes = nil
+	file_wandb_proto_wandb_core_service_proto_depIdxs = nil
+}
@@ -0,0 +1,392 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.6.2
+// - protoc             v7.34.1
+// source: wandb/proto/wandb_core_service.proto
+
+package service_go_proto
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	WandbCoreService_Connect_FullMethodName    = "/wandb_internal.WandbCoreService/Connect"
+	WandbCoreService_InitSync_FullMethodName   = "/wandb_internal.WandbCoreService/InitSync"
+	WandbCoreService_Sync_FullMethodName       = "/wandb_internal.WandbCoreService/Sync"
+	WandbCoreService_SyncStatus_FullMethodName = "/wandb_internal.WandbCoreService/SyncStatus"
+	WandbCoreService_ApiInit_FullMethodName    = "/wandb_internal.WandbCoreService/ApiInit"
+	WandbCoreService_ApiCleanup_FullMethodName = "/wandb_internal.WandbCoreService/ApiCleanup"
+	WandbCoreService_Api_FullMethodName        = "/wandb_internal.WandbCoreService/Api"
+)
+
+// WandbCoreServiceClient is the client API for WandbCoreService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// WandbCoreService exposes the wandb-core service over gRPC.
+//
+// It is an alternative to the length-prefixed framing used on wandb-core's
+// Unix and localhost sockets, so that clients can use generated stubs instead
+// of reimplementing the framing. It is only served if wandb-core is started
+// with the --grpc-addr flag.
+type WandbCoreServiceClient interface {
+	// Connect opens a session that behaves like a socket connection.
+	//
+	// Requests are processed in order as if written to a socket, and responses
+	// are sent as they become available, matched to requests by request_id.
+	// Streams and API instances created in a session belong to it.
+	//
+	// Closing the client side of the stream ends the session like closing
+	// a socket: responses that haven't been sent yet are dropped.
+	Connect(ctx context.Context, opts ...grpc.CallOption) (grpc.BidiStreamingClient[ServerRequest, ServerResponse], error)
+	// InitSync prepares to sync one or more .wandb files.
+	InitSync(ctx context.Context, in *ServerInitSyncRequest, opts ...grpc.CallOption) (*ServerInitSyncResponse, error)
+	// Sync runs a sync operation prepared by InitSync.
+	//
+	// The call completes when the operation does. Cancelling the call cancels
+	// the operation.
+	Sync(ctx context.Context, in *ServerSyncRequest, opts ...grpc.CallOption) (*ServerSyncResponse, error)
+	// SyncStatus returns the status of an ongoing sync operation.
+	SyncStatus(ctx context.Context, in *ServerSyncStatusRequest, opts ...grpc.CallOption) (*ServerSyncStatusResponse, error)
+	// ApiInit creates an API instance for use in Api calls.
+	ApiInit(ctx context.Context, in *ServerApiInitRequest, opts ...grpc.CallOption) (*ServerApiInitResponse, error)
+	// ApiCleanup releases an API instance created by ApiInit.
+	ApiCleanup(ctx context.Context, in *ServerApiCleanupRequest, opts ...grpc.CallOption) (*ServerApiCleanupResponse, error)
+	// Api makes a request using an API instance created by ApiInit.
+	Api(ctx context.Context, in *ApiRequest, opts ...grpc.CallOption) (*ApiResponse, error)
+}
+
+type wandbCoreServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewWandbCoreServiceClient(cc grpc.ClientConnInterface) WandbCoreServiceClient {
+	return &wandbCoreServiceClient{cc}
+}
+
+func (c *wandbCoreServiceClient) Connect(ctx context.Context, opts ...grpc.CallOption) (grpc.BidiStreamingClient[ServerRequest, ServerResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &WandbCoreService_ServiceDesc.Streams[0], WandbCoreService_Connect_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[ServerRequest, ServerResponse]{ClientStream: stream}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type WandbCoreService_ConnectClient = grpc.BidiStreamingClient[ServerRequest, ServerResponse]
+
+func (c *wandbCoreServiceClient) InitSync(ctx context.Context, in *ServerInitSyncRequest, opts ...grpc.CallOption) (*ServerInitSyncResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ServerInitSyncResponse)
+	err := c.cc.Invoke(ctx, WandbCoreService_InitSync_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *wandbCoreServiceClient) Sync(ctx context.Context, in *ServerSyncRequest, opts ...grpc.CallOption) (*ServerSyncResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ServerSyncResponse)
+	err := c.cc.Invoke(ctx, WandbCoreService_Sync_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *wandbCoreServiceClient) SyncStatus(ctx context.Context, in *ServerSyncStatusRequest, opts ...grpc.CallOption) (*ServerSyncStatusResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ServerSyncStatusResponse)
+	err := c.cc.Invoke(ctx, WandbCoreService_SyncStatus_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *wandbCoreServiceClient) ApiInit(ctx context.Context, in *ServerApiInitRequest, opts ...grpc.CallOption) (*ServerApiInitResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ServerApiInitResponse)
+	err := c.cc.Invoke(ctx, WandbCoreService_ApiInit_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *wandbCoreServiceClient) ApiCleanup(ctx context.Context, in *ServerApiCleanupRequest, opts ...grpc.CallOption) (*ServerApiCleanupResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ServerApiCleanupResponse)
+	err := c.cc.Invoke(ctx, WandbCoreService_ApiCleanup_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *wandbCoreServiceClient) Api(ctx context.Context, in *ApiRequest, opts ...grpc.CallOption) (*ApiResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ApiResponse)
+	err := c.cc.Invoke(ctx, WandbCoreService_Api_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// WandbCoreServiceServer is the server API for WandbCoreService service.
+// All implementations must embed UnimplementedWandbCoreServiceServer
+// for forward compatibility.
+//
+// WandbCoreService exposes the wandb-core service over gRPC.
+//
+// It is an alternative to the length-prefixed framing used on wandb-core's
+// Unix and localhost sockets, so that clients can use generated stubs instead
+// of reimplementing the framing. It is only served if wandb-core is started
+// with the --grpc-addr flag.
+type WandbCoreServiceServer interface {
+	// Connect opens a session that behaves like a socket connection.
+	//
+	// Requests are processed in order as if written to a socket, and responses
+	// are sent as they become available, matched to requests by request_id.
+	// Streams and API instances created in a session belong to it.
+	//
+	// Closing the client side of the stream ends the session like closing
+	// a socket: responses that haven't been sent yet are dropped.
+	Connect(grpc.BidiStreamingServer[ServerRequest, ServerResponse]) error
+	// InitSync prepares to sync one or more .wandb files.
+	InitSync(context.Context, *ServerInitSyncRequest) (*ServerInitSyncResponse, error)
+	// Sync runs a sync operation prepared by InitSync.
+	//
+	// The call completes when the operation does. Cancelling the call cancels
+	// the operation.
+	Sync(context.Context, *ServerSyncRequest) (*ServerSyncResponse, error)
+	// SyncStatus returns the status of an ongoing sync operation.
+	SyncStatus(context.Context, *ServerSyncStatusRequest) (*ServerSyncStatusResponse, error)
+	// ApiInit creates an API instance for use in Api calls.
+	ApiInit(context.Context, *ServerApiInitRequest) (*ServerApiInitResponse, error)
+	// ApiCleanup releases an API instance created by ApiInit.
+	ApiCleanup(context.Context, *ServerApiCleanupRequest) (*ServerApiCleanupResponse, error)
+	// Api makes a request using an API instance created by ApiInit.
+	Api(context.Context, *ApiRequest) (*ApiResponse, error)
+	mustEmbedUnimplementedWandbCoreServiceServer()
+}
+
+// UnimplementedWandbCoreServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedWandbCoreServiceServer struct{}
+
+func (UnimplementedWandbCoreServiceServer) Connect(grpc.BidiStreamingServer[ServerRequest, ServerResponse]) error {
+	return status.Error(codes.Unimplemented, "method Connect not implemented")
+}
+func (UnimplementedWandbCoreServiceServer) InitSync(context.Context, *ServerInitSyncRequest) (*ServerInitSyncResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method InitSync not implemented")
+}
+func (UnimplementedWandbCoreServiceServer) Sync(context.Context, *ServerSyncRequest) (*ServerSyncResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method Sync not implemented")
+}
+func (UnimplementedWandbCoreServiceServer) SyncStatus(context.Context, *ServerSyncStatusRequest) (*ServerSyncStatusResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SyncStatus not implemented")
+}
+func (UnimplementedWandbCoreServiceServer) ApiInit(context.Context, *ServerApiInitRequest) (*ServerApiInitResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ApiInit not implemented")
+}
+func (UnimplementedWandbCoreServiceServer) ApiCleanup(context.Context, *ServerApiCleanupRequest) (*ServerApiCleanupResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ApiCleanup not implemented")
+}
+func (UnimplementedWandbCoreServiceServer) Api(context.Context, *ApiRequest) (*ApiResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method Api not implemented")
+}
+func (UnimplementedWandbCoreServiceServer) mustEmbedUnimplementedWandbCoreServiceServer() {}
+func (UnimplementedWandbCoreServiceServer) testEmbeddedByValue()                          {}
+
+// UnsafeWandbCoreServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to WandbCoreServiceServer will
+// result in compilation errors.
+type UnsafeWandbCoreServiceServer interface {
+	mustEmbedUnimplementedWandbCoreServiceServer()
+}
+
+func RegisterWandbCoreServiceServer(s grpc.ServiceRegistrar, srv WandbCoreServiceServer) {
+	// If the following call panics, it indicates UnimplementedWandbCoreServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&WandbCoreService_ServiceDesc, srv)
+}
+
+func _WandbCoreService_Connect_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(WandbCoreServiceServer).Connect(&grpc.GenericServerStream[ServerRequest, ServerResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type WandbCoreService_ConnectServer = grpc.BidiStreamingServer[ServerRequest, ServerResponse]
+
+func _WandbCoreService_InitSync_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ServerInitSyncRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WandbCoreServiceServer).InitSync(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WandbCoreService_InitSync_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WandbCoreServiceServer).InitSync(ctx, req.(*ServerInitSyncRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WandbCoreService_Sync_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ServerSyncRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WandbCoreServiceServer).Sync(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WandbCoreService_Sync_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WandbCoreServiceServer).Sync(ctx, req.(*ServerSyncRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WandbCoreService_SyncStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ServerSyncStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WandbCoreServiceServer).SyncStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WandbCoreService_SyncStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WandbCoreServiceServer).SyncStatus(ctx, req.(*ServerSyncStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WandbCoreService_ApiInit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ServerApiInitRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WandbCoreServiceServer).ApiInit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WandbCoreService_ApiInit_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WandbCoreServiceServer).ApiInit(ctx, req.(*ServerApiInitRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WandbCoreService_ApiCleanup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ServerApiCleanupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WandbCoreServiceServer).ApiCleanup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WandbCoreService_ApiCleanup_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WandbCoreServiceServer).ApiCleanup(ctx, req.(*ServerApiCleanupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WandbCoreService_Api_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApiRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WandbCoreServiceServer).Api(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WandbCoreService_Api_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WandbCoreServiceServer).Api(ctx, req.(*ApiRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// WandbCoreService_ServiceDesc is the grpc.ServiceDesc for WandbCoreService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var WandbCoreService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "wandb_internal.WandbCoreService",
+	HandlerType: (*WandbCoreServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "InitSync",
+			Handler:    _WandbCoreService_InitSync_Handler,
+		},
+		{
+			MethodName: "Sync",
+			Handler:    _WandbCoreService_Sync_Handler,
+		},
+		{
+			MethodName: "SyncStatus",
+			Handler:    _WandbCoreService_SyncStatus_Handler,
+		},
+		{
+			MethodName: "ApiInit",
+			Handler:    _WandbCoreService_ApiInit_Handler,
+		},
+		{
+			MethodName: "ApiCleanup",
+			Handler:    _WandbCoreService_ApiCleanup_Handler,
+		},
+		{
+			MethodName: "Api",
+			Handler:    _WandbCoreService_Api_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "Connect",
+			Handler:       _WandbCoreService_Connect_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
+	},
+	Metadata: "wandb/proto/wandb_core_service.proto",
+}
@@ -685,7 +685,7 @@ func (x FileTransferInfoRequest_TransferType) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use FileTransferInfoRequest_TransferType.Descriptor instead.
 func (FileTransferInfoRequest_TransferType) EnumDescriptor() ([]byte, []int) {
-	return file_wandb_proto_wandb_internal_proto_rawDescGZIP(), []int{101, 0}
+	return file_wandb_proto_wandb_internal_proto_rawDescGZIP(), []int{102, 0}
 }
 
 // A sequence of Records fully defines a run.
@@ -4881,7 +4881,7 @@ func (*AlertResult) Descriptor() ([]byte, []int) {
 // step with a PartialHistoryRequest, which produces artificial Records
 // when a step is committed).
 //
-// Next ID: 85
+// Next ID: 86
 type Request struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// Types that are valid to be assigned to RequestType:
@@ -4922,6 +4922,7 @@ type Request struct {
 	//	*Request_SyncFinish
 	//	*Request_Operations
 	//	*Request_ProbeSystemInfo
+	//	*Request_CaptureProfile
 	//	*Request_TestInject
 	RequestType   isRequest_RequestType `protobuf_oneof:"request_type"`
 	unknownFields protoimpl.UnknownFields
@@ -5289,6 +5290,15 @@ func (x *Request) GetProbeSystemInfo() *ProbeSystemInfoRequest {
 	return nil
 }
 
+func (x *Request) GetCaptureProfile() *CaptureProfileRequest {
+	if x != nil {
+		if x, ok := x.RequestType.(*Request_CaptureProfile); ok {
+			return x.CaptureProfile
+		}
+	}
+	return nil
+}
+
 func (x *Request) GetTestInject() *TestInjectRequest {
 	if x != nil {
 		if x, ok := x.RequestType.(*Request_TestInject); ok {
@@ -5448,6 +5458,11 @@ type Request_ProbeSystemInfo struct {
 	ProbeSystemInfo *ProbeSystemInfoRequest `protobuf:"bytes,83,opt,name=probe_system_info,json=probeSystemInfo,proto3,oneof"`
 }
 
+type Request_CaptureProfile struct {
+	// Requests capturing a performance profile and uploading it as an artifact.
+	CaptureProfile *CaptureProfileRequest `protobuf:"bytes,85,opt,name=capture_profile,json=captureProfile,proto3,oneof"`
+}
+
 type Request_TestInject struct {
 	TestInject *TestInjectRequest `protobuf:"bytes,1000,opt,name=test_inject,json=testInject,proto3,oneof"`
 }
@@ -5524,6 +5539,8 @@ func (*Request_Operations) isRequest_RequestType() {}
 
 func (*Request_ProbeSystemInfo) isRequest_RequestType() {}
 
+func (*Request_CaptureProfile) isRequest_RequestType() {}
+
 func (*Request_TestInject) isRequest_RequestType() {}
 
 // Response: all non persistent responses to Requests
@@ -6186,6 +6203,73 @@ func (*ResumeResponse) Descriptor() ([]byte, []int) {
 	return file_wandb_proto_wandb_internal_proto_rawDescGZIP(), []int{62}
 }
 
+// CaptureProfileRequest: capture a performance profile of the run
+//
+// The service gathers a burst of system metrics, perf and py-spy output if
+// those tools are installed, and its own pprof profiles, and logs them as
+// a "profile" artifact. No response is sent.
+type CaptureProfileRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// How long to capture for. Zero uses the service's default.
+	DurationSeconds float64 `protobuf:"fixed64,1,opt,name=duration_seconds,json=durationSeconds,proto3" json:"duration_seconds,omitempty"`
+	// An optional label stored in the artifact's index.
+	Label         string        `protobuf:"bytes,2,opt,name=label,proto3" json:"label,omitempty"`
+	XInfo         *XRequestInfo `protobuf:"bytes,200,opt,name=_info,json=Info,proto3" json:"_info,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CaptureProfileRequest) Reset() {
+	*x = CaptureProfileRequest{}
+	mi := &file_wandb_proto_wandb_internal_proto_msgTypes[63]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CaptureProfileRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CaptureProfileRequest) ProtoMessage() {}
+
+func (x *CaptureProfileRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_wandb_proto_wandb_internal_proto_msgTypes[63]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CaptureProfileRequest.ProtoReflect.Descriptor instead.
+func (*CaptureProfileRequest) Descriptor() ([]byte, []int) {
+	return file_wandb_proto_wandb_internal_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *CaptureProfileRequest) GetDurationSeconds() float64 {
+	if x != nil {
+		return x.DurationSeconds
+	}
+	return 0
+}
+
+func (x *CaptureProfileRequest) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
+}
+
+func (x *CaptureProfileRequest) GetXInfo() *XRequestInfo {
+	if x != nil {
+		return x.XInfo
+	}
+	return nil
+}
+
 // Old request, no longer used for logging in (if it ever was).
 type LoginRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -6197,7 +6281,7 @@ type LoginRequest struct {
 
 func (x *LoginRequest) Reset() {
 	*x = LoginRequest{}
-	mi := &file_wandb_proto_wandb_internal_proto_msgTypes[63]
+	mi := &file_wandb_proto_wandb_internal_proto_msgTypes[64]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6209,7 +6293,7 @@ func (x *LoginRequest) String() string {
 func (*LoginRequest) ProtoMessage() {}
 
 func (x *LoginRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_wandb_proto_wandb_internal_proto_msgTypes[63]
+	mi := &file_wandb_proto_wandb_internal_proto_msgTypes[64]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6222,7 +6306,7 @@ func (x *LoginRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use LoginRequest.ProtoReflect.Descriptor instead.
 func (*LoginRequest) Descriptor() ([]byte, []int) {
-	return file_wandb_proto_wandb_internal_proto_rawDescGZIP(), []int{63}
+	return file_wandb_proto_wandb_internal_proto_rawDescGZIP(), []int{64}
 }
 
 func (x *LoginRequest) GetApiKey() string {
@@ -6248,7 +6332,7 @@ type LoginResponse struct {
 
 func (x *LoginResponse) Reset() {
 	*x = LoginResponse{}
-	mi := &file_wandb_proto_wandb_internal_proto_msgTypes[64]
+	mi := &file_wandb_proto_wandb_internal_proto_msgTypes[65]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6260,7 +6344,7 @@ func (x *LoginResponse) String() string {
 func (*LoginResponse) ProtoMessage() {}
 
 func (x *LoginResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_wandb_proto_wandb_internal_proto_msgTypes[64]
+	mi := &file_wandb_proto_wandb_internal_proto_msgTypes[65]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6273,7 +6357,7 @@ func (x *LoginResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use LoginResponse.ProtoReflect.Descriptor instead.
 func (*LoginResponse) Descriptor() ([]byte, []int) {
-	return file_wandb_proto_wandb_internal_proto_rawDescGZIP(), []int{64}
+	return file_wandb_proto_wandb_internal_proto_rawDescGZIP(), []int{65}
 }
 
 func (x *LoginResponse) GetActiveEntity() string {
@@ -6293,7 +6377,7 @@ type GetSummaryRequest struct {
 
 func (x *GetSummaryRequest) Reset() {
 	*x = GetSummaryRequest{}
-	mi := &file_wandb_proto_wandb_internal_proto_msgTypes[65]
+	mi := &file_wandb_proto_wandb_internal_proto_msgTypes[66]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6305,7 +6389,7 @@ func (x *GetSummaryRequest) String() string {
 func (*GetSummaryRequest) ProtoMessage() {}
 
 func (x *GetSummaryRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_wandb_proto_wandb_internal_proto_msgTypes[65]
+	mi := &file_wandb_proto_wandb_internal_proto_msgTypes[66]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6318,7 +6402,7 @@ func (x *GetSummaryRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetSummaryRequest.ProtoReflect.Descriptor instead.
 func (*GetSummaryRequest) Descriptor() ([]byte, []int) {
-	return file_wandb_proto_wandb_internal_proto_rawDescGZIP(), []int{65}
+	return file_wandb_proto_wandb_internal_proto_rawDescGZIP(), []int{66}
 }
 
 func (x *GetSummaryRequest) GetXInfo() *XRequestInfo {
@@ -6337,7 +6421,7 @@ type GetSummaryResponse struct {
 
 func (x *GetSummaryResponse) Reset() {
 	*x = GetSummaryResponse{}
-	mi := &file_wandb_proto_wandb_internal_proto_msgTypes[66]
+	mi := &file_wandb_proto_wandb_internal_proto_msgTypes[67]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6349,7 +6433,7 @@ func (x *GetSummaryResponse) String() string {
 func (*GetSummaryResponse) ProtoMessage() {}
 
 func (x *GetSummaryResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_wandb_proto_wandb_internal_proto_msgTypes[66]
+	mi := &file_wandb_proto_wandb_internal_proto_msgTypes[67]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6362,7 +6446,7 @@ func (x *GetSummaryResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetSummaryResponse.ProtoReflect.Descriptor instead.
 func (*GetSummaryResponse) Descriptor() ([]byte, []int) {
-	return file_wandb_proto_wandb_internal_proto_rawDescGZIP(), []int{66}
+	return file_wandb_proto_wandb_internal_proto_rawDescGZIP(), []int{67}
 }
 
 func (x *GetSummaryResponse) GetItem() []*SummaryItem {
@@ -6382,7 +6466,7 @@ type GetSystemMetricsRequest struct {
 
 func (x *GetSystemMetricsRequest) Reset() {
 	*x = GetSystemMetricsRequest{}
-	mi := &file_wandb_proto_wandb_internal_proto_msgTypes[67]
+	mi := &file_wandb_proto_wandb_internal_proto_msgTypes[68]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6394,7 +6478,7 @@ func (x *GetSystemMetricsRequest) String() string {
 func (*GetSystemMetricsRequest) ProtoMessage() {}
 
 func (x *GetSystemMetricsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_wandb_proto_wandb_internal_proto_msgTypes[67]
+	mi := &file_wandb_proto_wandb_internal_proto_msgTypes[68]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6407,7 +6491,7 @@ func (x *GetSystemMetricsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetSystemMetricsRequest.ProtoReflect.Descriptor instead.
 func (*GetSystemMetricsRequest) Descriptor() ([]byte, []int) {
-	return file_wandb_proto_wandb_internal_proto_rawDescGZIP(), []int{67}
+	return file_wandb_proto_wandb_internal_proto_rawDescGZIP(), []int{68}
 }
 
 func (x *GetSystemMetricsRequest) GetXInfo() *XRequestInfo {
@@ -6427,7 +6511,7 @@ type SystemMetricSample struct {
 
 func (x *SystemMetricSample) Reset() {
 	*x = SystemMetricSample{}
-	mi := &file_wandb_proto_wandb_internal_proto_msgTypes[68]
+	mi := &file_wandb_proto_wandb_internal_proto_msgTypes[69]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6439,7 +6523,7 @@ func (x *SystemMetricSample) String() string {
 func (*SystemMetricSample) ProtoMessage() {}
 
 func (x *SystemMetricSample) ProtoReflect() protoreflect.Message {
-	mi := &file_wandb_proto_wandb_internal_proto_msgTypes[68]
+	mi := &file_wandb_proto_wandb_internal_proto_msgTypes[69]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6452,7 +6536,7 @@ func (x *SystemMetricSample) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SystemMetricSample.ProtoReflect.Descriptor instead.
 func (*SystemMetricSample) Descriptor() ([]byte, []int) {
-	return file_wandb_proto_wandb_internal_proto_rawDescGZIP(), []int{68}
+	return file_wandb_proto_wandb_internal_proto_rawDescGZIP(), []int{69}
 }
 
 func (x *SystemMetricSample) GetTimestamp() *timestamppb.Timestamp {
@@ -6478,7 +6562,7 @@ type SystemMetricsBuffer struct {
 
 func (x *SystemMetricsBuffer) Reset() {
 	*x = SystemMetricsBuffer{}
-	mi := &file_wandb_proto_wandb_internal_proto_msgTypes[69]
+	mi := &file_wandb_proto_wandb_internal_proto_msgTypes[70]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6490,7 +6574,7 @@ func (x *SystemMetricsBuffer) String() string {
 func (*SystemMetricsBuffer) ProtoMessage() {}
 
 func (x *SystemMetricsBuffer) ProtoReflect() protoreflect.Message {
-	mi := &file_wandb_proto_wandb_internal_proto_msgTypes[69]
+	mi := &file_wandb_proto_wandb_internal_proto_msgTypes[70]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6503,7 +6587,7 @@ func (x *SystemMetricsBuffer) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SystemMetricsBuffer.ProtoReflect.Descriptor instead.
 func (*SystemMetricsBuffer) Descriptor() ([]byte, []int) {
-	return file_wandb_proto_wandb_internal_proto_rawDescGZIP(), []int{69}
+	return file_wandb_proto_wandb_internal_proto_rawDescGZIP(), []int{70}
 }
 
 func (x *SystemMetricsBuffer) GetRecord() []*SystemMetricSample {
@@ -6522,7 +6606,7 @@ type GetSystemMetricsResponse struct {
 
 func (x *GetSystemMetricsResponse) Reset() {
 	*x = GetSystemMetricsResponse{}
-	mi := &file_wandb_proto_wandb_internal_proto_msgTypes[70]
+	mi := &file_wandb_proto_wandb_internal_proto_msgTypes[71]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6534,7 +6618,7 @@ func (x *GetSystemMetricsResponse) String() string {
 func (*GetSystemMetricsResponse) ProtoMessage() {}
 
 func (x *GetSystemMetricsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_wandb_proto_wandb_internal_proto_msgTypes[70]
+	mi := &file_wandb_proto_wandb_internal_proto_msgTypes[71]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6547,7 +6631,7 @@ func (x *GetSystemMetricsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetSystemMetricsResponse.ProtoReflect.Descriptor instead.
 func (*GetSystemMetricsResponse) Descriptor() ([]byte, []int) {
-	return file_wandb_proto_wandb_internal_proto_rawDescGZIP(), []int{70}
+	return file_wandb_proto_wandb_internal_proto_rawDescGZIP(), []int{71}
 }
 
 func (x *GetSystemMetricsResponse) GetSystemMetrics() map[string]*SystemMetricsBuffer {
@@ -6567,7 +6651,7 @@ type StatusRequest struct {
 
 func (x *StatusRequest) Reset() {
 	*x = StatusRequest{}
-	mi := &file_wandb_proto_wandb_internal_proto_msgTypes[71]
+	mi := &file_wandb_proto_wandb_internal_proto_msgTypes[72]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6579,7 +6663,7 @@ func (x *StatusRequest) String() string {
 func (*StatusRequest) ProtoMessage() {}
 
 func (x *StatusRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_wandb_proto_wandb_internal_proto_msgTypes[71]
+	mi := &file_wandb_proto_wandb_internal_proto_msgTypes[72]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6592,7 +6676,7 @@ func (x *StatusRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use StatusRequest.ProtoReflect.Descriptor instead.
 func (*StatusRequest) Descriptor() ([]byte, []int) {
-	return file_wandb_proto_wandb_internal_proto_rawDescGZIP(), []int{71}
+	return file_wandb_proto_wandb_internal_proto_rawDescGZIP(), []int{72}
 }
 
 func (x *StatusRequest) GetXInfo() *XRequestInfo {
@@ -6611,7 +6695,7 @@ type StatusResponse struct {
 
 func (x *StatusResponse) Reset() {
 	*x = StatusResponse{}
-	mi := &file_wandb_proto_wandb_internal_proto_msgTypes[72]
+	mi := &file_wandb_proto_wandb_internal_proto_msgTypes[73]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6623,7 +6707,7 @@ func (x *StatusResponse) String() string {
 func (*StatusResponse) ProtoMessage() {}
 
 func (x *StatusResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_wandb_proto_wandb_internal_proto_msgTypes[72]
+	mi := &file_wandb_proto_wandb_internal_proto_msgTypes[73]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6636,7 +6720,7 @@ func (x *StatusResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use StatusResponse.ProtoReflect.Descriptor instead.
 func (*StatusResponse) Descriptor() ([]byte, []int) {
-	return file_wandb_proto_wandb_internal_proto_rawDescGZIP(), []int{72}
+	return file_wandb_proto_wandb_internal_proto_rawDescGZIP(), []int{73}
 }
 
 func (x *StatusResponse) GetRunShouldStop() bool {
@@ -6655,7 +6739,7 @@ type StopStatusRequest struct {
 
 func (x *StopStatusRequest) Reset() {
 	*x = StopStatusRequest{}
-	mi := &file_wandb_proto_wandb_internal_proto_msgTypes[73]
+	mi := &file_wandb_proto_wandb_internal_proto_msgTypes[74]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6667,7 +6751,7 @@ func (x *StopStatusRequest) String() string {
 func (*StopStatusRequest) ProtoMessage() {}
 
 func (x *StopStatusRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_wandb_proto_wandb_internal_proto_msgTypes[73]
+	mi := &file_wandb_proto_wandb_internal_proto_msgTypes[74]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6680,7 +6764,7 @@ func (x *StopStatusRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use StopStatusRequest.ProtoReflect.Descriptor instead.
 func (*StopStatusRequest) Descriptor() ([]byte, []int) {
-	return file_wandb_proto_wandb_internal_proto_rawDescGZIP(), []int{73}
+	return file_wandb_proto_wandb_internal_proto_rawDescGZIP(), []int{74}
 }
 
 func (x *StopStatusRequest) GetXInfo() *XRequestInfo {
@@ -6699,7 +6783,7 @@ type StopStatusResponse struct {
 
 func (x *StopStatusResponse) Reset() {
 	*x = StopStatusResponse{}
-	mi := &file_wandb_proto_wandb_internal_proto_msgTypes[74]
+	mi := &file_wandb_proto_wandb_internal_proto_msgTypes[75]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6711,7 +6795,7 @@ func (x *StopStatusResponse) String() string {
 func (*StopStatusResponse) ProtoMessage() {}
 
 func (x *StopStatusResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_wandb_proto_wandb_internal_proto_msgTypes[74]
+	mi := &file_wandb_proto_wandb_internal_proto_msgTypes[75]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6724,7 +6808,7 @@ func (x *StopStatusResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use StopStatusResponse.ProtoReflect.Descriptor instead.
 func (*StopStatusResponse) Descriptor() ([]byte, []int) {
-	return file_wandb_proto_wandb_internal_proto_rawDescGZIP(), []int{74}
+	return file_wandb_proto_wandb_internal_proto_rawDescGZIP(), []int{75}
 }
 
 func (x *StopStatusResponse) GetRunShouldStop() bool {
@@ -6743,7 +6827,7 @@ type NetworkStatusRequest struct {
 
 func (x *NetworkStatusRequest) Reset() {
 	*x = NetworkStatusRequest{}
-	mi := &file_wandb_proto_wandb_internal_proto_msgTypes[75]
+	mi := &file_wandb_proto_wandb_internal_proto_msgTypes[76]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6755,7 +6839,7 @@ func (x *NetworkStatusRequest) String() string {
 func (*NetworkStatusRequest) ProtoMessage() {}
 
 func (x *NetworkStatusRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_wandb_proto_wandb_internal_proto_msgTypes[75]
+	mi := &file_wandb_proto_wandb_internal_proto_msgTypes[76]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6768,7 +6852,7 @@ func (x *NetworkStatusRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use NetworkStatusRequest.ProtoReflect.Descriptor instead.
 func (*NetworkStatusRequest) Descriptor() ([]byte, []int) {
-	return file_wandb_proto_wandb_internal_proto_rawDescGZIP(), []int{75}
+	return file_wandb_proto_wandb_internal_proto_rawDescGZIP(), []int{76}
 }
 
 func (x *NetworkStatusRequest) GetXInfo() *XRequestInfo {
@@ -6787,7 +6871,7 @@ type NetworkStatusResponse struct {
 
 func (x *NetworkStatusResponse) Reset() {
 	*x = NetworkStatusResponse{}
-	mi := &file_wandb_proto_wandb_internal_proto_msgTypes[76]
+	mi := &file_wandb_proto_wandb_internal_proto_msgTypes[77]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6799,7 +6883,7 @@ func (x *NetworkStatusResponse) String() string {
 func (*NetworkStatusResponse) ProtoMessage() {}
 
 func (x *NetworkStatusResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_wandb_proto_wandb_internal_proto_msgTypes[76]
+	mi := &file_wandb_proto_wandb_internal_proto_msgTypes[77]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6812,7 +6896,7 @@ func (x *NetworkStatusResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use NetworkStatusResponse.ProtoReflect.Descriptor instead.
 func (*NetworkStatusResponse) Descriptor() ([]byte, []int) {
-	return file_wandb_proto_wandb_internal_proto_rawDescGZIP(), []int{76}
+	return file_wandb_proto_wandb_internal_proto_rawDescGZIP(), []int{77}
 }
 
 func (x *NetworkStatusResponse) GetNetworkResponses() []*HttpResponse {
@@ -6832,7 +6916,7 @@ type HttpResponse struct {
 
 func (x *HttpResponse) Reset() {
 	*x = HttpResponse{}
-	mi := &file_wandb_proto_wandb_internal_proto_msgTypes[77]
+	mi := &file_wandb_proto_wandb_internal_proto_msgTypes[78]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6844,7 +6928,7 @@ func (x *HttpResponse) String() string {
 func (*HttpResponse) ProtoMessage() {}
 
 func (x *HttpResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_wandb_proto_wandb_internal_proto_msgTypes[77]
+	mi := &file_wandb_proto_wandb_internal_proto_msgTypes[78]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6857,7 +6941,7 @@ func (x *HttpResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use HttpResponse.ProtoReflect.Descriptor instead.
 func (*HttpResponse) Descriptor() ([]byte, []int) {
-	return file_wandb_proto_wandb_internal_proto_rawDescGZIP(), []int{77}
+	return file_wandb_proto_wandb_internal_proto_rawDescGZIP(), []int{78}
 }
 
 func (x *HttpResponse) GetHttpStatusCode() int32 {
@@ -6890,7 +6974,7 @@ type InternalMessagesRequest struct {
 
 func (x *InternalMessagesRequest) Reset() {
 	*x = InternalMessagesRequest{}
-	mi := &file_wandb_proto_wandb_internal_proto_msgTypes[78]
+	mi := &file_wandb_proto_wandb_internal_proto_msgTypes[79]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6902,7 +6986,7 @@ func (x *InternalMessagesRequest) String() string {
 func (*InternalMessagesRequest) ProtoMessage() {}
 
 func (x *InternalMessagesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_wandb_proto_wandb_internal_proto_msgTypes[78]
+	mi := &file_wandb_proto_wandb_internal_proto_msgTypes[79]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6915,7 +6999,7 @@ func (x *InternalMessagesRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use InternalMessagesRequest.ProtoReflect.Descriptor instead.
 func (*InternalMessagesRequest) Descriptor() ([]byte, []int) {
-	return file_wandb_proto_wandb_internal_proto_rawDescGZIP(), []int{78}
+	return file_wandb_proto_wandb_internal_proto_rawDescGZIP(), []int{79}
 }
 
 func (x *InternalMessagesRequest) GetWait() bool {
@@ -6941,7 +7025,7 @@ type InternalMessagesResponse struct {
 
 func (x *InternalMessagesResponse) Reset() {
 	*x = InternalMessagesResponse{}
-	mi := &file_wandb_proto_wandb_internal_proto_msgTypes[79]
+	mi := &file_wandb_proto_wandb_internal_proto_msgTypes[80]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6953,7 +7037,7 @@ func (x *InternalMessagesResponse) String() string {
 func (*InternalMessagesResponse) ProtoMessage() {}
 
 func (x *InternalMessagesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_wandb_proto_wandb_internal_proto_msgTypes[79]
+	mi := &file_wandb_proto_wandb_internal_proto_msgTypes[80]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6966,7 +7050,7 @@ func (x *InternalMessagesResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use InternalMessagesResponse.ProtoReflect.Descriptor instead.
 func (*InternalMessagesResponse) Descriptor() ([]byte, []int) {
-	return file_wandb_proto_wandb_internal_proto_rawDescGZIP(), []int{79}
+	return file_wandb_proto_wandb_internal_proto_rawDescGZIP(), []int{80}
 }
 
 func (x *InternalMessagesResponse) GetMessages() *InternalMessages {
@@ -6985,7 +7069,7 @@ type InternalMessages struct {
 
 func (x *InternalMessages) Reset() {
 	*x = InternalMessages{}
-	mi := &file_wandb_proto_wandb_internal_proto_msgTypes[80]
+	mi := &file_wandb_proto_wandb_internal_proto_msgTypes[81]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6997,7 +7081,7 @@ func (x *InternalMessages) String() string {
 func (*InternalMessages) ProtoMessage() {}
 
 func (x *InternalMessages) ProtoReflect() protoreflect.Message {
-	mi := &file_wandb_proto_wandb_internal_proto_msgTypes[80]
+	mi := &file_wandb_proto_wandb_internal_proto_msgTypes[81]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7010,7 +7094,7 @@ func (x *InternalMessages) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use InternalMessages.ProtoReflect.Descriptor instead.
 func (*InternalMessages) Descriptor() ([]byte, []int) {
-	return file_wandb_proto_wandb_internal_proto_rawDescGZIP(), []int{80}
+	return file_wandb_proto_wandb_internal_proto_rawDescGZIP(), []int{81}
 }
 
 func (x *InternalMessages) GetWarning() []string {
@@ -7030,7 +7114,7 @@ type PollExitRequest struct {
 
 func (x *PollExitRequest) Reset() {
 	*x = PollExitRequest{}
-	mi := &file_wandb_proto_wandb_internal_proto_msgTypes[81]
+	mi := &file_wandb_proto_wandb_internal_proto_msgTypes[82]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -7042,7 +7126,7 @@ func (x *PollExitRequest) String() string {
 func (*PollExitRequest) ProtoMessage() {}
 
 func (x *PollExitRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_wandb_proto_wandb_internal_proto_msgTypes[81]
+	mi := &file_wandb_proto_wandb_internal_proto_msgTypes[82]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7055,7 +7139,7 @@ func (x *PollExitRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PollExitRequest.ProtoReflect.Descriptor instead.
 func (*PollExitRequest) Descriptor() ([]byte, []int) {
-	return file_wandb_proto_wandb_internal_proto_rawDescGZIP(), []int{81}
+	return file_wandb_proto_wandb_internal_proto_rawDescGZIP(), []int{82}
 }
 
 func (x *PollExitRequest) GetXInfo() *XRequestInfo {
@@ -7078,7 +7162,7 @@ type PollExitResponse struct {
 
 func (x *PollExitResponse) Reset() {
 	*x = PollExitResponse{}
-	mi := &file_wandb_proto_wandb_internal_proto_msgTypes[82]
+	mi := &file_wandb_proto_wandb_internal_proto_msgTypes[83]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -7090,7 +7174,7 @@ func (x *PollExitResponse) String() string {
 func (*PollExitResponse) ProtoMessage() {}
 
 func (x *PollExitResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_wandb_proto_wandb_internal_proto_msgTypes[82]
+	mi := &file_wandb_proto_wandb_internal_proto_msgTypes[83]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7103,7 +7187,7 @@ func (x *PollExitResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PollExitResponse.ProtoReflect.Descriptor instead.
 func (*PollExitResponse) Descriptor() ([]byte, []int) {
-	return file_wandb_proto_wandb_internal_proto_rawDescGZIP(), []int{82}
+	return file_wandb_proto_wandb_internal_proto_rawDescGZIP(), []int{83}
 }
 
 func (x *PollExitResponse) GetDone() bool {
@@ -7150,7 +7234,7 @@ type OperationStatsRequest struct {
 
 func (x *OperationStatsRequest) Reset() {
 	*x = OperationStatsRequest{}
-	mi := &file_wandb_proto_wandb_internal_proto_msgTypes[83]
+	mi := &file_wandb_proto_wandb_internal_proto_msgTypes[84]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -7162,7 +7246,7 @@ func (x *OperationStatsRequest) String() string {
 func (*OperationStatsRequest) ProtoMessage() {}
 
 func (x *OperationStatsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_wandb_proto_wandb_internal_proto_msgTypes[83]
+	mi := &file_wandb_proto_wandb_internal_proto_msgTypes[84]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7175,7 +7259,7 @@ func (x *OperationStatsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use OperationStatsRequest.ProtoReflect.Descriptor instead.
 func (*OperationStatsRequest) Descriptor() ([]byte, []int) {
-	return file_wandb_proto_wandb_internal_proto_rawDescGZIP(), []int{83}
+	return file_wandb_proto_wandb_internal_proto_rawDescGZIP(), []int{84}
 }
 
 func (x *OperationStatsRequest) GetXInfo() *XRequestInfo {
@@ -7194,7 +7278,7 @@ type OperationStatsResponse struct {
 
 func (x *OperationStatsResponse) Reset() {
 	*x = OperationStatsResponse{}
-	mi := &file_wandb_proto_wandb_internal_proto_msgTypes[84]
+	mi := &file_wandb_proto_wandb_internal_proto_msgTypes[85]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -7206,7 +7290,7 @@ func (x *OperationStatsResponse) String() string {
 func (*OperationStatsResponse) ProtoMessage() {}
 
 func (x *OperationStatsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_wandb_proto_wandb_internal_proto_msgTypes[84]
+	mi := &file_wandb_proto_wandb_internal_proto_msgTypes[85]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7219,7 +7303,7 @@ func (x *OperationStatsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use OperationStatsResponse.ProtoReflect.Descriptor instead.
 func (*OperationStatsResponse) Descriptor() ([]byte, []int) {
-	return file_wandb_proto_wandb_internal_proto_rawDescGZIP(), []int{84}
+	return file_wandb_proto_wandb_internal_proto_rawDescGZIP(), []int{85}
 }
 
 func (x *OperationStatsResponse) GetOperationStats() *OperationStats {
@@ -7269,7 +7353,7 @@ type OperationStats struct {
 
 func (x *OperationStats) Reset() {
 	*x = OperationStats{}
-	mi := &file_wandb_proto_wandb_internal_proto_msgTypes[85]
+	mi := &file_wandb_proto_wandb_internal_proto_msgTypes[86]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -7281,7 +7365,7 @@ func (x *OperationStats) String() string {
 func (*OperationStats) ProtoMessage() {}
 
 func (x *OperationStats) ProtoReflect() protoreflect.Message {
-	mi := &file_wandb_proto_wandb_internal_proto_msgTypes[85]
+	mi := &file_wandb_proto_wandb_internal_proto_msgTypes[86]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7294,7 +7378,7 @@ func (x *OperationStats) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use OperationStats.ProtoReflect.Descriptor instead.
 func (*OperationStats) Descriptor() ([]byte, []int) {
-	return file_wandb_proto_wandb_internal_proto_rawDescGZIP(), []int{85}
+	return file_wandb_proto_wandb_internal_proto_rawDescGZIP(), []int{86}
 }
 
 func (x *OperationStats) GetLabel() string {
@@ -7353,7 +7437,7 @@ type Operation struct {
 
 func (x *Operation) Reset() {
 	*x = Operation{}
-	mi := &file_wandb_proto_wandb_internal_proto_msgTypes[86]
+	mi := &file_wandb_proto_wandb_internal_proto_msgTypes[87]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -7365,7 +7449,7 @@ func (x *Operation) String() string {
 func (*Operation) ProtoMessage() {}
 
 func (x *Operation) ProtoReflect() protoreflect.Message {
-	mi := &file_wandb_proto_wandb_internal_proto_msgTypes[86]
+	mi := &file_wandb_proto_wandb_internal_proto_msgTypes[87]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7378,7 +7462,7 @@ func (x *Operation) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Operation.ProtoReflect.Descriptor instead.
 func (*Operation) Descriptor() ([]byte, []int) {
-	return file_wandb_proto_wandb_internal_proto_rawDescGZIP(), []int{86}
+	return file_wandb_proto_wandb_internal_proto_rawDescGZIP(), []int{87}
 }
 
 func (x *Operation) GetDesc() string {
@@ -7424,7 +7508,7 @@ type SenderMarkRequest struct {
 
 func (x *SenderMarkRequest) Reset() {
 	*x = SenderMarkRequest{}
-	mi := &file_wandb_proto_wandb_internal_proto_msgTypes[87]
+	mi := &file_wandb_proto_wandb_internal_proto_msgTypes[88]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -7436,7 +7520,7 @@ func (x *SenderMarkRequest) String() string {
 func (*SenderMarkRequest) ProtoMessage() {}
 
 func (x *SenderMarkRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_wandb_proto_wandb_internal_proto_msgTypes[87]
+	mi := &file_wandb_proto_wandb_internal_proto_msgTypes[88]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7449,7 +7533,7 @@ func (x *SenderMarkRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SenderMarkRequest.ProtoReflect.Descriptor instead.
 func (*SenderMarkRequest) Descriptor() ([]byte, []int) {
-	return file_wandb_proto_wandb_internal_proto_rawDescGZIP(), []int{87}
+	return file_wandb_proto_wandb_internal_proto_rawDescGZIP(), []int{88}
 }
 
 type SyncFinishRequest struct {
@@ -7460,7 +7544,7 @@ type SyncFinishRequest struct {
 
 func (x *SyncFinishRequest) Reset() {
 	*x = SyncFinishRequest{}
-	mi := &file_wandb_proto_wandb_internal_proto_msgTypes[88]
+	mi := &file_wandb_proto_wandb_internal_proto_msgTypes[89]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -7472,7 +7556,7 @@ func (x *SyncFinishRequest) String() string {
 func (*SyncFinishRequest) ProtoMessage() {}
 
 func (x *SyncFinishRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_wandb_proto_wandb_internal_proto_msgTypes[88]
+	mi := &file_wandb_proto_wandb_internal_proto_msgTypes[89]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7485,7 +7569,7 @@ func (x *SyncFinishRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SyncFinishRequest.ProtoReflect.Descriptor instead.
 func (*SyncFinishRequest) Descriptor() ([]byte, []int) {
-	return file_wandb_proto_wandb_internal_proto_rawDescGZIP(), []int{88}
+	return file_wandb_proto_wandb_internal_proto_rawDescGZIP(), []int{89}
 }
 
 type SyncResponse struct {
@@ -7498,7 +7582,7 @@ type SyncResponse struct {
 
 func (x *SyncResponse) Reset() {
 	*x = SyncResponse{}
-	mi := &file_wandb_proto_wandb_internal_proto_msgTypes[89]
+	mi := &file_wandb_proto_wandb_internal_proto_msgTypes[90]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -7510,7 +7594,7 @@ func (x *SyncResponse) String() string {
 func (*SyncResponse) ProtoMessage() {}
 
 func (x *SyncResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_wandb_proto_wandb_internal_proto_msgTypes[89]
+	mi := &file_wandb_proto_wandb_internal_proto_msgTypes[90]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7523,7 +7607,7 @@ func (x *SyncResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SyncResponse.ProtoReflect.Descriptor instead.
 func (*SyncResponse) Descriptor() ([]byte, []int) {
-	return file_wandb_proto_wandb_internal_proto_rawDescGZIP(), []int{89}
+	return file_wandb_proto_wandb_internal_proto_rawDescGZIP(), []int{90}
 }
 
 func (x *SyncResponse) GetUrl() string {
@@ -7550,7 +7634,7 @@ type SenderReadRequest struct {
 
 func (x *SenderReadRequest) Reset() {
 	*x = SenderReadRequest{}
-	mi := &file_wandb_proto_wandb_internal_proto_msgTypes[90]
+	mi := &file_wandb_proto_wandb_internal_proto_msgTypes[91]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -7562,7 +7646,7 @@ func (x *SenderReadRequest) String() string {
 func (*SenderReadRequest) ProtoMessage() {}
 
 func (x *SenderReadRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_wandb_proto_wandb_internal_proto_msgTypes[90]
+	mi := &file_wandb_proto_wandb_internal_proto_msgTypes[91]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7575,7 +7659,7 @@ func (x *SenderReadRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SenderReadRequest.ProtoReflect.Descriptor instead.
 func (*SenderReadRequest) Descriptor() ([]byte, []int) {
-	return file_wandb_proto_wandb_internal_proto_rawDescGZIP(), []int{90}
+	return file_wandb_proto_wandb_internal_proto_rawDescGZIP(), []int{91}
 }
 
 func (x *SenderReadRequest) GetStartOffset() int64 {
@@ -7603,7 +7687,7 @@ type StatusReportRequest struct {
 
 func (x *StatusReportRequest) Reset() {
 	*x = StatusReportRequest{}
-	mi := &file_wandb_proto_wandb_internal_proto_msgTypes[91]
+	mi := &file_wandb_proto_wandb_internal_proto_msgTypes[92]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -7615,7 +7699,7 @@ func (x *StatusReportRequest) String() string {
 func (*StatusReportRequest) ProtoMessage() {}
 
 func (x *StatusReportRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_wandb_proto_wandb_internal_proto_msgTypes[91]
+	mi := &file_wandb_proto_wandb_internal_proto_msgTypes[92]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7628,7 +7712,7 @@ func (x *StatusReportRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use StatusReportRequest.ProtoReflect.Descriptor instead.
 func (*StatusReportRequest) Descriptor() ([]byte, []int) {
-	return file_wandb_proto_wandb_internal_proto_rawDescGZIP(), []int{91}
+	return file_wandb_proto_wandb_internal_proto_rawDescGZIP(), []int{92}
 }
 
 func (x *StatusReportRequest) GetRecordNum() int64 {
@@ -7662,7 +7746,7 @@ type SummaryRecordRequest struct {
 
 func (x *SummaryRecordRequest) Reset() {
 	*x = SummaryRecordRequest{}
-	mi := &file_wandb_proto_wandb_internal_proto_msgTypes[92]
+	mi := &file_wandb_proto_wandb_internal_proto_msgTypes[93]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -7674,7 +7758,7 @@ func (x *SummaryRecordRequest) String() string {
 func (*SummaryRecordRequest) ProtoMessage() {}
 
 func (x *SummaryRecordRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_wandb_proto_wandb_internal_proto_msgTypes[92]
+	mi := &file_wandb_proto_wandb_internal_proto_msgTypes[93]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7687,7 +7771,7 @@ func (x *SummaryRecordRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SummaryRecordRequest.ProtoReflect.Descriptor instead.
 func (*SummaryRecordRequest) Descriptor() ([]byte, []int) {
-	return file_wandb_proto_wandb_internal_proto_rawDescGZIP(), []int{92}
+	return file_wandb_proto_wandb_internal_proto_rawDescGZIP(), []int{93}
 }
 
 func (x *SummaryRecordRequest) GetSummary() *SummaryRecord {
@@ -7706,7 +7790,7 @@ type TelemetryRecordRequest struct {
 
 func (x *TelemetryRecordRequest) Reset() {
 	*x = TelemetryRecordRequest{}
-	mi := &file_wandb_proto_wandb_internal_proto_msgTypes[93]
+	mi := &file_wandb_proto_wandb_internal_proto_msgTypes[94]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -7718,7 +7802,7 @@ func (x *TelemetryRecordRequest) String() string {
 func (*TelemetryRecordRequest) ProtoMessage() {}
 
 func (x *TelemetryRecordRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_wandb_proto_wandb_internal_proto_msgTypes[93]
+	mi := &file_wandb_proto_wandb_internal_proto_msgTypes[94]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7731,7 +7815,7 @@ func (x *TelemetryRecordRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TelemetryRecordRequest.ProtoReflect.Descriptor instead.
 func (*TelemetryRecordRequest) Descriptor() ([]byte, []int) {
-	return file_wandb_proto_wandb_internal_proto_rawDescGZIP(), []int{93}
+	return file_wandb_proto_wandb_internal_proto_rawDescGZIP(), []int{94}
 }
 
 func (x *TelemetryRecordRequest) GetTelemetry() *TelemetryRecord {
@@ -7751,7 +7835,7 @@ type ServerInfoRequest struct {
 
 func (x *ServerInfoRequest) Reset() {
 	*x = ServerInfoRequest{}
-	mi := &file_wandb_proto_wandb_internal_proto_msgTypes[94]
+	mi := &file_wandb_proto_wandb_internal_proto_msgTypes[95]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -7763,7 +7847,7 @@ func (x *ServerInfoRequest) String() string {
 func (*ServerInfoRequest) ProtoMessage() {}
 
 func (x *ServerInfoRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_wandb_proto_wandb_internal_proto_msgTypes[94]
+	mi := &file_wandb_proto_wandb_internal_proto_msgTypes[95]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7776,7 +7860,7 @@ func (x *ServerInfoRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ServerInfoRequest.ProtoReflect.Descriptor instead.
 func (*ServerInfoRequest) Descriptor() ([]byte, []int) {
-	return file_wandb_proto_wandb_internal_proto_rawDescGZIP(), []int{94}
+	return file_wandb_proto_wandb_internal_proto_rawDescGZIP(), []int{95}
 }
 
 func (x *ServerInfoRequest) GetXInfo() *XRequestInfo {
@@ -7796,7 +7880,7 @@ type ServerInfoResponse struct {
 
 func (x *ServerInfoResponse) Reset() {
 	*x = ServerInfoResponse{}
-	mi := &file_wandb_proto_wandb_internal_proto_msgTypes[95]
+	mi := &file_wandb_proto_wandb_internal_proto_msgTypes[96]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -7808,7 +7892,7 @@ func (x *ServerInfoResponse) String() string {
 func (*ServerInfoResponse) ProtoMessage() {}
 
 func (x *ServerInfoResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_wandb_proto_wandb_internal_proto_msgTypes[95]
+	mi := &file_wandb_proto_wandb_internal_proto_msgTypes[96]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7821,7 +7905,7 @@ func (x *ServerInfoResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ServerInfoResponse.ProtoReflect.Descriptor instead.
 func (*ServerInfoResponse) Descriptor() ([]byte, []int) {
-	return file_wandb_proto_wandb_internal_proto_rawDescGZIP(), []int{95}
+	return file_wandb_proto_wandb_internal_proto_rawDescGZIP(), []int{96}
 }
 
 func (x *ServerInfoResponse) GetLocalInfo() *LocalInfo {
@@ -7847,7 +7931,7 @@ type ServerMessages struct {
 
 func (x *ServerMessages) Reset() {
 	*x = ServerMessages{}
-	mi := &file_wandb_proto_wandb_internal_proto_msgTypes[96]
+	mi := &file_wandb_proto_wandb_internal_proto_msgTypes[97]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -7859,7 +7943,7 @@ func (x *ServerMessages) String() string {
 func (*ServerMessages) ProtoMessage() {}
 
 func (x *ServerMessages) ProtoReflect() protoreflect.Message {
-	mi := &file_wandb_proto_wandb_internal_proto_msgTypes[96]
+	mi := &file_wandb_proto_wandb_internal_proto_msgTypes[97]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7872,7 +7956,7 @@ func (x *ServerMessages) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ServerMessages.ProtoReflect.Descriptor instead.
 func (*ServerMessages) Descriptor() ([]byte, []int) {
-	return file_wandb_proto_wandb_internal_proto_rawDescGZIP(), []int{96}
+	return file_wandb_proto_wandb_internal_proto_rawDescGZIP(), []int{97}
 }
 
 func (x *ServerMessages) GetItem() []*ServerMessage {
@@ -7895,7 +7979,7 @@ type ServerMessage struct {
 
 func (x *ServerMessage) Reset() {
 	*x = ServerMessage{}
-	mi := &file_wandb_proto_wandb_internal_proto_msgTypes[97]
+	mi := &file_wandb_proto_wandb_internal_proto_msgTypes[98]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -7907,7 +7991,7 @@ func (x *ServerMessage) String() string {
 func (*ServerMessage) ProtoMessage() {}
 
 func (x *ServerMessage) ProtoReflect() protoreflect.Message {
-	mi := &file_wandb_proto_wandb_internal_proto_msgTypes[97]
+	mi := &file_wandb_proto_wandb_internal_proto_msgTypes[98]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7920,7 +8004,7 @@ func (x *ServerMessage) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ServerMessage.ProtoReflect.Descriptor instead.
 func (*ServerMessage) Descriptor() ([]byte, []int) {
-	return file_wandb_proto_wandb_internal_proto_rawDescGZIP(), []int{97}
+	return file_wandb_proto_wandb_internal_proto_rawDescGZIP(), []int{98}
 }
 
 func (x *ServerMessage) GetPlainText() string {
@@ -7970,7 +8054,7 @@ type FileCounts struct {
 
 func (x *FileCounts) Reset() {
 	*x = FileCounts{}
-	mi := &file_wandb_proto_wandb_internal_proto_msgTypes[98]
+	mi := &file_wandb_proto_wandb_internal_proto_msgTypes[99]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -7982,7 +8066,7 @@ func (x *FileCounts) String() string {
 func (*FileCounts) ProtoMessage() {}
 
 func (x *FileCounts) ProtoReflect() protoreflect.Message {
-	mi := &file_wandb_proto_wandb_internal_proto_msgTypes[98]
+	mi := &file_wandb_proto_wandb_internal_proto_msgTypes[99]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7995,7 +8079,7 @@ func (x *FileCounts) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FileCounts.ProtoReflect.Descriptor instead.
 func (*FileCounts) Descriptor() ([]byte, []int) {
-	return file_wandb_proto_wandb_internal_proto_rawDescGZIP(), []int{98}
+	return file_wandb_proto_wandb_internal_proto_rawDescGZIP(), []int{99}
 }
 
 func (x *FileCounts) GetWandbCount() int32 {
@@ -8037,7 +8121,7 @@ type FilePusherStats struct {
 
 func (x *FilePusherStats) Reset() {
 	*x = FilePusherStats{}
-	mi := &file_wandb_proto_wandb_internal_proto_msgTypes[99]
+	mi := &file_wandb_proto_wandb_internal_proto_msgTypes[100]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -8049,7 +8133,7 @@ func (x *FilePusherStats) String() string {
 func (*FilePusherStats) ProtoMessage() {}
 
 func (x *FilePusherStats) ProtoReflect() protoreflect.Message {
-	mi := &file_wandb_proto_wandb_internal_proto_msgTypes[99]
+	mi := &file_wandb_proto_wandb_internal_proto_msgTypes[100]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8062,7 +8146,7 @@ func (x *FilePusherStats) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FilePusherStats.ProtoReflect.Descriptor instead.
 func (*FilePusherStats) Descriptor() ([]byte, []int) {
-	return file_wandb_proto_wandb_internal_proto_rawDescGZIP(), []int{99}
+	return file_wandb_proto_wandb_internal_proto_rawDescGZIP(), []int{100}
 }
 
 func (x *FilePusherStats) GetUploadedBytes() int64 {
@@ -8095,7 +8179,7 @@ type FilesUploaded struct {
 
 func (x *FilesUploaded) Reset() {
 	*x = FilesUploaded{}
-	mi := &file_wandb_proto_wandb_internal_proto_msgTypes[100]
+	mi := &file_wandb_proto_wandb_internal_proto_msgTypes[101]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -8107,7 +8191,7 @@ func (x *FilesUploaded) String() string {
 func (*FilesUploaded) ProtoMessage() {}
 
 func (x *FilesUploaded) ProtoReflect() protoreflect.Message {
-	mi := &file_wandb_proto_wandb_internal_proto_msgTypes[100]
+	mi := &file_wandb_proto_wandb_internal_proto_msgTypes[101]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8120,7 +8204,7 @@ func (x *FilesUploaded) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FilesUploaded.ProtoReflect.Descriptor instead.
 func (*FilesUploaded) Descriptor() ([]byte, []int) {
-	return file_wandb_proto_wandb_internal_proto_rawDescGZIP(), []int{100}
+	return file_wandb_proto_wandb_internal_proto_rawDescGZIP(), []int{101}
 }
 
 func (x *FilesUploaded) GetFiles() []string {
@@ -8144,7 +8228,7 @@ type FileTransferInfoRequest struct {
 
 func (x *FileTransferInfoRequest) Reset() {
 	*x = FileTransferInfoRequest{}
-	mi := &file_wandb_proto_wandb_internal_proto_msgTypes[101]
+	mi := &file_wandb_proto_wandb_internal_proto_msgTypes[102]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -8156,7 +8240,7 @@ func (x *FileTransferInfoRequest) String() string {
 func (*FileTransferInfoRequest) ProtoMessage() {}
 
 func (x *FileTransferInfoRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_wandb_proto_wandb_internal_proto_msgTypes[101]
+	mi := &file_wandb_proto_wandb_internal_proto_msgTypes[102]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8169,7 +8253,7 @@ func (x *FileTransferInfoRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FileTransferInfoRequest.ProtoReflect.Descriptor instead.
 func (*FileTransferInfoRequest) Descriptor() ([]byte, []int) {
-	return file_wandb_proto_wandb_internal_proto_rawDescGZIP(), []int{101}
+	return file_wandb_proto_wandb_internal_proto_rawDescGZIP(), []int{102}
 }
 
 func (x *FileTransferInfoRequest) GetType() FileTransferInfoRequest_TransferType {
@@ -8224,7 +8308,7 @@ type LocalInfo struct {
 
 func (x *LocalInfo) Reset() {
 	*x = LocalInfo{}
-	mi := &file_wandb_proto_wandb_internal_proto_msgTypes[102]
+	mi := &file_wandb_proto_wandb_internal_proto_msgTypes[103]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -8236,7 +8320,7 @@ func (x *LocalInfo) String() string {
 func (*LocalInfo) ProtoMessage() {}
 
 func (x *LocalInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_wandb_proto_wandb_internal_proto_msgTypes[102]
+	mi := &file_wandb_proto_wandb_internal_proto_msgTypes[103]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8249,7 +8333,7 @@ func (x *LocalInfo) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use LocalInfo.ProtoReflect.Descriptor instead.
 func (*LocalInfo) Descriptor() ([]byte, []int) {
-	return file_wandb_proto_wandb_internal_proto_rawDescGZIP(), []int{102}
+	return file_wandb_proto_wandb_internal_proto_rawDescGZIP(), []int{103}
 }
 
 func (x *LocalInfo) GetVersion() string {
@@ -8276,7 +8360,7 @@ type ShutdownRequest struct {
 
 func (x *ShutdownRequest) Reset() {
 	*x = ShutdownRequest{}
-	mi := &file_wandb_proto_wandb_internal_proto_msgTypes[103]
+	mi := &file_wandb_proto_wandb_internal_proto_msgTypes[104]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -8288,7 +8372,7 @@ func (x *ShutdownRequest) String() string {
 func (*ShutdownRequest) ProtoMessage() {}
 
 func (x *ShutdownRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_wandb_proto_wandb_internal_proto_msgTypes[103]
+	mi := &file_wandb_proto_wandb_internal_proto_msgTypes[104]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8301,7 +8385,7 @@ func (x *ShutdownRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ShutdownRequest.ProtoReflect.Descriptor instead.
 func (*ShutdownRequest) Descriptor() ([]byte, []int) {
-	return file_wandb_proto_wandb_internal_proto_rawDescGZIP(), []int{103}
+	return file_wandb_proto_wandb_internal_proto_rawDescGZIP(), []int{104}
 }
 
 func (x *ShutdownRequest) GetXInfo() *XRequestInfo {
@@ -8319,7 +8403,7 @@ type ShutdownResponse struct {
 
 func (x *ShutdownResponse) Reset() {
 	*x = ShutdownResponse{}
-	mi := &file_wandb_proto_wandb_internal_proto_msgTypes[104]
+	mi := &file_wandb_proto_wandb_internal_proto_msgTypes[105]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -8331,7 +8415,7 @@ func (x *ShutdownResponse) String() string {
 func (*ShutdownResponse) ProtoMessage() {}
 
 func (x *ShutdownResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_wandb_proto_wandb_internal_proto_msgTypes[104]
+	mi := &file_wandb_proto_wandb_internal_proto_msgTypes[105]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8344,7 +8428,7 @@ func (x *ShutdownResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ShutdownResponse.ProtoReflect.Descriptor instead.
 func (*ShutdownResponse) Descriptor() ([]byte, []int) {
-	return file_wandb_proto_wandb_internal_proto_rawDescGZIP(), []int{104}
+	return file_wandb_proto_wandb_internal_proto_rawDescGZIP(), []int{105}
 }
 
 // AttachRequest:
@@ -8358,7 +8442,7 @@ type AttachRequest struct {
 
 func (x *AttachRequest) Reset() {
 	*x = AttachRequest{}
-	mi := &file_wandb_proto_wandb_internal_proto_msgTypes[105]
+	mi := &file_wandb_proto_wandb_internal_proto_msgTypes[106]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -8370,7 +8454,7 @@ func (x *AttachRequest) String() string {
 func (*AttachRequest) ProtoMessage() {}
 
 func (x *AttachRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_wandb_proto_wandb_internal_proto_msgTypes[105]
+	mi := &file_wandb_proto_wandb_internal_proto_msgTypes[106]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8383,7 +8467,7 @@ func (x *AttachRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AttachRequest.ProtoReflect.Descriptor instead.
 func (*AttachRequest) Descriptor() ([]byte, []int) {
-	return file_wandb_proto_wandb_internal_proto_rawDescGZIP(), []int{105}
+	return file_wandb_proto_wandb_internal_proto_rawDescGZIP(), []int{106}
 }
 
 func (x *AttachRequest) GetAttachId() string {
@@ -8410,7 +8494,7 @@ type AttachResponse struct {
 
 func (x *AttachResponse) Reset() {
 	*x = AttachResponse{}
-	mi := &file_wandb_proto_wandb_internal_proto_msgTypes[106]
+	mi := &file_wandb_proto_wandb_internal_proto_msgTypes[107]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -8422,7 +8506,7 @@ func (x *AttachResponse) String() string {
 func (*AttachResponse) ProtoMessage() {}
 
 func (x *AttachResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_wandb_proto_wandb_internal_proto_msgTypes[106]
+	mi := &file_wandb_proto_wandb_internal_proto_msgTypes[107]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8435,7 +8519,7 @@ func (x *AttachResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AttachResponse.ProtoReflect.Descriptor instead.
 func (*AttachResponse) Descriptor() ([]byte, []int) {
-	return file_wandb_proto_wandb_internal_proto_rawDescGZIP(), []int{106}
+	return file_wandb_proto_wandb_internal_proto_rawDescGZIP(), []int{107}
 }
 
 func (x *AttachResponse) GetRun() *RunRecord {
@@ -8476,7 +8560,7 @@ type TestInjectRequest struct {
 
 func (x *TestInjectRequest) Reset() {
 	*x = TestInjectRequest{}
-	mi := &file_wandb_proto_wandb_internal_proto_msgTypes[107]
+	mi := &file_wandb_proto_wandb_internal_proto_msgTypes[108]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -8488,7 +8572,7 @@ func (x *TestInjectRequest) String() string {
 func (*TestInjectRequest) ProtoMessage() {}
 
 func (x *TestInjectRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_wandb_proto_wandb_internal_proto_msgTypes[107]
+	mi := &file_wandb_proto_wandb_internal_proto_msgTypes[108]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8501,7 +8585,7 @@ func (x *TestInjectRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TestInjectRequest.ProtoReflect.Descriptor instead.
 func (*TestInjectRequest) Descriptor() ([]byte, []int) {
-	return file_wandb_proto_wandb_internal_proto_rawDescGZIP(), []int{107}
+	return file_wandb_proto_wandb_internal_proto_rawDescGZIP(), []int{108}
 }
 
 func (x *TestInjectRequest) GetHandlerExc() bool {
@@ -8617,7 +8701,7 @@ type TestInjectResponse struct {
 
 func (x *TestInjectResponse) Reset() {
 	*x = TestInjectResponse{}
-	mi := &file_wandb_proto_wandb_internal_proto_msgTypes[108]
+	mi := &file_wandb_proto_wandb_internal_proto_msgTypes[109]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -8629,7 +8713,7 @@ func (x *TestInjectResponse) String() string {
 func (*TestInjectResponse) ProtoMessage() {}
 
 func (x *TestInjectResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_wandb_proto_wandb_internal_proto_msgTypes[108]
+	mi := &file_wandb_proto_wandb_internal_proto_msgTypes[109]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8642,7 +8726,7 @@ func (x *TestInjectResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TestInjectResponse.ProtoReflect.Descriptor instead.
 func (*TestInjectResponse) Descriptor() ([]byte, []int) {
-	return file_wandb_proto_wandb_internal_proto_rawDescGZIP(), []int{108}
+	return file_wandb_proto_wandb_internal_proto_rawDescGZIP(), []int{109}
 }
 
 // PartialHistoryRequest:
@@ -8655,7 +8739,7 @@ type HistoryAction struct {
 
 func (x *HistoryAction) Reset() {
 	*x = HistoryAction{}
-	mi := &file_wandb_proto_wandb_internal_proto_msgTypes[109]
+	mi := &file_wandb_proto_wandb_internal_proto_msgTypes[110]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -8667,7 +8751,7 @@ func (x *HistoryAction) String() string {
 func (*HistoryAction) ProtoMessage() {}
 
 func (x *HistoryAction) ProtoReflect() protoreflect.Message {
-	mi := &file_wandb_proto_wandb_internal_proto_msgTypes[109]
+	mi := &file_wandb_proto_wandb_internal_proto_msgTypes[110]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8680,7 +8764,7 @@ func (x *HistoryAction) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use HistoryAction.ProtoReflect.Descriptor instead.
 func (*HistoryAction) Descriptor() ([]byte, []int) {
-	return file_wandb_proto_wandb_internal_proto_rawDescGZIP(), []int{109}
+	return file_wandb_proto_wandb_internal_proto_rawDescGZIP(), []int{110}
 }
 
 func (x *HistoryAction) GetFlush() bool {
@@ -8702,7 +8786,7 @@ type PartialHistoryRequest struct {
 
 func (x *PartialHistoryRequest) Reset() {
 	*x = PartialHistoryRequest{}
-	mi := &file_wandb_proto_wandb_internal_proto_msgTypes[110]
+	mi := &file_wandb_proto_wandb_internal_proto_msgTypes[111]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -8714,7 +8798,7 @@ func (x *PartialHistoryRequest) String() string {
 func (*PartialHistoryRequest) ProtoMessage() {}
 
 func (x *PartialHistoryRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_wandb_proto_wandb_internal_proto_msgTypes[110]
+	mi := &file_wandb_proto_wandb_internal_proto_msgTypes[111]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8727,7 +8811,7 @@ func (x *PartialHistoryRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PartialHistoryRequest.ProtoReflect.Descriptor instead.
 func (*PartialHistoryRequest) Descriptor() ([]byte, []int) {
-	return file_wandb_proto_wandb_internal_proto_rawDescGZIP(), []int{110}
+	return file_wandb_proto_wandb_internal_proto_rawDescGZIP(), []int{111}
 }
 
 func (x *PartialHistoryRequest) GetItem() []*HistoryItem {
@@ -8766,7 +8850,7 @@ type PartialHistoryResponse struct {
 
 func (x *PartialHistoryResponse) Reset() {
 	*x = PartialHistoryResponse{}
-	mi := &file_wandb_proto_wandb_internal_proto_msgTypes[111]
+	mi := &file_wandb_proto_wandb_internal_proto_msgTypes[112]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -8778,7 +8862,7 @@ func (x *PartialHistoryResponse) String() string {
 func (*PartialHistoryResponse) ProtoMessage() {}
 
 func (x *PartialHistoryResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_wandb_proto_wandb_internal_proto_msgTypes[111]
+	mi := &file_wandb_proto_wandb_internal_proto_msgTypes[112]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8791,7 +8875,7 @@ func (x *PartialHistoryResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PartialHistoryResponse.ProtoReflect.Descriptor instead.
 func (*PartialHistoryResponse) Descriptor() ([]byte, []int) {
-	return file_wandb_proto_wandb_internal_proto_rawDescGZIP(), []int{111}
+	return file_wandb_proto_wandb_internal_proto_rawDescGZIP(), []int{112}
 }
 
 // Get the run's current W&B step number (the step of the next `log()` call).
@@ -8805,7 +8889,7 @@ type HistoryStepRequest struct {
 
 func (x *HistoryStepRequest) Reset() {
 	*x = HistoryStepRequest{}
-	mi := &file_wandb_proto_wandb_internal_proto_msgTypes[112]
+	mi := &file_wandb_proto_wandb_internal_proto_msgTypes[113]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -8817,7 +8901,7 @@ func (x *HistoryStepRequest) String() string {
 func (*HistoryStepRequest) ProtoMessage() {}
 
 func (x *HistoryStepRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_wandb_proto_wandb_internal_proto_msgTypes[112]
+	mi := &file_wandb_proto_wandb_internal_proto_msgTypes[113]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8830,7 +8914,7 @@ func (x *HistoryStepRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use HistoryStepRequest.ProtoReflect.Descriptor instead.
 func (*HistoryStepRequest) Descriptor() ([]byte, []int) {
-	return file_wandb_proto_wandb_internal_proto_rawDescGZIP(), []int{112}
+	return file_wandb_proto_wandb_internal_proto_rawDescGZIP(), []int{113}
 }
 
 type HistoryStepResponse struct {
@@ -8846,7 +8930,7 @@ type HistoryStepResponse struct {
 
 func (x *HistoryStepResponse) Reset() {
 	*x = HistoryStepResponse{}
-	mi := &file_wandb_proto_wandb_internal_proto_msgTypes[113]
+	mi := &file_wandb_proto_wandb_internal_proto_msgTypes[114]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -8858,7 +8942,7 @@ func (x *HistoryStepResponse) String() string {
 func (*HistoryStepResponse) ProtoMessage() {}
 
 func (x *HistoryStepResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_wandb_proto_wandb_internal_proto_msgTypes[113]
+	mi := &file_wandb_proto_wandb_internal_proto_msgTypes[114]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8871,7 +8955,7 @@ func (x *HistoryStepResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use HistoryStepResponse.ProtoReflect.Descriptor instead.
 func (*HistoryStepResponse) Descriptor() ([]byte, []int) {
-	return file_wandb_proto_wandb_internal_proto_rawDescGZIP(), []int{113}
+	return file_wandb_proto_wandb_internal_proto_rawDescGZIP(), []int{114}
 }
 
 func (x *HistoryStepResponse) GetStep() int64 {
@@ -8891,7 +8975,7 @@ type SampledHistoryRequest struct {
 
 func (x *SampledHistoryRequest) Reset() {
 	*x = SampledHistoryRequest{}
-	mi := &file_wandb_proto_wandb_internal_proto_msgTypes[114]
+	mi := &file_wandb_proto_wandb_internal_proto_msgTypes[115]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -8903,7 +8987,7 @@ func (x *SampledHistoryRequest) String() string {
 func (*SampledHistoryRequest) ProtoMessage() {}
 
 func (x *SampledHistoryRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_wandb_proto_wandb_internal_proto_msgTypes[114]
+	mi := &file_wandb_proto_wandb_internal_proto_msgTypes[115]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8916,7 +9000,7 @@ func (x *SampledHistoryRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SampledHistoryRequest.ProtoReflect.Descriptor instead.
 func (*SampledHistoryRequest) Descriptor() ([]byte, []int) {
-	return file_wandb_proto_wandb_internal_proto_rawDescGZIP(), []int{114}
+	return file_wandb_proto_wandb_internal_proto_rawDescGZIP(), []int{115}
 }
 
 func (x *SampledHistoryRequest) GetXInfo() *XRequestInfo {
@@ -8943,7 +9027,7 @@ type SampledHistoryItem struct {
 
 func (x *SampledHistoryItem) Reset() {
 	*x = SampledHistoryItem{}
-	mi := &file_wandb_proto_wandb_internal_proto_msgTypes[115]
+	mi := &file_wandb_proto_wandb_internal_proto_msgTypes[116]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -8955,7 +9039,7 @@ func (x *SampledHistoryItem) String() string {
 func (*SampledHistoryItem) ProtoMessage() {}
 
 func (x *SampledHistoryItem) ProtoReflect() protoreflect.Message {
-	mi := &file_wandb_proto_wandb_internal_proto_msgTypes[115]
+	mi := &file_wandb_proto_wandb_internal_proto_msgTypes[116]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8968,7 +9052,7 @@ func (x *SampledHistoryItem) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SampledHistoryItem.ProtoReflect.Descriptor instead.
 func (*SampledHistoryItem) Descriptor() ([]byte, []int) {
-	return file_wandb_proto_wandb_internal_proto_rawDescGZIP(), []int{115}
+	return file_wandb_proto_wandb_internal_proto_rawDescGZIP(), []int{116}
 }
 
 func (x *SampledHistoryItem) GetKey() string {
@@ -9008,7 +9092,7 @@ type SampledHistoryResponse struct {
 
 func (x *SampledHistoryResponse) Reset() {
 	*x = SampledHistoryResponse{}
-	mi := &file_wandb_proto_wandb_internal_proto_msgTypes[116]
+	mi := &file_wandb_proto_wandb_internal_proto_msgTypes[117]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -9020,7 +9104,7 @@ func (x *SampledHistoryResponse) String() string {
 func (*SampledHistoryResponse) ProtoMessage() {}
 
 func (x *SampledHistoryResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_wandb_proto_wandb_internal_proto_msgTypes[116]
+	mi := &file_wandb_proto_wandb_internal_proto_msgTypes[117]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -9033,7 +9117,7 @@ func (x *SampledHistoryResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SampledHistoryResponse.ProtoReflect.Descriptor instead.
 func (*SampledHistoryResponse) Descriptor() ([]byte, []int) {
-	return file_wandb_proto_wandb_internal_proto_rawDescGZIP(), []int{116}
+	return file_wandb_proto_wandb_internal_proto_rawDescGZIP(), []int{117}
 }
 
 func (x *SampledHistoryResponse) GetItem() []*SampledHistoryItem {
@@ -9053,7 +9137,7 @@ type RunStatusRequest struct {
 
 func (x *RunStatusRequest) Reset() {
 	*x = RunStatusRequest{}
-	mi := &file_wandb_proto_wandb_internal_proto_msgTypes[117]
+	mi := &file_wandb_proto_wandb_internal_proto_msgTypes[118]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -9065,7 +9149,7 @@ func (x *RunStatusRequest) String() string {
 func (*RunStatusRequest) ProtoMessage() {}
 
 func (x *RunStatusRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_wandb_proto_wandb_internal_proto_msgTypes[117]
+	mi := &file_wandb_proto_wandb_internal_proto_msgTypes[118]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -9078,7 +9162,7 @@ func (x *RunStatusRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RunStatusRequest.ProtoReflect.Descriptor instead.
 func (*RunStatusRequest) Descriptor() ([]byte, []int) {
-	return file_wandb_proto_wandb_internal_proto_rawDescGZIP(), []int{117}
+	return file_wandb_proto_wandb_internal_proto_rawDescGZIP(), []int{118}
 }
 
 func (x *RunStatusRequest) GetXInfo() *XRequestInfo {
@@ -9099,7 +9183,7 @@ type RunStatusResponse struct {
 
 func (x *RunStatusResponse) Reset() {
 	*x = RunStatusResponse{}
-	mi := &file_wandb_proto_wandb_internal_proto_msgTypes[118]
+	mi := &file_wandb_proto_wandb_internal_proto_msgTypes[119]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -9111,7 +9195,7 @@ func (x *RunStatusResponse) String() string {
 func (*RunStatusResponse) ProtoMessage() {}
 
 func (x *RunStatusResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_wandb_proto_wandb_internal_proto_msgTypes[118]
+	mi := &file_wandb_proto_wandb_internal_proto_msgTypes[119]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -9124,7 +9208,7 @@ func (x *RunStatusResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RunStatusResponse.ProtoReflect.Descriptor instead.
 func (*RunStatusResponse) Descriptor() ([]byte, []int) {
-	return file_wandb_proto_wandb_internal_proto_rawDescGZIP(), []int{118}
+	return file_wandb_proto_wandb_internal_proto_rawDescGZIP(), []int{119}
 }
 
 func (x *RunStatusResponse) GetSyncItemsTotal() int64 {
@@ -9159,7 +9243,7 @@ type RunStartRequest struct {
 
 func (x *RunStartRequest) Reset() {
 	*x = RunStartRequest{}
-	mi := &file_wandb_proto_wandb_internal_proto_msgTypes[119]
+	mi := &file_wandb_proto_wandb_internal_proto_msgTypes[120]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -9171,7 +9255,7 @@ func (x *RunStartRequest) String() string {
 func (*RunStartRequest) ProtoMessage() {}
 
 func (x *RunStartRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_wandb_proto_wandb_internal_proto_msgTypes[119]
+	mi := &file_wandb_proto_wandb_internal_proto_msgTypes[120]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -9184,7 +9268,7 @@ func (x *RunStartRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RunStartRequest.ProtoReflect.Descriptor instead.
 func (*RunStartRequest) Descriptor() ([]byte, []int) {
-	return file_wandb_proto_wandb_internal_proto_rawDescGZIP(), []int{119}
+	return file_wandb_proto_wandb_internal_proto_rawDescGZIP(), []int{120}
 }
 
 func (x *RunStartRequest) GetRun() *RunRecord {
@@ -9209,7 +9293,7 @@ type RunStartResponse struct {
 
 func (x *RunStartResponse) Reset() {
 	*x = RunStartResponse{}
-	mi := &file_wandb_proto_wandb_internal_proto_msgTypes[120]
+	mi := &file_wandb_proto_wandb_internal_proto_msgTypes[121]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -9221,7 +9305,7 @@ func (x *RunStartResponse) String() string {
 func (*RunStartResponse) ProtoMessage() {}
 
 func (x *RunStartResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_wandb_proto_wandb_internal_proto_msgTypes[120]
+	mi := &file_wandb_proto_wandb_internal_proto_msgTypes[121]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -9234,7 +9318,7 @@ func (x *RunStartResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RunStartResponse.ProtoReflect.Descriptor instead.
 func (*RunStartResponse) Descriptor() ([]byte, []int) {
-	return file_wandb_proto_wandb_internal_proto_rawDescGZIP(), []int{120}
+	return file_wandb_proto_wandb_internal_proto_rawDescGZIP(), []int{121}
 }
 
 // CheckVersion:
@@ -9248,7 +9332,7 @@ type CheckVersionRequest struct {
 
 func (x *CheckVersionRequest) Reset() {
 	*x = CheckVersionRequest{}
-	mi := &file_wandb_proto_wandb_internal_proto_msgTypes[121]
+	mi := &file_wandb_proto_wandb_internal_proto_msgTypes[122]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -9260,7 +9344,7 @@ func (x *CheckVersionRequest) String() string {
 func (*CheckVersionRequest) ProtoMessage() {}
 
 func (x *CheckVersionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_wandb_proto_wandb_internal_proto_msgTypes[121]
+	mi := &file_wandb_proto_wandb_internal_proto_msgTypes[122]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -9273,7 +9357,7 @@ func (x *CheckVersionRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CheckVersionRequest.ProtoReflect.Descriptor instead.
 func (*CheckVersionRequest) Descriptor() ([]byte, []int) {
-	return file_wandb_proto_wandb_internal_proto_rawDescGZIP(), []int{121}
+	return file_wandb_proto_wandb_internal_proto_rawDescGZIP(), []int{122}
 }
 
 func (x *CheckVersionRequest) GetCurrentVersion() string {
@@ -9301,7 +9385,7 @@ type CheckVersionResponse struct {
 
 func (x *CheckVersionResponse) Reset() {
 	*x = CheckVersionResponse{}
-	mi := &file_wandb_proto_wandb_internal_proto_msgTypes[122]
+	mi := &file_wandb_proto_wandb_internal_proto_msgTypes[123]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -9313,7 +9397,7 @@ func (x *CheckVersionResponse) String() string {
 func (*CheckVersionResponse) ProtoMessage() {}
 
 func (x *CheckVersionResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_wandb_proto_wandb_internal_proto_msgTypes[122]
+	mi := &file_wandb_proto_wandb_internal_proto_msgTypes[123]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -9326,7 +9410,7 @@ func (x *CheckVersionResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CheckVersionResponse.ProtoReflect.Descriptor instead.
 func (*CheckVersionResponse) Descriptor() ([]byte, []int) {
-	return file_wandb_proto_wandb_internal_proto_rawDescGZIP(), []int{122}
+	return file_wandb_proto_wandb_internal_proto_rawDescGZIP(), []int{123}
 }
 
 func (x *CheckVersionResponse) GetUpgradeMessage() string {
@@ -9360,7 +9444,7 @@ type JobInfoRequest struct {
 
 func (x *JobInfoRequest) Reset() {
 	*x = JobInfoRequest{}
-	mi := &file_wandb_proto_wandb_internal_proto_msgTypes[123]
+	mi := &file_wandb_proto_wandb_internal_proto_msgTypes[124]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -9372,7 +9456,7 @@ func (x *JobInfoRequest) String() string {
 func (*JobInfoRequest) ProtoMessage() {}
 
 func (x *JobInfoRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_wandb_proto_wandb_internal_proto_msgTypes[123]
+	mi := &file_wandb_proto_wandb_internal_proto_msgTypes[124]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -9385,7 +9469,7 @@ func (x *JobInfoRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use JobInfoRequest.ProtoReflect.Descriptor instead.
 func (*JobInfoRequest) Descriptor() ([]byte, []int) {
-	return file_wandb_proto_wandb_internal_proto_rawDescGZIP(), []int{123}
+	return file_wandb_proto_wandb_internal_proto_rawDescGZIP(), []int{124}
 }
 
 func (x *JobInfoRequest) GetXInfo() *XRequestInfo {
@@ -9405,7 +9489,7 @@ type JobInfoResponse struct {
 
 func (x *JobInfoResponse) Reset() {
 	*x = JobInfoResponse{}
-	mi := &file_wandb_proto_wandb_internal_proto_msgTypes[124]
+	mi := &file_wandb_proto_wandb_internal_proto_msgTypes[125]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -9417,7 +9501,7 @@ func (x *JobInfoResponse) String() string {
 func (*JobInfoResponse) ProtoMessage() {}
 
 func (x *JobInfoResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_wandb_proto_wandb_internal_proto_msgTypes[124]
+	mi := &file_wandb_proto_wandb_internal_proto_msgTypes[125]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -9430,7 +9514,7 @@ func (x *JobInfoResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use JobInfoResponse.ProtoReflect.Descriptor instead.
 func (*JobInfoResponse) Descriptor() ([]byte, []int) {
-	return file_wandb_proto_wandb_internal_proto_rawDescGZIP(), []int{124}
+	return file_wandb_proto_wandb_internal_proto_rawDescGZIP(), []int{125}
 }
 
 func (x *JobInfoResponse) GetSequenceId() string {
@@ -9460,7 +9544,7 @@ type LogArtifactRequest struct {
 
 func (x *LogArtifactRequest) Reset() {
 	*x = LogArtifactRequest{}
-	mi := &file_wandb_proto_wandb_internal_proto_msgTypes[125]
+	mi := &file_wandb_proto_wandb_internal_proto_msgTypes[126]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -9472,7 +9556,7 @@ func (x *LogArtifactRequest) String() string {
 func (*LogArtifactRequest) ProtoMessage() {}
 
 func (x *LogArtifactRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_wandb_proto_wandb_internal_proto_msgTypes[125]
+	mi := &file_wandb_proto_wandb_internal_proto_msgTypes[126]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -9485,7 +9569,7 @@ func (x *LogArtifactRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use LogArtifactRequest.ProtoReflect.Descriptor instead.
 func (*LogArtifactRequest) Descriptor() ([]byte, []int) {
-	return file_wandb_proto_wandb_internal_proto_rawDescGZIP(), []int{125}
+	return file_wandb_proto_wandb_internal_proto_rawDescGZIP(), []int{126}
 }
 
 func (x *LogArtifactRequest) GetArtifact() *ArtifactRecord {
@@ -9526,7 +9610,7 @@ type LogArtifactResponse struct {
 
 func (x *LogArtifactResponse) Reset() {
 	*x = LogArtifactResponse{}
-	mi := &file_wandb_proto_wandb_internal_proto_msgTypes[126]
+	mi := &file_wandb_proto_wandb_internal_proto_msgTypes[127]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -9538,7 +9622,7 @@ func (x *LogArtifactResponse) String() string {
 func (*LogArtifactResponse) ProtoMessage() {}
 
 func (x *LogArtifactResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_wandb_proto_wandb_internal_proto_msgTypes[126]
+	mi := &file_wandb_proto_wandb_internal_proto_msgTypes[127]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -9551,7 +9635,7 @@ func (x *LogArtifactResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use LogArtifactResponse.ProtoReflect.Descriptor instead.
 func (*LogArtifactResponse) Descriptor() ([]byte, []int) {
-	return file_wandb_proto_wandb_internal_proto_rawDescGZIP(), []int{126}
+	return file_wandb_proto_wandb_internal_proto_rawDescGZIP(), []int{127}
 }
 
 func (x *LogArtifactResponse) GetArtifactId() string {
@@ -9583,7 +9667,7 @@ type DownloadArtifactRequest struct {
 
 func (x *DownloadArtifactRequest) Reset() {
 	*x = DownloadArtifactRequest{}
-	mi := &file_wandb_proto_wandb_internal_proto_msgTypes[127]
+	mi := &file_wandb_proto_wandb_internal_proto_msgTypes[128]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -9595,7 +9679,7 @@ func (x *DownloadArtifactRequest) String() string {
 func (*DownloadArtifactRequest) ProtoMessage() {}
 
 func (x *DownloadArtifactRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_wandb_proto_wandb_internal_proto_msgTypes[127]
+	mi := &file_wandb_proto_wandb_internal_proto_msgTypes[128]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -9608,7 +9692,7 @@ func (x *DownloadArtifactRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DownloadArtifactRequest.ProtoReflect.Descriptor instead.
 func (*DownloadArtifactRequest) Descriptor() ([]byte, []int) {
-	return file_wandb_proto_wandb_internal_proto_rawDescGZIP(), []int{127}
+	return file_wandb_proto_wandb_internal_proto_rawDescGZIP(), []int{128}
 }
 
 func (x *DownloadArtifactRequest) GetArtifactId() string {
@@ -9662,7 +9746,7 @@ type DownloadArtifactResponse struct {
 
 func (x *DownloadArtifactResponse) Reset() {
 	*x = DownloadArtifactResponse{}
-	mi := &file_wandb_proto_wandb_internal_proto_msgTypes[128]
+	mi := &file_wandb_proto_wandb_internal_proto_msgTypes[129]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -9674,7 +9758,7 @@ func (x *DownloadArtifactResponse) String() string {
 func (*DownloadArtifactResponse) ProtoMessage() {}
 
 func (x *DownloadArtifactResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_wandb_proto_wandb_internal_proto_msgTypes[128]
+	mi := &file_wandb_proto_wandb_internal_proto_msgTypes[129]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -9687,7 +9771,7 @@ func (x *DownloadArtifactResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DownloadArtifactResponse.ProtoReflect.Descriptor instead.
 func (*DownloadArtifactResponse) Descriptor() ([]byte, []int) {
-	return file_wandb_proto_wandb_internal_proto_rawDescGZIP(), []int{128}
+	return file_wandb_proto_wandb_internal_proto_rawDescGZIP(), []int{129}
 }
 
 func (x *DownloadArtifactResponse) GetErrorMessage() string {
@@ -9707,7 +9791,7 @@ type KeepaliveRequest struct {
 
 func (x *KeepaliveRequest) Reset() {
 	*x = KeepaliveRequest{}
-	mi := &file_wandb_proto_wandb_internal_proto_msgTypes[129]
+	mi := &file_wandb_proto_wandb_internal_proto_msgTypes[130]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -9719,7 +9803,7 @@ func (x *KeepaliveRequest) String() string {
 func (*KeepaliveRequest) ProtoMessage() {}
 
 func (x *KeepaliveRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_wandb_proto_wandb_internal_proto_msgTypes[129]
+	mi := &file_wandb_proto_wandb_internal_proto_msgTypes[130]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -9732,7 +9816,7 @@ func (x *KeepaliveRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use KeepaliveRequest.ProtoReflect.Descriptor instead.
 func (*KeepaliveRequest) Descriptor() ([]byte, []int) {
-	return file_wandb_proto_wandb_internal_proto_rawDescGZIP(), []int{129}
+	return file_wandb_proto_wandb_internal_proto_rawDescGZIP(), []int{130}
 }
 
 func (x *KeepaliveRequest) GetXInfo() *XRequestInfo {
@@ -9750,7 +9834,7 @@ type KeepaliveResponse struct {
 
 func (x *KeepaliveResponse) Reset() {
 	*x = KeepaliveResponse{}
-	mi := &file_wandb_proto_wandb_internal_proto_msgTypes[130]
+	mi := &file_wandb_proto_wandb_internal_proto_msgTypes[131]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -9762,7 +9846,7 @@ func (x *KeepaliveResponse) String() string {
 func (*KeepaliveResponse) ProtoMessage() {}
 
 func (x *KeepaliveResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_wandb_proto_wandb_internal_proto_msgTypes[130]
+	mi := &file_wandb_proto_wandb_internal_proto_msgTypes[131]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -9775,7 +9859,7 @@ func (x *KeepaliveResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use KeepaliveResponse.ProtoReflect.Descriptor instead.
 func (*KeepaliveResponse) Descriptor() ([]byte, []int) {
-	return file_wandb_proto_wandb_internal_proto_rawDescGZIP(), []int{130}
+	return file_wandb_proto_wandb_internal_proto_rawDescGZIP(), []int{131}
 }
 
 // Job info specific for Partial -> Job upgrade
@@ -9792,7 +9876,7 @@ type ArtifactInfo struct {
 
 func (x *ArtifactInfo) Reset() {
 	*x = ArtifactInfo{}
-	mi := &file_wandb_proto_wandb_internal_proto_msgTypes[131]
+	mi := &file_wandb_proto_wandb_internal_proto_msgTypes[132]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -9804,7 +9888,7 @@ func (x *ArtifactInfo) String() string {
 func (*ArtifactInfo) ProtoMessage() {}
 
 func (x *ArtifactInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_wandb_proto_wandb_internal_proto_msgTypes[131]
+	mi := &file_wandb_proto_wandb_internal_proto_msgTypes[132]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -9817,7 +9901,7 @@ func (x *ArtifactInfo) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ArtifactInfo.ProtoReflect.Descriptor instead.
 func (*ArtifactInfo) Descriptor() ([]byte, []int) {
-	return file_wandb_proto_wandb_internal_proto_rawDescGZIP(), []int{131}
+	return file_wandb_proto_wandb_internal_proto_rawDescGZIP(), []int{132}
 }
 
 func (x *ArtifactInfo) GetArtifact() string {
@@ -9865,7 +9949,7 @@ type GitInfo struct {
 
 func (x *GitInfo) Reset() {
 	*x = GitInfo{}
-	mi := &file_wandb_proto_wandb_internal_proto_msgTypes[132]
+	mi := &file_wandb_proto_wandb_internal_proto_msgTypes[133]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -9877,7 +9961,7 @@ func (x *GitInfo) String() string {
 func (*GitInfo) ProtoMessage() {}
 
 func (x *GitInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_wandb_proto_wandb_internal_proto_msgTypes[132]
+	mi := &file_wandb_proto_wandb_internal_proto_msgTypes[133]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -9890,7 +9974,7 @@ func (x *GitInfo) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GitInfo.ProtoReflect.Descriptor instead.
 func (*GitInfo) Descriptor() ([]byte, []int) {
-	return file_wandb_proto_wandb_internal_proto_rawDescGZIP(), []int{132}
+	return file_wandb_proto_wandb_internal_proto_rawDescGZIP(), []int{133}
 }
 
 func (x *GitInfo) GetRemote() string {
@@ -9920,7 +10004,7 @@ type GitSource struct {
 
 func (x *GitSource) Reset() {
 	*x = GitSource{}
-	mi := &file_wandb_proto_wandb_internal_proto_msgTypes[133]
+	mi := &file_wandb_proto_wandb_internal_proto_msgTypes[134]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -9932,7 +10016,7 @@ func (x *GitSource) String() string {
 func (*GitSource) ProtoMessage() {}
 
 func (x *GitSource) ProtoReflect() protoreflect.Message {
-	mi := &file_wandb_proto_wandb_internal_proto_msgTypes[133]
+	mi := &file_wandb_proto_wandb_internal_proto_msgTypes[134]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -9945,7 +10029,7 @@ func (x *GitSource) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GitSource.ProtoReflect.Descriptor instead.
 func (*GitSource) Descriptor() ([]byte, []int) {
-	return file_wandb_proto_wandb_internal_proto_rawDescGZIP(), []int{133}
+	return file_wandb_proto_wandb_internal_proto_rawDescGZIP(), []int{134}
 }
 
 func (x *GitSource) GetGitInfo() *GitInfo {
@@ -9992,7 +10076,7 @@ type ImageSource struct {
 
 func (x *ImageSource) Reset() {
 	*x = ImageSource{}
-	mi := &file_wandb_proto_wandb_internal_proto_msgTypes[134]
+	mi := &file_wandb_proto_wandb_internal_proto_msgTypes[135]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -10004,7 +10088,7 @@ func (x *ImageSource) String() string {
 func (*ImageSource) ProtoMessage() {}
 
 func (x *ImageSource) ProtoReflect() protoreflect.Message {
-	mi := &file_wandb_proto_wandb_internal_proto_msgTypes[134]
+	mi := &file_wandb_proto_wandb_internal_proto_msgTypes[135]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -10017,7 +10101,7 @@ func (x *ImageSource) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ImageSource.ProtoReflect.Descriptor instead.
 func (*ImageSource) Descriptor() ([]byte, []int) {
-	return file_wandb_proto_wandb_internal_proto_rawDescGZIP(), []int{134}
+	return file_wandb_proto_wandb_internal_proto_rawDescGZIP(), []int{135}
 }
 
 func (x *ImageSource) GetImage() string {
@@ -10038,7 +10122,7 @@ type Source struct {
 
 func (x *Source) Reset() {
 	*x = Source{}
-	mi := &file_wandb_proto_wandb_internal_proto_msgTypes[135]
+	mi := &file_wandb_proto_wandb_internal_proto_msgTypes[136]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -10050,7 +10134,7 @@ func (x *Source) String() string {
 func (*Source) ProtoMessage() {}
 
 func (x *Source) ProtoReflect() protoreflect.Message {
-	mi := &file_wandb_proto_wandb_internal_proto_msgTypes[135]
+	mi := &file_wandb_proto_wandb_internal_proto_msgTypes[136]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -10063,7 +10147,7 @@ func (x *Source) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Source.ProtoReflect.Descriptor instead.
 func (*Source) Descriptor() ([]byte, []int) {
-	return file_wandb_proto_wandb_internal_proto_rawDescGZIP(), []int{135}
+	return file_wandb_proto_wandb_internal_proto_rawDescGZIP(), []int{136}
 }
 
 func (x *Source) GetGit() *GitSource {
@@ -10100,7 +10184,7 @@ type JobSource struct {
 
 func (x *JobSource) Reset() {
 	*x = JobSource{}
-	mi := &file_wandb_proto_wandb_internal_proto_msgTypes[136]
+	mi := &file_wandb_proto_wandb_internal_proto_msgTypes[137]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -10112,7 +10196,7 @@ func (x *JobSource) String() string {
 func (*JobSource) ProtoMessage() {}
 
 func (x *JobSource) ProtoReflect() protoreflect.Message {
-	mi := &file_wandb_proto_wandb_internal_proto_msgTypes[136]
+	mi := &file_wandb_proto_wandb_internal_proto_msgTypes[137]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -10125,7 +10209,7 @@ func (x *JobSource) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use JobSource.ProtoReflect.Descriptor instead.
 func (*JobSource) Descriptor() ([]byte, []int) {
-	return file_wandb_proto_wandb_internal_proto_rawDescGZIP(), []int{136}
+	return file_wandb_proto_wandb_internal_proto_rawDescGZIP(), []int{137}
 }
 
 func (x *JobSource) GetXVersion() string {
@@ -10166,7 +10250,7 @@ type PartialJobArtifact struct {
 
 func (x *PartialJobArtifact) Reset() {
 	*x = PartialJobArtifact{}
-	mi := &file_wandb_proto_wandb_internal_proto_msgTypes[137]
+	mi := &file_wandb_proto_wandb_internal_proto_msgTypes[138]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -10178,7 +10262,7 @@ func (x *PartialJobArtifact) String() string {
 func (*PartialJobArtifact) ProtoMessage() {}
 
 func (x *PartialJobArtifact) ProtoReflect() protoreflect.Message {
-	mi := &file_wandb_proto_wandb_internal_proto_msgTypes[137]
+	mi := &file_wandb_proto_wandb_internal_proto_msgTypes[138]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -10191,7 +10275,7 @@ func (x *PartialJobArtifact) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PartialJobArtifact.ProtoReflect.Descriptor instead.
 func (*PartialJobArtifact) Descriptor() ([]byte, []int) {
-	return file_wandb_proto_wandb_internal_proto_rawDescGZIP(), []int{137}
+	return file_wandb_proto_wandb_internal_proto_rawDescGZIP(), []int{138}
 }
 
 func (x *PartialJobArtifact) GetJobName() string {
@@ -10222,7 +10306,7 @@ type UseArtifactRecord struct {
 
 func (x *UseArtifactRecord) Reset() {
 	*x = UseArtifactRecord{}
-	mi := &file_wandb_proto_wandb_internal_proto_msgTypes[138]
+	mi := &file_wandb_proto_wandb_internal_proto_msgTypes[139]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -10234,7 +10318,7 @@ func (x *UseArtifactRecord) String() string {
 func (*UseArtifactRecord) ProtoMessage() {}
 
 func (x *UseArtifactRecord) ProtoReflect() protoreflect.Message {
-	mi := &file_wandb_proto_wandb_internal_proto_msgTypes[138]
+	mi := &file_wandb_proto_wandb_internal_proto_msgTypes[139]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -10247,7 +10331,7 @@ func (x *UseArtifactRecord) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UseArtifactRecord.ProtoReflect.Descriptor instead.
 func (*UseArtifactRecord) Descriptor() ([]byte, []int) {
-	return file_wandb_proto_wandb_internal_proto_rawDescGZIP(), []int{138}
+	return file_wandb_proto_wandb_internal_proto_rawDescGZIP(), []int{139}
 }
 
 func (x *UseArtifactRecord) GetId() string {
@@ -10293,7 +10377,7 @@ type UseArtifactResult struct {
 
 func (x *UseArtifactResult) Reset() {
 	*x = UseArtifactResult{}
-	mi := &file_wandb_proto_wandb_internal_proto_msgTypes[139]
+	mi := &file_wandb_proto_wandb_internal_proto_msgTypes[140]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -10305,7 +10389,7 @@ func (x *UseArtifactResult) String() string {
 func (*UseArtifactResult) ProtoMessage() {}
 
 func (x *UseArtifactResult) ProtoReflect() protoreflect.Message {
-	mi := &file_wandb_proto_wandb_internal_proto_msgTypes[139]
+	mi := &file_wandb_proto_wandb_internal_proto_msgTypes[140]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -10318,7 +10402,7 @@ func (x *UseArtifactResult) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UseArtifactResult.ProtoReflect.Descriptor instead.
 func (*UseArtifactResult) Descriptor() ([]byte, []int) {
-	return file_wandb_proto_wandb_internal_proto_rawDescGZIP(), []int{139}
+	return file_wandb_proto_wandb_internal_proto_rawDescGZIP(), []int{140}
 }
 
 // Cancel:
@@ -10332,7 +10416,7 @@ type CancelRequest struct {
 
 func (x *CancelRequest) Reset() {
 	*x = CancelRequest{}
-	mi := &file_wandb_proto_wandb_internal_proto_msgTypes[140]
+	mi := &file_wandb_proto_wandb_internal_proto_msgTypes[141]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -10344,7 +10428,7 @@ func (x *CancelRequest) String() string {
 func (*CancelRequest) ProtoMessage() {}
 
 func (x *CancelRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_wandb_proto_wandb_internal_proto_msgTypes[140]
+	mi := &file_wandb_proto_wandb_internal_proto_msgTypes[141]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -10357,7 +10441,7 @@ func (x *CancelRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CancelRequest.ProtoReflect.Descriptor instead.
 func (*CancelRequest) Descriptor() ([]byte, []int) {
-	return file_wandb_proto_wandb_internal_proto_rawDescGZIP(), []int{140}
+	return file_wandb_proto_wandb_internal_proto_rawDescGZIP(), []int{141}
 }
 
 func (x *CancelRequest) GetCancelSlot() string {
@@ -10382,7 +10466,7 @@ type CancelResponse struct {
 
 func (x *CancelResponse) Reset() {
 	*x = CancelResponse{}
-	mi := &file_wandb_proto_wandb_internal_proto_msgTypes[141]
+	mi := &file_wandb_proto_wandb_internal_proto_msgTypes[142]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -10394,7 +10478,7 @@ func (x *CancelResponse) String() string {
 func (*CancelResponse) ProtoMessage() {}
 
 func (x *CancelResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_wandb_proto_wandb_internal_proto_msgTypes[141]
+	mi := &file_wandb_proto_wandb_internal_proto_msgTypes[142]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -10407,7 +10491,7 @@ func (x *CancelResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CancelResponse.ProtoReflect.Descriptor instead.
 func (*CancelResponse) Descriptor() ([]byte, []int) {
-	return file_wandb_proto_wandb_internal_proto_rawDescGZIP(), []int{141}
+	return file_wandb_proto_wandb_internal_proto_rawDescGZIP(), []int{142}
 }
 
 // Run environment including system, hardware, software, and execution parameters.
@@ -10419,7 +10503,7 @@ type ProbeSystemInfoRequest struct {
 
 func (x *ProbeSystemInfoRequest) Reset() {
 	*x = ProbeSystemInfoRequest{}
-	mi := &file_wandb_proto_wandb_internal_proto_msgTypes[142]
+	mi := &file_wandb_proto_wandb_internal_proto_msgTypes[143]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -10431,7 +10515,7 @@ func (x *ProbeSystemInfoRequest) String() string {
 func (*ProbeSystemInfoRequest) ProtoMessage() {}
 
 func (x *ProbeSystemInfoRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_wandb_proto_wandb_internal_proto_msgTypes[142]
+	mi := &file_wandb_proto_wandb_internal_proto_msgTypes[143]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -10444,7 +10528,7 @@ func (x *ProbeSystemInfoRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ProbeSystemInfoRequest.ProtoReflect.Descriptor instead.
 func (*ProbeSystemInfoRequest) Descriptor() ([]byte, []int) {
-	return file_wandb_proto_wandb_internal_proto_rawDescGZIP(), []int{142}
+	return file_wandb_proto_wandb_internal_proto_rawDescGZIP(), []int{143}
 }
 
 type DiskInfo struct {
@@ -10457,7 +10541,7 @@ type DiskInfo struct {
 
 func (x *DiskInfo) Reset() {
 	*x = DiskInfo{}
-	mi := &file_wandb_proto_wandb_internal_proto_msgTypes[143]
+	mi := &file_wandb_proto_wandb_internal_proto_msgTypes[144]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -10469,7 +10553,7 @@ func (x *DiskInfo) String() string {
 func (*DiskInfo) ProtoMessage() {}
 
 func (x *DiskInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_wandb_proto_wandb_internal_proto_msgTypes[143]
+	mi := &file_wandb_proto_wandb_internal_proto_msgTypes[144]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -10482,7 +10566,7 @@ func (x *DiskInfo) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DiskInfo.ProtoReflect.Descriptor instead.
 func (*DiskInfo) Descriptor() ([]byte, []int) {
-	return file_wandb_proto_wandb_internal_proto_rawDescGZIP(), []int{143}
+	return file_wandb_proto_wandb_internal_proto_rawDescGZIP(), []int{144}
 }
 
 func (x *DiskInfo) GetTotal() uint64 {
@@ -10508,7 +10592,7 @@ type MemoryInfo struct {
 
 func (x *MemoryInfo) Reset() {
 	*x = MemoryInfo{}
-	mi := &file_wandb_proto_wandb_internal_proto_msgTypes[144]
+	mi := &file_wandb_proto_wandb_internal_proto_msgTypes[145]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -10520,7 +10604,7 @@ func (x *MemoryInfo) String() string {
 func (*MemoryInfo) ProtoMessage() {}
 
 func (x *MemoryInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_wandb_proto_wandb_internal_proto_msgTypes[144]
+	mi := &file_wandb_proto_wandb_internal_proto_msgTypes[145]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -10533,7 +10617,7 @@ func (x *MemoryInfo) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MemoryInfo.ProtoReflect.Descriptor instead.
 func (*MemoryInfo) Descriptor() ([]byte, []int) {
-	return file_wandb_proto_wandb_internal_proto_rawDescGZIP(), []int{144}
+	return file_wandb_proto_wandb_internal_proto_rawDescGZIP(), []int{145}
 }
 
 func (x *MemoryInfo) GetTotal() uint64 {
@@ -10553,7 +10637,7 @@ type CpuInfo struct {
 
 func (x *CpuInfo) Reset() {
 	*x = CpuInfo{}
-	mi := &file_wandb_proto_wandb_internal_proto_msgTypes[145]
+	mi := &file_wandb_proto_wandb_internal_proto_msgTypes[146]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -10565,7 +10649,7 @@ func (x *CpuInfo) String() string {
 func (*CpuInfo) ProtoMessage() {}
 
 func (x *CpuInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_wandb_proto_wandb_internal_proto_msgTypes[145]
+	mi := &file_wandb_proto_wandb_internal_proto_msgTypes[146]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -10578,7 +10662,7 @@ func (x *CpuInfo) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CpuInfo.ProtoReflect.Descriptor instead.
 func (*CpuInfo) Descriptor() ([]byte, []int) {
-	return file_wandb_proto_wandb_internal_proto_rawDescGZIP(), []int{145}
+	return file_wandb_proto_wandb_internal_proto_rawDescGZIP(), []int{146}
 }
 
 func (x *CpuInfo) GetCount() uint32 {
@@ -10611,7 +10695,7 @@ type AppleInfo struct {
 
 func (x *AppleInfo) Reset() {
 	*x = AppleInfo{}
-	mi := &file_wandb_proto_wandb_internal_proto_msgTypes[146]
+	mi := &file_wandb_proto_wandb_internal_proto_msgTypes[147]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -10623,7 +10707,7 @@ func (x *AppleInfo) String() string {
 func (*AppleInfo) ProtoMessage() {}
 
 func (x *AppleInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_wandb_proto_wandb_internal_proto_msgTypes[146]
+	mi := &file_wandb_proto_wandb_internal_proto_msgTypes[147]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -10636,7 +10720,7 @@ func (x *AppleInfo) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AppleInfo.ProtoReflect.Descriptor instead.
 func (*AppleInfo) Descriptor() ([]byte, []int) {
-	return file_wandb_proto_wandb_internal_proto_rawDescGZIP(), []int{146}
+	return file_wandb_proto_wandb_internal_proto_rawDescGZIP(), []int{147}
 }
 
 func (x *AppleInfo) GetName() string {
@@ -10708,7 +10792,7 @@ type GpuNvidiaInfo struct {
 
 func (x *GpuNvidiaInfo) Reset() {
 	*x = GpuNvidiaInfo{}
-	mi := &file_wandb_proto_wandb_internal_proto_msgTypes[147]
+	mi := &file_wandb_proto_wandb_internal_proto_msgTypes[148]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -10720,7 +10804,7 @@ func (x *GpuNvidiaInfo) String() string {
 func (*GpuNvidiaInfo) ProtoMessage() {}
 
 func (x *GpuNvidiaInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_wandb_proto_wandb_internal_proto_msgTypes[147]
+	mi := &file_wandb_proto_wandb_internal_proto_msgTypes[148]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -10733,7 +10817,7 @@ func (x *GpuNvidiaInfo) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GpuNvidiaInfo.ProtoReflect.Descriptor instead.
 func (*GpuNvidiaInfo) Descriptor() ([]byte, []int) {
-	return file_wandb_proto_wandb_internal_proto_rawDescGZIP(), []int{147}
+	return file_wandb_proto_wandb_internal_proto_rawDescGZIP(), []int{148}
 }
 
 func (x *GpuNvidiaInfo) GetName() string {
@@ -10792,7 +10876,7 @@ type GpuAmdInfo struct {
 
 func (x *GpuAmdInfo) Reset() {
 	*x = GpuAmdInfo{}
-	mi := &file_wandb_proto_wandb_internal_proto_msgTypes[148]
+	mi := &file_wandb_proto_wandb_internal_proto_msgTypes[149]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -10804,7 +10888,7 @@ func (x *GpuAmdInfo) String() string {
 func (*GpuAmdInfo) ProtoMessage() {}
 
 func (x *GpuAmdInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_wandb_proto_wandb_internal_proto_msgTypes[148]
+	mi := &file_wandb_proto_wandb_internal_proto_msgTypes[149]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -10817,7 +10901,7 @@ func (x *GpuAmdInfo) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GpuAmdInfo.ProtoReflect.Descriptor instead.
 func (*GpuAmdInfo) Descriptor() ([]byte, []int) {
-	return file_wandb_proto_wandb_internal_proto_rawDescGZIP(), []int{148}
+	return file_wandb_proto_wandb_internal_proto_rawDescGZIP(), []int{149}
 }
 
 func (x *GpuAmdInfo) GetId() string {
@@ -10923,7 +11007,7 @@ type TrainiumInfo struct {
 
 func (x *TrainiumInfo) Reset() {
 	*x = TrainiumInfo{}
-	mi := &file_wandb_proto_wandb_internal_proto_msgTypes[149]
+	mi := &file_wandb_proto_wandb_internal_proto_msgTypes[150]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -10935,7 +11019,7 @@ func (x *TrainiumInfo) String() string {
 func (*TrainiumInfo) ProtoMessage() {}
 
 func (x *TrainiumInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_wandb_proto_wandb_internal_proto_msgTypes[149]
+	mi := &file_wandb_proto_wandb_internal_proto_msgTypes[150]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -10948,7 +11032,7 @@ func (x *TrainiumInfo) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TrainiumInfo.ProtoReflect.Descriptor instead.
 func (*TrainiumInfo) Descriptor() ([]byte, []int) {
-	return file_wandb_proto_wandb_internal_proto_rawDescGZIP(), []int{149}
+	return file_wandb_proto_wandb_internal_proto_rawDescGZIP(), []int{150}
 }
 
 func (x *TrainiumInfo) GetName() string {
@@ -10991,7 +11075,7 @@ type TPUInfo struct {
 
 func (x *TPUInfo) Reset() {
 	*x = TPUInfo{}
-	mi := &file_wandb_proto_wandb_internal_proto_msgTypes[150]
+	mi := &file_wandb_proto_wandb_internal_proto_msgTypes[151]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -11003,7 +11087,7 @@ func (x *TPUInfo) String() string {
 func (*TPUInfo) ProtoMessage() {}
 
 func (x *TPUInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_wandb_proto_wandb_internal_proto_msgTypes[150]
+	mi := &file_wandb_proto_wandb_internal_proto_msgTypes[151]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -11016,7 +11100,7 @@ func (x *TPUInfo) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TPUInfo.ProtoReflect.Descriptor instead.
 func (*TPUInfo) Descriptor() ([]byte, []int) {
-	return file_wandb_proto_wandb_internal_proto_rawDescGZIP(), []int{150}
+	return file_wandb_proto_wandb_internal_proto_rawDescGZIP(), []int{151}
 }
 
 func (x *TPUInfo) GetName() string {
@@ -11059,7 +11143,7 @@ type CoreWeaveInfo struct {
 
 func (x *CoreWeaveInfo) Reset() {
 	*x = CoreWeaveInfo{}
-	mi := &file_wandb_proto_wandb_internal_proto_msgTypes[151]
+	mi := &file_wandb_proto_wandb_internal_proto_msgTypes[152]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -11071,7 +11155,7 @@ func (x *CoreWeaveInfo) String() string {
 func (*CoreWeaveInfo) ProtoMessage() {}
 
 func (x *CoreWeaveInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_wandb_proto_wandb_internal_proto_msgTypes[151]
+	mi := &file_wandb_proto_wandb_internal_proto_msgTypes[152]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -11084,7 +11168,7 @@ func (x *CoreWeaveInfo) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CoreWeaveInfo.ProtoReflect.Descriptor instead.
 func (*CoreWeaveInfo) Descriptor() ([]byte, []int) {
-	return file_wandb_proto_wandb_internal_proto_rawDescGZIP(), []int{151}
+	return file_wandb_proto_wandb_internal_proto_rawDescGZIP(), []int{152}
 }
 
 func (x *CoreWeaveInfo) GetClusterName() string {
@@ -11195,7 +11279,7 @@ type EnvironmentRecord struct {
 
 func (x *EnvironmentRecord) Reset() {
 	*x = EnvironmentRecord{}
-	mi := &file_wandb_proto_wandb_internal_proto_msgTypes[152]
+	mi := &file_wandb_proto_wandb_internal_proto_msgTypes[153]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -11207,7 +11291,7 @@ func (x *EnvironmentRecord) String() string {
 func (*EnvironmentRecord) ProtoMessage() {}
 
 func (x *EnvironmentRecord) ProtoReflect() protoreflect.Message {
-	mi := &file_wandb_proto_wandb_internal_proto_msgTypes[152]
+	mi := &file_wandb_proto_wandb_internal_proto_msgTypes[153]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -11220,7 +11304,7 @@ func (x *EnvironmentRecord) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use EnvironmentRecord.ProtoReflect.Descriptor instead.
 func (*EnvironmentRecord) Descriptor() ([]byte, []int) {
-	return file_wandb_proto_wandb_internal_proto_rawDescGZIP(), []int{152}
+	return file_wandb_proto_wandb_internal_proto_rawDescGZIP(), []int{153}
 }
 
 func (x *EnvironmentRecord) GetOs() string {
@@ -11456,7 +11540,7 @@ type PythonPackagesRequest struct {
 
 func (x *PythonPackagesRequest) Reset() {
 	*x = PythonPackagesRequest{}
-	mi := &file_wandb_proto_wandb_internal_proto_msgTypes[153]
+	mi := &file_wandb_proto_wandb_internal_proto_msgTypes[154]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -11468,7 +11552,7 @@ func (x *PythonPackagesRequest) String() string {
 func (*PythonPackagesRequest) ProtoMessage() {}
 
 func (x *PythonPackagesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_wandb_proto_wandb_internal_proto_msgTypes[153]
+	mi := &file_wandb_proto_wandb_internal_proto_msgTypes[154]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -11481,7 +11565,7 @@ func (x *PythonPackagesRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PythonPackagesRequest.ProtoReflect.Descriptor instead.
 func (*PythonPackagesRequest) Descriptor() ([]byte, []int) {
-	return file_wandb_proto_wandb_internal_proto_rawDescGZIP(), []int{153}
+	return file_wandb_proto_wandb_internal_proto_rawDescGZIP(), []int{154}
 }
 
 func (x *PythonPackagesRequest) GetPackage() []*PythonPackagesRequest_PythonPackage {
@@ -11506,7 +11590,7 @@ type JobInputPath struct {
 
 func (x *JobInputPath) Reset() {
 	*x = JobInputPath{}
-	mi := &file_wandb_proto_wandb_internal_proto_msgTypes[154]
+	mi := &file_wandb_proto_wandb_internal_proto_msgTypes[155]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -11518,7 +11602,7 @@ func (x *JobInputPath) String() string {
 func (*JobInputPath) ProtoMessage() {}
 
 func (x *JobInputPath) ProtoReflect() protoreflect.Message {
-	mi := &file_wandb_proto_wandb_internal_proto_msgTypes[154]
+	mi := &file_wandb_proto_wandb_internal_proto_msgTypes[155]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -11531,7 +11615,7 @@ func (x *JobInputPath) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use JobInputPath.ProtoReflect.Descriptor instead.
 func (*JobInputPath) Descriptor() ([]byte, []int) {
-	return file_wandb_proto_wandb_internal_proto_rawDescGZIP(), []int{154}
+	return file_wandb_proto_wandb_internal_proto_rawDescGZIP(), []int{155}
 }
 
 func (x *JobInputPath) GetPath() []string {
@@ -11559,7 +11643,7 @@ type JobInputSource struct {
 
 func (x *JobInputSource) Reset() {
 	*x = JobInputSource{}
-	mi := &file_wandb_proto_wandb_internal_proto_msgTypes[155]
+	mi := &file_wandb_proto_wandb_internal_proto_msgTypes[156]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -11571,7 +11655,7 @@ func (x *JobInputSource) String() string {
 func (*JobInputSource) ProtoMessage() {}
 
 func (x *JobInputSource) ProtoReflect() protoreflect.Message {
-	mi := &file_wandb_proto_wandb_internal_proto_msgTypes[155]
+	mi := &file_wandb_proto_wandb_internal_proto_msgTypes[156]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -11584,7 +11668,7 @@ func (x *JobInputSource) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use JobInputSource.ProtoReflect.Descriptor instead.
 func (*JobInputSource) Descriptor() ([]byte, []int) {
-	return file_wandb_proto_wandb_internal_proto_rawDescGZIP(), []int{155}
+	return file_wandb_proto_wandb_internal_proto_rawDescGZIP(), []int{156}
 }
 
 func (x *JobInputSource) GetSource() isJobInputSource_Source {
@@ -11650,7 +11734,7 @@ type JobInputRequest struct {
 
 func (x *JobInputRequest) Reset() {
 	*x = JobInputRequest{}
-	mi := &file_wandb_proto_wandb_internal_proto_msgTypes[156]
+	mi := &file_wandb_proto_wandb_internal_proto_msgTypes[157]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -11662,7 +11746,7 @@ func (x *JobInputRequest) String() string {
 func (*JobInputRequest) ProtoMessage() {}
 
 func (x *JobInputRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_wandb_proto_wandb_internal_proto_msgTypes[156]
+	mi := &file_wandb_proto_wandb_internal_proto_msgTypes[157]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -11675,7 +11759,7 @@ func (x *JobInputRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use JobInputRequest.ProtoReflect.Descriptor instead.
 func (*JobInputRequest) Descriptor() ([]byte, []int) {
-	return file_wandb_proto_wandb_internal_proto_rawDescGZIP(), []int{156}
+	return file_wandb_proto_wandb_internal_proto_rawDescGZIP(), []int{157}
 }
 
 func (x *JobInputRequest) GetInputSource() *JobInputSource {
@@ -11716,7 +11800,7 @@ type PythonPackagesRequest_PythonPackage struct {
 
 func (x *PythonPackagesRequest_PythonPackage) Reset() {
 	*x = PythonPackagesRequest_PythonPackage{}
-	mi := &file_wandb_proto_wandb_internal_proto_msgTypes[160]
+	mi := &file_wandb_proto_wandb_internal_proto_msgTypes[161]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -11728,7 +11812,7 @@ func (x *PythonPackagesRequest_PythonPackage) String() string {
 func (*PythonPackagesRequest_PythonPackage) ProtoMessage() {}
 
 func (x *PythonPackagesRequest_PythonPackage) ProtoReflect() protoreflect.Message {
-	mi := &file_wandb_proto_wandb_internal_proto_msgTypes[160]
+	mi := &file_wandb_proto_wandb_internal_proto_msgTypes[161]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -11741,7 +11825,7 @@ func (x *PythonPackagesRequest_PythonPackage) ProtoReflect() protoreflect.Messag
 
 // Deprecated: Use PythonPackagesRequest_PythonPackage.ProtoReflect.Descriptor instead.
 func (*PythonPackagesRequest_PythonPackage) Descriptor() ([]byte, []int) {
-	return file_wandb_proto_wandb_internal_proto_rawDescGZIP(), []int{153, 0}
+	return file_wandb_proto_wandb_internal_proto_rawDescGZIP(), []int{154, 0}
 }
 
 func (x *PythonPackagesRequest_PythonPackage) GetName() string {
@@ -11766,7 +11850,7 @@ type JobInputSource_RunConfigSource struct {
 
 func (x *JobInputSource_RunConfigSource) Reset() {
 	*x = JobInputSource_RunConfigSource{}
-	mi := &file_wandb_proto_wandb_internal_proto_msgTypes[161]
+	mi := &file_wandb_proto_wandb_internal_proto_msgTypes[162]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -11778,7 +11862,7 @@ func (x *JobInputSource_RunConfigSource) String() string {
 func (*JobInputSource_RunConfigSource) ProtoMessage() {}
 
 func (x *JobInputSource_RunConfigSource) ProtoReflect() protoreflect.Message {
-	mi := &file_wandb_proto_wandb_internal_proto_msgTypes[161]
+	mi := &file_wandb_proto_wandb_internal_proto_msgTypes[162]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -11791,7 +11875,7 @@ func (x *JobInputSource_RunConfigSource) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use JobInputSource_RunConfigSource.ProtoReflect.Descriptor instead.
 func (*JobInputSource_RunConfigSource) Descriptor() ([]byte, []int) {
-	return file_wandb_proto_wandb_internal_proto_rawDescGZIP(), []int{155, 0}
+	return file_wandb_proto_wandb_internal_proto_rawDescGZIP(), []int{156, 0}
 }
 
 type JobInputSource_ConfigFileSource struct {
@@ -11803,7 +11887,7 @@ type JobInputSource_ConfigFileSource struct {
 
 func (x *JobInputSource_ConfigFileSource) Reset() {
 	*x = JobInputSource_ConfigFileSource{}
-	mi := &file_wandb_proto_wandb_internal_proto_msgTypes[162]
+	mi := &file_wandb_proto_wandb_internal_proto_msgTypes[163]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -11815,7 +11899,7 @@ func (x *JobInputSource_ConfigFileSource) String() string {
 func (*JobInputSource_ConfigFileSource) ProtoMessage() {}
 
 func (x *JobInputSource_ConfigFileSource) ProtoReflect() protoreflect.Message {
-	mi := &file_wandb_proto_wandb_internal_proto_msgTypes[162]
+	mi := &file_wandb_proto_wandb_internal_proto_msgTypes[163]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -11828,7 +11912,7 @@ func (x *JobInputSource_ConfigFileSource) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use JobInputSource_ConfigFileSource.ProtoReflect.Descriptor instead.
 func (*JobInputSource_ConfigFileSource) Descriptor() ([]byte, []int) {
-	return file_wandb_proto_wandb_internal_proto_rawDescGZIP(), []int{155, 1}
+	return file_wandb_proto_wandb_internal_proto_rawDescGZIP(), []int{156, 1}
 }
 
 func (x *JobInputSource_ConfigFileSource) GetPath() string {
@@ -12190,7 +12274,7 @@ const file_wandb_proto_wandb_internal_proto_rawDesc = "" +
 	"\x05level\x18\x03 \x01(\tR\x05level\x12#\n" +
 	"\rwait_duration\x18\x04 \x01(\x03R\fwaitDuration\x121\n" +
 	"\x05_info\x18\xc8\x01 \x01(\v2\x1b.wandb_internal._RecordInfoR\x04Info\"\r\n" +
-	"\vAlertResult\"\xf6\x14\n" +
+	"\vAlertResult\"\xc8\x15\n" +
 	"\aRequest\x12D\n" +
 	"\vstop_status\x18\x01 \x01(\v2!.wandb_internal.StopStatusRequestH\x00R\n" +
 	"stopStatus\x12M\n" +
@@ -12237,7 +12321,8 @@ const file_wandb_proto_wandb_internal_proto_rawDesc = "" +
 	"\n" +
 	"operations\x18R \x01(\v2%.wandb_internal.OperationStatsRequestH\x00R\n" +
 	"operations\x12T\n" +
-	"\x11probe_system_info\x18S \x01(\v2&.wandb_internal.ProbeSystemInfoRequestH\x00R\x0fprobeSystemInfo\x12E\n" +
+	"\x11probe_system_info\x18S \x01(\v2&.wandb_internal.ProbeSystemInfoRequestH\x00R\x0fprobeSystemInfo\x12P\n" +
+	"\x0fcapture_profile\x18U \x01(\v2%.wandb_internal.CaptureProfileRequestH\x00R\x0ecaptureProfile\x12E\n" +
 	"\vtest_inject\x18\xe8\a \x01(\v2!.wandb_internal.TestInjectRequestH\x00R\n" +
 	"testInjectB\x0e\n" +
 	"\frequest_typeJ\x04\b\x12\x10\x13J\x04\b\x16\x10\x17J\x04\bK\x10LJ\x04\bL\x10MJ\x04\bO\x10PJ\x04\bP\x10Q\"\xba\x11\n" +
@@ -12293,7 +12378,11 @@ const file_wandb_proto_wandb_internal_proto_rawDesc = "" +
 	"\rPauseResponse\"C\n" +
 	"\rResumeRequest\x122\n" +
 	"\x05_info\x18\xc8\x01 \x01(\v2\x1c.wandb_internal._RequestInfoR\x04Info\"\x10\n" +
-	"\x0eResumeResponse\"[\n" +
+	"\x0eResumeResponse\"\x8c\x01\n" +
+	"\x15CaptureProfileRequest\x12)\n" +
+	"\x10duration_seconds\x18\x01 \x01(\x01R\x0fdurationSeconds\x12\x14\n" +
+	"\x05label\x18\x02 \x01(\tR\x05label\x122\n" +
+	"\x05_info\x18\xc8\x01 \x01(\v2\x1c.wandb_internal._RequestInfoR\x04Info\"[\n" +
 	"\fLoginRequest\x12\x17\n" +
 	"\aapi_key\x18\x01 \x01(\tR\x06apiKey\x122\n" +
 	"\x05_info\x18\xc8\x01 \x01(\v2\x1c.wandb_internal._RequestInfoR\x04Info\"4\n" +
@@ -12761,7 +12850,7 @@ func file_wandb_proto_wandb_internal_proto_rawDescGZIP() []byte {
 }
 
 var file_wandb_proto_wandb_internal_proto_enumTypes = make([]protoimpl.EnumInfo, 10)
-var file_wandb_proto_wandb_internal_proto_msgTypes = make([]protoimpl.MessageInfo, 163)
+var file_wandb_proto_wandb_internal_proto_msgTypes = make([]protoimpl.MessageInfo, 164)
 var file_wandb_proto_wandb_internal_proto_goTypes = []any{
 	(ServerFeature)(0),                          // 0: wandb_internal.ServerFeature
 	(ErrorInfo_ErrorCode)(0),                    // 1: wandb_internal.ErrorInfo.ErrorCode
@@ -12836,112 +12925,113 @@ var file_wandb_proto_wandb_internal_proto_goTypes = []any{
 	(*PauseResponse)(nil),                       // 70: wandb_internal.PauseResponse
 	(*ResumeRequest)(nil),                       // 71: wandb_internal.ResumeRequest
 	(*ResumeResponse)(nil),                      // 72: wandb_internal.ResumeResponse
-	(*LoginRequest)(nil),                        // 73: wandb_internal.LoginRequest
-	(*LoginResponse)(nil),                       // 74: wandb_internal.LoginResponse
-	(*GetSummaryRequest)(nil),                   // 75: wandb_internal.GetSummaryRequest
-	(*GetSummaryResponse)(nil),                  // 76: wandb_internal.GetSummaryResponse
-	(*GetSystemMetricsRequest)(nil),             // 77: wandb_internal.GetSystemMetricsRequest
-	(*SystemMetricSample)(nil),                  // 78: wandb_internal.SystemMetricSample
-	(*SystemMetricsBuffer)(nil),                 // 79: wandb_internal.SystemMetricsBuffer
-	(*GetSystemMetricsResponse)(nil),            // 80: wandb_internal.GetSystemMetricsResponse
-	(*StatusRequest)(nil),                       // 81: wandb_internal.StatusRequest
-	(*StatusResponse)(nil),                      // 82: wandb_internal.StatusResponse
-	(*StopStatusRequest)(nil),                   // 83: wandb_internal.StopStatusRequest
-	(*StopStatusResponse)(nil),                  // 84: wandb_internal.StopStatusResponse
-	(*NetworkStatusRequest)(nil),                // 85: wandb_internal.NetworkStatusRequest
-	(*NetworkStatusResponse)(nil),               // 86: wandb_internal.NetworkStatusResponse
-	(*HttpResponse)(nil),                        // 87: wandb_internal.HttpResponse
-	(*InternalMessagesRequest)(nil),             // 88: wandb_internal.InternalMessagesRequest
-	(*InternalMessagesResponse)(nil),            // 89: wandb_internal.InternalMessagesResponse
-	(*InternalMessages)(nil),                    // 90: wandb_internal.InternalMessages
-	(*PollExitRequest)(nil),                     // 91: wandb_internal.PollExitRequest
-	(*PollExitResponse)(nil),                    // 92: wandb_internal.PollExitResponse
-	(*OperationStatsRequest)(nil),               // 93: wandb_internal.OperationStatsRequest
-	(*OperationStatsResponse)(nil),              // 94: wandb_internal.OperationStatsResponse
-	(*OperationStats)(nil),                      // 95: wandb_internal.OperationStats
-	(*Operation)(nil),                           // 96: wandb_internal.Operation
-	(*SenderMarkRequest)(nil),                   // 97: wandb_internal.SenderMarkRequest
-	(*SyncFinishRequest)(nil),                   // 98: wandb_internal.SyncFinishRequest
-	(*SyncResponse)(nil),                        // 99: wandb_internal.SyncResponse
-	(*SenderReadRequest)(nil),                   // 100: wandb_internal.SenderReadRequest
-	(*StatusReportRequest)(nil),                 // 101: wandb_internal.StatusReportRequest
-	(*SummaryRecordRequest)(nil),                // 102: wandb_internal.SummaryRecordRequest
-	(*TelemetryRecordRequest)(nil),              // 103: wandb_internal.TelemetryRecordRequest
-	(*ServerInfoRequest)(nil),                   // 104: wandb_internal.ServerInfoRequest
-	(*ServerInfoResponse)(nil),                  // 105: wandb_internal.ServerInfoResponse
-	(*ServerMessages)(nil),                      // 106: wandb_internal.ServerMessages
-	(*ServerMessage)(nil),                       // 107: wandb_internal.ServerMessage
-	(*FileCounts)(nil),                          // 108: wandb_internal.FileCounts
-	(*FilePusherStats)(nil),                     // 109: wandb_internal.FilePusherStats
-	(*FilesUploaded)(nil),                       // 110: wandb_internal.FilesUploaded
-	(*FileTransferInfoRequest)(nil),             // 111: wandb_internal.FileTransferInfoRequest
-	(*LocalInfo)(nil),                           // 112: wandb_internal.LocalInfo
-	(*ShutdownRequest)(nil),                     // 113: wandb_internal.ShutdownRequest
-	(*ShutdownResponse)(nil),                    // 114: wandb_internal.ShutdownResponse
-	(*AttachRequest)(nil),                       // 115: wandb_internal.AttachRequest
-	(*AttachResponse)(nil),                      // 116: wandb_internal.AttachResponse
-	(*TestInjectRequest)(nil),                   // 117: wandb_internal.TestInjectRequest
-	(*TestInjectResponse)(nil),                  // 118: wandb_internal.TestInjectResponse
-	(*HistoryAction)(nil),                       // 119: wandb_internal.HistoryAction
-	(*PartialHistoryRequest)(nil),               // 120: wandb_internal.PartialHistoryRequest
-	(*PartialHistoryResponse)(nil),              // 121: wandb_internal.PartialHistoryResponse
-	(*HistoryStepRequest)(nil),                  // 122: wandb_internal.HistoryStepRequest
-	(*HistoryStepResponse)(nil),                 // 123: wandb_internal.HistoryStepResponse
-	(*SampledHistoryRequest)(nil),               // 124: wandb_internal.SampledHistoryRequest
-	(*SampledHistoryItem)(nil),                  // 125: wandb_internal.SampledHistoryItem
-	(*SampledHistoryResponse)(nil),              // 126: wandb_internal.SampledHistoryResponse
-	(*RunStatusRequest)(nil),                    // 127: wandb_internal.RunStatusRequest
-	(*RunStatusResponse)(nil),                   // 128: wandb_internal.RunStatusResponse
-	(*RunStartRequest)(nil),                     // 129: wandb_internal.RunStartRequest
-	(*RunStartResponse)(nil),                    // 130: wandb_internal.RunStartResponse
-	(*CheckVersionRequest)(nil),                 // 131: wandb_internal.CheckVersionRequest
-	(*CheckVersionResponse)(nil),                // 132: wandb_internal.CheckVersionResponse
-	(*JobInfoRequest)(nil),                      // 133: wandb_internal.JobInfoRequest
-	(*JobInfoResponse)(nil),                     // 134: wandb_internal.JobInfoResponse
-	(*LogArtifactRequest)(nil),                  // 135: wandb_internal.LogArtifactRequest
-	(*LogArtifactResponse)(nil),                 // 136: wandb_internal.LogArtifactResponse
-	(*DownloadArtifactRequest)(nil),             // 137: wandb_internal.DownloadArtifactRequest
-	(*DownloadArtifactResponse)(nil),            // 138: wandb_internal.DownloadArtifactResponse
-	(*KeepaliveRequest)(nil),                    // 139: wandb_internal.KeepaliveRequest
-	(*KeepaliveResponse)(nil),                   // 140: wandb_internal.KeepaliveResponse
-	(*ArtifactInfo)(nil),                        // 141: wandb_internal.ArtifactInfo
-	(*GitInfo)(nil),                             // 142: wandb_internal.GitInfo
-	(*GitSource)(nil),                           // 143: wandb_internal.GitSource
-	(*ImageSource)(nil),                         // 144: wandb_internal.ImageSource
-	(*Source)(nil),                              // 145: wandb_internal.Source
-	(*JobSource)(nil),                           // 146: wandb_internal.JobSource
-	(*PartialJobArtifact)(nil),                  // 147: wandb_internal.PartialJobArtifact
-	(*UseArtifactRecord)(nil),                   // 148: wandb_internal.UseArtifactRecord
-	(*UseArtifactResult)(nil),                   // 149: wandb_internal.UseArtifactResult
-	(*CancelRequest)(nil),                       // 150: wandb_internal.CancelRequest
-	(*CancelResponse)(nil),                      // 151: wandb_internal.CancelResponse
-	(*ProbeSystemInfoRequest)(nil),              // 152: wandb_internal.ProbeSystemInfoRequest
-	(*DiskInfo)(nil),                            // 153: wandb_internal.DiskInfo
-	(*MemoryInfo)(nil),                          // 154: wandb_internal.MemoryInfo
-	(*CpuInfo)(nil),                             // 155: wandb_internal.CpuInfo
-	(*AppleInfo)(nil),                           // 156: wandb_internal.AppleInfo
-	(*GpuNvidiaInfo)(nil),                       // 157: wandb_internal.GpuNvidiaInfo
-	(*GpuAmdInfo)(nil),                          // 158: wandb_internal.GpuAmdInfo
-	(*TrainiumInfo)(nil),                        // 159: wandb_internal.TrainiumInfo
-	(*TPUInfo)(nil),                             // 160: wandb_internal.TPUInfo
-	(*CoreWeaveInfo)(nil),                       // 161: wandb_internal.CoreWeaveInfo
-	(*EnvironmentRecord)(nil),                   // 162: wandb_internal.EnvironmentRecord
-	(*PythonPackagesRequest)(nil),               // 163: wandb_internal.PythonPackagesRequest
-	(*JobInputPath)(nil),                        // 164: wandb_internal.JobInputPath
-	(*JobInputSource)(nil),                      // 165: wandb_internal.JobInputSource
-	(*JobInputRequest)(nil),                     // 166: wandb_internal.JobInputRequest
-	nil,                                         // 167: wandb_internal.GetSystemMetricsResponse.SystemMetricsEntry
-	nil,                                         // 168: wandb_internal.EnvironmentRecord.DiskEntry
-	nil,                                         // 169: wandb_internal.EnvironmentRecord.SlurmEntry
-	(*PythonPackagesRequest_PythonPackage)(nil), // 170: wandb_internal.PythonPackagesRequest.PythonPackage
-	(*JobInputSource_RunConfigSource)(nil),      // 171: wandb_internal.JobInputSource.RunConfigSource
-	(*JobInputSource_ConfigFileSource)(nil),     // 172: wandb_internal.JobInputSource.ConfigFileSource
-	(*TelemetryRecord)(nil),                     // 173: wandb_internal.TelemetryRecord
-	(*emptypb.Empty)(nil),                       // 174: google.protobuf.Empty
-	(*XRecordInfo)(nil),                         // 175: wandb_internal._RecordInfo
-	(*XResultInfo)(nil),                         // 176: wandb_internal._ResultInfo
-	(*timestamppb.Timestamp)(nil),               // 177: google.protobuf.Timestamp
-	(*XRequestInfo)(nil),                        // 178: wandb_internal._RequestInfo
+	(*CaptureProfileRequest)(nil),               // 73: wandb_internal.CaptureProfileRequest
+	(*LoginRequest)(nil),                        // 74: wandb_internal.LoginRequest
+	(*LoginResponse)(nil),                       // 75: wandb_internal.LoginResponse
+	(*GetSummaryRequest)(nil),                   // 76: wandb_internal.GetSummaryRequest
+	(*GetSummaryResponse)(nil),                  // 77: wandb_internal.GetSummaryResponse
+	(*GetSystemMetricsRequest)(nil),             // 78: wandb_internal.GetSystemMetricsRequest
+	(*SystemMetricSample)(nil),                  // 79: wandb_internal.SystemMetricSample
+	(*SystemMetricsBuffer)(nil),                 // 80: wandb_internal.SystemMetricsBuffer
+	(*GetSystemMetricsResponse)(nil),            // 81: wandb_internal.GetSystemMetricsResponse
+	(*StatusRequest)(nil),                       // 82: wandb_internal.StatusRequest
+	(*StatusResponse)(nil),                      // 83: wandb_internal.StatusResponse
+	(*StopStatusRequest)(nil),                   // 84: wandb_internal.StopStatusRequest
+	(*StopStatusResponse)(nil),                  // 85: wandb_internal.StopStatusResponse
+	(*NetworkStatusRequest)(nil),                // 86: wandb_internal.NetworkStatusRequest
+	(*NetworkStatusResponse)(nil),               // 87: wandb_internal.NetworkStatusResponse
+	(*HttpResponse)(nil),                        // 88: wandb_internal.HttpResponse
+	(*InternalMessagesRequest)(nil),             // 89: wandb_internal.InternalMessagesRequest
+	(*InternalMessagesResponse)(nil),            // 90: wandb_internal.InternalMessagesResponse
+	(*InternalMessages)(nil),                    // 91: wandb_internal.InternalMessages
+	(*PollExitRequest)(nil),                     // 92: wandb_internal.PollExitRequest
+	(*PollExitResponse)(nil),                    // 93: wandb_internal.PollExitResponse
+	(*OperationStatsRequest)(nil),               // 94: wandb_internal.OperationStatsRequest
+	(*OperationStatsResponse)(nil),              // 95: wandb_internal.OperationStatsResponse
+	(*OperationStats)(nil),                      // 96: wandb_internal.OperationStats
+	(*Operation)(nil),                           // 97: wandb_internal.Operation
+	(*SenderMarkRequest)(nil),                   // 98: wandb_internal.SenderMarkRequest
+	(*SyncFinishRequest)(nil),                   // 99: wandb_internal.SyncFinishRequest
+	(*SyncResponse)(nil),                        // 100: wandb_internal.SyncResponse
+	(*SenderReadRequest)(nil),                   // 101: wandb_internal.SenderReadRequest
+	(*StatusReportRequest)(nil),                 // 102: wandb_internal.StatusReportRequest
+	(*SummaryRecordRequest)(nil),                // 103: wandb_internal.SummaryRecordRequest
+	(*TelemetryRecordRequest)(nil),              // 104: wandb_internal.TelemetryRecordRequest
+	(*ServerInfoRequest)(nil),                   // 105: wandb_internal.ServerInfoRequest
+	(*ServerInfoResponse)(nil),                  // 106: wandb_internal.ServerInfoResponse
+	(*ServerMessages)(nil),                      // 107: wandb_internal.ServerMessages
+	(*ServerMessage)(nil),                       // 108: wandb_internal.ServerMessage
+	(*FileCounts)(nil),                          // 109: wandb_internal.FileCounts
+	(*FilePusherStats)(nil),                     // 110: wandb_internal.FilePusherStats
+	(*FilesUploaded)(nil),                       // 111: wandb_internal.FilesUploaded
+	(*FileTransferInfoRequest)(nil),             // 112: wandb_internal.FileTransferInfoRequest
+	(*LocalInfo)(nil),                           // 113: wandb_internal.LocalInfo
+	(*ShutdownRequest)(nil),                     // 114: wandb_internal.ShutdownRequest
+	(*ShutdownResponse)(nil),                    // 115: wandb_internal.ShutdownResponse
+	(*AttachRequest)(nil),                       // 116: wandb_internal.AttachRequest
+	(*AttachResponse)(nil),                      // 117: wandb_internal.AttachResponse
+	(*TestInjectRequest)(nil),                   // 118: wandb_internal.TestInjectRequest
+	(*TestInjectResponse)(nil),                  // 119: wandb_internal.TestInjectResponse
+	(*HistoryAction)(nil),                       // 120: wandb_internal.HistoryAction
+	(*PartialHistoryRequest)(nil),               // 121: wandb_internal.PartialHistoryRequest
+	(*PartialHistoryResponse)(nil),              // 122: wandb_internal.PartialHistoryResponse
+	(*HistoryStepRequest)(nil),                  // 123: wandb_internal.HistoryStepRequest
+	(*HistoryStepResponse)(nil),                 // 124: wandb_internal.HistoryStepResponse
+	(*SampledHistoryRequest)(nil),               // 125: wandb_internal.SampledHistoryRequest
+	(*SampledHistoryItem)(nil),                  // 126: wandb_internal.SampledHistoryItem
+	(*SampledHistoryResponse)(nil),              // 127: wandb_internal.SampledHistoryResponse
+	(*RunStatusRequest)(nil),                    // 128: wandb_internal.RunStatusRequest
+	(*RunStatusResponse)(nil),                   // 129: wandb_internal.RunStatusResponse
+	(*RunStartRequest)(nil),                     // 130: wandb_internal.RunStartRequest
+	(*RunStartResponse)(nil),                    // 131: wandb_internal.RunStartResponse
+	(*CheckVersionRequest)(nil),                 // 132: wandb_internal.CheckVersionRequest
+	(*CheckVersionResponse)(nil),                // 133: wandb_internal.CheckVersionResponse
+	(*JobInfoRequest)(nil),                      // 134: wandb_internal.JobInfoRequest
+	(*JobInfoResponse)(nil),                     // 135: wandb_internal.JobInfoResponse
+	(*LogArtifactRequest)(nil),                  // 136: wandb_internal.LogArtifactRequest
+	(*LogArtifactResponse)(nil),                 // 137: wandb_internal.LogArtifactResponse
+	(*DownloadArtifactRequest)(nil),             // 138: wandb_internal.DownloadArtifactRequest
+	(*DownloadArtifactResponse)(nil),            // 139: wandb_internal.DownloadArtifactResponse
+	(*KeepaliveRequest)(nil),                    // 140: wandb_internal.KeepaliveRequest
+	(*KeepaliveResponse)(nil),                   // 141: wandb_internal.KeepaliveResponse
+	(*ArtifactInfo)(nil),                        // 142: wandb_internal.ArtifactInfo
+	(*GitInfo)(nil),                             // 143: wandb_internal.GitInfo
+	(*GitSource)(nil),                           // 144: wandb_internal.GitSource
+	(*ImageSource)(nil),                         // 145: wandb_internal.ImageSource
+	(*Source)(nil),                              // 146: wandb_internal.Source
+	(*JobSource)(nil),                           // 147: wandb_internal.JobSource
+	(*PartialJobArtifact)(nil),                  // 148: wandb_internal.PartialJobArtifact
+	(*UseArtifactRecord)(nil),                   // 149: wandb_internal.UseArtifactRecord
+	(*UseArtifactResult)(nil),                   // 150: wandb_internal.UseArtifactResult
+	(*CancelRequest)(nil),                       // 151: wandb_internal.CancelRequest
+	(*CancelResponse)(nil),                      // 152: wandb_internal.CancelResponse
+	(*ProbeSystemInfoRequest)(nil),              // 153: wandb_internal.ProbeSystemInfoRequest
+	(*DiskInfo)(nil),                            // 154: wandb_internal.DiskInfo
+	(*MemoryInfo)(nil),                          // 155: wandb_internal.MemoryInfo
+	(*CpuInfo)(nil),                             // 156: wandb_internal.CpuInfo
+	(*AppleInfo)(nil),                           // 157: wandb_internal.AppleInfo
+	(*GpuNvidiaInfo)(nil),                       // 158: wandb_internal.GpuNvidiaInfo
+	(*GpuAmdInfo)(nil),                          // 159: wandb_internal.GpuAmdInfo
+	(*TrainiumInfo)(nil),                        // 160: wandb_internal.TrainiumInfo
+	(*TPUInfo)(nil),                             // 161: wandb_internal.TPUInfo
+	(*CoreWeaveInfo)(nil),                       // 162: wandb_internal.CoreWeaveInfo
+	(*EnvironmentRecord)(nil),                   // 163: wandb_internal.EnvironmentRecord
+	(*PythonPackagesRequest)(nil),               // 164: wandb_internal.PythonPackagesRequest
+	(*JobInputPath)(nil),                        // 165: wandb_internal.JobInputPath
+	(*JobInputSource)(nil),                      // 166: wandb_internal.JobInputSource
+	(*JobInputRequest)(nil),                     // 167: wandb_internal.JobInputRequest
+	nil,                                         // 168: wandb_internal.GetSystemMetricsResponse.SystemMetricsEntry
+	nil,                                         // 169: wandb_internal.EnvironmentRecord.DiskEntry
+	nil,                                         // 170: wandb_internal.EnvironmentRecord.SlurmEntry
+	(*PythonPackagesRequest_PythonPackage)(nil), // 171: wandb_internal.PythonPackagesRequest.PythonPackage
+	(*JobInputSource_RunConfigSource)(nil),      // 172: wandb_internal.JobInputSource.RunConfigSource
+	(*JobInputSource_ConfigFileSource)(nil),     // 173: wandb_internal.JobInputSource.ConfigFileSource
+	(*TelemetryRecord)(nil),                     // 174: wandb_internal.TelemetryRecord
+	(*emptypb.Empty)(nil),                       // 175: google.protobuf.Empty
+	(*XRecordInfo)(nil),                         // 176: wandb_internal._RecordInfo
+	(*XResultInfo)(nil),                         // 177: wandb_internal._ResultInfo
+	(*timestamppb.Timestamp)(nil),               // 178: google.protobuf.Timestamp
+	(*XRequestInfo)(nil),                        // 179: wandb_internal._RequestInfo
 }
 var file_wandb_proto_wandb_internal_proto_depIdxs = []int32{
 	29,  // 0: wandb_internal.Record.history:type_name -> wandb_internal.HistoryRecord
@@ -12953,7 +13043,7 @@ var file_wandb_proto_wandb_internal_proto_depIdxs = []int32{
 	53,  // 6: wandb_internal.Record.artifact:type_name -> wandb_internal.ArtifactRecord
 	62,  // 7: wandb_internal.Record.tbrecord:type_name -> wandb_internal.TBRecord
 	64,  // 8: wandb_internal.Record.alert:type_name -> wandb_internal.AlertRecord
-	173, // 9: wandb_internal.Record.telemetry:type_name -> wandb_internal.TelemetryRecord
+	174, // 9: wandb_internal.Record.telemetry:type_name -> wandb_internal.TelemetryRecord
 	37,  // 10: wandb_internal.Record.metric:type_name -> wandb_internal.MetricRecord
 	34,  // 11: wandb_internal.Record.output_raw:type_name -> wandb_internal.OutputRawRecord
 	18,  // 12: wandb_internal.Record.run:type_name -> wandb_internal.RunRecord
@@ -12962,13 +13052,13 @@ var file_wandb_proto_wandb_internal_proto_depIdxs = []int32{
 	15,  // 15: wandb_internal.Record.header:type_name -> wandb_internal.HeaderRecord
 	16,  // 16: wandb_internal.Record.footer:type_name -> wandb_internal.FooterRecord
 	24,  // 17: wandb_internal.Record.preempting:type_name -> wandb_internal.RunPreemptingRecord
-	174, // 18: wandb_internal.Record.noop_link_artifact:type_name -> google.protobuf.Empty
-	148, // 19: wandb_internal.Record.use_artifact:type_name -> wandb_internal.UseArtifactRecord
-	162, // 20: wandb_internal.Record.environment:type_name -> wandb_internal.EnvironmentRecord
+	175, // 18: wandb_internal.Record.noop_link_artifact:type_name -> google.protobuf.Empty
+	149, // 19: wandb_internal.Record.use_artifact:type_name -> wandb_internal.UseArtifactRecord
+	163, // 20: wandb_internal.Record.environment:type_name -> wandb_internal.EnvironmentRecord
 	36,  // 21: wandb_internal.Record.output_logger:type_name -> wandb_internal.OutputLoggerRecord
 	66,  // 22: wandb_internal.Record.request:type_name -> wandb_internal.Request
 	11,  // 23: wandb_internal.Record.control:type_name -> wandb_internal.Control
-	175, // 24: wandb_internal.Record._info:type_name -> wandb_internal._RecordInfo
+	176, // 24: wandb_internal.Record._info:type_name -> wandb_internal._RecordInfo
 	20,  // 25: wandb_internal.Result.run_result:type_name -> wandb_internal.RunUpdateResult
 	23,  // 26: wandb_internal.Result.exit_result:type_name -> wandb_internal.RunExitResult
 	31,  // 27: wandb_internal.Result.log_result:type_name -> wandb_internal.HistoryResult
@@ -12977,216 +13067,218 @@ var file_wandb_proto_wandb_internal_proto_depIdxs = []int32{
 	44,  // 30: wandb_internal.Result.config_result:type_name -> wandb_internal.ConfigResult
 	67,  // 31: wandb_internal.Result.response:type_name -> wandb_internal.Response
 	11,  // 32: wandb_internal.Result.control:type_name -> wandb_internal.Control
-	176, // 33: wandb_internal.Result._info:type_name -> wandb_internal._ResultInfo
-	175, // 34: wandb_internal.FinalRecord._info:type_name -> wandb_internal._RecordInfo
-	175, // 35: wandb_internal.VersionInfo._info:type_name -> wandb_internal._RecordInfo
+	177, // 33: wandb_internal.Result._info:type_name -> wandb_internal._ResultInfo
+	176, // 34: wandb_internal.FinalRecord._info:type_name -> wandb_internal._RecordInfo
+	176, // 35: wandb_internal.VersionInfo._info:type_name -> wandb_internal._RecordInfo
 	14,  // 36: wandb_internal.HeaderRecord.version_info:type_name -> wandb_internal.VersionInfo
-	175, // 37: wandb_internal.HeaderRecord._info:type_name -> wandb_internal._RecordInfo
-	175, // 38: wandb_internal.FooterRecord._info:type_name -> wandb_internal._RecordInfo
+	176, // 37: wandb_internal.HeaderRecord._info:type_name -> wandb_internal._RecordInfo
+	176, // 38: wandb_internal.FooterRecord._info:type_name -> wandb_internal._RecordInfo
 	42,  // 39: wandb_internal.RunRecord.config:type_name -> wandb_internal.ConfigRecord
 	45,  // 40: wandb_internal.RunRecord.summary:type_name -> wandb_internal.SummaryRecord
 	26,  // 41: wandb_internal.RunRecord.settings:type_name -> wandb_internal.SettingsRecord
-	177, // 42: wandb_internal.RunRecord.start_time:type_name -> google.protobuf.Timestamp
-	173, // 43: wandb_internal.RunRecord.telemetry:type_name -> wandb_internal.TelemetryRecord
+	178, // 42: wandb_internal.RunRecord.start_time:type_name -> google.protobuf.Timestamp
+	174, // 43: wandb_internal.RunRecord.telemetry:type_name -> wandb_internal.TelemetryRecord
 	19,  // 44: wandb_internal.RunRecord.git:type_name -> wandb_internal.GitRepoRecord
 	17,  // 45: wandb_internal.RunRecord.branch_point:type_name -> wandb_internal.BranchPoint
-	175, // 46: wandb_internal.RunRecord._info:type_name -> wandb_internal._RecordInfo
+	176, // 46: wandb_internal.RunRecord._info:type_name -> wandb_internal._RecordInfo
 	18,  // 47: wandb_internal.RunUpdateResult.run:type_name -> wandb_internal.RunRecord
 	21,  // 48: wandb_internal.RunUpdateResult.error:type_name -> wandb_internal.ErrorInfo
 	1,   // 49: wandb_internal.ErrorInfo.code:type_name -> wandb_internal.ErrorInfo.ErrorCode
-	175, // 50: wandb_internal.RunExitRecord._info:type_name -> wandb_internal._RecordInfo
-	175, // 51: wandb_internal.RunPreemptingRecord._info:type_name -> wandb_internal._RecordInfo
+	176, // 50: wandb_internal.RunExitRecord._info:type_name -> wandb_internal._RecordInfo
+	176, // 51: wandb_internal.RunPreemptingRecord._info:type_name -> wandb_internal._RecordInfo
 	27,  // 52: wandb_internal.SettingsRecord.item:type_name -> wandb_internal.SettingsItem
-	175, // 53: wandb_internal.SettingsRecord._info:type_name -> wandb_internal._RecordInfo
+	176, // 53: wandb_internal.SettingsRecord._info:type_name -> wandb_internal._RecordInfo
 	30,  // 54: wandb_internal.HistoryRecord.item:type_name -> wandb_internal.HistoryItem
 	28,  // 55: wandb_internal.HistoryRecord.step:type_name -> wandb_internal.HistoryStep
-	175, // 56: wandb_internal.HistoryRecord._info:type_name -> wandb_internal._RecordInfo
+	176, // 56: wandb_internal.HistoryRecord._info:type_name -> wandb_internal._RecordInfo
 	2,   // 57: wandb_internal.OutputRecord.output_type:type_name -> wandb_internal.OutputRecord.OutputType
-	177, // 58: wandb_internal.OutputRecord.timestamp:type_name -> google.protobuf.Timestamp
-	175, // 59: wandb_internal.OutputRecord._info:type_name -> wandb_internal._RecordInfo
+	178, // 58: wandb_internal.OutputRecord.timestamp:type_name -> google.protobuf.Timestamp
+	176, // 59: wandb_internal.OutputRecord._info:type_name -> wandb_internal._RecordInfo
 	3,   // 60: wandb_internal.OutputRawRecord.output_type:type_name -> wandb_internal.OutputRawRecord.OutputType
-	177, // 61: wandb_internal.OutputRawRecord.timestamp:type_name -> google.protobuf.Timestamp
-	175, // 62: wandb_internal.OutputRawRecord._info:type_name -> wandb_internal._RecordInfo
+	178, // 61: wandb_internal.OutputRawRecord.timestamp:type_name -> google.protobuf.Timestamp
+	176, // 62: wandb_internal.OutputRawRecord._info:type_name -> wandb_internal._RecordInfo
 	39,  // 63: wandb_internal.MetricRecord.options:type_name -> wandb_internal.MetricOptions
 	41,  // 64: wandb_internal.MetricRecord.summary:type_name -> wandb_internal.MetricSummary
 	4,   // 65: wandb_internal.MetricRecord.goal:type_name -> wandb_internal.MetricRecord.MetricGoal
 	40,  // 66: wandb_internal.MetricRecord._control:type_name -> wandb_internal.MetricControl
-	175, // 67: wandb_internal.MetricRecord._info:type_name -> wandb_internal._RecordInfo
+	176, // 67: wandb_internal.MetricRecord._info:type_name -> wandb_internal._RecordInfo
 	43,  // 68: wandb_internal.ConfigRecord.update:type_name -> wandb_internal.ConfigItem
 	43,  // 69: wandb_internal.ConfigRecord.remove:type_name -> wandb_internal.ConfigItem
-	175, // 70: wandb_internal.ConfigRecord._info:type_name -> wandb_internal._RecordInfo
+	176, // 70: wandb_internal.ConfigRecord._info:type_name -> wandb_internal._RecordInfo
 	46,  // 71: wandb_internal.SummaryRecord.update:type_name -> wandb_internal.SummaryItem
 	46,  // 72: wandb_internal.SummaryRecord.remove:type_name -> wandb_internal.SummaryItem
-	175, // 73: wandb_internal.SummaryRecord._info:type_name -> wandb_internal._RecordInfo
+	176, // 73: wandb_internal.SummaryRecord._info:type_name -> wandb_internal._RecordInfo
 	49,  // 74: wandb_internal.FilesRecord.files:type_name -> wandb_internal.FilesItem
-	175, // 75: wandb_internal.FilesRecord._info:type_name -> wandb_internal._RecordInfo
+	176, // 75: wandb_internal.FilesRecord._info:type_name -> wandb_internal._RecordInfo
 	5,   // 76: wandb_internal.FilesItem.policy:type_name -> wandb_internal.FilesItem.PolicyType
 	6,   // 77: wandb_internal.FilesItem.type:type_name -> wandb_internal.FilesItem.FileType
 	7,   // 78: wandb_internal.StatsRecord.stats_type:type_name -> wandb_internal.StatsRecord.StatsType
-	177, // 79: wandb_internal.StatsRecord.timestamp:type_name -> google.protobuf.Timestamp
+	178, // 79: wandb_internal.StatsRecord.timestamp:type_name -> google.protobuf.Timestamp
 	52,  // 80: wandb_internal.StatsRecord.item:type_name -> wandb_internal.StatsItem
-	175, // 81: wandb_internal.StatsRecord._info:type_name -> wandb_internal._RecordInfo
+	176, // 81: wandb_internal.StatsRecord._info:type_name -> wandb_internal._RecordInfo
 	54,  // 82: wandb_internal.ArtifactRecord.manifest:type_name -> wandb_internal.ArtifactManifest
-	175, // 83: wandb_internal.ArtifactRecord._info:type_name -> wandb_internal._RecordInfo
+	176, // 83: wandb_internal.ArtifactRecord._info:type_name -> wandb_internal._RecordInfo
 	57,  // 84: wandb_internal.ArtifactManifest.storage_policy_config:type_name -> wandb_internal.StoragePolicyConfigItem
 	55,  // 85: wandb_internal.ArtifactManifest.contents:type_name -> wandb_internal.ArtifactManifestEntry
 	56,  // 86: wandb_internal.ArtifactManifestEntry.extra:type_name -> wandb_internal.ExtraItem
-	175, // 87: wandb_internal.LinkArtifactRequest._info:type_name -> wandb_internal._RecordInfo
-	175, // 88: wandb_internal.TBRecord._info:type_name -> wandb_internal._RecordInfo
-	175, // 89: wandb_internal.AlertRecord._info:type_name -> wandb_internal._RecordInfo
-	83,  // 90: wandb_internal.Request.stop_status:type_name -> wandb_internal.StopStatusRequest
-	85,  // 91: wandb_internal.Request.network_status:type_name -> wandb_internal.NetworkStatusRequest
+	176, // 87: wandb_internal.LinkArtifactRequest._info:type_name -> wandb_internal._RecordInfo
+	176, // 88: wandb_internal.TBRecord._info:type_name -> wandb_internal._RecordInfo
+	176, // 89: wandb_internal.AlertRecord._info:type_name -> wandb_internal._RecordInfo
+	84,  // 90: wandb_internal.Request.stop_status:type_name -> wandb_internal.StopStatusRequest
+	86,  // 91: wandb_internal.Request.network_status:type_name -> wandb_internal.NetworkStatusRequest
 	68,  // 92: wandb_internal.Request.defer:type_name -> wandb_internal.DeferRequest
-	75,  // 93: wandb_internal.Request.get_summary:type_name -> wandb_internal.GetSummaryRequest
-	73,  // 94: wandb_internal.Request.login:type_name -> wandb_internal.LoginRequest
+	76,  // 93: wandb_internal.Request.get_summary:type_name -> wandb_internal.GetSummaryRequest
+	74,  // 94: wandb_internal.Request.login:type_name -> wandb_internal.LoginRequest
 	69,  // 95: wandb_internal.Request.pause:type_name -> wandb_internal.PauseRequest
 	71,  // 96: wandb_internal.Request.resume:type_name -> wandb_internal.ResumeRequest
-	91,  // 97: wandb_internal.Request.poll_exit:type_name -> wandb_internal.PollExitRequest
-	124, // 98: wandb_internal.Request.sampled_history:type_name -> wandb_internal.SampledHistoryRequest
-	120, // 99: wandb_internal.Request.partial_history:type_name -> wandb_internal.PartialHistoryRequest
-	122, // 100: wandb_internal.Request.history_step:type_name -> wandb_internal.HistoryStepRequest
-	129, // 101: wandb_internal.Request.run_start:type_name -> wandb_internal.RunStartRequest
-	131, // 102: wandb_internal.Request.check_version:type_name -> wandb_internal.CheckVersionRequest
-	135, // 103: wandb_internal.Request.log_artifact:type_name -> wandb_internal.LogArtifactRequest
-	137, // 104: wandb_internal.Request.download_artifact:type_name -> wandb_internal.DownloadArtifactRequest
-	139, // 105: wandb_internal.Request.keepalive:type_name -> wandb_internal.KeepaliveRequest
-	127, // 106: wandb_internal.Request.run_status:type_name -> wandb_internal.RunStatusRequest
-	150, // 107: wandb_internal.Request.cancel:type_name -> wandb_internal.CancelRequest
-	88,  // 108: wandb_internal.Request.internal_messages:type_name -> wandb_internal.InternalMessagesRequest
-	163, // 109: wandb_internal.Request.python_packages:type_name -> wandb_internal.PythonPackagesRequest
-	113, // 110: wandb_internal.Request.shutdown:type_name -> wandb_internal.ShutdownRequest
-	115, // 111: wandb_internal.Request.attach:type_name -> wandb_internal.AttachRequest
-	81,  // 112: wandb_internal.Request.status:type_name -> wandb_internal.StatusRequest
-	104, // 113: wandb_internal.Request.server_info:type_name -> wandb_internal.ServerInfoRequest
-	97,  // 114: wandb_internal.Request.sender_mark:type_name -> wandb_internal.SenderMarkRequest
-	100, // 115: wandb_internal.Request.sender_read:type_name -> wandb_internal.SenderReadRequest
-	101, // 116: wandb_internal.Request.status_report:type_name -> wandb_internal.StatusReportRequest
-	102, // 117: wandb_internal.Request.summary_record:type_name -> wandb_internal.SummaryRecordRequest
-	103, // 118: wandb_internal.Request.telemetry_record:type_name -> wandb_internal.TelemetryRecordRequest
-	133, // 119: wandb_internal.Request.job_info:type_name -> wandb_internal.JobInfoRequest
-	77,  // 120: wandb_internal.Request.get_system_metrics:type_name -> wandb_internal.GetSystemMetricsRequest
-	166, // 121: wandb_internal.Request.job_input:type_name -> wandb_internal.JobInputRequest
+	92,  // 97: wandb_internal.Request.poll_exit:type_name -> wandb_internal.PollExitRequest
+	125, // 98: wandb_internal.Request.sampled_history:type_name -> wandb_internal.SampledHistoryRequest
+	121, // 99: wandb_internal.Request.partial_history:type_name -> wandb_internal.PartialHistoryRequest
+	123, // 100: wandb_internal.Request.history_step:type_name -> wandb_internal.HistoryStepRequest
+	130, // 101: wandb_internal.Request.run_start:type_name -> wandb_internal.RunStartRequest
+	132, // 102: wandb_internal.Request.check_version:type_name -> wandb_internal.CheckVersionRequest
+	136, // 103: wandb_internal.Request.log_artifact:type_name -> wandb_internal.LogArtifactRequest
+	138, // 104: wandb_internal.Request.download_artifact:type_name -> wandb_internal.DownloadArtifactRequest
+	140, // 105: wandb_internal.Request.keepalive:type_name -> wandb_internal.KeepaliveRequest
+	128, // 106: wandb_internal.Request.run_status:type_name -> wandb_internal.RunStatusRequest
+	151, // 107: wandb_internal.Request.cancel:type_name -> wandb_internal.CancelRequest
+	89,  // 108: wandb_internal.Request.internal_messages:type_name -> wandb_internal.InternalMessagesRequest
+	164, // 109: wandb_internal.Request.python_packages:type_name -> wandb_internal.PythonPackagesRequest
+	114, // 110: wandb_internal.Request.shutdown:type_name -> wandb_internal.ShutdownRequest
+	116, // 111: wandb_internal.Request.attach:type_name -> wandb_internal.AttachRequest
+	82,  // 112: wandb_internal.Request.status:type_name -> wandb_internal.StatusRequest
+	105, // 113: wandb_internal.Request.server_info:type_name -> wandb_internal.ServerInfoRequest
+	98,  // 114: wandb_internal.Request.sender_mark:type_name -> wandb_internal.SenderMarkRequest
+	101, // 115: wandb_internal.Request.sender_read:type_name -> wandb_internal.SenderReadRequest
+	102, // 116: wandb_internal.Request.status_report:type_name -> wandb_internal.StatusReportRequest
+	103, // 117: wandb_internal.Request.summary_record:type_name -> wandb_internal.SummaryRecordRequest
+	104, // 118: wandb_internal.Request.telemetry_record:type_name -> wandb_internal.TelemetryRecordRequest
+	134, // 119: wandb_internal.Request.job_info:type_name -> wandb_internal.JobInfoRequest
+	78,  // 120: wandb_internal.Request.get_system_metrics:type_name -> wandb_internal.GetSystemMetricsRequest
+	167, // 121: wandb_internal.Request.job_input:type_name -> wandb_internal.JobInputRequest
 	60,  // 122: wandb_internal.Request.link_artifact:type_name -> wandb_internal.LinkArtifactRequest
-	98,  // 123: wandb_internal.Request.sync_finish:type_name -> wandb_internal.SyncFinishRequest
-	93,  // 124: wandb_internal.Request.operations:type_name -> wandb_internal.OperationStatsRequest
-	152, // 125: wandb_internal.Request.probe_system_info:type_name -> wandb_internal.ProbeSystemInfoRequest
-	117, // 126: wandb_internal.Request.test_inject:type_name -> wandb_internal.TestInjectRequest
-	140, // 127: wandb_internal.Response.keepalive_response:type_name -> wandb_internal.KeepaliveResponse
-	84,  // 128: wandb_internal.Response.stop_status_response:type_name -> wandb_internal.StopStatusResponse
-	86,  // 129: wandb_internal.Response.network_status_response:type_name -> wandb_internal.NetworkStatusResponse
-	74,  // 130: wandb_internal.Response.login_response:type_name -> wandb_internal.LoginResponse
-	76,  // 131: wandb_internal.Response.get_summary_response:type_name -> wandb_internal.GetSummaryResponse
-	92,  // 132: wandb_internal.Response.poll_exit_response:type_name -> wandb_internal.PollExitResponse
-	126, // 133: wandb_internal.Response.sampled_history_response:type_name -> wandb_internal.SampledHistoryResponse
-	123, // 134: wandb_internal.Response.history_step_response:type_name -> wandb_internal.HistoryStepResponse
-	130, // 135: wandb_internal.Response.run_start_response:type_name -> wandb_internal.RunStartResponse
-	132, // 136: wandb_internal.Response.check_version_response:type_name -> wandb_internal.CheckVersionResponse
-	136, // 137: wandb_internal.Response.log_artifact_response:type_name -> wandb_internal.LogArtifactResponse
-	138, // 138: wandb_internal.Response.download_artifact_response:type_name -> wandb_internal.DownloadArtifactResponse
-	128, // 139: wandb_internal.Response.run_status_response:type_name -> wandb_internal.RunStatusResponse
-	151, // 140: wandb_internal.Response.cancel_response:type_name -> wandb_internal.CancelResponse
-	89,  // 141: wandb_internal.Response.internal_messages_response:type_name -> wandb_internal.InternalMessagesResponse
-	114, // 142: wandb_internal.Response.shutdown_response:type_name -> wandb_internal.ShutdownResponse
-	116, // 143: wandb_internal.Response.attach_response:type_name -> wandb_internal.AttachResponse
-	82,  // 144: wandb_internal.Response.status_response:type_name -> wandb_internal.StatusResponse
-	105, // 145: wandb_internal.Response.server_info_response:type_name -> wandb_internal.ServerInfoResponse
-	134, // 146: wandb_internal.Response.job_info_response:type_name -> wandb_internal.JobInfoResponse
-	80,  // 147: wandb_internal.Response.get_system_metrics_response:type_name -> wandb_internal.GetSystemMetricsResponse
-	61,  // 148: wandb_internal.Response.link_artifact_response:type_name -> wandb_internal.LinkArtifactResponse
-	99,  // 149: wandb_internal.Response.sync_response:type_name -> wandb_internal.SyncResponse
-	94,  // 150: wandb_internal.Response.operations_response:type_name -> wandb_internal.OperationStatsResponse
-	118, // 151: wandb_internal.Response.test_inject_response:type_name -> wandb_internal.TestInjectResponse
-	8,   // 152: wandb_internal.DeferRequest.state:type_name -> wandb_internal.DeferRequest.DeferState
-	178, // 153: wandb_internal.PauseRequest._info:type_name -> wandb_internal._RequestInfo
-	178, // 154: wandb_internal.ResumeRequest._info:type_name -> wandb_internal._RequestInfo
-	178, // 155: wandb_internal.LoginRequest._info:type_name -> wandb_internal._RequestInfo
-	178, // 156: wandb_internal.GetSummaryRequest._info:type_name -> wandb_internal._RequestInfo
-	46,  // 157: wandb_internal.GetSummaryResponse.item:type_name -> wandb_internal.SummaryItem
-	178, // 158: wandb_internal.GetSystemMetricsRequest._info:type_name -> wandb_internal._RequestInfo
-	177, // 159: wandb_internal.SystemMetricSample.timestamp:type_name -> google.protobuf.Timestamp
-	78,  // 160: wandb_internal.SystemMetricsBuffer.record:type_name -> wandb_internal.SystemMetricSample
-	167, // 161: wandb_internal.GetSystemMetricsResponse.system_metrics:type_name -> wandb_internal.GetSystemMetricsResponse.SystemMetricsEntry
-	178, // 162: wandb_internal.StatusRequest._info:type_name -> wandb_internal._RequestInfo
-	178, // 163: wandb_internal.StopStatusRequest._info:type_name -> wandb_internal._RequestInfo
-	178, // 164: wandb_internal.NetworkStatusRequest._info:type_name -> wandb_internal._RequestInfo
-	87,  // 165: wandb_internal.NetworkStatusResponse.network_responses:type_name -> wandb_internal.HttpResponse
-	178, // 166: wandb_internal.InternalMessagesRequest._info:type_name -> wandb_internal._RequestInfo
-	90,  // 167: wandb_internal.InternalMessagesResponse.messages:type_name -> wandb_internal.InternalMessages
-	178, // 168: wandb_internal.PollExitRequest._info:type_name -> wandb_internal._RequestInfo
-	23,  // 169: wandb_internal.PollExitResponse.exit_result:type_name -> wandb_internal.RunExitResult
-	109, // 170: wandb_internal.PollExitResponse.pusher_stats:type_name -> wandb_internal.FilePusherStats
-	108, // 171: wandb_internal.PollExitResponse.file_counts:type_name -> wandb_internal.FileCounts
-	95,  // 172: wandb_internal.PollExitResponse.operation_stats:type_name -> wandb_internal.OperationStats
-	178, // 173: wandb_internal.OperationStatsRequest._info:type_name -> wandb_internal._RequestInfo
-	95,  // 174: wandb_internal.OperationStatsResponse.operation_stats:type_name -> wandb_internal.OperationStats
-	96,  // 175: wandb_internal.OperationStats.operations:type_name -> wandb_internal.Operation
-	96,  // 176: wandb_internal.Operation.subtasks:type_name -> wandb_internal.Operation
-	21,  // 177: wandb_internal.SyncResponse.error:type_name -> wandb_internal.ErrorInfo
-	177, // 178: wandb_internal.StatusReportRequest.sync_time:type_name -> google.protobuf.Timestamp
-	45,  // 179: wandb_internal.SummaryRecordRequest.summary:type_name -> wandb_internal.SummaryRecord
-	173, // 180: wandb_internal.TelemetryRecordRequest.telemetry:type_name -> wandb_internal.TelemetryRecord
-	178, // 181: wandb_internal.ServerInfoRequest._info:type_name -> wandb_internal._RequestInfo
-	112, // 182: wandb_internal.ServerInfoResponse.local_info:type_name -> wandb_internal.LocalInfo
-	106, // 183: wandb_internal.ServerInfoResponse.server_messages:type_name -> wandb_internal.ServerMessages
-	107, // 184: wandb_internal.ServerMessages.item:type_name -> wandb_internal.ServerMessage
-	9,   // 185: wandb_internal.FileTransferInfoRequest.type:type_name -> wandb_internal.FileTransferInfoRequest.TransferType
-	108, // 186: wandb_internal.FileTransferInfoRequest.file_counts:type_name -> wandb_internal.FileCounts
-	178, // 187: wandb_internal.ShutdownRequest._info:type_name -> wandb_internal._RequestInfo
-	178, // 188: wandb_internal.AttachRequest._info:type_name -> wandb_internal._RequestInfo
-	18,  // 189: wandb_internal.AttachResponse.run:type_name -> wandb_internal.RunRecord
-	21,  // 190: wandb_internal.AttachResponse.error:type_name -> wandb_internal.ErrorInfo
-	178, // 191: wandb_internal.TestInjectRequest._info:type_name -> wandb_internal._RequestInfo
-	30,  // 192: wandb_internal.PartialHistoryRequest.item:type_name -> wandb_internal.HistoryItem
-	28,  // 193: wandb_internal.PartialHistoryRequest.step:type_name -> wandb_internal.HistoryStep
-	119, // 194: wandb_internal.PartialHistoryRequest.action:type_name -> wandb_internal.HistoryAction
-	178, // 195: wandb_internal.PartialHistoryRequest._info:type_name -> wandb_internal._RequestInfo
-	178, // 196: wandb_internal.SampledHistoryRequest._info:type_name -> wandb_internal._RequestInfo
-	125, // 197: wandb_internal.SampledHistoryResponse.item:type_name -> wandb_internal.SampledHistoryItem
-	178, // 198: wandb_internal.RunStatusRequest._info:type_name -> wandb_internal._RequestInfo
-	177, // 199: wandb_internal.RunStatusResponse.sync_time:type_name -> google.protobuf.Timestamp
-	18,  // 200: wandb_internal.RunStartRequest.run:type_name -> wandb_internal.RunRecord
-	178, // 201: wandb_internal.RunStartRequest._info:type_name -> wandb_internal._RequestInfo
-	178, // 202: wandb_internal.CheckVersionRequest._info:type_name -> wandb_internal._RequestInfo
-	178, // 203: wandb_internal.JobInfoRequest._info:type_name -> wandb_internal._RequestInfo
-	53,  // 204: wandb_internal.LogArtifactRequest.artifact:type_name -> wandb_internal.ArtifactRecord
-	178, // 205: wandb_internal.LogArtifactRequest._info:type_name -> wandb_internal._RequestInfo
-	178, // 206: wandb_internal.DownloadArtifactRequest._info:type_name -> wandb_internal._RequestInfo
-	178, // 207: wandb_internal.KeepaliveRequest._info:type_name -> wandb_internal._RequestInfo
-	142, // 208: wandb_internal.GitSource.git_info:type_name -> wandb_internal.GitInfo
-	143, // 209: wandb_internal.Source.git:type_name -> wandb_internal.GitSource
-	141, // 210: wandb_internal.Source.artifact:type_name -> wandb_internal.ArtifactInfo
-	144, // 211: wandb_internal.Source.image:type_name -> wandb_internal.ImageSource
-	145, // 212: wandb_internal.JobSource.source:type_name -> wandb_internal.Source
-	146, // 213: wandb_internal.PartialJobArtifact.source_info:type_name -> wandb_internal.JobSource
-	147, // 214: wandb_internal.UseArtifactRecord.partial:type_name -> wandb_internal.PartialJobArtifact
-	175, // 215: wandb_internal.UseArtifactRecord._info:type_name -> wandb_internal._RecordInfo
-	178, // 216: wandb_internal.CancelRequest._info:type_name -> wandb_internal._RequestInfo
-	177, // 217: wandb_internal.EnvironmentRecord.started_at:type_name -> google.protobuf.Timestamp
-	19,  // 218: wandb_internal.EnvironmentRecord.git:type_name -> wandb_internal.GitRepoRecord
-	168, // 219: wandb_internal.EnvironmentRecord.disk:type_name -> wandb_internal.EnvironmentRecord.DiskEntry
-	154, // 220: wandb_internal.EnvironmentRecord.memory:type_name -> wandb_internal.MemoryInfo
-	155, // 221: wandb_internal.EnvironmentRecord.cpu:type_name -> wandb_internal.CpuInfo
-	156, // 222: wandb_internal.EnvironmentRecord.apple:type_name -> wandb_internal.AppleInfo
-	157, // 223: wandb_internal.EnvironmentRecord.gpu_nvidia:type_name -> wandb_internal.GpuNvidiaInfo
-	158, // 224: wandb_internal.EnvironmentRecord.gpu_amd:type_name -> wandb_internal.GpuAmdInfo
-	169, // 225: wandb_internal.EnvironmentRecord.slurm:type_name -> wandb_internal.EnvironmentRecord.SlurmEntry
-	159, // 226: wandb_internal.EnvironmentRecord.trainium:type_name -> wandb_internal.TrainiumInfo
-	160, // 227: wandb_internal.EnvironmentRecord.tpu:type_name -> wandb_internal.TPUInfo
-	161, // 228: wandb_internal.EnvironmentRecord.coreweave:type_name -> wandb_internal.CoreWeaveInfo
-	175, // 229: wandb_internal.EnvironmentRecord._info:type_name -> wandb_internal._RecordInfo
-	170, // 230: wandb_internal.PythonPackagesRequest.package:type_name -> wandb_internal.PythonPackagesRequest.PythonPackage
-	171, // 231: wandb_internal.JobInputSource.run_config:type_name -> wandb_internal.JobInputSource.RunConfigSource
-	172, // 232: wandb_internal.JobInputSource.file:type_name -> wandb_internal.JobInputSource.ConfigFileSource
-	165, // 233: wandb_internal.JobInputRequest.input_source:type_name -> wandb_internal.JobInputSource
-	164, // 234: wandb_internal.JobInputRequest.include_paths:type_name -> wandb_internal.JobInputPath
-	164, // 235: wandb_internal.JobInputRequest.exclude_paths:type_name -> wandb_internal.JobInputPath
-	79,  // 236: wandb_internal.GetSystemMetricsResponse.SystemMetricsEntry.value:type_name -> wandb_internal.SystemMetricsBuffer
-	153, // 237: wandb_internal.EnvironmentRecord.DiskEntry.value:type_name -> wandb_internal.DiskInfo
-	238, // [238:238] is the sub-list for method output_type
-	238, // [238:238] is the sub-list for method input_type
-	238, // [238:238] is the sub-list for extension type_name
-	238, // [238:238] is the sub-list for extension extendee
-	0,   // [0:238] is the sub-list for field type_name
+	99,  // 123: wandb_internal.Request.sync_finish:type_name -> wandb_internal.SyncFinishRequest
+	94,  // 124: wandb_internal.Request.operations:type_name -> wandb_internal.OperationStatsRequest
+	153, // 125: wandb_internal.Request.probe_system_info:type_name -> wandb_internal.ProbeSystemInfoRequest
+	73,  // 126: wandb_internal.Request.capture_profile:type_name -> wandb_internal.CaptureProfileRequest
+	118, // 127: wandb_internal.Request.test_inject:type_name -> wandb_internal.TestInjectRequest
+	141, // 128: wandb_internal.Response.keepalive_response:type_name -> wandb_internal.KeepaliveResponse
+	85,  // 129: wandb_internal.Response.stop_status_response:type_name -> wandb_internal.StopStatusResponse
+	87,  // 130: wandb_internal.Response.network_status_response:type_name -> wandb_internal.NetworkStatusResponse
+	75,  // 131: wandb_internal.Response.login_response:type_name -> wandb_internal.LoginResponse
+	77,  // 132: wandb_internal.Response.get_summary_response:type_name -> wandb_internal.GetSummaryResponse
+	93,  // 133: wandb_internal.Response.poll_exit_response:type_name -> wandb_internal.PollExitResponse
+	127, // 134: wandb_internal.Response.sampled_history_response:type_name -> wandb_internal.SampledHistoryResponse
+	124, // 135: wandb_internal.Response.history_step_response:type_name -> wandb_internal.HistoryStepResponse
+	131, // 136: wandb_internal.Response.run_start_response:type_name -> wandb_internal.RunStartResponse
+	133, // 137: wandb_internal.Response.check_version_response:type_name -> wandb_internal.CheckVersionResponse
+	137, // 138: wandb_internal.Response.log_artifact_response:type_name -> wandb_internal.LogArtifactResponse
+	139, // 139: wandb_internal.Response.download_artifact_response:type_name -> wandb_internal.DownloadArtifactResponse
+	129, // 140: wandb_internal.Response.run_status_response:type_name -> wandb_internal.RunStatusResponse
+	152, // 141: wandb_internal.Response.cancel_response:type_name -> wandb_internal.CancelResponse
+	90,  // 142: wandb_internal.Response.internal_messages_response:type_name -> wandb_internal.InternalMessagesResponse
+	115, // 143: wandb_internal.Response.shutdown_response:type_name -> wandb_internal.ShutdownResponse
+	117, // 144: wandb_internal.Response.attach_response:type_name -> wandb_internal.AttachResponse
+	83,  // 145: wandb_internal.Response.status_response:type_name -> wandb_internal.StatusResponse
+	106, // 146: wandb_internal.Response.server_info_response:type_name -> wandb_internal.ServerInfoResponse
+	135, // 147: wandb_internal.Response.job_info_response:type_name -> wandb_internal.JobInfoResponse
+	81,  // 148: wandb_internal.Response.get_system_metrics_response:type_name -> wandb_internal.GetSystemMetricsResponse
+	61,  // 149: wandb_internal.Response.link_artifact_response:type_name -> wandb_internal.LinkArtifactResponse
+	100, // 150: wandb_internal.Response.sync_response:type_name -> wandb_internal.SyncResponse
+	95,  // 151: wandb_internal.Response.operations_response:type_name -> wandb_internal.OperationStatsResponse
+	119, // 152: wandb_internal.Response.test_inject_response:type_name -> wandb_internal.TestInjectResponse
+	8,   // 153: wandb_internal.DeferRequest.state:type_name -> wandb_internal.DeferRequest.DeferState
+	179, // 154: wandb_internal.PauseRequest._info:type_name -> wandb_internal._RequestInfo
+	179, // 155: wandb_internal.ResumeRequest._info:type_name -> wandb_internal._RequestInfo
+	179, // 156: wandb_internal.CaptureProfileRequest._info:type_name -> wandb_internal._RequestInfo
+	179, // 157: wandb_internal.LoginRequest._info:type_name -> wandb_internal._RequestInfo
+	179, // 158: wandb_internal.GetSummaryRequest._info:type_name -> wandb_internal._RequestInfo
+	46,  // 159: wandb_internal.GetSummaryResponse.item:type_name -> wandb_internal.SummaryItem
+	179, // 160: wandb_internal.GetSystemMetricsRequest._info:type_name -> wandb_internal._RequestInfo
+	178, // 161: wandb_internal.SystemMetricSample.timestamp:type_name -> google.protobuf.Timestamp
+	79,  // 162: wandb_internal.SystemMetricsBuffer.record:type_name -> wandb_internal.SystemMetricSample
+	168, // 163: wandb_internal.GetSystemMetricsResponse.system_metrics:type_name -> wandb_internal.GetSystemMetricsResponse.SystemMetricsEntry
+	179, // 164: wandb_internal.StatusRequest._info:type_name -> wandb_internal._RequestInfo
+	179, // 165: wandb_internal.StopStatusRequest._info:type_name -> wandb_internal._RequestInfo
+	179, // 166: wandb_internal.NetworkStatusRequest._info:type_name -> wandb_internal._RequestInfo
+	88,  // 167: wandb_internal.NetworkStatusResponse.network_responses:type_name -> wandb_internal.HttpResponse
+	179, // 168: wandb_internal.InternalMessagesRequest._info:type_name -> wandb_internal._RequestInfo
+	91,  // 169: wandb_internal.InternalMessagesResponse.messages:type_name -> wandb_internal.InternalMessages
+	179, // 170: wandb_internal.PollExitRequest._info:type_name -> wandb_internal._RequestInfo
+	23,  // 171: wandb_internal.PollExitResponse.exit_result:type_name -> wandb_internal.RunExitResult
+	110, // 172: wandb_internal.PollExitResponse.pusher_stats:type_name -> wandb_internal.FilePusherStats
+	109, // 173: wandb_internal.PollExitResponse.file_counts:type_name -> wandb_internal.FileCounts
+	96,  // 174: wandb_internal.PollExitResponse.operation_stats:type_name -> wandb_internal.OperationStats
+	179, // 175: wandb_internal.OperationStatsRequest._info:type_name -> wandb_internal._RequestInfo
+	96,  // 176: wandb_internal.OperationStatsResponse.operation_stats:type_name -> wandb_internal.OperationStats
+	97,  // 177: wandb_internal.OperationStats.operations:type_name -> wandb_internal.Operation
+	97,  // 178: wandb_internal.Operation.subtasks:type_name -> wandb_internal.Operation
+	21,  // 179: wandb_internal.SyncResponse.error:type_name -> wandb_internal.ErrorInfo
+	178, // 180: wandb_internal.StatusReportRequest.sync_time:type_name -> google.protobuf.Timestamp
+	45,  // 181: wandb_internal.SummaryRecordRequest.summary:type_name -> wandb_internal.SummaryRecord
+	174, // 182: wandb_internal.TelemetryRecordRequest.telemetry:type_name -> wandb_internal.TelemetryRecord
+	179, // 183: wandb_internal.ServerInfoRequest._info:type_name -> wandb_internal._RequestInfo
+	113, // 184: wandb_internal.ServerInfoResponse.local_info:type_name -> wandb_internal.LocalInfo
+	107, // 185: wandb_internal.ServerInfoResponse.server_messages:type_name -> wandb_internal.ServerMessages
+	108, // 186: wandb_internal.ServerMessages.item:type_name -> wandb_internal.ServerMessage
+	9,   // 187: wandb_internal.FileTransferInfoRequest.type:type_name -> wandb_internal.FileTransferInfoRequest.TransferType
+	109, // 188: wandb_internal.FileTransferInfoRequest.file_counts:type_name -> wandb_internal.FileCounts
+	179, // 189: wandb_internal.ShutdownRequest._info:type_name -> wandb_internal._RequestInfo
+	179, // 190: wandb_internal.AttachRequest._info:type_name -> wandb_internal._RequestInfo
+	18,  // 191: wandb_internal.AttachResponse.run:type_name -> wandb_internal.RunRecord
+	21,  // 192: wandb_internal.AttachResponse.error:type_name -> wandb_internal.ErrorInfo
+	179, // 193: wandb_internal.TestInjectRequest._info:type_name -> wandb_internal._RequestInfo
+	30,  // 194: wandb_internal.PartialHistoryRequest.item:type_name -> wandb_internal.HistoryItem
+	28,  // 195: wandb_internal.PartialHistoryRequest.step:type_name -> wandb_internal.HistoryStep
+	120, // 196: wandb_internal.PartialHistoryRequest.action:type_name -> wandb_internal.HistoryAction
+	179, // 197: wandb_internal.PartialHistoryRequest._info:type_name -> wandb_internal._RequestInfo
+	179, // 198: wandb_internal.SampledHistoryRequest._info:type_name -> wandb_internal._RequestInfo
+	126, // 199: wandb_internal.SampledHistoryResponse.item:type_name -> wandb_internal.SampledHistoryItem
+	179, // 200: wandb_internal.RunStatusRequest._info:type_name -> wandb_internal._RequestInfo
+	178, // 201: wandb_internal.RunStatusResponse.sync_time:type_name -> google.protobuf.Timestamp
+	18,  // 202: wandb_internal.RunStartRequest.run:type_name -> wandb_internal.RunRecord
+	179, // 203: wandb_internal.RunStartRequest._info:type_name -> wandb_internal._RequestInfo
+	179, // 204: wandb_internal.CheckVersionRequest._info:type_name -> wandb_internal._RequestInfo
+	179, // 205: wandb_internal.JobInfoRequest._info:type_name -> wandb_internal._RequestInfo
+	53,  // 206: wandb_internal.LogArtifactRequest.artifact:type_name -> wandb_internal.ArtifactRecord
+	179, // 207: wandb_internal.LogArtifactRequest._info:type_name -> wandb_internal._RequestInfo
+	179, // 208: wandb_internal.DownloadArtifactRequest._info:type_name -> wandb_internal._RequestInfo
+	179, // 209: wandb_internal.KeepaliveRequest._info:type_name -> wandb_internal._RequestInfo
+	143, // 210: wandb_internal.GitSource.git_info:type_name -> wandb_internal.GitInfo
+	144, // 211: wandb_internal.Source.git:type_name -> wandb_internal.GitSource
+	142, // 212: wandb_internal.Source.artifact:type_name -> wandb_internal.ArtifactInfo
+	145, // 213: wandb_internal.Source.image:type_name -> wandb_internal.ImageSource
+	146, // 214: wandb_internal.JobSource.source:type_name -> wandb_internal.Source
+	147, // 215: wandb_internal.PartialJobArtifact.source_info:type_name -> wandb_internal.JobSource
+	148, // 216: wandb_internal.UseArtifactRecord.partial:type_name -> wandb_internal.PartialJobArtifact
+	176, // 217: wandb_internal.UseArtifactRecord._info:type_name -> wandb_internal._RecordInfo
+	179, // 218: wandb_internal.CancelRequest._info:type_name -> wandb_internal._RequestInfo
+	178, // 219: wandb_internal.EnvironmentRecord.started_at:type_name -> google.protobuf.Timestamp
+	19,  // 220: wandb_internal.EnvironmentRecord.git:type_name -> wandb_internal.GitRepoRecord
+	169, // 221: wandb_internal.EnvironmentRecord.disk:type_name -> wandb_internal.EnvironmentRecord.DiskEntry
+	155, // 222: wandb_internal.EnvironmentRecord.memory:type_name -> wandb_internal.MemoryInfo
+	156, // 223: wandb_internal.EnvironmentRecord.cpu:type_name -> wandb_internal.CpuInfo
+	157, // 224: wandb_internal.EnvironmentRecord.apple:type_name -> wandb_internal.AppleInfo
+	158, // 225: wandb_internal.EnvironmentRecord.gpu_nvidia:type_name -> wandb_internal.GpuNvidiaInfo
+	159, // 226: wandb_internal.EnvironmentRecord.gpu_amd:type_name -> wandb_internal.GpuAmdInfo
+	170, // 227: wandb_internal.EnvironmentRecord.slurm:type_name -> wandb_internal.EnvironmentRecord.SlurmEntry
+	160, // 228: wandb_internal.EnvironmentRecord.trainium:type_name -> wandb_internal.TrainiumInfo
+	161, // 229: wandb_internal.EnvironmentRecord.tpu:type_name -> wandb_internal.TPUInfo
+	162, // 230: wandb_internal.EnvironmentRecord.coreweave:type_name -> wandb_internal.CoreWeaveInfo
+	176, // 231: wandb_internal.EnvironmentRecord._info:type_name -> wandb_internal._RecordInfo
+	171, // 232: wandb_internal.PythonPackagesRequest.package:type_name -> wandb_internal.PythonPackagesRequest.PythonPackage
+	172, // 233: wandb_internal.JobInputSource.run_config:type_name -> wandb_internal.JobInputSource.RunConfigSource
+	173, // 234: wandb_internal.JobInputSource.file:type_name -> wandb_internal.JobInputSource.ConfigFileSource
+	166, // 235: wandb_internal.JobInputRequest.input_source:type_name -> wandb_internal.JobInputSource
+	165, // 236: wandb_internal.JobInputRequest.include_paths:type_name -> wandb_internal.JobInputPath
+	165, // 237: wandb_internal.JobInputRequest.exclude_paths:type_name -> wandb_internal.JobInputPath
+	80,  // 238: wandb_internal.GetSystemMetricsResponse.SystemMetricsEntry.value:type_name -> wandb_internal.SystemMetricsBuffer
+	154, // 239: wandb_internal.EnvironmentRecord.DiskEntry.value:type_name -> wandb_internal.DiskInfo
+	240, // [240:240] is the sub-list for method output_type
+	240, // [240:240] is the sub-list for method input_type
+	240, // [240:240] is the sub-list for extension type_name
+	240, // [240:240] is the sub-list for extension extendee
+	0,   // [0:240] is the sub-list for field type_name
 }
 
 func init() { file_wandb_proto_wandb_internal_proto_init() }
@@ -13269,6 +13361,7 @@ func file_wandb_proto_wandb_internal_proto_init() {
 		(*Request_SyncFinish)(nil),
 		(*Request_Operations)(nil),
 		(*Request_ProbeSystemInfo)(nil),
+		(*Request_CaptureProfile)(nil),
 		(*Request_TestInject)(nil),
 	}
 	file_wandb_proto_wandb_internal_proto_msgTypes[57].OneofWrappers = []any{
@@ -13298,7 +13391,7 @@ func file_wandb_proto_wandb_internal_proto_init() {
 		(*Response_OperationsResponse)(nil),
 		(*Response_TestInjectResponse)(nil),
 	}
-	file_wandb_proto_wandb_internal_proto_msgTypes[155].OneofWrappers = []any{
+	file_wandb_proto_wandb_internal_proto_msgTypes[156].OneofWrappers = []any{
 		(*JobInputSource_RunConfig)(nil),
 		(*JobInputSource_File)(nil),
 	}
@@ -13308,7 +13401,7 @@ func file_wandb_proto_wandb_internal_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_wandb_proto_wandb_internal_proto_rawDesc), len(file_wandb_proto_wandb_internal_proto_rawDesc)),
 			NumEnums:      10,
-			NumMessages:   163,
+			NumMessages:   164,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -353,7 +353,7 @@ func (x *RunMoment) GetMetric() string {
 //
 // Some fields such as `run_id` only make sense at the run level.
 //
-// Next ID: 209
+// Next ID: 229
 type Settings struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// The W&B API key.
@@ -392,6 +392,12 @@ type Settings struct {
 	//
 	// If not set to a positive number, there is no timeout.
 	FinishTimeout *wrapperspb.DoubleValue `protobuf:"bytes,206,opt,name=finish_timeout,json=finishTimeout,proto3" json:"finish_timeout,omitempty"`
+	// Seconds the W&B server can be unreachable before an online run switches
+	// to offline mode, writing only its transaction log until the server is
+	// reachable again.
+	//
+	// If not set to a positive number, the run never switches.
+	OfflineFallbackTimeout *wrapperspb.DoubleValue `protobuf:"bytes,219,opt,name=offline_fallback_timeout,json=offlineFallbackTimeout,proto3" json:"offline_fallback_timeout,omitempty"`
 	// The start time of the run in seconds since the Unix epoch.
 	XStartTime *wrapperspb.DoubleValue `protobuf:"bytes,41,opt,name=x_start_time,json=xStartTime,proto3" json:"x_start_time,omitempty"`
 	// The root directory that will be used to derive other paths.
@@ -409,6 +415,18 @@ type Settings struct {
 	LogInternal *wrapperspb.StringValue `protobuf:"bytes,86,opt,name=log_internal,json=logInternal,proto3" json:"log_internal,omitempty"`
 	// Unix glob patterns relative to `files_dir` to not upload.
 	IgnoreGlobs *ListStringValue `protobuf:"bytes,78,opt,name=ignore_globs,json=ignoreGlobs,proto3" json:"ignore_globs,omitempty"`
+	// Dotted path patterns of config, summary and metadata values to remove
+	// before uploading, such as "config.db.password" or "metadata.host".
+	//
+	// Patterns that don't start with "config", "summary" or "metadata"
+	// match keys at any depth, such as "*token*".
+	RedactDrop *ListStringValue `protobuf:"bytes,216,opt,name=redact_drop,json=redactDrop,proto3" json:"redact_drop,omitempty"`
+	// Dotted path patterns of config, summary and metadata values to replace
+	// with their SHA-256 hash before uploading.
+	RedactHash *ListStringValue `protobuf:"bytes,217,opt,name=redact_hash,json=redactHash,proto3" json:"redact_hash,omitempty"`
+	// Dotted path patterns of values to upload unchanged even if they match
+	// `redact_drop` or `redact_hash`.
+	RedactKeep *ListStringValue `protobuf:"bytes,218,opt,name=redact_keep,json=redactKeep,proto3" json:"redact_keep,omitempty"`
 	// The URL for the W&B UI.
 	AppUrl *wrapperspb.StringValue `protobuf:"bytes,202,opt,name=app_url,json=appUrl,proto3" json:"app_url,omitempty"`
 	// The URL for the W&B backend.
@@ -441,6 +459,18 @@ type Settings struct {
 	// Note: this value should not be set unless you have clear understanding of
 	// the impact on the back-end.
 	XFileStreamMaxLineBytes *wrapperspb.Int32Value `protobuf:"bytes,178,opt,name=x_file_stream_max_line_bytes,json=xFileStreamMaxLineBytes,proto3" json:"x_file_stream_max_line_bytes,omitempty"`
+	// Number of history values per second above which uploaded history is
+	// thinned.
+	//
+	// A value is one key in one history row. The transaction log always keeps
+	// every row. Zero or unset disables throttling.
+	XHistoryUploadBudget *wrapperspb.Int32Value `protobuf:"bytes,211,opt,name=x_history_upload_budget,json=xHistoryUploadBudget,proto3" json:"x_history_upload_budget,omitempty"`
+	// How history is thinned when over the upload budget.
+	//
+	// Either "stride", which uploads every Nth value of each key, or "buckets",
+	// which uploads the rows with each key's minimum, maximum and last value
+	// in short time buckets.
+	XHistoryUploadThrottle *wrapperspb.StringValue `protobuf:"bytes,212,opt,name=x_history_upload_throttle,json=xHistoryUploadThrottle,proto3" json:"x_history_upload_throttle,omitempty"`
 	// Maximum number of retries for file upload/download operations.
 	XFileTransferRetryMax *wrapperspb.Int32Value `protobuf:"bytes,150,opt,name=x_file_transfer_retry_max,json=xFileTransferRetryMax,proto3" json:"x_file_transfer_retry_max,omitempty"`
 	// Initial wait in-between file upload/download retries.
@@ -541,6 +571,14 @@ type Settings struct {
 	XDisableMeta *wrapperspb.BoolValue `protobuf:"bytes,7,opt,name=x_disable_meta,json=xDisableMeta,proto3" json:"x_disable_meta,omitempty"`
 	// Whether to save the code used to create the run.
 	SaveCode *wrapperspb.BoolValue `protobuf:"bytes,115,opt,name=save_code,json=saveCode,proto3" json:"save_code,omitempty"`
+	// Whether to snapshot the full source tree into a code artifact.
+	//
+	// The snapshot covers the git root, or the program's directory when the
+	// program is not in a git repository. Files ignored by .gitignore are
+	// skipped, and untracked files that are not ignored are included.
+	SaveCodeSnapshot *wrapperspb.BoolValue `protobuf:"bytes,209,opt,name=save_code_snapshot,json=saveCodeSnapshot,proto3" json:"save_code_snapshot,omitempty"`
+	// Maximum total size of a source snapshot, in bytes.
+	SaveCodeSnapshotMaxBytes *wrapperspb.Int64Value `protobuf:"bytes,210,opt,name=save_code_snapshot_max_bytes,json=saveCodeSnapshotMaxBytes,proto3" json:"save_code_snapshot_max_bytes,omitempty"`
 	// Whether to stop the run after an error that prevents further uploads.
 	StopOnFatalError *wrapperspb.BoolValue `protobuf:"bytes,205,opt,name=stop_on_fatal_error,json=stopOnFatalError,proto3" json:"stop_on_fatal_error,omitempty"`
 	// Whether to disable git capture and diff generation.
@@ -599,6 +637,22 @@ type Settings struct {
 	XStatsTrackProcessTree *wrapperspb.BoolValue `protobuf:"bytes,198,opt,name=x_stats_track_process_tree,json=xStatsTrackProcessTree,proto3" json:"x_stats_track_process_tree,omitempty"`
 	// Whether to skip cgroup CPU and memory limits for system metric percentages.
 	XStatsNoCgroup *wrapperspb.BoolValue `protobuf:"bytes,207,opt,name=x_stats_no_cgroup,json=xStatsNoCgroup,proto3" json:"x_stats_no_cgroup,omitempty"`
+	// Glob patterns of network interfaces for which to report per-interface
+	// send and receive rates. No per-interface rates are reported if unset.
+	XStatsNetworkInterfaces *ListStringValue `protobuf:"bytes,223,opt,name=x_stats_network_interfaces,json=xStatsNetworkInterfaces,proto3" json:"x_stats_network_interfaces,omitempty"`
+	// Glob patterns of network interfaces to leave out of per-interface rates.
+	XStatsNetworkInterfacesExclude *ListStringValue `protobuf:"bytes,224,opt,name=x_stats_network_interfaces_exclude,json=xStatsNetworkInterfacesExclude,proto3" json:"x_stats_network_interfaces_exclude,omitempty"`
+	// Glob patterns of disk devices for which to report per-device read and
+	// write rates. If unset, the devices backing `x_stats_disk_paths` are used.
+	XStatsDiskDevices *ListStringValue `protobuf:"bytes,225,opt,name=x_stats_disk_devices,json=xStatsDiskDevices,proto3" json:"x_stats_disk_devices,omitempty"`
+	// Glob patterns of disk devices to leave out of per-device rates.
+	XStatsDiskDevicesExclude *ListStringValue `protobuf:"bytes,226,opt,name=x_stats_disk_devices_exclude,json=xStatsDiskDevicesExclude,proto3" json:"x_stats_disk_devices_exclude,omitempty"`
+	// The number of processes in the monitored process tree for which to log
+	// periodic resource usage snapshots to a run file. Disabled if not positive.
+	XStatsTopProcesses *wrapperspb.Int32Value `protobuf:"bytes,227,opt,name=x_stats_top_processes,json=xStatsTopProcesses,proto3" json:"x_stats_top_processes,omitempty"`
+	// Whether to log wandb-core's internal state, such as its upload backlog,
+	// request latencies and rate limits, as `_wandb/core.*` system metrics.
+	XStatsCoreMetrics *wrapperspb.BoolValue `protobuf:"bytes,228,opt,name=x_stats_core_metrics,json=xStatsCoreMetrics,proto3" json:"x_stats_core_metrics,omitempty"`
 	// Label to assign to system metrics and console logs collected for the run
 	// to group by on the frontend. Can be used to distinguish data from different
 	// processes in a distributed training job.
@@ -646,6 +700,24 @@ type Settings struct {
 	ConsoleChunkMaxBytes *wrapperspb.Int32Value `protobuf:"bytes,199,opt,name=console_chunk_max_bytes,json=consoleChunkMaxBytes,proto3" json:"console_chunk_max_bytes,omitempty"`
 	// Time-based rollover threshold for multipart console logs, in seconds.
 	ConsoleChunkMaxSeconds *wrapperspb.Int32Value `protobuf:"bytes,201,opt,name=console_chunk_max_seconds,json=consoleChunkMaxSeconds,proto3" json:"console_chunk_max_seconds,omitempty"`
+	// Log formats to recognize in console output.
+	//
+	// Recognized lines are saved with their level, logger and message to a
+	// JSON Lines file next to the console log, and the number of lines at
+	// each level is recorded in the run summary under `_console/`.
+	// Supported formats are "python", "json" and "glog".
+	ConsoleLogParsers *ListStringValue `protobuf:"bytes,213,opt,name=console_log_parsers,json=consoleLogParsers,proto3" json:"console_log_parsers,omitempty"`
+	// Whether to mask secrets in console output before it is saved or uploaded.
+	//
+	// Built-in detectors find AWS keys, bearer tokens, W&B API keys and
+	// signatures in pre-signed URLs. Matches are replaced by "[REDACTED]" and
+	// counted in the run summary under `_console/redactions`.
+	ConsoleRedact *wrapperspb.BoolValue `protobuf:"bytes,214,opt,name=console_redact,json=consoleRedact,proto3" json:"console_redact,omitempty"`
+	// Additional regular expressions (Go RE2 syntax) to redact from console
+	// output. If a pattern has capture groups, only the groups are redacted.
+	//
+	// Setting this enables redaction even if `console_redact` is false.
+	ConsoleRedactPatterns *ListStringValue `protobuf:"bytes,215,opt,name=console_redact_patterns,json=consoleRedactPatterns,proto3" json:"console_redact_patterns,omitempty"`
 	// Whether to sync TensorBoard logs to W&B.
 	SyncTensorboard *wrapperspb.BoolValue `protobuf:"bytes,179,opt,name=sync_tensorboard,json=syncTensorboard,proto3" json:"sync_tensorboard,omitempty"`
 	// Whether to delegate automatic computation of summary from history to the server.
@@ -663,7 +735,11 @@ type Settings struct {
 	// The scheme and hostname for contacting the CoreWeave metadata server.
 	XStatsCoreweaveMetadataBaseUrl *wrapperspb.StringValue `protobuf:"bytes,192,opt,name=x_stats_coreweave_metadata_base_url,json=xStatsCoreweaveMetadataBaseUrl,proto3" json:"x_stats_coreweave_metadata_base_url,omitempty"`
 	// The relative path on the CoreWeave metadata server to which to make requests.
-	XStatsCoreweaveMetadataEndpoint *wrapperspb.StringValue  `protobuf:"bytes,193,opt,name=x_stats_coreweave_metadata_endpoint,json=xStatsCoreweaveMetadataEndpoint,proto3" json:"x_stats_coreweave_metadata_endpoint,omitempty"`
+	XStatsCoreweaveMetadataEndpoint *wrapperspb.StringValue `protobuf:"bytes,193,opt,name=x_stats_coreweave_metadata_endpoint,json=xStatsCoreweaveMetadataEndpoint,proto3" json:"x_stats_coreweave_metadata_endpoint,omitempty"`
+	// Whether the client prints the end-of-run footer itself.
+	//
+	// If not, wandb-core prints it when the client finishes the run.
+	XClientPrintsFooter             *wrapperspb.BoolValue    `protobuf:"bytes,222,opt,name=x_client_prints_footer,json=xClientPrintsFooter,proto3" json:"x_client_prints_footer,omitempty"`
 	XAwsLambda                      *wrapperspb.BoolValue    `protobuf:"bytes,2,opt,name=_aws_lambda,json=AwsLambda,proto3" json:"_aws_lambda,omitempty"`
 	XCliOnlyMode                    *wrapperspb.BoolValue    `protobuf:"bytes,4,opt,name=x_cli_only_mode,json=xCliOnlyMode,proto3" json:"x_cli_only_mode,omitempty"`
 	XColab                          *wrapperspb.BoolValue    `protobuf:"bytes,5,opt,name=_colab,json=Colab,proto3" json:"_colab,omitempty"`
@@ -714,6 +790,8 @@ type Settings struct {
 	LogSymlinkUser                  *wrapperspb.StringValue  `protobuf:"bytes,88,opt,name=log_symlink_user,json=logSymlinkUser,proto3" json:"log_symlink_user,omitempty"`
 	LogUser                         *wrapperspb.StringValue  `protobuf:"bytes,89,opt,name=log_user,json=logUser,proto3" json:"log_user,omitempty"`
 	LoginTimeout                    *wrapperspb.DoubleValue  `protobuf:"bytes,90,opt,name=login_timeout,json=loginTimeout,proto3" json:"login_timeout,omitempty"`
+	MaxEndOfRunHistoryMetrics       *wrapperspb.Int32Value   `protobuf:"bytes,220,opt,name=max_end_of_run_history_metrics,json=maxEndOfRunHistoryMetrics,proto3" json:"max_end_of_run_history_metrics,omitempty"`
+	MaxEndOfRunSummaryMetrics       *wrapperspb.Int32Value   `protobuf:"bytes,221,opt,name=max_end_of_run_summary_metrics,json=maxEndOfRunSummaryMetrics,proto3" json:"max_end_of_run_summary_metrics,omitempty"`
 	Mode                            *wrapperspb.StringValue  `protobuf:"bytes,92,opt,name=mode,proto3" json:"mode,omitempty"`
 	NotebookName                    *wrapperspb.StringValue  `protobuf:"bytes,93,opt,name=notebook_name,json=notebookName,proto3" json:"notebook_name,omitempty"`
 	ProjectUrl                      *wrapperspb.StringValue  `protobuf:"bytes,98,opt,name=project_url,json=projectUrl,proto3" json:"project_url,omitempty"`
@@ -884,6 +962,13 @@ func (x *Settings) GetFinishTimeout() *wrapperspb.DoubleValue {
 	return nil
 }
 
+func (x *Settings) GetOfflineFallbackTimeout() *wrapperspb.DoubleValue {
+	if x != nil {
+		return x.OfflineFallbackTimeout
+	}
+	return nil
+}
+
 func (x *Settings) GetXStartTime() *wrapperspb.DoubleValue {
 	if x != nil {
 		return x.XStartTime
@@ -926,6 +1011,27 @@ func (x *Settings) GetIgnoreGlobs() *ListStringValue {
 	return nil
 }
 
+func (x *Settings) GetRedactDrop() *ListStringValue {
+	if x != nil {
+		return x.RedactDrop
+	}
+	return nil
+}
+
+func (x *Settings) GetRedactHash() *ListStringValue {
+	if x != nil {
+		return x.RedactHash
+	}
+	return nil
+}
+
+func (x *Settings) GetRedactKeep() *ListStringValue {
+	if x != nil {
+		return x.RedactKeep
+	}
+	return nil
+}
+
 func (x *Settings) GetAppUrl() *wrapperspb.StringValue {
 	if x != nil {
 		return x.AppUrl
@@ -1003,6 +1109,20 @@ func (x *Settings) GetXFileStreamMaxLineBytes() *wrapperspb.Int32Value {
 	return nil
 }
 
+func (x *Settings) GetXHistoryUploadBudget() *wrapperspb.Int32Value {
+	if x != nil {
+		return x.XHistoryUploadBudget
+	}
+	return nil
+}
+
+func (x *Settings) GetXHistoryUploadThrottle() *wrapperspb.StringValue {
+	if x != nil {
+		return x.XHistoryUploadThrottle
+	}
+	return nil
+}
+
 func (x *Settings) GetXFileTransferRetryMax() *wrapperspb.Int32Value {
 	if x != nil {
 		return x.XFileTransferRetryMax
@@ -1227,6 +1347,20 @@ func (x *Settings) GetSaveCode() *wrapperspb.BoolValue {
 	return nil
 }
 
+func (x *Settings) GetSaveCodeSnapshot() *wrapperspb.BoolValue {
+	if x != nil {
+		return x.SaveCodeSnapshot
+	}
+	return nil
+}
+
+func (x *Settings) GetSaveCodeSnapshotMaxBytes() *wrapperspb.Int64Value {
+	if x != nil {
+		return x.SaveCodeSnapshotMaxBytes
+	}
+	return nil
+}
+
 func (x *Settings) GetStopOnFatalError() *wrapperspb.BoolValue {
 	if x != nil {
 		return x.StopOnFatalError
@@ -1374,6 +1508,48 @@ func (x *Settings) GetXStatsNoCgroup() *wrapperspb.BoolValue {
 	return nil
 }
 
+func (x *Settings) GetXStatsNetworkInterfaces() *ListStringValue {
+	if x != nil {
+		return x.XStatsNetworkInterfaces
+	}
+	return nil
+}
+
+func (x *Settings) GetXStatsNetworkInterfacesExclude() *ListStringValue {
+	if x != nil {
+		return x.XStatsNetworkInterfacesExclude
+	}
+	return nil
+}
+
+func (x *Settings) GetXStatsDiskDevices() *ListStringValue {
+	if x != nil {
+		return x.XStatsDiskDevices
+	}
+	return nil
+}
+
+func (x *Settings) GetXStatsDiskDevicesExclude() *ListStringValue {
+	if x != nil {
+		return x.XStatsDiskDevicesExclude
+	}
+	return nil
+}
+
+func (x *Settings) GetXStatsTopProcesses() *wrapperspb.Int32Value {
+	if x != nil {
+		return x.XStatsTopProcesses
+	}
+	return nil
+}
+
+func (x *Settings) GetXStatsCoreMetrics() *wrapperspb.BoolValue {
+	if x != nil {
+		return x.XStatsCoreMetrics
+	}
+	return nil
+}
+
 func (x *Settings) GetXLabel() *wrapperspb.StringValue {
 	if x != nil {
 		return x.XLabel
@@ -1430,6 +1606,27 @@ func (x *Settings) GetConsoleChunkMaxSeconds() *wrapperspb.Int32Value {
 	return nil
 }
 
+func (x *Settings) GetConsoleLogParsers() *ListStringValue {
+	if x != nil {
+		return x.ConsoleLogParsers
+	}
+	return nil
+}
+
+func (x *Settings) GetConsoleRedact() *wrapperspb.BoolValue {
+	if x != nil {
+		return x.ConsoleRedact
+	}
+	return nil
+}
+
+func (x *Settings) GetConsoleRedactPatterns() *ListStringValue {
+	if x != nil {
+		return x.ConsoleRedactPatterns
+	}
+	return nil
+}
+
 func (x *Settings) GetSyncTensorboard() *wrapperspb.BoolValue {
 	if x != nil {
 		return x.SyncTensorboard
@@ -1472,6 +1669,13 @@ func (x *Settings) GetXStatsCoreweaveMetadataEndpoint() *wrapperspb.StringValue
 	return nil
 }
 
+func (x *Settings) GetXClientPrintsFooter() *wrapperspb.BoolValue {
+	if x != nil {
+		return x.XClientPrintsFooter
+	}
+	return nil
+}
+
 func (x *Settings) GetXAwsLambda() *wrapperspb.BoolValue {
 	if x != nil {
 		return x.XAwsLambda
@@ -1822,6 +2026,20 @@ func (x *Settings) GetLoginTimeout() *wrapperspb.DoubleValue {
 	return nil
 }
 
+func (x *Settings) GetMaxEndOfRunHistoryMetrics() *wrapperspb.Int32Value {
+	if x != nil {
+		return x.MaxEndOfRunHistoryMetrics
+	}
+	return nil
+}
+
+func (x *Settings) GetMaxEndOfRunSummaryMetrics() *wrapperspb.Int32Value {
+	if x != nil {
+		return x.MaxEndOfRunSummaryMetrics
+	}
+	return nil
+}
+
 func (x *Settings) GetMode() *wrapperspb.StringValue {
 	if x != nil {
 		return x.Mode
@@ -2116,7 +2334,7 @@ const file_wandb_proto_wandb_settings_proto_rawDesc = "" +
 	"\tRunMoment\x12\x10\n" +
 	"\x03run\x18\x01 \x01(\tR\x03run\x12\x14\n" +
 	"\x05value\x18\x02 \x01(\x01R\x05value\x12\x16\n" +
-	"\x06metric\x18\x03 \x01(\tR\x06metric\"\xadg\n" +
+	"\x06metric\x18\x03 \x01(\tR\x06metric\"\xcct\n" +
 	"\bSettings\x125\n" +
 	"\aapi_key\x187 \x01(\v2\x1c.google.protobuf.StringValueR\x06apiKey\x12M\n" +
 	"\x13identity_token_file\x18\xaa\x01 \x01(\v2\x1c.google.protobuf.StringValueR\x11identityTokenFile\x12H\n" +
@@ -2131,14 +2349,21 @@ const file_wandb_proto_wandb_settings_proto_rawDesc = "" +
 	"\aproject\x18a \x01(\v2\x1c.google.protobuf.StringValueR\aproject\x124\n" +
 	"\x06entity\x18E \x01(\v2\x1c.google.protobuf.StringValueR\x06entity\x12A\n" +
 	"\forganization\x18\xbc\x01 \x01(\v2\x1c.google.protobuf.StringValueR\forganization\x12D\n" +
-	"\x0efinish_timeout\x18\xce\x01 \x01(\v2\x1c.google.protobuf.DoubleValueR\rfinishTimeout\x12>\n" +
+	"\x0efinish_timeout\x18\xce\x01 \x01(\v2\x1c.google.protobuf.DoubleValueR\rfinishTimeout\x12W\n" +
+	"\x18offline_fallback_timeout\x18\xdb\x01 \x01(\v2\x1c.google.protobuf.DoubleValueR\x16offlineFallbackTimeout\x12>\n" +
 	"\fx_start_time\x18) \x01(\v2\x1c.google.protobuf.DoubleValueR\n" +
 	"xStartTime\x127\n" +
 	"\broot_dir\x18i \x01(\v2\x1c.google.protobuf.StringValueR\arootDir\x12:\n" +
 	"\twandb_dir\x18\x8e\x01 \x01(\v2\x1c.google.protobuf.StringValueR\bwandbDir\x125\n" +
 	"\alog_dir\x18U \x01(\v2\x1c.google.protobuf.StringValueR\x06logDir\x12?\n" +
 	"\flog_internal\x18V \x01(\v2\x1c.google.protobuf.StringValueR\vlogInternal\x12B\n" +
-	"\fignore_globs\x18N \x01(\v2\x1f.wandb_internal.ListStringValueR\vignoreGlobs\x126\n" +
+	"\fignore_globs\x18N \x01(\v2\x1f.wandb_internal.ListStringValueR\vignoreGlobs\x12A\n" +
+	"\vredact_drop\x18\xd8\x01 \x01(\v2\x1f.wandb_internal.ListStringValueR\n" +
+	"redactDrop\x12A\n" +
+	"\vredact_hash\x18\xd9\x01 \x01(\v2\x1f.wandb_internal.ListStringValueR\n" +
+	"redactHash\x12A\n" +
+	"\vredact_keep\x18\xda\x01 \x01(\v2\x1f.wandb_internal.ListStringValueR\n" +
+	"redactKeep\x126\n" +
 	"\aapp_url\x18\xca\x01 \x01(\v2\x1c.google.protobuf.StringValueR\x06appUrl\x127\n" +
 	"\bbase_url\x189 \x01(\v2\x1c.google.protobuf.StringValueR\abaseUrl\x12R\n" +
 	"\x17x_file_stream_max_bytes\x18\xac\x01 \x01(\v2\x1b.google.protobuf.Int32ValueR\x13xFileStreamMaxBytes\x12c\n" +
@@ -2149,7 +2374,9 @@ const file_wandb_proto_wandb_settings_proto_rawDesc = "" +
 	"$x_file_stream_retry_wait_min_seconds\x18\x94\x01 \x01(\v2\x1c.google.protobuf.DoubleValueR\x1exFileStreamRetryWaitMinSeconds\x12k\n" +
 	"$x_file_stream_retry_wait_max_seconds\x18\x95\x01 \x01(\v2\x1c.google.protobuf.DoubleValueR\x1exFileStreamRetryWaitMaxSeconds\x12^\n" +
 	"\x1dx_file_stream_timeout_seconds\x18\x0f \x01(\v2\x1c.google.protobuf.DoubleValueR\x19xFileStreamTimeoutSeconds\x12[\n" +
-	"\x1cx_file_stream_max_line_bytes\x18\xb2\x01 \x01(\v2\x1b.google.protobuf.Int32ValueR\x17xFileStreamMaxLineBytes\x12V\n" +
+	"\x1cx_file_stream_max_line_bytes\x18\xb2\x01 \x01(\v2\x1b.google.protobuf.Int32ValueR\x17xFileStreamMaxLineBytes\x12S\n" +
+	"\x17x_history_upload_budget\x18\xd3\x01 \x01(\v2\x1b.google.protobuf.Int32ValueR\x14xHistoryUploadBudget\x12X\n" +
+	"\x19x_history_upload_throttle\x18\xd4\x01 \x01(\v2\x1c.google.protobuf.StringValueR\x16xHistoryUploadThrottle\x12V\n" +
 	"\x19x_file_transfer_retry_max\x18\x96\x01 \x01(\v2\x1b.google.protobuf.Int32ValueR\x15xFileTransferRetryMax\x12o\n" +
 	"&x_file_transfer_retry_wait_min_seconds\x18\x97\x01 \x01(\v2\x1c.google.protobuf.DoubleValueR xFileTransferRetryWaitMinSeconds\x12o\n" +
 	"&x_file_transfer_retry_wait_max_seconds\x18\x98\x01 \x01(\v2\x1c.google.protobuf.DoubleValueR xFileTransferRetryWaitMaxSeconds\x12c\n" +
@@ -2184,7 +2411,9 @@ const file_wandb_proto_wandb_settings_proto_rawDesc = "" +
 	"\tsweep_url\x18\x83\x01 \x01(\v2\x1c.google.protobuf.StringValueR\bsweepUrl\x12P\n" +
 	"\x16x_disable_update_check\x18\xa5\x01 \x01(\v2\x1a.google.protobuf.BoolValueR\x13xDisableUpdateCheck\x12@\n" +
 	"\x0ex_disable_meta\x18\a \x01(\v2\x1a.google.protobuf.BoolValueR\fxDisableMeta\x127\n" +
-	"\tsave_code\x18s \x01(\v2\x1a.google.protobuf.BoolValueR\bsaveCode\x12J\n" +
+	"\tsave_code\x18s \x01(\v2\x1a.google.protobuf.BoolValueR\bsaveCode\x12I\n" +
+	"\x12save_code_snapshot\x18\xd1\x01 \x01(\v2\x1a.google.protobuf.BoolValueR\x10saveCodeSnapshot\x12\\\n" +
+	"\x1csave_code_snapshot_max_bytes\x18\xd2\x01 \x01(\v2\x1b.google.protobuf.Int64ValueR\x18saveCodeSnapshotMaxBytes\x12J\n" +
 	"\x13stop_on_fatal_error\x18\xcd\x01 \x01(\v2\x1a.google.protobuf.BoolValueR\x10stopOnFatalError\x12;\n" +
 	"\vdisable_git\x18? \x01(\v2\x1a.google.protobuf.BoolValueR\n" +
 	"disableGit\x12P\n" +
@@ -2207,7 +2436,13 @@ const file_wandb_proto_wandb_settings_proto_rawDesc = "" +
 	"\x11x_stats_gpu_count\x18\xc4\x01 \x01(\v2\x1b.google.protobuf.Int32ValueR\x0exStatsGpuCount\x12F\n" +
 	"\x10x_stats_gpu_type\x18\xc5\x01 \x01(\v2\x1c.google.protobuf.StringValueR\rxStatsGpuType\x12W\n" +
 	"\x1ax_stats_track_process_tree\x18\xc6\x01 \x01(\v2\x1a.google.protobuf.BoolValueR\x16xStatsTrackProcessTree\x12F\n" +
-	"\x11x_stats_no_cgroup\x18\xcf\x01 \x01(\v2\x1a.google.protobuf.BoolValueR\x0exStatsNoCgroup\x126\n" +
+	"\x11x_stats_no_cgroup\x18\xcf\x01 \x01(\v2\x1a.google.protobuf.BoolValueR\x0exStatsNoCgroup\x12]\n" +
+	"\x1ax_stats_network_interfaces\x18\xdf\x01 \x01(\v2\x1f.wandb_internal.ListStringValueR\x17xStatsNetworkInterfaces\x12l\n" +
+	"\"x_stats_network_interfaces_exclude\x18\xe0\x01 \x01(\v2\x1f.wandb_internal.ListStringValueR\x1exStatsNetworkInterfacesExclude\x12Q\n" +
+	"\x14x_stats_disk_devices\x18\xe1\x01 \x01(\v2\x1f.wandb_internal.ListStringValueR\x11xStatsDiskDevices\x12`\n" +
+	"\x1cx_stats_disk_devices_exclude\x18\xe2\x01 \x01(\v2\x1f.wandb_internal.ListStringValueR\x18xStatsDiskDevicesExclude\x12O\n" +
+	"\x15x_stats_top_processes\x18\xe3\x01 \x01(\v2\x1b.google.protobuf.Int32ValueR\x12xStatsTopProcesses\x12L\n" +
+	"\x14x_stats_core_metrics\x18\xe4\x01 \x01(\v2\x1a.google.protobuf.BoolValueR\x11xStatsCoreMetrics\x126\n" +
 	"\ax_label\x18\xb5\x01 \x01(\v2\x1c.google.protobuf.StringValueR\x06xLabel\x128\n" +
 	"\tx_primary\x18\xb6\x01 \x01(\v2\x1a.google.protobuf.BoolValueR\bxPrimary\x12N\n" +
 	"\x15x_update_finish_state\x18\xb7\x01 \x01(\v2\x1a.google.protobuf.BoolValueR\x12xUpdateFinishState\x12S\n" +
@@ -2215,13 +2450,17 @@ const file_wandb_proto_wandb_settings_proto_rawDesc = "" +
 	"\aconsole\x18< \x01(\v2\x1c.google.protobuf.StringValueR\aconsole\x12H\n" +
 	"\x11console_multipart\x18\xa6\x01 \x01(\v2\x1a.google.protobuf.BoolValueR\x10consoleMultipart\x12S\n" +
 	"\x17console_chunk_max_bytes\x18\xc7\x01 \x01(\v2\x1b.google.protobuf.Int32ValueR\x14consoleChunkMaxBytes\x12W\n" +
-	"\x19console_chunk_max_seconds\x18\xc9\x01 \x01(\v2\x1b.google.protobuf.Int32ValueR\x16consoleChunkMaxSeconds\x12F\n" +
+	"\x19console_chunk_max_seconds\x18\xc9\x01 \x01(\v2\x1b.google.protobuf.Int32ValueR\x16consoleChunkMaxSeconds\x12P\n" +
+	"\x13console_log_parsers\x18\xd5\x01 \x01(\v2\x1f.wandb_internal.ListStringValueR\x11consoleLogParsers\x12B\n" +
+	"\x0econsole_redact\x18\xd6\x01 \x01(\v2\x1a.google.protobuf.BoolValueR\rconsoleRedact\x12X\n" +
+	"\x17console_redact_patterns\x18\xd7\x01 \x01(\v2\x1f.wandb_internal.ListStringValueR\x15consoleRedactPatterns\x12F\n" +
 	"\x10sync_tensorboard\x18\xb3\x01 \x01(\v2\x1a.google.protobuf.BoolValueR\x0fsyncTensorboard\x12]\n" +
 	"\x1dx_server_side_derived_summary\x18\xbd\x01 \x01(\v2\x1a.google.protobuf.BoolValueR\x19xServerSideDerivedSummary\x12d\n" +
 	"!x_server_side_expand_glob_metrics\x18\xbe\x01 \x01(\v2\x1a.google.protobuf.BoolValueR\x1cxServerSideExpandGlobMetrics\x12P\n" +
 	"\x16x_skip_transaction_log\x18\xbf\x01 \x01(\v2\x1a.google.protobuf.BoolValueR\x13xSkipTransactionLog\x12j\n" +
 	"#x_stats_coreweave_metadata_base_url\x18\xc0\x01 \x01(\v2\x1c.google.protobuf.StringValueR\x1exStatsCoreweaveMetadataBaseUrl\x12k\n" +
-	"#x_stats_coreweave_metadata_endpoint\x18\xc1\x01 \x01(\v2\x1c.google.protobuf.StringValueR\x1fxStatsCoreweaveMetadataEndpoint\x12:\n" +
+	"#x_stats_coreweave_metadata_endpoint\x18\xc1\x01 \x01(\v2\x1c.google.protobuf.StringValueR\x1fxStatsCoreweaveMetadataEndpoint\x12P\n" +
+	"\x16x_client_prints_footer\x18\xde\x01 \x01(\v2\x1a.google.protobuf.BoolValueR\x13xClientPrintsFooter\x12:\n" +
 	"\v_aws_lambda\x18\x02 \x01(\v2\x1a.google.protobuf.BoolValueR\tAwsLambda\x12A\n" +
 	"\x0fx_cli_only_mode\x18\x04 \x01(\v2\x1a.google.protobuf.BoolValueR\fxCliOnlyMode\x121\n" +
 	"\x06_colab\x18\x05 \x01(\v2\x1a.google.protobuf.BoolValueR\x05Colab\x12D\n" +
@@ -2277,7 +2516,9 @@ const file_wandb_proto_wandb_settings_proto_rawDesc = "" +
 	"\x14log_symlink_internal\x18W \x01(\v2\x1c.google.protobuf.StringValueR\x12logSymlinkInternal\x12F\n" +
 	"\x10log_symlink_user\x18X \x01(\v2\x1c.google.protobuf.StringValueR\x0elogSymlinkUser\x127\n" +
 	"\blog_user\x18Y \x01(\v2\x1c.google.protobuf.StringValueR\alogUser\x12A\n" +
-	"\rlogin_timeout\x18Z \x01(\v2\x1c.google.protobuf.DoubleValueR\floginTimeout\x120\n" +
+	"\rlogin_timeout\x18Z \x01(\v2\x1c.google.protobuf.DoubleValueR\floginTimeout\x12_\n" +
+	"\x1emax_end_of_run_history_metrics\x18\xdc\x01 \x01(\v2\x1b.google.protobuf.Int32ValueR\x19maxEndOfRunHistoryMetrics\x12_\n" +
+	"\x1emax_end_of_run_summary_metrics\x18\xdd\x01 \x01(\v2\x1b.google.protobuf.Int32ValueR\x19maxEndOfRunSummaryMetrics\x120\n" +
 	"\x04mode\x18\\ \x01(\v2\x1c.google.protobuf.StringValueR\x04mode\x12A\n" +
 	"\rnotebook_name\x18] \x01(\v2\x1c.google.protobuf.StringValueR\fnotebookName\x12=\n" +
 	"\vproject_url\x18b \x01(\v2\x1c.google.protobuf.StringValueR\n" +
@@ -2350,6 +2591,7 @@ var file_wandb_proto_wandb_settings_proto_goTypes = []any{
 	(*wrapperspb.BoolValue)(nil),                // 10: google.protobuf.BoolValue
 	(*wrapperspb.DoubleValue)(nil),              // 11: google.protobuf.DoubleValue
 	(*wrapperspb.Int32Value)(nil),               // 12: google.protobuf.Int32Value
+	(*wrapperspb.Int64Value)(nil),               // 13: google.protobuf.Int64Value
 }
 var file_wandb_proto_wandb_settings_proto_depIdxs = []int32{
 	7,   // 0: wandb_internal.MapStringKeyStringValue.value:type_name -> wandb_internal.MapStringKeyStringValue.ValueEntry
@@ -2370,184 +2612,204 @@ var file_wandb_proto_wandb_settings_proto_depIdxs = []int32{
 	9,   // 15: wandb_internal.Settings.entity:type_name -> google.protobuf.StringValue
 	9,   // 16: wandb_internal.Settings.organization:type_name -> google.protobuf.StringValue
 	11,  // 17: wandb_internal.Settings.finish_timeout:type_name -> google.protobuf.DoubleValue
-	11,  // 18: wandb_internal.Settings.x_start_time:type_name -> google.protobuf.DoubleValue
-	9,   // 19: wandb_internal.Settings.root_dir:type_name -> google.protobuf.StringValue
-	9,   // 20: wandb_internal.Settings.wandb_dir:type_name -> google.protobuf.StringValue
-	9,   // 21: wandb_internal.Settings.log_dir:type_name -> google.protobuf.StringValue
-	9,   // 22: wandb_internal.Settings.log_internal:type_name -> google.protobuf.StringValue
-	0,   // 23: wandb_internal.Settings.ignore_globs:type_name -> wandb_internal.ListStringValue
-	9,   // 24: wandb_internal.Settings.app_url:type_name -> google.protobuf.StringValue
-	9,   // 25: wandb_internal.Settings.base_url:type_name -> google.protobuf.StringValue
-	12,  // 26: wandb_internal.Settings.x_file_stream_max_bytes:type_name -> google.protobuf.Int32Value
-	11,  // 27: wandb_internal.Settings.x_file_stream_transmit_interval:type_name -> google.protobuf.DoubleValue
-	10,  // 28: wandb_internal.Settings.x_file_stream_no_gzip:type_name -> google.protobuf.BoolValue
-	2,   // 29: wandb_internal.Settings.x_extra_http_headers:type_name -> wandb_internal.MapStringKeyStringValue
-	12,  // 30: wandb_internal.Settings.x_file_stream_retry_max:type_name -> google.protobuf.Int32Value
-	11,  // 31: wandb_internal.Settings.x_file_stream_retry_wait_min_seconds:type_name -> google.protobuf.DoubleValue
-	11,  // 32: wandb_internal.Settings.x_file_stream_retry_wait_max_seconds:type_name -> google.protobuf.DoubleValue
-	11,  // 33: wandb_internal.Settings.x_file_stream_timeout_seconds:type_name -> google.protobuf.DoubleValue
-	12,  // 34: wandb_internal.Settings.x_file_stream_max_line_bytes:type_name -> google.protobuf.Int32Value
-	12,  // 35: wandb_internal.Settings.x_file_transfer_retry_max:type_name -> google.protobuf.Int32Value
-	11,  // 36: wandb_internal.Settings.x_file_transfer_retry_wait_min_seconds:type_name -> google.protobuf.DoubleValue
-	11,  // 37: wandb_internal.Settings.x_file_transfer_retry_wait_max_seconds:type_name -> google.protobuf.DoubleValue
-	11,  // 38: wandb_internal.Settings.x_file_transfer_timeout_seconds:type_name -> google.protobuf.DoubleValue
-	12,  // 39: wandb_internal.Settings.x_graphql_retry_max:type_name -> google.protobuf.Int32Value
-	11,  // 40: wandb_internal.Settings.x_graphql_retry_wait_min_seconds:type_name -> google.protobuf.DoubleValue
-	11,  // 41: wandb_internal.Settings.x_graphql_retry_wait_max_seconds:type_name -> google.protobuf.DoubleValue
-	11,  // 42: wandb_internal.Settings.x_graphql_timeout_seconds:type_name -> google.protobuf.DoubleValue
-	9,   // 43: wandb_internal.Settings.http_proxy:type_name -> google.protobuf.StringValue
-	9,   // 44: wandb_internal.Settings.https_proxy:type_name -> google.protobuf.StringValue
-	2,   // 45: wandb_internal.Settings.x_proxies:type_name -> wandb_internal.MapStringKeyStringValue
-	9,   // 46: wandb_internal.Settings.program:type_name -> google.protobuf.StringValue
-	9,   // 47: wandb_internal.Settings.program_relpath:type_name -> google.protobuf.StringValue
-	9,   // 48: wandb_internal.Settings._code_path_local:type_name -> google.protobuf.StringValue
-	9,   // 49: wandb_internal.Settings.program_abspath:type_name -> google.protobuf.StringValue
-	0,   // 50: wandb_internal.Settings._args:type_name -> wandb_internal.ListStringValue
-	9,   // 51: wandb_internal.Settings._os:type_name -> google.protobuf.StringValue
-	9,   // 52: wandb_internal.Settings.docker:type_name -> google.protobuf.StringValue
-	9,   // 53: wandb_internal.Settings.x_executable:type_name -> google.protobuf.StringValue
-	9,   // 54: wandb_internal.Settings._python:type_name -> google.protobuf.StringValue
-	9,   // 55: wandb_internal.Settings.colab_url:type_name -> google.protobuf.StringValue
-	9,   // 56: wandb_internal.Settings.host:type_name -> google.protobuf.StringValue
-	9,   // 57: wandb_internal.Settings.username:type_name -> google.protobuf.StringValue
-	9,   // 58: wandb_internal.Settings.email:type_name -> google.protobuf.StringValue
-	9,   // 59: wandb_internal.Settings.resume:type_name -> google.protobuf.StringValue
-	5,   // 60: wandb_internal.Settings.resume_from:type_name -> wandb_internal.RunMoment
-	5,   // 61: wandb_internal.Settings.fork_from:type_name -> wandb_internal.RunMoment
-	10,  // 62: wandb_internal.Settings.disable_job_creation:type_name -> google.protobuf.BoolValue
-	9,   // 63: wandb_internal.Settings.sweep_url:type_name -> google.protobuf.StringValue
-	10,  // 64: wandb_internal.Settings.x_disable_update_check:type_name -> google.protobuf.BoolValue
-	10,  // 65: wandb_internal.Settings.x_disable_meta:type_name -> google.protobuf.BoolValue
-	10,  // 66: wandb_internal.Settings.save_code:type_name -> google.protobuf.BoolValue
-	10,  // 67: wandb_internal.Settings.stop_on_fatal_error:type_name -> google.protobuf.BoolValue
-	10,  // 68: wandb_internal.Settings.disable_git:type_name -> google.protobuf.BoolValue
-	10,  // 69: wandb_internal.Settings.disable_git_fork_point:type_name -> google.protobuf.BoolValue
-	10,  // 70: wandb_internal.Settings.x_disable_machine_info:type_name -> google.protobuf.BoolValue
-	10,  // 71: wandb_internal.Settings.x_disable_stats:type_name -> google.protobuf.BoolValue
-	12,  // 72: wandb_internal.Settings.x_stats_buffer_size:type_name -> google.protobuf.Int32Value
-	11,  // 73: wandb_internal.Settings.x_stats_sampling_interval:type_name -> google.protobuf.DoubleValue
-	12,  // 74: wandb_internal.Settings.x_stats_pid:type_name -> google.protobuf.Int32Value
-	0,   // 75: wandb_internal.Settings.x_stats_disk_paths:type_name -> wandb_internal.ListStringValue
-	9,   // 76: wandb_internal.Settings.x_stats_neuron_monitor_config_path:type_name -> google.protobuf.StringValue
-	9,   // 77: wandb_internal.Settings.x_stats_dcgm_exporter:type_name -> google.protobuf.StringValue
-	2,   // 78: wandb_internal.Settings.x_stats_open_metrics_endpoints:type_name -> wandb_internal.MapStringKeyStringValue
-	4,   // 79: wandb_internal.Settings.x_stats_open_metrics_filters:type_name -> wandb_internal.OpenMetricsFilters
-	2,   // 80: wandb_internal.Settings.x_stats_open_metrics_http_headers:type_name -> wandb_internal.MapStringKeyStringValue
-	1,   // 81: wandb_internal.Settings.x_stats_gpu_device_ids:type_name -> wandb_internal.ListIntValue
-	12,  // 82: wandb_internal.Settings.x_stats_cpu_count:type_name -> google.protobuf.Int32Value
-	12,  // 83: wandb_internal.Settings.x_stats_cpu_logical_count:type_name -> google.protobuf.Int32Value
-	12,  // 84: wandb_internal.Settings.x_stats_gpu_count:type_name -> google.protobuf.Int32Value
-	9,   // 85: wandb_internal.Settings.x_stats_gpu_type:type_name -> google.protobuf.StringValue
-	10,  // 86: wandb_internal.Settings.x_stats_track_process_tree:type_name -> google.protobuf.BoolValue
-	10,  // 87: wandb_internal.Settings.x_stats_no_cgroup:type_name -> google.protobuf.BoolValue
-	9,   // 88: wandb_internal.Settings.x_label:type_name -> google.protobuf.StringValue
-	10,  // 89: wandb_internal.Settings.x_primary:type_name -> google.protobuf.BoolValue
-	10,  // 90: wandb_internal.Settings.x_update_finish_state:type_name -> google.protobuf.BoolValue
-	10,  // 91: wandb_internal.Settings.allow_offline_artifacts:type_name -> google.protobuf.BoolValue
-	9,   // 92: wandb_internal.Settings.console:type_name -> google.protobuf.StringValue
-	10,  // 93: wandb_internal.Settings.console_multipart:type_name -> google.protobuf.BoolValue
-	12,  // 94: wandb_internal.Settings.console_chunk_max_bytes:type_name -> google.protobuf.Int32Value
-	12,  // 95: wandb_internal.Settings.console_chunk_max_seconds:type_name -> google.protobuf.Int32Value
-	10,  // 96: wandb_internal.Settings.sync_tensorboard:type_name -> google.protobuf.BoolValue
-	10,  // 97: wandb_internal.Settings.x_server_side_derived_summary:type_name -> google.protobuf.BoolValue
-	10,  // 98: wandb_internal.Settings.x_server_side_expand_glob_metrics:type_name -> google.protobuf.BoolValue
-	10,  // 99: wandb_internal.Settings.x_skip_transaction_log:type_name -> google.protobuf.BoolValue
-	9,   // 100: wandb_internal.Settings.x_stats_coreweave_metadata_base_url:type_name -> google.protobuf.StringValue
-	9,   // 101: wandb_internal.Settings.x_stats_coreweave_metadata_endpoint:type_name -> google.protobuf.StringValue
-	10,  // 102: wandb_internal.Settings._aws_lambda:type_name -> google.protobuf.BoolValue
-	10,  // 103: wandb_internal.Settings.x_cli_only_mode:type_name -> google.protobuf.BoolValue
-	10,  // 104: wandb_internal.Settings._colab:type_name -> google.protobuf.BoolValue
-	10,  // 105: wandb_internal.Settings.x_disable_viewer:type_name -> google.protobuf.BoolValue
-	10,  // 106: wandb_internal.Settings.x_flow_control_custom:type_name -> google.protobuf.BoolValue
-	10,  // 107: wandb_internal.Settings.x_flow_control_disabled:type_name -> google.protobuf.BoolValue
-	11,  // 108: wandb_internal.Settings.x_internal_check_process:type_name -> google.protobuf.DoubleValue
-	10,  // 109: wandb_internal.Settings._ipython:type_name -> google.protobuf.BoolValue
-	10,  // 110: wandb_internal.Settings._jupyter:type_name -> google.protobuf.BoolValue
-	9,   // 111: wandb_internal.Settings.x_jupyter_root:type_name -> google.protobuf.StringValue
-	10,  // 112: wandb_internal.Settings._kaggle:type_name -> google.protobuf.BoolValue
-	12,  // 113: wandb_internal.Settings.x_live_policy_rate_limit:type_name -> google.protobuf.Int32Value
-	12,  // 114: wandb_internal.Settings.x_live_policy_wait_time:type_name -> google.protobuf.Int32Value
-	12,  // 115: wandb_internal.Settings.x_log_level:type_name -> google.protobuf.Int32Value
-	12,  // 116: wandb_internal.Settings.x_network_buffer:type_name -> google.protobuf.Int32Value
-	10,  // 117: wandb_internal.Settings._noop:type_name -> google.protobuf.BoolValue
-	10,  // 118: wandb_internal.Settings._notebook:type_name -> google.protobuf.BoolValue
-	9,   // 119: wandb_internal.Settings._platform:type_name -> google.protobuf.StringValue
-	9,   // 120: wandb_internal.Settings.x_runqueue_item_id:type_name -> google.protobuf.StringValue
-	10,  // 121: wandb_internal.Settings.x_save_requirements:type_name -> google.protobuf.BoolValue
-	9,   // 122: wandb_internal.Settings.x_service_transport:type_name -> google.protobuf.StringValue
-	11,  // 123: wandb_internal.Settings.x_service_wait:type_name -> google.protobuf.DoubleValue
-	9,   // 124: wandb_internal.Settings._start_datetime:type_name -> google.protobuf.StringValue
-	9,   // 125: wandb_internal.Settings._tmp_code_dir:type_name -> google.protobuf.StringValue
-	10,  // 126: wandb_internal.Settings._windows:type_name -> google.protobuf.BoolValue
-	10,  // 127: wandb_internal.Settings.allow_media_symlink:type_name -> google.protobuf.BoolValue
-	10,  // 128: wandb_internal.Settings.allow_val_change:type_name -> google.protobuf.BoolValue
-	2,   // 129: wandb_internal.Settings.azure_account_url_to_access_key:type_name -> wandb_internal.MapStringKeyStringValue
-	9,   // 130: wandb_internal.Settings.code_dir:type_name -> google.protobuf.StringValue
-	0,   // 131: wandb_internal.Settings.config_paths:type_name -> wandb_internal.ListStringValue
-	9,   // 132: wandb_internal.Settings.deployment:type_name -> google.protobuf.StringValue
-	10,  // 133: wandb_internal.Settings.disable_code:type_name -> google.protobuf.BoolValue
-	10,  // 134: wandb_internal.Settings.disable_hints:type_name -> google.protobuf.BoolValue
-	10,  // 135: wandb_internal.Settings.disabled:type_name -> google.protobuf.BoolValue
-	10,  // 136: wandb_internal.Settings.force:type_name -> google.protobuf.BoolValue
-	9,   // 137: wandb_internal.Settings.git_commit:type_name -> google.protobuf.StringValue
-	9,   // 138: wandb_internal.Settings.git_remote:type_name -> google.protobuf.StringValue
-	9,   // 139: wandb_internal.Settings.git_remote_url:type_name -> google.protobuf.StringValue
-	9,   // 140: wandb_internal.Settings.git_root:type_name -> google.protobuf.StringValue
-	12,  // 141: wandb_internal.Settings.heartbeat_seconds:type_name -> google.protobuf.Int32Value
-	11,  // 142: wandb_internal.Settings.init_timeout:type_name -> google.protobuf.DoubleValue
-	10,  // 143: wandb_internal.Settings.is_local:type_name -> google.protobuf.BoolValue
-	9,   // 144: wandb_internal.Settings.job_source:type_name -> google.protobuf.StringValue
-	10,  // 145: wandb_internal.Settings.label_disable:type_name -> google.protobuf.BoolValue
-	10,  // 146: wandb_internal.Settings.launch:type_name -> google.protobuf.BoolValue
-	9,   // 147: wandb_internal.Settings.launch_config_path:type_name -> google.protobuf.StringValue
-	9,   // 148: wandb_internal.Settings.log_symlink_internal:type_name -> google.protobuf.StringValue
-	9,   // 149: wandb_internal.Settings.log_symlink_user:type_name -> google.protobuf.StringValue
-	9,   // 150: wandb_internal.Settings.log_user:type_name -> google.protobuf.StringValue
-	11,  // 151: wandb_internal.Settings.login_timeout:type_name -> google.protobuf.DoubleValue
-	9,   // 152: wandb_internal.Settings.mode:type_name -> google.protobuf.StringValue
-	9,   // 153: wandb_internal.Settings.notebook_name:type_name -> google.protobuf.StringValue
-	9,   // 154: wandb_internal.Settings.project_url:type_name -> google.protobuf.StringValue
-	10,  // 155: wandb_internal.Settings.quiet:type_name -> google.protobuf.BoolValue
-	10,  // 156: wandb_internal.Settings.relogin:type_name -> google.protobuf.BoolValue
-	9,   // 157: wandb_internal.Settings.resume_fname:type_name -> google.protobuf.StringValue
-	10,  // 158: wandb_internal.Settings.resumed:type_name -> google.protobuf.BoolValue
-	9,   // 159: wandb_internal.Settings.run_group:type_name -> google.protobuf.StringValue
-	9,   // 160: wandb_internal.Settings.run_job_type:type_name -> google.protobuf.StringValue
-	9,   // 161: wandb_internal.Settings.run_mode:type_name -> google.protobuf.StringValue
-	9,   // 162: wandb_internal.Settings.run_name:type_name -> google.protobuf.StringValue
-	9,   // 163: wandb_internal.Settings.run_notes:type_name -> google.protobuf.StringValue
-	0,   // 164: wandb_internal.Settings.run_tags:type_name -> wandb_internal.ListStringValue
-	10,  // 165: wandb_internal.Settings.sagemaker_disable:type_name -> google.protobuf.BoolValue
-	9,   // 166: wandb_internal.Settings.settings_system:type_name -> google.protobuf.StringValue
-	9,   // 167: wandb_internal.Settings.settings_workspace:type_name -> google.protobuf.StringValue
-	10,  // 168: wandb_internal.Settings.show_colors:type_name -> google.protobuf.BoolValue
-	10,  // 169: wandb_internal.Settings.show_emoji:type_name -> google.protobuf.BoolValue
-	10,  // 170: wandb_internal.Settings.show_errors:type_name -> google.protobuf.BoolValue
-	10,  // 171: wandb_internal.Settings.show_info:type_name -> google.protobuf.BoolValue
-	10,  // 172: wandb_internal.Settings.show_warnings:type_name -> google.protobuf.BoolValue
-	10,  // 173: wandb_internal.Settings.silent:type_name -> google.protobuf.BoolValue
-	9,   // 174: wandb_internal.Settings.start_method:type_name -> google.protobuf.StringValue
-	10,  // 175: wandb_internal.Settings.strict:type_name -> google.protobuf.BoolValue
-	12,  // 176: wandb_internal.Settings.summary_errors:type_name -> google.protobuf.Int32Value
-	12,  // 177: wandb_internal.Settings.summary_timeout:type_name -> google.protobuf.Int32Value
-	12,  // 178: wandb_internal.Settings.summary_warnings:type_name -> google.protobuf.Int32Value
-	9,   // 179: wandb_internal.Settings.sweep_id:type_name -> google.protobuf.StringValue
-	9,   // 180: wandb_internal.Settings.sweep_param_path:type_name -> google.protobuf.StringValue
-	10,  // 181: wandb_internal.Settings.symlink:type_name -> google.protobuf.BoolValue
-	9,   // 182: wandb_internal.Settings.sync_dir:type_name -> google.protobuf.StringValue
-	9,   // 183: wandb_internal.Settings.sync_symlink_latest:type_name -> google.protobuf.StringValue
-	10,  // 184: wandb_internal.Settings.table_raise_on_max_row_limit_exceeded:type_name -> google.protobuf.BoolValue
-	9,   // 185: wandb_internal.Settings.timespec:type_name -> google.protobuf.StringValue
-	9,   // 186: wandb_internal.Settings.tmp_dir:type_name -> google.protobuf.StringValue
-	9,   // 187: wandb_internal.Settings.x_jupyter_name:type_name -> google.protobuf.StringValue
-	9,   // 188: wandb_internal.Settings.x_jupyter_path:type_name -> google.protobuf.StringValue
-	9,   // 189: wandb_internal.Settings.job_name:type_name -> google.protobuf.StringValue
-	2,   // 190: wandb_internal.MapStringKeyMapStringKeyStringValue.ValueEntry.value:type_name -> wandb_internal.MapStringKeyStringValue
-	191, // [191:191] is the sub-list for method output_type
-	191, // [191:191] is the sub-list for method input_type
-	191, // [191:191] is the sub-list for extension type_name
-	191, // [191:191] is the sub-list for extension extendee
-	0,   // [0:191] is the sub-list for field type_name
+	11,  // 18: wandb_internal.Settings.offline_fallback_timeout:type_name -> google.protobuf.DoubleValue
+	11,  // 19: wandb_internal.Settings.x_start_time:type_name -> google.protobuf.DoubleValue
+	9,   // 20: wandb_internal.Settings.root_dir:type_name -> google.protobuf.StringValue
+	9,   // 21: wandb_internal.Settings.wandb_dir:type_name -> google.protobuf.StringValue
+	9,   // 22: wandb_internal.Settings.log_dir:type_name -> google.protobuf.StringValue
+	9,   // 23: wandb_internal.Settings.log_internal:type_name -> google.protobuf.StringValue
+	0,   // 24: wandb_internal.Settings.ignore_globs:type_name -> wandb_internal.ListStringValue
+	0,   // 25: wandb_internal.Settings.redact_drop:type_name -> wandb_internal.ListStringValue
+	0,   // 26: wandb_internal.Settings.redact_hash:type_name -> wandb_internal.ListStringValue
+	0,   // 27: wandb_internal.Settings.redact_keep:type_name -> wandb_internal.ListStringValue
+	9,   // 28: wandb_internal.Settings.app_url:type_name -> google.protobuf.StringValue
+	9,   // 29: wandb_internal.Settings.base_url:type_name -> google.protobuf.StringValue
+	12,  // 30: wandb_internal.Settings.x_file_stream_max_bytes:type_name -> google.protobuf.Int32Value
+	11,  // 31: wandb_internal.Settings.x_file_stream_transmit_interval:type_name -> google.protobuf.DoubleValue
+	10,  // 32: wandb_internal.Settings.x_file_stream_no_gzip:type_name -> google.protobuf.BoolValue
+	2,   // 33: wandb_internal.Settings.x_extra_http_headers:type_name -> wandb_internal.MapStringKeyStringValue
+	12,  // 34: wandb_internal.Settings.x_file_stream_retry_max:type_name -> google.protobuf.Int32Value
+	11,  // 35: wandb_internal.Settings.x_file_stream_retry_wait_min_seconds:type_name -> google.protobuf.DoubleValue
+	11,  // 36: wandb_internal.Settings.x_file_stream_retry_wait_max_seconds:type_name -> google.protobuf.DoubleValue
+	11,  // 37: wandb_internal.Settings.x_file_stream_timeout_seconds:type_name -> google.protobuf.DoubleValue
+	12,  // 38: wandb_internal.Settings.x_file_stream_max_line_bytes:type_name -> google.protobuf.Int32Value
+	12,  // 39: wandb_internal.Settings.x_history_upload_budget:type_name -> google.protobuf.Int32Value
+	9,   // 40: wandb_internal.Settings.x_history_upload_throttle:type_name -> google.protobuf.StringValue
+	12,  // 41: wandb_internal.Settings.x_file_transfer_retry_max:type_name -> google.protobuf.Int32Value
+	11,  // 42: wandb_internal.Settings.x_file_transfer_retry_wait_min_seconds:type_name -> google.protobuf.DoubleValue
+	11,  // 43: wandb_internal.Settings.x_file_transfer_retry_wait_max_seconds:type_name -> google.protobuf.DoubleValue
+	11,  // 44: wandb_internal.Settings.x_file_transfer_timeout_seconds:type_name -> google.protobuf.DoubleValue
+	12,  // 45: wandb_internal.Settings.x_graphql_retry_max:type_name -> google.protobuf.Int32Value
+	11,  // 46: wandb_internal.Settings.x_graphql_retry_wait_min_seconds:type_name -> google.protobuf.DoubleValue
+	11,  // 47: wandb_internal.Settings.x_graphql_retry_wait_max_seconds:type_name -> google.protobuf.DoubleValue
+	11,  // 48: wandb_internal.Settings.x_graphql_timeout_seconds:type_name -> google.protobuf.DoubleValue
+	9,   // 49: wandb_internal.Settings.http_proxy:type_name -> google.protobuf.StringValue
+	9,   // 50: wandb_internal.Settings.https_proxy:type_name -> google.protobuf.StringValue
+	2,   // 51: wandb_internal.Settings.x_proxies:type_name -> wandb_internal.MapStringKeyStringValue
+	9,   // 52: wandb_internal.Settings.program:type_name -> google.protobuf.StringValue
+	9,   // 53: wandb_internal.Settings.program_relpath:type_name -> google.protobuf.StringValue
+	9,   // 54: wandb_internal.Settings._code_path_local:type_name -> google.protobuf.StringValue
+	9,   // 55: wandb_internal.Settings.program_abspath:type_name -> google.protobuf.StringValue
+	0,   // 56: wandb_internal.Settings._args:type_name -> wandb_internal.ListStringValue
+	9,   // 57: wandb_internal.Settings._os:type_name -> google.protobuf.StringValue
+	9,   // 58: wandb_internal.Settings.docker:type_name -> google.protobuf.StringValue
+	9,   // 59: wandb_internal.Settings.x_executable:type_name -> google.protobuf.StringValue
+	9,   // 60: wandb_internal.Settings._python:type_name -> google.protobuf.StringValue
+	9,   // 61: wandb_internal.Settings.colab_url:type_name -> google.protobuf.StringValue
+	9,   // 62: wandb_internal.Settings.host:type_name -> google.protobuf.StringValue
+	9,   // 63: wandb_internal.Settings.username:type_name -> google.protobuf.StringValue
+	9,   // 64: wandb_internal.Settings.email:type_name -> google.protobuf.StringValue
+	9,   // 65: wandb_internal.Settings.resume:type_name -> google.protobuf.StringValue
+	5,   // 66: wandb_internal.Settings.resume_from:type_name -> wandb_internal.RunMoment
+	5,   // 67: wandb_internal.Settings.fork_from:type_name -> wandb_internal.RunMoment
+	10,  // 68: wandb_internal.Settings.disable_job_creation:type_name -> google.protobuf.BoolValue
+	9,   // 69: wandb_internal.Settings.sweep_url:type_name -> google.protobuf.StringValue
+	10,  // 70: wandb_internal.Settings.x_disable_update_check:type_name -> google.protobuf.BoolValue
+	10,  // 71: wandb_internal.Settings.x_disable_meta:type_name -> google.protobuf.BoolValue
+	10,  // 72: wandb_internal.Settings.save_code:type_name -> google.protobuf.BoolValue
+	10,  // 73: wandb_internal.Settings.save_code_snapshot:type_name -> google.protobuf.BoolValue
+	13,  // 74: wandb_internal.Settings.save_code_snapshot_max_bytes:type_name -> google.protobuf.Int64Value
+	10,  // 75: wandb_internal.Settings.stop_on_fatal_error:type_name -> google.protobuf.BoolValue
+	10,  // 76: wandb_internal.Settings.disable_git:type_name -> google.protobuf.BoolValue
+	10,  // 77: wandb_internal.Settings.disable_git_fork_point:type_name -> google.protobuf.BoolValue
+	10,  // 78: wandb_internal.Settings.x_disable_machine_info:type_name -> google.protobuf.BoolValue
+	10,  // 79: wandb_internal.Settings.x_disable_stats:type_name -> google.protobuf.BoolValue
+	12,  // 80: wandb_internal.Settings.x_stats_buffer_size:type_name -> google.protobuf.Int32Value
+	11,  // 81: wandb_internal.Settings.x_stats_sampling_interval:type_name -> google.protobuf.DoubleValue
+	12,  // 82: wandb_internal.Settings.x_stats_pid:type_name -> google.protobuf.Int32Value
+	0,   // 83: wandb_internal.Settings.x_stats_disk_paths:type_name -> wandb_internal.ListStringValue
+	9,   // 84: wandb_internal.Settings.x_stats_neuron_monitor_config_path:type_name -> google.protobuf.StringValue
+	9,   // 85: wandb_internal.Settings.x_stats_dcgm_exporter:type_name -> google.protobuf.StringValue
+	2,   // 86: wandb_internal.Settings.x_stats_open_metrics_endpoints:type_name -> wandb_internal.MapStringKeyStringValue
+	4,   // 87: wandb_internal.Settings.x_stats_open_metrics_filters:type_name -> wandb_internal.OpenMetricsFilters
+	2,   // 88: wandb_internal.Settings.x_stats_open_metrics_http_headers:type_name -> wandb_internal.MapStringKeyStringValue
+	1,   // 89: wandb_internal.Settings.x_stats_gpu_device_ids:type_name -> wandb_internal.ListIntValue
+	12,  // 90: wandb_internal.Settings.x_stats_cpu_count:type_name -> google.protobuf.Int32Value
+	12,  // 91: wandb_internal.Settings.x_stats_cpu_logical_count:type_name -> google.protobuf.Int32Value
+	12,  // 92: wandb_internal.Settings.x_stats_gpu_count:type_name -> google.protobuf.Int32Value
+	9,   // 93: wandb_internal.Settings.x_stats_gpu_type:type_name -> google.protobuf.StringValue
+	10,  // 94: wandb_internal.Settings.x_stats_track_process_tree:type_name -> google.protobuf.BoolValue
+	10,  // 95: wandb_internal.Settings.x_stats_no_cgroup:type_name -> google.protobuf.BoolValue
+	0,   // 96: wandb_internal.Settings.x_stats_network_interfaces:type_name -> wandb_internal.ListStringValue
+	0,   // 97: wandb_internal.Settings.x_stats_network_interfaces_exclude:type_name -> wandb_internal.ListStringValue
+	0,   // 98: wandb_internal.Settings.x_stats_disk_devices:type_name -> wandb_internal.ListStringValue
+	0,   // 99: wandb_internal.Settings.x_stats_disk_devices_exclude:type_name -> wandb_internal.ListStringValue
+	12,  // 100: wandb_internal.Settings.x_stats_top_processes:type_name -> google.protobuf.Int32Value
+	10,  // 101: wandb_internal.Settings.x_stats_core_metrics:type_name -> google.protobuf.BoolValue
+	9,   // 102: wandb_internal.Settings.x_label:type_name -> google.protobuf.StringValue
+	10,  // 103: wandb_internal.Settings.x_primary:type_name -> google.protobuf.BoolValue
+	10,  // 104: wandb_internal.Settings.x_update_finish_state:type_name -> google.protobuf.BoolValue
+	10,  // 105: wandb_internal.Settings.allow_offline_artifacts:type_name -> google.protobuf.BoolValue
+	9,   // 106: wandb_internal.Settings.console:type_name -> google.protobuf.StringValue
+	10,  // 107: wandb_internal.Settings.console_multipart:type_name -> google.protobuf.BoolValue
+	12,  // 108: wandb_internal.Settings.console_chunk_max_bytes:type_name -> google.protobuf.Int32Value
+	12,  // 109: wandb_internal.Settings.console_chunk_max_seconds:type_name -> google.protobuf.Int32Value
+	0,   // 110: wandb_internal.Settings.console_log_parsers:type_name -> wandb_internal.ListStringValue
+	10,  // 111: wandb_internal.Settings.console_redact:type_name -> google.protobuf.BoolValue
+	0,   // 112: wandb_internal.Settings.console_redact_patterns:type_name -> wandb_internal.ListStringValue
+	10,  // 113: wandb_internal.Settings.sync_tensorboard:type_name -> google.protobuf.BoolValue
+	10,  // 114: wandb_internal.Settings.x_server_side_derived_summary:type_name -> google.protobuf.BoolValue
+	10,  // 115: wandb_internal.Settings.x_server_side_expand_glob_metrics:type_name -> google.protobuf.BoolValue
+	10,  // 116: wandb_internal.Settings.x_skip_transaction_log:type_name -> google.protobuf.BoolValue
+	9,   // 117: wandb_internal.Settings.x_stats_coreweave_metadata_base_url:type_name -> google.protobuf.StringValue
+	9,   // 118: wandb_internal.Settings.x_stats_coreweave_metadata_endpoint:type_name -> google.protobuf.StringValue
+	10,  // 119: wandb_internal.Settings.x_client_prints_footer:type_name -> google.protobuf.BoolValue
+	10,  // 120: wandb_internal.Settings._aws_lambda:type_name -> google.protobuf.BoolValue
+	10,  // 121: wandb_internal.Settings.x_cli_only_mode:type_name -> google.protobuf.BoolValue
+	10,  // 122: wandb_internal.Settings._colab:type_name -> google.protobuf.BoolValue
+	10,  // 123: wandb_internal.Settings.x_disable_viewer:type_name -> google.protobuf.BoolValue
+	10,  // 124: wandb_internal.Settings.x_flow_control_custom:type_name -> google.protobuf.BoolValue
+	10,  // 125: wandb_internal.Settings.x_flow_control_disabled:type_name -> google.protobuf.BoolValue
+	11,  // 126: wandb_internal.Settings.x_internal_check_process:type_name -> google.protobuf.DoubleValue
+	10,  // 127: wandb_internal.Settings._ipython:type_name -> google.protobuf.BoolValue
+	10,  // 128: wandb_internal.Settings._jupyter:type_name -> google.protobuf.BoolValue
+	9,   // 129: wandb_internal.Settings.x_jupyter_root:type_name -> google.protobuf.StringValue
+	10,  // 130: wandb_internal.Settings._kaggle:type_name -> google.protobuf.BoolValue
+	12,  // 131: wandb_internal.Settings.x_live_policy_rate_limit:type_name -> google.protobuf.Int32Value
+	12,  // 132: wandb_internal.Settings.x_live_policy_wait_time:type_name -> google.protobuf.Int32Value
+	12,  // 133: wandb_internal.Settings.x_log_level:type_name -> google.protobuf.Int32Value
+	12,  // 134: wandb_internal.Settings.x_network_buffer:type_name -> google.protobuf.Int32Value
+	10,  // 135: wandb_internal.Settings._noop:type_name -> google.protobuf.BoolValue
+	10,  // 136: wandb_internal.Settings._notebook:type_name -> google.protobuf.BoolValue
+	9,   // 137: wandb_internal.Settings._platform:type_name -> google.protobuf.StringValue
+	9,   // 138: wandb_internal.Settings.x_runqueue_item_id:type_name -> google.protobuf.StringValue
+	10,  // 139: wandb_internal.Settings.x_save_requirements:type_name -> google.protobuf.BoolValue
+	9,   // 140: wandb_internal.Settings.x_service_transport:type_name -> google.protobuf.StringValue
+	11,  // 141: wandb_internal.Settings.x_service_wait:type_name -> google.protobuf.DoubleValue
+	9,   // 142: wandb_internal.Settings._start_datetime:type_name -> google.protobuf.StringValue
+	9,   // 143: wandb_internal.Settings._tmp_code_dir:type_name -> google.protobuf.StringValue
+	10,  // 144: wandb_internal.Settings._windows:type_name -> google.protobuf.BoolValue
+	10,  // 145: wandb_internal.Settings.allow_media_symlink:type_name -> google.protobuf.BoolValue
+	10,  // 146: wandb_internal.Settings.allow_val_change:type_name -> google.protobuf.BoolValue
+	2,   // 147: wandb_internal.Settings.azure_account_url_to_access_key:type_name -> wandb_internal.MapStringKeyStringValue
+	9,   // 148: wandb_internal.Settings.code_dir:type_name -> google.protobuf.StringValue
+	0,   // 149: wandb_internal.Settings.config_paths:type_name -> wandb_internal.ListStringValue
+	9,   // 150: wandb_internal.Settings.deployment:type_name -> google.protobuf.StringValue
+	10,  // 151: wandb_internal.Settings.disable_code:type_name -> google.protobuf.BoolValue
+	10,  // 152: wandb_internal.Settings.disable_hints:type_name -> google.protobuf.BoolValue
+	10,  // 153: wandb_internal.Settings.disabled:type_name -> google.protobuf.BoolValue
+	10,  // 154: wandb_internal.Settings.force:type_name -> google.protobuf.BoolValue
+	9,   // 155: wandb_internal.Settings.git_commit:type_name -> google.protobuf.StringValue
+	9,   // 156: wandb_internal.Settings.git_remote:type_name -> google.protobuf.StringValue
+	9,   // 157: wandb_internal.Settings.git_remote_url:type_name -> google.protobuf.StringValue
+	9,   // 158: wandb_internal.Settings.git_root:type_name -> google.protobuf.StringValue
+	12,  // 159: wandb_internal.Settings.heartbeat_seconds:type_name -> google.protobuf.Int32Value
+	11,  // 160: wandb_internal.Settings.init_timeout:type_name -> google.protobuf.DoubleValue
+	10,  // 161: wandb_internal.Settings.is_local:type_name -> google.protobuf.BoolValue
+	9,   // 162: wandb_internal.Settings.job_source:type_name -> google.protobuf.StringValue
+	10,  // 163: wandb_internal.Settings.label_disable:type_name -> google.protobuf.BoolValue
+	10,  // 164: wandb_internal.Settings.launch:type_name -> google.protobuf.BoolValue
+	9,   // 165: wandb_internal.Settings.launch_config_path:type_name -> google.protobuf.StringValue
+	9,   // 166: wandb_internal.Settings.log_symlink_internal:type_name -> google.protobuf.StringValue
+	9,   // 167: wandb_internal.Settings.log_symlink_user:type_name -> google.protobuf.StringValue
+	9,   // 168: wandb_internal.Settings.log_user:type_name -> google.protobuf.StringValue
+	11,  // 169: wandb_internal.Settings.login_timeout:type_name -> google.protobuf.DoubleValue
+	12,  // 170: wandb_internal.Settings.max_end_of_run_history_metrics:type_name -> google.protobuf.Int32Value
+	12,  // 171: wandb_internal.Settings.max_end_of_run_summary_metrics:type_name -> google.protobuf.Int32Value
+	9,   // 172: wandb_internal.Settings.mode:type_name -> google.protobuf.StringValue
+	9,   // 173: wandb_internal.Settings.notebook_name:type_name -> google.protobuf.StringValue
+	9,   // 174: wandb_internal.Settings.project_url:type_name -> google.protobuf.StringValue
+	10,  // 175: wandb_internal.Settings.quiet:type_name -> google.protobuf.BoolValue
+	10,  // 176: wandb_internal.Settings.relogin:type_name -> google.protobuf.BoolValue
+	9,   // 177: wandb_internal.Settings.resume_fname:type_name -> google.protobuf.StringValue
+	10,  // 178: wandb_internal.Settings.resumed:type_name -> google.protobuf.BoolValue
+	9,   // 179: wandb_internal.Settings.run_group:type_name -> google.protobuf.StringValue
+	9,   // 180: wandb_internal.Settings.run_job_type:type_name -> google.protobuf.StringValue
+	9,   // 181: wandb_internal.Settings.run_mode:type_name -> google.protobuf.StringValue
+	9,   // 182: wandb_internal.Settings.run_name:type_name -> google.protobuf.StringValue
+	9,   // 183: wandb_internal.Settings.run_notes:type_name -> google.protobuf.StringValue
+	0,   // 184: wandb_internal.Settings.run_tags:type_name -> wandb_internal.ListStringValue
+	10,  // 185: wandb_internal.Settings.sagemaker_disable:type_name -> google.protobuf.BoolValue
+	9,   // 186: wandb_internal.Settings.settings_system:type_name -> google.protobuf.StringValue
+	9,   // 187: wandb_internal.Settings.settings_workspace:type_name -> google.protobuf.StringValue
+	10,  // 188: wandb_internal.Settings.show_colors:type_name -> google.protobuf.BoolValue
+	10,  // 189: wandb_internal.Settings.show_emoji:type_name -> google.protobuf.BoolValue
+	10,  // 190: wandb_internal.Settings.show_errors:type_name -> google.protobuf.BoolValue
+	10,  // 191: wandb_internal.Settings.show_info:type_name -> google.protobuf.BoolValue
+	10,  // 192: wandb_internal.Settings.show_warnings:type_name -> google.protobuf.BoolValue
+	10,  // 193: wandb_internal.Settings.silent:type_name -> google.protobuf.BoolValue
+	9,   // 194: wandb_internal.Settings.start_method:type_name -> google.protobuf.StringValue
+	10,  // 195: wandb_internal.Settings.strict:type_name -> google.protobuf.BoolValue
+	12,  // 196: wandb_internal.Settings.summary_errors:type_name -> google.protobuf.Int32Value
+	12,  // 197: wandb_internal.Settings.summary_timeout:type_name -> google.protobuf.Int32Value
+	12,  // 198: wandb_internal.Settings.summary_warnings:type_name -> google.protobuf.Int32Value
+	9,   // 199: wandb_internal.Settings.sweep_id:type_name -> google.protobuf.StringValue
+	9,   // 200: wandb_internal.Settings.sweep_param_path:type_name -> google.protobuf.StringValue
+	10,  // 201: wandb_internal.Settings.symlink:type_name -> google.protobuf.BoolValue
+	9,   // 202: wandb_internal.Settings.sync_dir:type_name -> google.protobuf.StringValue
+	9,   // 203: wandb_internal.Settings.sync_symlink_latest:type_name -> google.protobuf.StringValue
+	10,  // 204: wandb_internal.Settings.table_raise_on_max_row_limit_exceeded:type_name -> google.protobuf.BoolValue
+	9,   // 205: wandb_internal.Settings.timespec:type_name -> google.protobuf.StringValue
+	9,   // 206: wandb_internal.Settings.tmp_dir:type_name -> google.protobuf.StringValue
+	9,   // 207: wandb_internal.Settings.x_jupyter_name:type_name -> google.protobuf.StringValue
+	9,   // 208: wandb_internal.Settings.x_jupyter_path:type_name -> google.protobuf.StringValue
+	9,   // 209: wandb_internal.Settings.job_name:type_name -> google.protobuf.StringValue
+	2,   // 210: wandb_internal.MapStringKeyMapStringKeyStringValue.ValueEntry.value:type_name -> wandb_internal.MapStringKeyStringValue
+	211, // [211:211] is the sub-list for method output_type
+	211, // [211:211] is the sub-list for method input_type
+	211, // [211:211] is the sub-list for extension type_name
+	211, // [211:211] is the sub-list for extension extendee
+	0,   // [0:211] is the sub-list for field type_name
 }
 
 func init() { file_wandb_proto_wandb_settings_proto_init() }
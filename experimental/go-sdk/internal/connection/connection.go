@@ -11,6 +11,7 @@ import (
 	"github.com/wandb/wandb/experimental/go-sdk/internal/mailbox"
 
 	"net"
+	"sync"
 
 	"google.golang.org/protobuf/proto"
 )
@@ -28,6 +29,10 @@ type Connection struct {
 	// Conn is the connection to the server
 	net.Conn
 	Mailbox *mailbox.Mailbox
+
+	// sendMu serializes Send so that messages sent from different
+	// goroutines aren't interleaved on the wire.
+	sendMu sync.Mutex
 }
 
 // NewConnection creates a new connection to the server.
@@ -52,6 +57,9 @@ func (c *Connection) Send(msg proto.Message) error {
 	if err != nil {
 		return fmt.Errorf("error marshaling message: %w", err)
 	}
+	c.sendMu.Lock()
+	defer c.sendMu.Unlock()
+
 	writer := bufio.NewWriterSize(c, 16384)
 
 	header := server.Header{Magic: byte('W'), DataLength: uint32(len(data))}
@@ -229,3 +229,31 @@ func (r *IRun) PublishPartialHistory(data map[string]interface{}) {
 	}
 	r.Conn.Send(&record)
 }
+
+// PublishOutputRaw sends a chunk of console output to the server.
+//
+// The text is passed through as written; the server reassembles lines and
+// interprets terminal control sequences.
+func (r *IRun) PublishOutputRaw(text string, isStderr bool) error {
+	outputType := spb.OutputRawRecord_STDOUT
+	if isStderr {
+		outputType = spb.OutputRawRecord_STDERR
+	}
+
+	record := spb.ServerRequest{
+		ServerRequestType: &spb.ServerRequest_RecordPublish{
+			RecordPublish: &spb.Record{
+				RecordType: &spb.Record_OutputRaw{
+					OutputRaw: &spb.OutputRawRecord{
+						OutputType: outputType,
+						Timestamp:  timestamppb.Now(),
+						Line:       text,
+					},
+				},
+				XInfo: &spb.XRecordInfo{
+					StreamId: r.StreamID,
+				},
+			}},
+	}
+	return r.Conn.Send(&record)
+}
@@ -273,6 +273,21 @@ func (s *Settings) IsDisabled() bool {
 	return s.Mode == ModeDisabled
 }
 
+// IsConsoleCapture reports whether the run should tee the process's stdout
+// and stderr into its console logs.
+//
+// Capture swaps out os.Stdout and os.Stderr for the whole process, so it is
+// opt-in: it is enabled by the wrap and redirect console modes, but not by
+// ConsoleAuto. Only one run in the process captures the console at a time.
+func (s *Settings) IsConsoleCapture() bool {
+	switch s.Console {
+	case ConsoleWrap, ConsoleRedirect, ConsoleWrapRaw, ConsoleWrapEmul:
+		return true
+	default:
+		return false
+	}
+}
+
 func (s *Settings) GetRunMode() string {
 	if s.IsOffline() {
 		return "offline-run"
@@ -0,0 +1,158 @@
+package wandb
+
+import (
+	"io"
+	"log/slog"
+	"os"
+	"sync"
+)
+
+var (
+	// consoleMu guards replacing os.Stdout and os.Stderr and activeConsole.
+	consoleMu sync.Mutex
+
+	// activeConsole is the capture that replaced os.Stdout and os.Stderr,
+	// if any.
+	//
+	// Only one run captures the console at a time; otherwise a run finishing
+	// could restore streams that another run had replaced.
+	activeConsole *consoleCapture
+)
+
+// consoleTee copies everything written to one of the process's standard
+// streams into the run, while still writing it to the original stream.
+//
+// It works by replacing the *os.File variable (os.Stdout or os.Stderr) with
+// the write end of a pipe. Output written directly to the underlying file
+// descriptor, such as by subprocesses or C code, is not captured.
+//
+// The variable is only replaced while holding consoleMu.
+type consoleTee struct {
+	target   **os.File
+	original *os.File
+	writer   *os.File
+	done     chan struct{}
+}
+
+// startConsoleTee redirects *target through a pipe, calling publish with
+// each chunk of output read from it.
+func startConsoleTee(target **os.File, publish func(string)) (*consoleTee, error) {
+	reader, writer, err := os.Pipe()
+	if err != nil {
+		return nil, err
+	}
+
+	tee := &consoleTee{
+		target:   target,
+		original: *target,
+		writer:   writer,
+		done:     make(chan struct{}),
+	}
+	*target = writer
+
+	go func() {
+		defer close(tee.done)
+		defer func() {
+			_ = reader.Close()
+		}()
+
+		buf := make([]byte, 32*1024)
+		for {
+			n, err := reader.Read(buf)
+			if n > 0 {
+				_, _ = tee.original.Write(buf[:n])
+				publish(string(buf[:n]))
+			}
+			if err != nil {
+				if err != io.EOF {
+					slog.Error("error reading captured console output", "err", err)
+				}
+				return
+			}
+		}
+	}()
+
+	return tee, nil
+}
+
+// stop restores the original stream and waits for buffered output to be
+// forwarded.
+//
+// The caller must hold consoleMu.
+func (t *consoleTee) stop() {
+	*t.target = t.original
+	_ = t.writer.Close()
+	<-t.done
+}
+
+// consoleCapture tees os.Stdout and os.Stderr into a run's console logs.
+type consoleCapture struct {
+	tees []*consoleTee
+}
+
+// startConsoleCapture starts teeing the console into the run.
+//
+// Returns nil if another run is already capturing the console.
+func startConsoleCapture(run *Run) *consoleCapture {
+	consoleMu.Lock()
+	defer consoleMu.Unlock()
+
+	if activeConsole != nil {
+		slog.Warn("console output is already captured by another run")
+		return nil
+	}
+
+	capture := &consoleCapture{}
+
+	streams := []struct {
+		target   **os.File
+		isStderr bool
+	}{
+		{&os.Stdout, false},
+		{&os.Stderr, true},
+	}
+	for _, stream := range streams {
+		isStderr := stream.isStderr
+		tee, err := startConsoleTee(stream.target, func(text string) {
+			_ = run.interfaces.PublishOutputRaw(text, isStderr)
+		})
+		if err != nil {
+			slog.Error("error capturing console output", "err", err)
+			continue
+		}
+		capture.tees = append(capture.tees, tee)
+	}
+
+	activeConsole = capture
+	return capture
+}
+
+// stop ends the capture, restoring the original streams.
+//
+// It is safe to call more than once.
+func (c *consoleCapture) stop() {
+	consoleMu.Lock()
+	defer consoleMu.Unlock()
+
+	if activeConsole != c {
+		return
+	}
+	activeConsole = nil
+
+	// Restore in the reverse order of replacing.
+	for i := len(c.tees) - 1; i >= 0; i-- {
+		c.tees[i].stop()
+	}
+}
+
+// stopActiveConsoleCapture ends the capture of any run that was not
+// finished, restoring the original streams.
+func stopActiveConsoleCapture() {
+	consoleMu.Lock()
+	capture := activeConsole
+	consoleMu.Unlock()
+
+	if capture != nil {
+		capture.stop()
+	}
+}
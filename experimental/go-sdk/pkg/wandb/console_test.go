@@ -0,0 +1,152 @@
+package wandb
+
+import (
+	"bufio"
+	"fmt"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"google.golang.org/protobuf/proto"
+
+	spb "github.com/wandb/wandb/core/pkg/service_go_proto"
+
+	"github.com/wandb/wandb/experimental/go-sdk/internal/connection"
+	"github.com/wandb/wandb/experimental/go-sdk/internal/interfaces"
+)
+
+// newTestRun returns a run connected to a fake server.
+//
+// The returned function disconnects the run and returns the console output
+// records received by the server.
+func newTestRun(t *testing.T) (*Run, func() []*spb.OutputRawRecord) {
+	t.Helper()
+
+	client, server := net.Pipe()
+	run := &Run{
+		interfaces: interfaces.IRun{
+			Conn:     &connection.Connection{Conn: client},
+			StreamID: "test-run",
+		},
+	}
+
+	var records []*spb.OutputRawRecord
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		scanner := bufio.NewScanner(server)
+		scanner.Split(connection.ScanWBRecords)
+		for scanner.Scan() {
+			request := &spb.ServerRequest{}
+			if err := proto.Unmarshal(scanner.Bytes(), request); err != nil {
+				t.Errorf("failed to unmarshal request: %v", err)
+				return
+			}
+			if record := request.GetRecordPublish().GetOutputRaw(); record != nil {
+				records = append(records, record)
+			}
+		}
+	}()
+
+	return run, func() []*spb.OutputRawRecord {
+		_ = client.Close()
+		<-done
+		return records
+	}
+}
+
+// replaceStdStreams points os.Stdout and os.Stderr at files in a temporary
+// directory for the duration of the test.
+func replaceStdStreams(t *testing.T) (stdout, stderr *os.File) {
+	t.Helper()
+
+	dir := t.TempDir()
+	var err error
+	if stdout, err = os.Create(filepath.Join(dir, "stdout")); err != nil {
+		t.Fatal(err)
+	}
+	if stderr, err = os.Create(filepath.Join(dir, "stderr")); err != nil {
+		t.Fatal(err)
+	}
+
+	originalStdout, originalStderr := os.Stdout, os.Stderr
+	os.Stdout, os.Stderr = stdout, stderr
+	t.Cleanup(func() {
+		os.Stdout, os.Stderr = originalStdout, originalStderr
+		_ = stdout.Close()
+		_ = stderr.Close()
+	})
+
+	return stdout, stderr
+}
+
+func readFile(t *testing.T, file *os.File) string {
+	t.Helper()
+	data, err := os.ReadFile(file.Name())
+	if err != nil {
+		t.Fatal(err)
+	}
+	return string(data)
+}
+
+func TestConsoleCapture(t *testing.T) {
+	stdout, stderr := replaceStdStreams(t)
+	run, disconnect := newTestRun(t)
+
+	capture := startConsoleCapture(run)
+	fmt.Fprint(os.Stdout, "to stdout\n")
+	fmt.Fprint(os.Stderr, "to stderr\n")
+	capture.stop()
+	capture.stop()
+	records := disconnect()
+
+	if os.Stdout != stdout || os.Stderr != stderr {
+		t.Error("streams were not restored")
+	}
+	if got := readFile(t, stdout); got != "to stdout\n" {
+		t.Errorf("stdout = %q, want output to be forwarded", got)
+	}
+	if got := readFile(t, stderr); got != "to stderr\n" {
+		t.Errorf("stderr = %q, want output to be forwarded", got)
+	}
+
+	output := make(map[spb.OutputRawRecord_OutputType]string)
+	for _, record := range records {
+		output[record.GetOutputType()] += record.GetLine()
+	}
+	want := map[spb.OutputRawRecord_OutputType]string{
+		spb.OutputRawRecord_STDOUT: "to stdout\n",
+		spb.OutputRawRecord_STDERR: "to stderr\n",
+	}
+	if len(output) != len(want) ||
+		output[spb.OutputRawRecord_STDOUT] != want[spb.OutputRawRecord_STDOUT] ||
+		output[spb.OutputRawRecord_STDERR] != want[spb.OutputRawRecord_STDERR] {
+		t.Errorf("published output = %v, want %v", output, want)
+	}
+}
+
+func TestConsoleCapture_OneRunAtATime(t *testing.T) {
+	stdout, stderr := replaceStdStreams(t)
+	first, disconnectFirst := newTestRun(t)
+	second, disconnectSecond := newTestRun(t)
+
+	firstCapture := startConsoleCapture(first)
+	secondCapture := startConsoleCapture(second)
+	fmt.Fprint(os.Stdout, "output\n")
+	stopActiveConsoleCapture()
+	firstCapture.stop()
+
+	if secondCapture != nil {
+		t.Error("second run captured the console")
+	}
+	if os.Stdout != stdout || os.Stderr != stderr {
+		t.Error("streams were not restored")
+	}
+	if records := disconnectFirst(); len(records) != 1 {
+		t.Errorf("first run got %d records, want 1", len(records))
+	}
+	if records := disconnectSecond(); len(records) != 0 {
+		t.Errorf("second run got %d records, want 0", len(records))
+	}
+}
@@ -0,0 +1,26 @@
+package wandb
+
+import (
+	"log/slog"
+)
+
+// LogHandler returns a slog.Handler that sends log records to the run's
+// console logs, formatted as by slog.TextHandler.
+//
+// Records are sent as stderr output. The handler works whether or not console
+// capture is enabled in the run's settings.
+func (r *Run) LogHandler(opts *slog.HandlerOptions) slog.Handler {
+	return slog.NewTextHandler(&runLogWriter{run: r}, opts)
+}
+
+// runLogWriter forwards each formatted log record to the run.
+type runLogWriter struct {
+	run *Run
+}
+
+func (w *runLogWriter) Write(p []byte) (int, error) {
+	if err := w.run.interfaces.PublishOutputRaw(string(p), true); err != nil {
+		return 0, err
+	}
+	return len(p), nil
+}
@@ -0,0 +1,28 @@
+package wandb
+
+import (
+	"log/slog"
+	"strings"
+	"testing"
+
+	spb "github.com/wandb/wandb/core/pkg/service_go_proto"
+)
+
+func TestLogHandler(t *testing.T) {
+	run, disconnect := newTestRun(t)
+
+	logger := slog.New(run.LogHandler(&slog.HandlerOptions{Level: slog.LevelInfo}))
+	logger.Debug("not logged")
+	logger.Info("hello", "step", 3)
+	records := disconnect()
+
+	if len(records) != 1 {
+		t.Fatalf("got %d records, want 1", len(records))
+	}
+	if records[0].GetOutputType() != spb.OutputRawRecord_STDERR {
+		t.Errorf("output type = %v, want STDERR", records[0].GetOutputType())
+	}
+	if line := records[0].GetLine(); !strings.Contains(line, "level=INFO msg=hello step=3\n") {
+		t.Errorf("line = %q, want the formatted record", line)
+	}
+}
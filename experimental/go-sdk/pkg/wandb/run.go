@@ -5,7 +5,6 @@ import (
 	"fmt"
 	"log/slog"
 	"os"
-	"path/filepath"
 
 	spb "github.com/wandb/wandb/core/pkg/service_go_proto"
 
@@ -47,6 +46,9 @@ type Run struct {
 	config         *runconfig.Config
 	interfaces     interfaces.IRun
 	partialHistory map[string]interface{}
+
+	// console tees stdout and stderr into the run, if enabled.
+	console *consoleCapture
 }
 
 // newRun creates a new run with the given settings and responders.
@@ -86,6 +88,10 @@ func (r *Run) start() {
 	result = r.interfaces.DeliverRunStartRequest(r.settings).Wait()
 	// print the header
 	r.printRunURL()
+
+	if r.settings.IsConsoleCapture() {
+		r.console = startConsoleCapture(r)
+	}
 }
 
 func (r *Run) Log(data map[string]any, commit bool) {
@@ -99,16 +105,20 @@ func (r *Run) Log(data map[string]any, commit bool) {
 }
 
 func (r *Run) Finish() {
+	// Restore the console before anything that can fail or block.
+	if r.console != nil {
+		r.console.stop()
+		r.console = nil
+	}
+
 	_ = r.interfaces.DeliverExitRecord().Wait()
 
 	_ = r.interfaces.DeliverShutdownRecord().Wait()
 
 	r.interfaces.InformFinish(r.settings)
 
+	// wandb-core prints the footer once it finishes the run.
 	r.interfaces.Close()
-	// print the footer
-	r.printRunURL()
-	r.printLogDir()
 }
 
 func (r *Run) printRunURL() {
@@ -118,20 +128,3 @@ func (r *Run) printRunURL() {
 		format(r.settings.GetRunURL(), Blue),
 	)
 }
-
-func (r *Run) printLogDir() {
-	logDir := r.settings.GetLogDir()
-	cwd, err := os.Getwd()
-	if err != nil {
-		slog.Error("error getting current working directory", "err", err)
-	} else {
-		logDir, err = filepath.Rel(cwd, r.settings.GetLogDir())
-		if err != nil {
-			slog.Error("error getting relative log dir", "err", err)
-		}
-	}
-	fmt.Printf("%v: Find logs at: %v\n",
-		format("wandb", BrightBlue),
-		format(logDir, BrightMagenta),
-	)
-}
@@ -79,6 +79,9 @@ func (s *Session) connect() *connection.Connection {
 }
 
 func (s *Session) Close() {
+	// Restore the console if a run was not finished, such as after an error.
+	stopActiveConsoleCapture()
+
 	conn := s.connect()
 	if err := conn.Send(&spb.ServerRequest{
 		ServerRequestType: &spb.ServerRequest_InformTeardown{
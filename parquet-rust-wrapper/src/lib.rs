@@ -239,21 +239,92 @@ pub unsafe extern "C" fn reader_scan_step_range(
 
     let handle = unsafe { &mut *reader_ptr };
 
+    let matching_rows = match scan_matching_batches(handle, min_step, max_step) {
+        Ok(batches) => batches,
+        Err(e) => return error_to_c_string(&e),
+    };
+
+    // Serialize the matching data into the buffer
+    let mut buffer: Vec<u8> = Vec::new();
+    if !matching_rows.is_empty() {
+        buffer = match serialize::serialize_batches_to_kv_binary(&matching_rows) {
+            Ok(b) => b,
+            Err(e) => return error_to_c_string(&format!("Failed to serialize data: {}", e)),
+        };
+    }
+
+    unsafe { write_scan_result(buffer, &matching_rows, out_result) };
+    std::ptr::null()
+}
+
+/// Scan records like `reader_scan_step_range`, but return them as an
+/// Arrow IPC stream instead of the binary KV format.
+///
+/// The stream holds one schema message followed by the matching record
+/// batches, and can be read by any Arrow implementation without copying.
+///
+/// Parameters and ownership rules are the same as for `reader_scan_step_range`.
+///
+/// # Safety
+///
+/// This function is unsafe because it:
+/// - Dereferences raw pointers
+/// - out_result must point to valid memory
+/// - Returns a buffer that must be freed by caller using free_buffer
+#[no_mangle]
+pub unsafe extern "C" fn reader_scan_step_range_ipc(
+    reader_ptr: *mut ReaderHandle,
+    min_step: i64,
+    max_step: i64,
+    out_result: *mut StepScanResult,
+) -> *const libc::c_char {
+    if reader_ptr.is_null() {
+        return error_to_c_string("Null reader pointer provided");
+    }
+    if out_result.is_null() {
+        return error_to_c_string("Null out result pointer provided");
+    }
+
+    let handle = unsafe { &mut *reader_ptr };
+
+    let matching_rows = match scan_matching_batches(handle, min_step, max_step) {
+        Ok(batches) => batches,
+        Err(e) => return error_to_c_string(&e),
+    };
+
+    let mut buffer: Vec<u8> = Vec::new();
+    if !matching_rows.is_empty() {
+        buffer = match serialize::serialize_batches_to_ipc_stream(&matching_rows) {
+            Ok(b) => b,
+            Err(e) => return error_to_c_string(&format!("Failed to serialize data: {}", e)),
+        };
+    }
+
+    unsafe { write_scan_result(buffer, &matching_rows, out_result) };
+    std::ptr::null()
+}
+
+/// Read the record batches of a reader whose step values are between
+/// min_step (inclusive) and max_step (exclusive).
+///
+/// The reader is recreated if it is exhausted or the range starts before
+/// the last step returned, so that earlier records can be read again.
+fn scan_matching_batches(
+    handle: &mut ReaderHandle,
+    min_step: i64,
+    max_step: i64,
+) -> Result<Vec<RecordBatch>, String> {
     // Only recreate the reader if:
     // 1. We're going backwards (min_step <= last_step_returned)
     // 2. The reader was exhausted in a previous call
     // Otherwise, continue from where we left off
     let needs_recreation = min_step <= handle.last_step_returned || handle.reader_exhausted;
     if needs_recreation {
-        if let Err(e) = handle.recreate_reader() {
-            return error_to_c_string(&format!("Failed to recreate reader: {}", e));
-        }
+        handle
+            .recreate_reader()
+            .map_err(|e| format!("Failed to recreate reader: {}", e))?;
     }
 
-    // Create a buffer to hold the resulting data
-    let mut buffer: Vec<u8> = Vec::new();
-
-    let mut total_rows_returned = 0;
     let mut actual_max_step_returned: Option<i64> = None;
 
     // Read batches and process row by row
@@ -272,7 +343,7 @@ pub unsafe extern "C" fn reader_scan_step_range(
                     batch
                 }
                 Some(Err(e)) => {
-                    return error_to_c_string(&format!("Failed to read batch: {}", e));
+                    return Err(format!("Failed to read batch: {}", e));
                 }
                 // Reached end of file
                 None => {
@@ -284,22 +355,13 @@ pub unsafe extern "C" fn reader_scan_step_range(
         };
 
         // Get the index of the step column
-        let step_col_idx = match batch.schema().index_of(STEP_COLUMN_NAME) {
-            Ok(idx) => idx,
-            Err(_) => {
-                return error_to_c_string(&format!(
-                    "Step column '{}' not found in schema",
-                    STEP_COLUMN_NAME
-                ));
-            }
-        };
+        let step_col_idx = batch.schema().index_of(STEP_COLUMN_NAME).map_err(|_| {
+            format!("Step column '{}' not found in schema", STEP_COLUMN_NAME)
+        })?;
         let step_column = batch.column(step_col_idx);
 
         // Get step values as i64
-        let step_values: Vec<Option<i64>> = match step_values_as_i64(step_column, batch.num_rows()) {
-            Ok(v) => v,
-            Err(e) => return error_to_c_string(&e),
-        };
+        let step_values = step_values_as_i64(step_column, batch.num_rows())?;
 
         // Filter rows that fall within the step range, using a filter mask.
         let mut filter_mask_vec = vec![false; batch.num_rows()];
@@ -337,12 +399,8 @@ pub unsafe extern "C" fn reader_scan_step_range(
         let filter_mask = arrow::array::BooleanArray::from(filter_mask_vec);
 
         // Apply the filter to get matching rows
-        let filtered_batch = match arrow::compute::filter_record_batch(&batch, &filter_mask) {
-            Ok(b) => b,
-            Err(e) => {
-                return error_to_c_string(&format!("Failed to filter batch: {}", e));
-            }
-        };
+        let filtered_batch = arrow::compute::filter_record_batch(&batch, &filter_mask)
+            .map_err(|e| format!("Failed to filter batch: {}", e))?;
 
         if filtered_batch.num_rows() > 0 {
             matching_rows.push(filtered_batch);
@@ -356,17 +414,29 @@ pub unsafe extern "C" fn reader_scan_step_range(
         }
     }
 
-    // Serialize the matching data into the buffer
-    if !matching_rows.is_empty() {
-        for batch in &matching_rows {
-            total_rows_returned += batch.num_rows();
-        }
-        buffer = match serialize::serialize_batches_to_kv_binary(&matching_rows) {
-            Ok(b) => b,
-            Err(e) => return error_to_c_string(&format!("Failed to serialize data: {}", e)),
-        };
+    // Update the last step returned to the actual max step value we returned
+    // This allows the next query to continue from where we left off
+    if let Some(actual_max) = actual_max_step_returned {
+        handle.last_step_returned = actual_max;
     }
 
+    Ok(matching_rows)
+}
+
+/// Hand a serialized scan result over to the caller.
+///
+/// If no rows matched, the result is zeroed and the buffer is dropped.
+///
+/// # Safety
+///
+/// out_result must point to valid memory.
+unsafe fn write_scan_result(
+    buffer: Vec<u8>,
+    batches: &[RecordBatch],
+    out_result: *mut StepScanResult,
+) {
+    let total_rows_returned: usize = batches.iter().map(|b| b.num_rows()).sum();
+
     // If no rows were found, return empty result
     if total_rows_returned == 0 {
         unsafe {
@@ -375,13 +445,7 @@ pub unsafe extern "C" fn reader_scan_step_range(
             (*out_result).data_len = 0;
             (*out_result).num_rows_returned = 0;
         }
-        return std::ptr::null();
-    }
-
-    // Update the last step returned to the actual max step value we returned
-    // This allows the next query to continue from where we left off
-    if let Some(actual_max) = actual_max_step_returned {
-        handle.last_step_returned = actual_max;
+        return;
     }
 
     // Convert the buffer to a boxed pointer
@@ -401,7 +465,6 @@ pub unsafe extern "C" fn reader_scan_step_range(
         (*out_result).data_len = data_len as u64;
         (*out_result).num_rows_returned = total_rows_returned as u64;
     }
-    std::ptr::null()
 }
 
 /// Free a buffer allocated by Rust.
@@ -411,6 +474,7 @@ pub unsafe extern "C" fn reader_scan_step_range(
 /// This function is unsafe because it:
 /// - Takes ownership of a raw pointer to a Vec<u8>
 /// - Must only be called with pointers returned from reader_scan_step_range
+///   or reader_scan_step_range_ipc
 /// - Must only be called once per pointer
 #[no_mangle]
 pub unsafe extern "C" fn free_buffer(buffer_ptr: *mut Vec<u8>) {
@@ -21,6 +21,7 @@ use arrow::array::{
 };
 use arrow::compute::cast;
 use arrow::datatypes::DataType;
+use arrow::ipc::writer::StreamWriter;
 
 /// Type tags for the binary wire format between Rust and Go.
 pub const TYPE_NULL: u8 = 0;
@@ -78,6 +79,25 @@ pub fn serialize_batches_to_kv_binary(batches: &[RecordBatch]) -> Result<Vec<u8>
     Ok(buf)
 }
 
+/// Serializes RecordBatches into an Arrow IPC stream.
+///
+/// The stream contains the schema of the first batch followed by every
+/// batch, all of which must share that schema.
+pub fn serialize_batches_to_ipc_stream(batches: &[RecordBatch]) -> Result<Vec<u8>, io::Error> {
+    if batches.is_empty() {
+        return Ok(Vec::new());
+    }
+
+    let schema = batches[0].schema();
+    let mut writer = StreamWriter::try_new(Vec::new(), &schema).map_err(io::Error::other)?;
+    for batch in batches {
+        writer.write(batch).map_err(io::Error::other)?;
+    }
+    writer.finish().map_err(io::Error::other)?;
+
+    writer.into_inner().map_err(io::Error::other)
+}
+
 fn write_value(buf: &mut Vec<u8>, arr: &dyn Array, idx: usize) -> Result<(), io::Error> {
     if arr.is_null(idx) {
         buf.push(TYPE_NULL);
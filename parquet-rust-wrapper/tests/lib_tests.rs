@@ -385,6 +385,63 @@ fn test_reader_scan_step_range_with_columns_subset() {
     }
 }
 
+#[test]
+fn test_reader_scan_step_range_ipc() {
+    let temp_dir = TempDir::new().unwrap();
+    let file_path = temp_dir.path().join("test.parquet");
+    create_test_parquet_file(file_path.to_str().unwrap(), 100).unwrap();
+
+    let path_cstring = CString::new(file_path.to_str().unwrap()).unwrap();
+    let mut out_error: *mut libc::c_char = std::ptr::null_mut();
+    let reader_ptr = unsafe {
+        create_reader(
+            path_cstring.as_ptr(),
+            std::ptr::null(),
+            0,
+            &mut out_error,
+        )
+    };
+    assert!(!reader_ptr.is_null());
+
+    let mut result = StepScanResult {
+        vec_ptr: 0,
+        data_ptr: 0,
+        data_len: 0,
+        num_rows_returned: 0,
+    };
+
+    let error = unsafe {
+        reader_scan_step_range_ipc(reader_ptr, 10, 20, &mut result)
+    };
+    assert!(error.is_null());
+    assert_eq!(result.num_rows_returned, 10);
+
+    let data_slice = unsafe {
+        std::slice::from_raw_parts(result.data_ptr as *const u8, result.data_len as usize)
+    };
+    let stream_reader =
+        arrow::ipc::reader::StreamReader::try_new(std::io::Cursor::new(data_slice), None)
+            .unwrap();
+    let batches: Vec<RecordBatch> = stream_reader.map(|b| b.unwrap()).collect();
+
+    let mut step_values = Vec::new();
+    for batch in &batches {
+        assert_eq!(batch.schema().field(2).name(), "name");
+        let steps = batch
+            .column(0)
+            .as_any()
+            .downcast_ref::<Int64Array>()
+            .unwrap();
+        step_values.extend(steps.values().iter().copied());
+    }
+    assert_eq!(step_values, (10..20).collect::<Vec<i64>>());
+
+    unsafe {
+        free_buffer(result.vec_ptr as *mut Vec<u8>);
+        free_reader(reader_ptr);
+    }
+}
+
 #[test]
 fn test_reader_scan_step_range_sequential_calls() {
     let temp_dir = TempDir::new().unwrap();
@@ -202,6 +202,7 @@ SYMBOLS_RUN = {
     "notes",
     "tags",
     "mark_preempting",
+    "capture_profile",
     "to_html",
     "display",
     "settings",
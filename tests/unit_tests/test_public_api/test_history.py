@@ -2,7 +2,9 @@ import json
 from collections.abc import Callable
 from types import SimpleNamespace
 
-from wandb.apis.public.history import HistoryScan
+import pytest
+
+from wandb.apis.public.history import BatchHistoryScan, HistoryScan
 from wandb.proto import wandb_api_pb2 as apb
 
 
@@ -10,6 +12,7 @@ class FakeServiceApi:
     def __init__(self, pages):
         self.pages = list(pages)
         self.scan_ranges = []
+        self.scan_formats = []
 
     def send_api_request(self, request):
         read_request = request.read_run_history_request
@@ -24,10 +27,12 @@ class FakeServiceApi:
         if read_request.HasField("scan_run_history"):
             scan_request = read_request.scan_run_history
             self.scan_ranges.append((scan_request.min_step, scan_request.max_step))
+            self.scan_formats.append(scan_request.format)
+            page = self.pages.pop(0)
+            if not isinstance(page, apb.RunHistoryResponse):
+                page = apb.RunHistoryResponse(history_rows=page)
             return apb.ApiResponse(
-                read_run_history_response=apb.ReadRunHistoryResponse(
-                    run_history=apb.RunHistoryResponse(history_rows=self.pages.pop(0))
-                )
+                read_run_history_response=apb.ReadRunHistoryResponse(run_history=page)
             )
 
         return apb.ApiResponse(
@@ -74,3 +79,110 @@ def test_scan_history_skips_empty_pages_before_later_history():
         {"_step": 3, "acc": 0.75},
     ]
     assert service_api.scan_ranges == [(0, 2), (2, 4)]
+
+
+def test_scan_history_to_arrow():
+    pa = pytest.importorskip("pyarrow")
+
+    exported = pa.table({"_step": [0, 1], "acc": [0.25, 0.5]})
+    sink = pa.BufferOutputStream()
+    with pa.ipc.new_stream(sink, exported.schema) as writer:
+        writer.write_table(exported)
+    service_api = FakeServiceApi(
+        pages=[
+            apb.RunHistoryResponse(
+                arrow_ipc_streams=[sink.getvalue().to_pybytes()],
+                history_rows=[history_row(_step=2, acc=0.75, loss=1.5)],
+            ),
+        ]
+    )
+    run = SimpleNamespace(entity="entity", project="project", id="run-id")
+
+    scan = HistoryScan(
+        service_api=service_api,
+        run=run,
+        min_step=0,
+        max_step=4,
+        page_size=2,
+    )
+
+    assert scan.to_arrow().to_pylist() == [
+        {"_step": 0, "acc": 0.25, "loss": None},
+        {"_step": 1, "acc": 0.5, "loss": None},
+        {"_step": 2, "acc": 0.75, "loss": 1.5},
+    ]
+    assert service_api.scan_ranges == [(0, 4)]
+    assert service_api.scan_formats == [apb.ScanRunHistory.ARROW_IPC]
+
+
+class FakeBatchServiceApi:
+    def __init__(self, responses):
+        self.responses = list(responses)
+        self.init_request = None
+
+    def send_api_request(self, request):
+        read_request = request.read_run_history_request
+
+        if read_request.HasField("batch_run_history_init"):
+            self.init_request = read_request.batch_run_history_init
+            return apb.ApiResponse(
+                read_run_history_response=apb.ReadRunHistoryResponse(
+                    batch_run_history_init=apb.BatchRunHistoryInitResponse(
+                        request_id=1
+                    )
+                )
+            )
+
+        return apb.ApiResponse(
+            read_run_history_response=apb.ReadRunHistoryResponse(
+                batch_run_history=self.responses.pop(0)
+            )
+        )
+
+    def finalize(self, *args, **kwargs) -> Callable[[], None]:
+        return lambda: None
+
+
+def run_result(run_id, rows=(), error=""):
+    return apb.RunHistoryResult(
+        run=apb.HistoryRunPath(entity="e", project="p", run_id=run_id),
+        history_rows=list(rows),
+        error=error,
+    )
+
+
+def test_batch_history_scan_yields_runs_as_they_finish():
+    run_a = SimpleNamespace(entity="e", project="p", id="a")
+    run_b = SimpleNamespace(entity="e", project="p", id="b")
+    run_c = SimpleNamespace(entity="e", project="p", id="c")
+    service_api = FakeBatchServiceApi(
+        responses=[
+            apb.BatchRunHistoryResponse(
+                results=[run_result("b", [history_row(_step=0, loss=1.0)])],
+            ),
+            apb.BatchRunHistoryResponse(
+                results=[
+                    run_result("a", [history_row(_step=0, loss=2.0)]),
+                    run_result("c", error="not found"),
+                ],
+                done=True,
+            ),
+        ]
+    )
+
+    scan = BatchHistoryScan(
+        [run_a, run_b, run_c],
+        service_api=service_api,
+        min_step=0,
+        max_step=10,
+        keys=["loss"],
+        samples=100,
+    )
+
+    assert list(scan) == [
+        (run_b, [{"_step": 0, "loss": 1.0}]),
+        (run_a, [{"_step": 0, "loss": 2.0}]),
+    ]
+    assert service_api.init_request.keys == ["loss"]
+    assert service_api.init_request.sampling.target_points == 100
+    assert len(service_api.init_request.runs) == 3
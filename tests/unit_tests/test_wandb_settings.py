@@ -195,6 +195,19 @@ def test_ignore_globs_env():
     )
 
 
+def test_stats_device_patterns_env():
+    s = Settings()
+    s.update_from_env_vars(
+        {
+            "WANDB_X_STATS_NETWORK_INTERFACES": "eth*,ib0",
+            "WANDB_X_STATS_DISK_DEVICES_EXCLUDE": "loop*",
+        }
+    )
+    assert s.x_stats_network_interfaces == ["eth*", "ib0"]
+    assert s.x_stats_network_interfaces_exclude is None
+    assert s.x_stats_disk_devices_exclude == ["loop*"]
+
+
 def test_token_file_env():
     s = Settings()
     s.update_from_env_vars({"WANDB_IDENTITY_TOKEN_FILE": "jwt.txt"})
@@ -605,6 +618,23 @@ def test_run_id_validation(restricted_chars):
         Settings(run_id=f"test{restricted_chars}")
 
 
+@pytest.mark.parametrize(
+    "pattern",
+    [r"secret(?=\d)", r"(?<!x)token", r"(a)\1", r"key\Z", r"a++", r"(?>ab)"],
+)
+def test_console_redact_patterns_rejects_non_re2_syntax(pattern):
+    with pytest.raises(UsageError, match="Invalid console redaction pattern"):
+        Settings(console_redact_patterns=[pattern])
+
+
+def test_console_redact_patterns_allows_re2_syntax():
+    patterns = [r"password=(\S+)", r"\\1", r"(?P<key>sk-\w+)", r"a\++"]
+
+    settings = Settings(console_redact_patterns=patterns)
+
+    assert settings.console_redact_patterns == tuple(patterns)
+
+
 def test_reads_system_settings(
     tmp_path: pathlib.Path,
     mock_wandb_log: MockWandbLog,
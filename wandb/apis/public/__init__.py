@@ -14,6 +14,7 @@ __all__ = (
     "ConsoleLogs",
     "File",
     "Files",
+    "BatchHistoryScan",  # doc:exclude
     "HistoryScan",  # doc:exclude
     "IncompleteRunHistoryError",
     "Integrations",  # doc:exclude
@@ -60,7 +61,7 @@ from wandb.apis.public.artifacts import (
 from wandb.apis.public.automations import Automations
 from wandb.apis.public.console_logs import ConsoleLogLine, ConsoleLogs
 from wandb.apis.public.files import FILE_FRAGMENT, File, Files
-from wandb.apis.public.history import HistoryScan
+from wandb.apis.public.history import BatchHistoryScan, HistoryScan
 from wandb.apis.public.integrations import (
     Integrations,
     SlackIntegrations,
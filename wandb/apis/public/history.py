@@ -17,9 +17,12 @@ from typing import TYPE_CHECKING, Any, TypeAlias
 
 from typing_extensions import Self
 
+from wandb import util
 from wandb.proto import wandb_api_pb2 as pb
 
 if TYPE_CHECKING:
+    import pyarrow
+
     from . import runs
     from .service_api import ServiceApi
 
@@ -128,6 +131,56 @@ class HistoryScan(Iterator[_RowDict]):
         self.page_offset += self.page_size
         self.scan_offset = 0
 
+    def to_arrow(self) -> pyarrow.Table:
+        """Reads the scan's whole step range as a pyarrow Table.
+
+        History exported to parquet files is sent by wandb-core as Arrow IPC
+        streams, without converting each value to JSON, so the table can be
+        passed to pandas with `to_pandas()` or to polars with
+        `polars.from_arrow()` without building a dict per row. Columns that
+        some rows don't have are null in those rows.
+
+        Requires pyarrow.
+        """
+        pa = util.get_module(
+            "pyarrow", required="Reading history as Arrow requires pyarrow"
+        )
+
+        read_run_history_request = pb.ReadRunHistoryRequest(
+            scan_run_history=pb.ScanRunHistory(
+                min_step=self.min_step,
+                max_step=self._stop_step,
+                request_id=self._scan_request_id,
+                format=pb.ScanRunHistory.ARROW_IPC,
+            ),
+        )
+        api_request = pb.ApiRequest(read_run_history_request=read_run_history_request)
+
+        response: pb.ApiResponse = self._service_api.send_api_request(api_request)
+        run_history: pb.RunHistoryResponse = (
+            response.read_run_history_response.run_history
+        )
+
+        tables = [
+            pa.ipc.open_stream(stream).read_all()
+            for stream in run_history.arrow_ipc_streams
+        ]
+
+        # Rows that haven't been exported yet are still sent as HistoryRows.
+        if run_history.history_rows:
+            tables.append(
+                pa.Table.from_pylist(
+                    [
+                        self._convert_history_row_to_dict(row)
+                        for row in run_history.history_rows
+                    ]
+                )
+            )
+
+        if not tables:
+            return pa.table({})
+        return pa.concat_tables(tables, promote_options="permissive")
+
     @staticmethod
     def _convert_history_row_to_dict(history_row: pb.HistoryRow) -> _RowDict:
         return {
@@ -135,6 +188,120 @@ class HistoryScan(Iterator[_RowDict]):
         }
 
 
+class BatchHistoryScan(Iterator[tuple["runs.Run", list[_RowDict]]]):
+    """Iterator over the history of many runs, read in one batch.
+
+    wandb-core reads the runs concurrently. Each run is yielded with its
+    history rows as soon as it is done, so runs are not yielded in order.
+    Runs whose history could not be read are skipped with a warning.
+    """
+
+    def __init__(
+        self,
+        runs: list[runs.Run],
+        *,
+        service_api: ServiceApi,
+        min_step: int,
+        max_step: int,
+        keys: list[str] | None = None,
+        samples: int | None = None,
+        use_cache: bool = True,
+    ):
+        self._service_api = service_api
+        self._runs_by_path = {(run.entity, run.project, run.id): run for run in runs}
+
+        sampling = None
+        if samples is not None:
+            sampling = pb.HistorySampling(target_points=samples)
+
+        batch_run_history_init = pb.BatchRunHistoryInit(
+            runs=[
+                pb.HistoryRunPath(entity=entity, project=project, run_id=run_id)
+                for entity, project, run_id in self._runs_by_path
+            ],
+            keys=keys,
+            min_step=min_step,
+            max_step=max_step,
+            use_cache=use_cache,
+            sampling=sampling,
+        )
+        api_request = pb.ApiRequest(
+            read_run_history_request=pb.ReadRunHistoryRequest(
+                batch_run_history_init=batch_run_history_init
+            )
+        )
+        response: pb.ApiResponse = self._service_api.send_api_request(api_request)
+
+        self._batch_request_id = (
+            response.read_run_history_response.batch_run_history_init.request_id
+        )
+        self._results: list[tuple[runs.Run, list[_RowDict]]] = []
+        self._done = False
+
+        # Clean up resources when the object is GC'ed.
+        self._service_api.finalize(
+            self,
+            _batch_cleanup_request(self._batch_request_id),
+        )
+
+    def __iter__(self) -> Self:
+        return self
+
+    def __next__(self) -> tuple[runs.Run, list[_RowDict]]:
+        while not self._results:
+            if self._done:
+                raise StopIteration()
+            self._load_next()
+
+        return self._results.pop(0)
+
+    def _load_next(self) -> None:
+        import wandb
+
+        api_request = pb.ApiRequest(
+            read_run_history_request=pb.ReadRunHistoryRequest(
+                batch_run_history_next=pb.BatchRunHistoryNext(
+                    request_id=self._batch_request_id,
+                ),
+            )
+        )
+        response: pb.ApiResponse = self._service_api.send_api_request(api_request)
+        batch: pb.BatchRunHistoryResponse = (
+            response.read_run_history_response.batch_run_history
+        )
+
+        for result in batch.results:
+            path = (result.run.entity, result.run.project, result.run.run_id)
+            run = self._runs_by_path.get(path)
+            if run is None:
+                continue
+
+            if result.error:
+                wandb.termwarn(
+                    f"Could not read the history of run {'/'.join(path)}:"
+                    f" {result.error}"
+                )
+                continue
+
+            rows = [
+                HistoryScan._convert_history_row_to_dict(row)
+                for row in result.history_rows
+            ]
+            self._results.append((run, rows))
+
+        self._done = batch.done
+
+
+def _batch_cleanup_request(id: int) -> pb.ApiRequest:
+    """Returns a BatchRunHistoryCleanup request for the given ID."""
+    batch_cleanup_request = pb.BatchRunHistoryCleanup(request_id=id)
+    run_history_request = pb.ReadRunHistoryRequest(
+        batch_run_history_cleanup=batch_cleanup_request,
+    )
+
+    return pb.ApiRequest(read_run_history_request=run_history_request)
+
+
 def _scan_cleanup_request(id: int) -> pb.ApiRequest:
     """Returns a ScanRunHistoryCleanup request for the given ID."""
     scan_cleanup_request = pb.ScanRunHistoryCleanup(request_id=id)
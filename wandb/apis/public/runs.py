@@ -127,6 +127,14 @@ RUN_FRAGMENT_NAME = "RunFragment"
 LIGHTWEIGHT_RUN_FRAGMENT_NAME = "LightweightRunFragment"
 
 
+def _last_history_step(run: Run) -> int:
+    """Returns the run's last history step, querying it if not loaded."""
+    history_keys = run._attrs.get("historyKeys")
+    if isinstance(history_keys, dict):
+        return history_keys.get("lastStep", -1)
+    return run.lastHistoryStep
+
+
 class RunNotFoundError(ValueError):
     """Raised when a run's data is not able to be loaded."""
 
@@ -444,6 +452,52 @@ class Runs(SizedPaginator["Run"]):
 
             return combined_df
 
+    @normalize_exceptions
+    def scan_histories(
+        self,
+        keys: list[str] | None = None,
+        min_step: int = 0,
+        max_step: int | None = None,
+        samples: int | None = None,
+        use_cache: bool = True,
+    ) -> public.BatchHistoryScan:
+        """Read the history of all runs that fit the filter conditions at once.
+
+        The runs are read concurrently by wandb-core, which is much faster
+        than reading them one at a time for large sweeps.
+
+        Args:
+            keys: Only return metrics for specific keys. If not provided,
+                all metrics are returned.
+            min_step: The minimum step to read history from (inclusive).
+            max_step: The maximum step to read history up to (exclusive).
+                Defaults to reading each run's full history.
+            samples: If set, the maximum number of points to return for each
+                metric of each run.
+            use_cache: When set to True, checks the WANDB_CACHE_DIR for
+                each run's history before downloading it.
+
+        Returns:
+            A BatchHistoryScan that yields `(run, rows)` pairs as each run's
+            history is read, where `rows` is a list of history records.
+        """
+        if keys is not None and not isinstance(keys, list):
+            raise ValueError("keys must be specified in a list")
+
+        runs = list(self)
+        if max_step is None:
+            max_step = max((_last_history_step(run) for run in runs), default=-1) + 1
+
+        return public.BatchHistoryScan(
+            runs,
+            service_api=self._service_api,
+            min_step=min_step,
+            max_step=max_step,
+            keys=keys,
+            samples=samples,
+            use_cache=use_cache,
+        )
+
     def __repr__(self) -> str:
         return f"<{nameof(type(self))} {self.entity}/{self.project}>"
 
@@ -1348,6 +1402,8 @@ class Run(Attrs):
         Returns:
             A HistoryScan object,
             which can be iterator over to get history records.
+            Call its `to_arrow()` method to read all the records
+            into a pyarrow Table instead.
         """
         if keys is not None and not isinstance(keys, list):
             raise ValueError("keys must be specified in a list")
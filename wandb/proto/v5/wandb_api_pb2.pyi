@@ -448,36 +448,48 @@ class UpsertRunQueueResponse(_message.Message):
     def __init__(self, success: bool = ..., config_schema_validation_errors: _Optional[_Iterable[str]] = ...) -> None: ...
 
 class ReadRunHistoryRequest(_message.Message):
-    __slots__ = ("scan_run_history_init", "scan_run_history", "scan_run_history_cleanup", "download_run_history_init", "download_run_history", "download_run_history_status")
+    __slots__ = ("scan_run_history_init", "scan_run_history", "scan_run_history_cleanup", "download_run_history_init", "download_run_history", "download_run_history_status", "batch_run_history_init", "batch_run_history_next", "batch_run_history_cleanup")
     SCAN_RUN_HISTORY_INIT_FIELD_NUMBER: _ClassVar[int]
     SCAN_RUN_HISTORY_FIELD_NUMBER: _ClassVar[int]
     SCAN_RUN_HISTORY_CLEANUP_FIELD_NUMBER: _ClassVar[int]
     DOWNLOAD_RUN_HISTORY_INIT_FIELD_NUMBER: _ClassVar[int]
     DOWNLOAD_RUN_HISTORY_FIELD_NUMBER: _ClassVar[int]
     DOWNLOAD_RUN_HISTORY_STATUS_FIELD_NUMBER: _ClassVar[int]
+    BATCH_RUN_HISTORY_INIT_FIELD_NUMBER: _ClassVar[int]
+    BATCH_RUN_HISTORY_NEXT_FIELD_NUMBER: _ClassVar[int]
+    BATCH_RUN_HISTORY_CLEANUP_FIELD_NUMBER: _ClassVar[int]
     scan_run_history_init: ScanRunHistoryInit
     scan_run_history: ScanRunHistory
     scan_run_history_cleanup: ScanRunHistoryCleanup
     download_run_history_init: DownloadRunHistoryInit
     download_run_history: DownloadRunHistory
     download_run_history_status: DownloadRunHistoryStatus
-    def __init__(self, scan_run_history_init: _Optional[_Union[ScanRunHistoryInit, _Mapping]] = ..., scan_run_history: _Optional[_Union[ScanRunHistory, _Mapping]] = ..., scan_run_history_cleanup: _Optional[_Union[ScanRunHistoryCleanup, _Mapping]] = ..., download_run_history_init: _Optional[_Union[DownloadRunHistoryInit, _Mapping]] = ..., download_run_history: _Optional[_Union[DownloadRunHistory, _Mapping]] = ..., download_run_history_status: _Optional[_Union[DownloadRunHistoryStatus, _Mapping]] = ...) -> None: ...
+    batch_run_history_init: BatchRunHistoryInit
+    batch_run_history_next: BatchRunHistoryNext
+    batch_run_history_cleanup: BatchRunHistoryCleanup
+    def __init__(self, scan_run_history_init: _Optional[_Union[ScanRunHistoryInit, _Mapping]] = ..., scan_run_history: _Optional[_Union[ScanRunHistory, _Mapping]] = ..., scan_run_history_cleanup: _Optional[_Union[ScanRunHistoryCleanup, _Mapping]] = ..., download_run_history_init: _Optional[_Union[DownloadRunHistoryInit, _Mapping]] = ..., download_run_history: _Optional[_Union[DownloadRunHistory, _Mapping]] = ..., download_run_history_status: _Optional[_Union[DownloadRunHistoryStatus, _Mapping]] = ..., batch_run_history_init: _Optional[_Union[BatchRunHistoryInit, _Mapping]] = ..., batch_run_history_next: _Optional[_Union[BatchRunHistoryNext, _Mapping]] = ..., batch_run_history_cleanup: _Optional[_Union[BatchRunHistoryCleanup, _Mapping]] = ...) -> None: ...
 
 class ReadRunHistoryResponse(_message.Message):
-    __slots__ = ("scan_run_history_init", "run_history", "scan_run_history_cleanup", "download_run_history_init", "download_run_history", "download_run_history_status")
+    __slots__ = ("scan_run_history_init", "run_history", "scan_run_history_cleanup", "download_run_history_init", "download_run_history", "download_run_history_status", "batch_run_history_init", "batch_run_history", "batch_run_history_cleanup")
     SCAN_RUN_HISTORY_INIT_FIELD_NUMBER: _ClassVar[int]
     RUN_HISTORY_FIELD_NUMBER: _ClassVar[int]
     SCAN_RUN_HISTORY_CLEANUP_FIELD_NUMBER: _ClassVar[int]
     DOWNLOAD_RUN_HISTORY_INIT_FIELD_NUMBER: _ClassVar[int]
     DOWNLOAD_RUN_HISTORY_FIELD_NUMBER: _ClassVar[int]
     DOWNLOAD_RUN_HISTORY_STATUS_FIELD_NUMBER: _ClassVar[int]
+    BATCH_RUN_HISTORY_INIT_FIELD_NUMBER: _ClassVar[int]
+    BATCH_RUN_HISTORY_FIELD_NUMBER: _ClassVar[int]
+    BATCH_RUN_HISTORY_CLEANUP_FIELD_NUMBER: _ClassVar[int]
     scan_run_history_init: ScanRunHistoryInitResponse
     run_history: RunHistoryResponse
     scan_run_history_cleanup: ScanRunHistoryCleanupResponse
     download_run_history_init: DownloadRunHistoryInitResponse
     download_run_history: DownloadRunHistoryResponse
     download_run_history_status: DownloadRunHistoryStatusResponse
-    def __init__(self, scan_run_history_init: _Optional[_Union[ScanRunHistoryInitResponse, _Mapping]] = ..., run_history: _Optional[_Union[RunHistoryResponse, _Mapping]] = ..., scan_run_history_cleanup: _Optional[_Union[ScanRunHistoryCleanupResponse, _Mapping]] = ..., download_run_history_init: _Optional[_Union[DownloadRunHistoryInitResponse, _Mapping]] = ..., download_run_history: _Optional[_Union[DownloadRunHistoryResponse, _Mapping]] = ..., download_run_history_status: _Optional[_Union[DownloadRunHistoryStatusResponse, _Mapping]] = ...) -> None: ...
+    batch_run_history_init: BatchRunHistoryInitResponse
+    batch_run_history: BatchRunHistoryResponse
+    batch_run_history_cleanup: BatchRunHistoryCleanupResponse
+    def __init__(self, scan_run_history_init: _Optional[_Union[ScanRunHistoryInitResponse, _Mapping]] = ..., run_history: _Optional[_Union[RunHistoryResponse, _Mapping]] = ..., scan_run_history_cleanup: _Optional[_Union[ScanRunHistoryCleanupResponse, _Mapping]] = ..., download_run_history_init: _Optional[_Union[DownloadRunHistoryInitResponse, _Mapping]] = ..., download_run_history: _Optional[_Union[DownloadRunHistoryResponse, _Mapping]] = ..., download_run_history_status: _Optional[_Union[DownloadRunHistoryStatusResponse, _Mapping]] = ..., batch_run_history_init: _Optional[_Union[BatchRunHistoryInitResponse, _Mapping]] = ..., batch_run_history: _Optional[_Union[BatchRunHistoryResponse, _Mapping]] = ..., batch_run_history_cleanup: _Optional[_Union[BatchRunHistoryCleanupResponse, _Mapping]] = ...) -> None: ...
 
 class ScanRunHistoryInit(_message.Message):
     __slots__ = ("entity", "project", "run_id", "keys", "use_cache")
@@ -500,20 +512,54 @@ class ScanRunHistoryInitResponse(_message.Message):
     def __init__(self, request_id: _Optional[int] = ...) -> None: ...
 
 class ScanRunHistory(_message.Message):
-    __slots__ = ("min_step", "max_step", "request_id")
+    __slots__ = ("min_step", "max_step", "request_id", "sampling", "format")
+    class Format(int, metaclass=_enum_type_wrapper.EnumTypeWrapper):
+        __slots__ = ()
+        HISTORY_ROWS: _ClassVar[ScanRunHistory.Format]
+        ARROW_IPC: _ClassVar[ScanRunHistory.Format]
+        ARROW_IPC_FILE: _ClassVar[ScanRunHistory.Format]
+    HISTORY_ROWS: ScanRunHistory.Format
+    ARROW_IPC: ScanRunHistory.Format
+    ARROW_IPC_FILE: ScanRunHistory.Format
     MIN_STEP_FIELD_NUMBER: _ClassVar[int]
     MAX_STEP_FIELD_NUMBER: _ClassVar[int]
     REQUEST_ID_FIELD_NUMBER: _ClassVar[int]
+    SAMPLING_FIELD_NUMBER: _ClassVar[int]
+    FORMAT_FIELD_NUMBER: _ClassVar[int]
     min_step: int
     max_step: int
     request_id: int
-    def __init__(self, min_step: _Optional[int] = ..., max_step: _Optional[int] = ..., request_id: _Optional[int] = ...) -> None: ...
+    sampling: HistorySampling
+    format: ScanRunHistory.Format
+    def __init__(self, min_step: _Optional[int] = ..., max_step: _Optional[int] = ..., request_id: _Optional[int] = ..., sampling: _Optional[_Union[HistorySampling, _Mapping]] = ..., format: _Optional[_Union[ScanRunHistory.Format, str]] = ...) -> None: ...
+
+class HistorySampling(_message.Message):
+    __slots__ = ("target_points", "aggregation")
+    class Aggregation(int, metaclass=_enum_type_wrapper.EnumTypeWrapper):
+        __slots__ = ()
+        EVERY_NTH: _ClassVar[HistorySampling.Aggregation]
+        MIN_MAX: _ClassVar[HistorySampling.Aggregation]
+        MEAN: _ClassVar[HistorySampling.Aggregation]
+        LTTB: _ClassVar[HistorySampling.Aggregation]
+    EVERY_NTH: HistorySampling.Aggregation
+    MIN_MAX: HistorySampling.Aggregation
+    MEAN: HistorySampling.Aggregation
+    LTTB: HistorySampling.Aggregation
+    TARGET_POINTS_FIELD_NUMBER: _ClassVar[int]
+    AGGREGATION_FIELD_NUMBER: _ClassVar[int]
+    target_points: int
+    aggregation: HistorySampling.Aggregation
+    def __init__(self, target_points: _Optional[int] = ..., aggregation: _Optional[_Union[HistorySampling.Aggregation, str]] = ...) -> None: ...
 
 class RunHistoryResponse(_message.Message):
-    __slots__ = ("history_rows",)
+    __slots__ = ("history_rows", "arrow_ipc_streams", "arrow_ipc_files")
     HISTORY_ROWS_FIELD_NUMBER: _ClassVar[int]
+    ARROW_IPC_STREAMS_FIELD_NUMBER: _ClassVar[int]
+    ARROW_IPC_FILES_FIELD_NUMBER: _ClassVar[int]
     history_rows: _containers.RepeatedCompositeFieldContainer[HistoryRow]
-    def __init__(self, history_rows: _Optional[_Iterable[_Union[HistoryRow, _Mapping]]] = ...) -> None: ...
+    arrow_ipc_streams: _containers.RepeatedScalarFieldContainer[bytes]
+    arrow_ipc_files: _containers.RepeatedScalarFieldContainer[str]
+    def __init__(self, history_rows: _Optional[_Iterable[_Union[HistoryRow, _Mapping]]] = ..., arrow_ipc_streams: _Optional[_Iterable[bytes]] = ..., arrow_ipc_files: _Optional[_Iterable[str]] = ...) -> None: ...
 
 class HistoryRow(_message.Message):
     __slots__ = ("history_items",)
@@ -539,6 +585,72 @@ class ScanRunHistoryCleanupResponse(_message.Message):
     __slots__ = ()
     def __init__(self) -> None: ...
 
+class HistoryRunPath(_message.Message):
+    __slots__ = ("entity", "project", "run_id")
+    ENTITY_FIELD_NUMBER: _ClassVar[int]
+    PROJECT_FIELD_NUMBER: _ClassVar[int]
+    RUN_ID_FIELD_NUMBER: _ClassVar[int]
+    entity: str
+    project: str
+    run_id: str
+    def __init__(self, entity: _Optional[str] = ..., project: _Optional[str] = ..., run_id: _Optional[str] = ...) -> None: ...
+
+class BatchRunHistoryInit(_message.Message):
+    __slots__ = ("runs", "keys", "min_step", "max_step", "use_cache", "sampling")
+    RUNS_FIELD_NUMBER: _ClassVar[int]
+    KEYS_FIELD_NUMBER: _ClassVar[int]
+    MIN_STEP_FIELD_NUMBER: _ClassVar[int]
+    MAX_STEP_FIELD_NUMBER: _ClassVar[int]
+    USE_CACHE_FIELD_NUMBER: _ClassVar[int]
+    SAMPLING_FIELD_NUMBER: _ClassVar[int]
+    runs: _containers.RepeatedCompositeFieldContainer[HistoryRunPath]
+    keys: _containers.RepeatedScalarFieldContainer[str]
+    min_step: int
+    max_step: int
+    use_cache: bool
+    sampling: HistorySampling
+    def __init__(self, runs: _Optional[_Iterable[_Union[HistoryRunPath, _Mapping]]] = ..., keys: _Optional[_Iterable[str]] = ..., min_step: _Optional[int] = ..., max_step: _Optional[int] = ..., use_cache: _Optional[bool] = ..., sampling: _Optional[_Union[HistorySampling, _Mapping]] = ...) -> None: ...
+
+class BatchRunHistoryInitResponse(_message.Message):
+    __slots__ = ("request_id",)
+    REQUEST_ID_FIELD_NUMBER: _ClassVar[int]
+    request_id: int
+    def __init__(self, request_id: _Optional[int] = ...) -> None: ...
+
+class BatchRunHistoryNext(_message.Message):
+    __slots__ = ("request_id",)
+    REQUEST_ID_FIELD_NUMBER: _ClassVar[int]
+    request_id: int
+    def __init__(self, request_id: _Optional[int] = ...) -> None: ...
+
+class BatchRunHistoryResponse(_message.Message):
+    __slots__ = ("results", "done")
+    RESULTS_FIELD_NUMBER: _ClassVar[int]
+    DONE_FIELD_NUMBER: _ClassVar[int]
+    results: _containers.RepeatedCompositeFieldContainer[RunHistoryResult]
+    done: bool
+    def __init__(self, results: _Optional[_Iterable[_Union[RunHistoryResult, _Mapping]]] = ..., done: _Optional[bool] = ...) -> None: ...
+
+class RunHistoryResult(_message.Message):
+    __slots__ = ("run", "history_rows", "error")
+    RUN_FIELD_NUMBER: _ClassVar[int]
+    HISTORY_ROWS_FIELD_NUMBER: _ClassVar[int]
+    ERROR_FIELD_NUMBER: _ClassVar[int]
+    run: HistoryRunPath
+    history_rows: _containers.RepeatedCompositeFieldContainer[HistoryRow]
+    error: str
+    def __init__(self, run: _Optional[_Union[HistoryRunPath, _Mapping]] = ..., history_rows: _Optional[_Iterable[_Union[HistoryRow, _Mapping]]] = ..., error: _Optional[str] = ...) -> None: ...
+
+class BatchRunHistoryCleanup(_message.Message):
+    __slots__ = ("request_id",)
+    REQUEST_ID_FIELD_NUMBER: _ClassVar[int]
+    request_id: int
+    def __init__(self, request_id: _Optional[int] = ...) -> None: ...
+
+class BatchRunHistoryCleanupResponse(_message.Message):
+    __slots__ = ()
+    def __init__(self) -> None: ...
+
 class DownloadRunHistoryInit(_message.Message):
     __slots__ = ("entity", "project", "run_id", "download_dir", "require_complete_history")
     ENTITY_FIELD_NUMBER: _ClassVar[int]
@@ -63,7 +63,7 @@ class RunMoment(_message.Message):
     def __init__(self, run: _Optional[str] = ..., value: _Optional[float] = ..., metric: _Optional[str] = ...) -> None: ...
 
 class Settings(_message.Message):
-    __slots__ = ("api_key", "identity_token_file", "credentials_file", "insecure_disable_ssl", "_offline", "x_sync", "sync_file", "_shared", "run_id", "run_url", "project", "entity", "organization", "finish_timeout", "x_start_time", "root_dir", "wandb_dir", "log_dir", "log_internal", "ignore_globs", "app_url", "base_url", "x_file_stream_max_bytes", "x_file_stream_transmit_interval", "x_file_stream_no_gzip", "x_extra_http_headers", "x_file_stream_retry_max", "x_file_stream_retry_wait_min_seconds", "x_file_stream_retry_wait_max_seconds", "x_file_stream_timeout_seconds", "x_file_stream_max_line_bytes", "x_file_transfer_retry_max", "x_file_transfer_retry_wait_min_seconds", "x_file_transfer_retry_wait_max_seconds", "x_file_transfer_timeout_seconds", "x_graphql_retry_max", "x_graphql_retry_wait_min_seconds", "x_graphql_retry_wait_max_seconds", "x_graphql_timeout_seconds", "http_proxy", "https_proxy", "x_proxies", "program", "program_relpath", "_code_path_local", "program_abspath", "_args", "_os", "docker", "x_executable", "_python", "colab_url", "host", "username", "email", "resume", "resume_from", "fork_from", "disable_job_creation", "sweep_url", "x_disable_update_check", "x_disable_meta", "save_code", "stop_on_fatal_error", "disable_git", "disable_git_fork_point", "x_disable_machine_info", "x_disable_stats", "x_stats_buffer_size", "x_stats_sampling_interval", "x_stats_pid", "x_stats_disk_paths", "x_stats_neuron_monitor_config_path", "x_stats_dcgm_exporter", "x_stats_open_metrics_endpoints", "x_stats_open_metrics_filters", "x_stats_open_metrics_http_headers", "x_stats_gpu_device_ids", "x_stats_cpu_count", "x_stats_cpu_logical_count", "x_stats_gpu_count", "x_stats_gpu_type", "x_stats_track_process_tree", "x_stats_no_cgroup", "x_label", "x_primary", "x_update_finish_state", "allow_offline_artifacts", "console", "console_multipart", "console_chunk_max_bytes", "console_chunk_max_seconds", "sync_tensorboard", "x_server_side_derived_summary", "x_server_side_expand_glob_metrics", "x_skip_transaction_log", "x_stats_coreweave_metadata_base_url", "x_stats_coreweave_metadata_endpoint", "_aws_lambda", "x_cli_only_mode", "_colab", "x_disable_viewer", "x_flow_control_custom", "x_flow_control_disabled", "x_internal_check_process", "_ipython", "_jupyter", "x_jupyter_root", "_kaggle", "x_live_policy_rate_limit", "x_live_policy_wait_time", "x_log_level", "x_network_buffer", "_noop", "_notebook", "_platform", "x_runqueue_item_id", "x_save_requirements", "x_service_transport", "x_service_wait", "_start_datetime", "_tmp_code_dir", "_windows", "allow_media_symlink", "allow_val_change", "azure_account_url_to_access_key", "code_dir", "config_paths", "deployment", "disable_code", "disable_hints", "disabled", "force", "git_commit", "git_remote", "git_remote_url", "git_root", "heartbeat_seconds", "init_timeout", "is_local", "job_source", "label_disable", "launch", "launch_config_path", "log_symlink_internal", "log_symlink_user", "log_user", "login_timeout", "mode", "notebook_name", "project_url", "quiet", "relogin", "resume_fname", "resumed", "run_group", "run_job_type", "run_mode", "run_name", "run_notes", "run_tags", "sagemaker_disable", "settings_system", "settings_workspace", "show_colors", "show_emoji", "show_errors", "show_info", "show_warnings", "silent", "start_method", "strict", "summary_errors", "summary_timeout", "summary_warnings", "sweep_id", "sweep_param_path", "symlink", "sync_dir", "sync_symlink_latest", "table_raise_on_max_row_limit_exceeded", "timespec", "tmp_dir", "x_jupyter_name", "x_jupyter_path", "job_name")
+    __slots__ = ("api_key", "identity_token_file", "credentials_file", "insecure_disable_ssl", "_offline", "x_sync", "sync_file", "_shared", "run_id", "run_url", "project", "entity", "organization", "finish_timeout", "offline_fallback_timeout", "x_start_time", "root_dir", "wandb_dir", "log_dir", "log_internal", "ignore_globs", "redact_drop", "redact_hash", "redact_keep", "app_url", "base_url", "x_file_stream_max_bytes", "x_file_stream_transmit_interval", "x_file_stream_no_gzip", "x_extra_http_headers", "x_file_stream_retry_max", "x_file_stream_retry_wait_min_seconds", "x_file_stream_retry_wait_max_seconds", "x_file_stream_timeout_seconds", "x_file_stream_max_line_bytes", "x_history_upload_budget", "x_history_upload_throttle", "x_file_transfer_retry_max", "x_file_transfer_retry_wait_min_seconds", "x_file_transfer_retry_wait_max_seconds", "x_file_transfer_timeout_seconds", "x_graphql_retry_max", "x_graphql_retry_wait_min_seconds", "x_graphql_retry_wait_max_seconds", "x_graphql_timeout_seconds", "http_proxy", "https_proxy", "x_proxies", "program", "program_relpath", "_code_path_local", "program_abspath", "_args", "_os", "docker", "x_executable", "_python", "colab_url", "host", "username", "email", "resume", "resume_from", "fork_from", "disable_job_creation", "sweep_url", "x_disable_update_check", "x_disable_meta", "save_code", "save_code_snapshot", "save_code_snapshot_max_bytes", "stop_on_fatal_error", "disable_git", "disable_git_fork_point", "x_disable_machine_info", "x_disable_stats", "x_stats_buffer_size", "x_stats_sampling_interval", "x_stats_pid", "x_stats_disk_paths", "x_stats_neuron_monitor_config_path", "x_stats_dcgm_exporter", "x_stats_open_metrics_endpoints", "x_stats_open_metrics_filters", "x_stats_open_metrics_http_headers", "x_stats_gpu_device_ids", "x_stats_cpu_count", "x_stats_cpu_logical_count", "x_stats_gpu_count", "x_stats_gpu_type", "x_stats_track_process_tree", "x_stats_no_cgroup", "x_stats_network_interfaces", "x_stats_network_interfaces_exclude", "x_stats_disk_devices", "x_stats_disk_devices_exclude", "x_stats_top_processes", "x_stats_core_metrics", "x_label", "x_primary", "x_update_finish_state", "allow_offline_artifacts", "console", "console_multipart", "console_chunk_max_bytes", "console_chunk_max_seconds", "console_log_parsers", "console_redact", "console_redact_patterns", "sync_tensorboard", "x_server_side_derived_summary", "x_server_side_expand_glob_metrics", "x_skip_transaction_log", "x_stats_coreweave_metadata_base_url", "x_stats_coreweave_metadata_endpoint", "x_client_prints_footer", "_aws_lambda", "x_cli_only_mode", "_colab", "x_disable_viewer", "x_flow_control_custom", "x_flow_control_disabled", "x_internal_check_process", "_ipython", "_jupyter", "x_jupyter_root", "_kaggle", "x_live_policy_rate_limit", "x_live_policy_wait_time", "x_log_level", "x_network_buffer", "_noop", "_notebook", "_platform", "x_runqueue_item_id", "x_save_requirements", "x_service_transport", "x_service_wait", "_start_datetime", "_tmp_code_dir", "_windows", "allow_media_symlink", "allow_val_change", "azure_account_url_to_access_key", "code_dir", "config_paths", "deployment", "disable_code", "disable_hints", "disabled", "force", "git_commit", "git_remote", "git_remote_url", "git_root", "heartbeat_seconds", "init_timeout", "is_local", "job_source", "label_disable", "launch", "launch_config_path", "log_symlink_internal", "log_symlink_user", "log_user", "login_timeout", "max_end_of_run_history_metrics", "max_end_of_run_summary_metrics", "mode", "notebook_name", "project_url", "quiet", "relogin", "resume_fname", "resumed", "run_group", "run_job_type", "run_mode", "run_name", "run_notes", "run_tags", "sagemaker_disable", "settings_system", "settings_workspace", "show_colors", "show_emoji", "show_errors", "show_info", "show_warnings", "silent", "start_method", "strict", "summary_errors", "summary_timeout", "summary_warnings", "sweep_id", "sweep_param_path", "symlink", "sync_dir", "sync_symlink_latest", "table_raise_on_max_row_limit_exceeded", "timespec", "tmp_dir", "x_jupyter_name", "x_jupyter_path", "job_name")
     API_KEY_FIELD_NUMBER: _ClassVar[int]
     IDENTITY_TOKEN_FILE_FIELD_NUMBER: _ClassVar[int]
     CREDENTIALS_FILE_FIELD_NUMBER: _ClassVar[int]
@@ -78,12 +78,16 @@ class Settings(_message.Message):
     ENTITY_FIELD_NUMBER: _ClassVar[int]
     ORGANIZATION_FIELD_NUMBER: _ClassVar[int]
     FINISH_TIMEOUT_FIELD_NUMBER: _ClassVar[int]
+    OFFLINE_FALLBACK_TIMEOUT_FIELD_NUMBER: _ClassVar[int]
     X_START_TIME_FIELD_NUMBER: _ClassVar[int]
     ROOT_DIR_FIELD_NUMBER: _ClassVar[int]
     WANDB_DIR_FIELD_NUMBER: _ClassVar[int]
     LOG_DIR_FIELD_NUMBER: _ClassVar[int]
     LOG_INTERNAL_FIELD_NUMBER: _ClassVar[int]
     IGNORE_GLOBS_FIELD_NUMBER: _ClassVar[int]
+    REDACT_DROP_FIELD_NUMBER: _ClassVar[int]
+    REDACT_HASH_FIELD_NUMBER: _ClassVar[int]
+    REDACT_KEEP_FIELD_NUMBER: _ClassVar[int]
     APP_URL_FIELD_NUMBER: _ClassVar[int]
     BASE_URL_FIELD_NUMBER: _ClassVar[int]
     X_FILE_STREAM_MAX_BYTES_FIELD_NUMBER: _ClassVar[int]
@@ -95,6 +99,8 @@ class Settings(_message.Message):
     X_FILE_STREAM_RETRY_WAIT_MAX_SECONDS_FIELD_NUMBER: _ClassVar[int]
     X_FILE_STREAM_TIMEOUT_SECONDS_FIELD_NUMBER: _ClassVar[int]
     X_FILE_STREAM_MAX_LINE_BYTES_FIELD_NUMBER: _ClassVar[int]
+    X_HISTORY_UPLOAD_BUDGET_FIELD_NUMBER: _ClassVar[int]
+    X_HISTORY_UPLOAD_THROTTLE_FIELD_NUMBER: _ClassVar[int]
     X_FILE_TRANSFER_RETRY_MAX_FIELD_NUMBER: _ClassVar[int]
     X_FILE_TRANSFER_RETRY_WAIT_MIN_SECONDS_FIELD_NUMBER: _ClassVar[int]
     X_FILE_TRANSFER_RETRY_WAIT_MAX_SECONDS_FIELD_NUMBER: _ClassVar[int]
@@ -127,6 +133,8 @@ class Settings(_message.Message):
     X_DISABLE_UPDATE_CHECK_FIELD_NUMBER: _ClassVar[int]
     X_DISABLE_META_FIELD_NUMBER: _ClassVar[int]
     SAVE_CODE_FIELD_NUMBER: _ClassVar[int]
+    SAVE_CODE_SNAPSHOT_FIELD_NUMBER: _ClassVar[int]
+    SAVE_CODE_SNAPSHOT_MAX_BYTES_FIELD_NUMBER: _ClassVar[int]
     STOP_ON_FATAL_ERROR_FIELD_NUMBER: _ClassVar[int]
     DISABLE_GIT_FIELD_NUMBER: _ClassVar[int]
     DISABLE_GIT_FORK_POINT_FIELD_NUMBER: _ClassVar[int]
@@ -148,6 +156,12 @@ class Settings(_message.Message):
     X_STATS_GPU_TYPE_FIELD_NUMBER: _ClassVar[int]
     X_STATS_TRACK_PROCESS_TREE_FIELD_NUMBER: _ClassVar[int]
     X_STATS_NO_CGROUP_FIELD_NUMBER: _ClassVar[int]
+    X_STATS_NETWORK_INTERFACES_FIELD_NUMBER: _ClassVar[int]
+    X_STATS_NETWORK_INTERFACES_EXCLUDE_FIELD_NUMBER: _ClassVar[int]
+    X_STATS_DISK_DEVICES_FIELD_NUMBER: _ClassVar[int]
+    X_STATS_DISK_DEVICES_EXCLUDE_FIELD_NUMBER: _ClassVar[int]
+    X_STATS_TOP_PROCESSES_FIELD_NUMBER: _ClassVar[int]
+    X_STATS_CORE_METRICS_FIELD_NUMBER: _ClassVar[int]
     X_LABEL_FIELD_NUMBER: _ClassVar[int]
     X_PRIMARY_FIELD_NUMBER: _ClassVar[int]
     X_UPDATE_FINISH_STATE_FIELD_NUMBER: _ClassVar[int]
@@ -156,12 +170,16 @@ class Settings(_message.Message):
     CONSOLE_MULTIPART_FIELD_NUMBER: _ClassVar[int]
     CONSOLE_CHUNK_MAX_BYTES_FIELD_NUMBER: _ClassVar[int]
     CONSOLE_CHUNK_MAX_SECONDS_FIELD_NUMBER: _ClassVar[int]
+    CONSOLE_LOG_PARSERS_FIELD_NUMBER: _ClassVar[int]
+    CONSOLE_REDACT_FIELD_NUMBER: _ClassVar[int]
+    CONSOLE_REDACT_PATTERNS_FIELD_NUMBER: _ClassVar[int]
     SYNC_TENSORBOARD_FIELD_NUMBER: _ClassVar[int]
     X_SERVER_SIDE_DERIVED_SUMMARY_FIELD_NUMBER: _ClassVar[int]
     X_SERVER_SIDE_EXPAND_GLOB_METRICS_FIELD_NUMBER: _ClassVar[int]
     X_SKIP_TRANSACTION_LOG_FIELD_NUMBER: _ClassVar[int]
     X_STATS_COREWEAVE_METADATA_BASE_URL_FIELD_NUMBER: _ClassVar[int]
     X_STATS_COREWEAVE_METADATA_ENDPOINT_FIELD_NUMBER: _ClassVar[int]
+    X_CLIENT_PRINTS_FOOTER_FIELD_NUMBER: _ClassVar[int]
     _AWS_LAMBDA_FIELD_NUMBER: _ClassVar[int]
     X_CLI_ONLY_MODE_FIELD_NUMBER: _ClassVar[int]
     _COLAB_FIELD_NUMBER: _ClassVar[int]
@@ -212,6 +230,8 @@ class Settings(_message.Message):
     LOG_SYMLINK_USER_FIELD_NUMBER: _ClassVar[int]
     LOG_USER_FIELD_NUMBER: _ClassVar[int]
     LOGIN_TIMEOUT_FIELD_NUMBER: _ClassVar[int]
+    MAX_END_OF_RUN_HISTORY_METRICS_FIELD_NUMBER: _ClassVar[int]
+    MAX_END_OF_RUN_SUMMARY_METRICS_FIELD_NUMBER: _ClassVar[int]
     MODE_FIELD_NUMBER: _ClassVar[int]
     NOTEBOOK_NAME_FIELD_NUMBER: _ClassVar[int]
     PROJECT_URL_FIELD_NUMBER: _ClassVar[int]
@@ -264,12 +284,16 @@ class Settings(_message.Message):
     entity: _wrappers_pb2.StringValue
     organization: _wrappers_pb2.StringValue
     finish_timeout: _wrappers_pb2.DoubleValue
+    offline_fallback_timeout: _wrappers_pb2.DoubleValue
     x_start_time: _wrappers_pb2.DoubleValue
     root_dir: _wrappers_pb2.StringValue
     wandb_dir: _wrappers_pb2.StringValue
     log_dir: _wrappers_pb2.StringValue
     log_internal: _wrappers_pb2.StringValue
     ignore_globs: ListStringValue
+    redact_drop: ListStringValue
+    redact_hash: ListStringValue
+    redact_keep: ListStringValue
     app_url: _wrappers_pb2.StringValue
     base_url: _wrappers_pb2.StringValue
     x_file_stream_max_bytes: _wrappers_pb2.Int32Value
@@ -281,6 +305,8 @@ class Settings(_message.Message):
     x_file_stream_retry_wait_max_seconds: _wrappers_pb2.DoubleValue
     x_file_stream_timeout_seconds: _wrappers_pb2.DoubleValue
     x_file_stream_max_line_bytes: _wrappers_pb2.Int32Value
+    x_history_upload_budget: _wrappers_pb2.Int32Value
+    x_history_upload_throttle: _wrappers_pb2.StringValue
     x_file_transfer_retry_max: _wrappers_pb2.Int32Value
     x_file_transfer_retry_wait_min_seconds: _wrappers_pb2.DoubleValue
     x_file_transfer_retry_wait_max_seconds: _wrappers_pb2.DoubleValue
@@ -313,6 +339,8 @@ class Settings(_message.Message):
     x_disable_update_check: _wrappers_pb2.BoolValue
     x_disable_meta: _wrappers_pb2.BoolValue
     save_code: _wrappers_pb2.BoolValue
+    save_code_snapshot: _wrappers_pb2.BoolValue
+    save_code_snapshot_max_bytes: _wrappers_pb2.Int64Value
     stop_on_fatal_error: _wrappers_pb2.BoolValue
     disable_git: _wrappers_pb2.BoolValue
     disable_git_fork_point: _wrappers_pb2.BoolValue
@@ -334,6 +362,12 @@ class Settings(_message.Message):
     x_stats_gpu_type: _wrappers_pb2.StringValue
     x_stats_track_process_tree: _wrappers_pb2.BoolValue
     x_stats_no_cgroup: _wrappers_pb2.BoolValue
+    x_stats_network_interfaces: ListStringValue
+    x_stats_network_interfaces_exclude: ListStringValue
+    x_stats_disk_devices: ListStringValue
+    x_stats_disk_devices_exclude: ListStringValue
+    x_stats_top_processes: _wrappers_pb2.Int32Value
+    x_stats_core_metrics: _wrappers_pb2.BoolValue
     x_label: _wrappers_pb2.StringValue
     x_primary: _wrappers_pb2.BoolValue
     x_update_finish_state: _wrappers_pb2.BoolValue
@@ -342,12 +376,16 @@ class Settings(_message.Message):
     console_multipart: _wrappers_pb2.BoolValue
     console_chunk_max_bytes: _wrappers_pb2.Int32Value
     console_chunk_max_seconds: _wrappers_pb2.Int32Value
+    console_log_parsers: ListStringValue
+    console_redact: _wrappers_pb2.BoolValue
+    console_redact_patterns: ListStringValue
     sync_tensorboard: _wrappers_pb2.BoolValue
     x_server_side_derived_summary: _wrappers_pb2.BoolValue
     x_server_side_expand_glob_metrics: _wrappers_pb2.BoolValue
     x_skip_transaction_log: _wrappers_pb2.BoolValue
     x_stats_coreweave_metadata_base_url: _wrappers_pb2.StringValue
     x_stats_coreweave_metadata_endpoint: _wrappers_pb2.StringValue
+    x_client_prints_footer: _wrappers_pb2.BoolValue
     _aws_lambda: _wrappers_pb2.BoolValue
     x_cli_only_mode: _wrappers_pb2.BoolValue
     _colab: _wrappers_pb2.BoolValue
@@ -398,6 +436,8 @@ class Settings(_message.Message):
     log_symlink_user: _wrappers_pb2.StringValue
     log_user: _wrappers_pb2.StringValue
     login_timeout: _wrappers_pb2.DoubleValue
+    max_end_of_run_history_metrics: _wrappers_pb2.Int32Value
+    max_end_of_run_summary_metrics: _wrappers_pb2.Int32Value
     mode: _wrappers_pb2.StringValue
     notebook_name: _wrappers_pb2.StringValue
     project_url: _wrappers_pb2.StringValue
@@ -436,4 +476,4 @@ class Settings(_message.Message):
     x_jupyter_name: _wrappers_pb2.StringValue
     x_jupyter_path: _wrappers_pb2.StringValue
     job_name: _wrappers_pb2.StringValue
-    def __init__(self, api_key: _Optional[_Union[_wrappers_pb2.StringValue, _Mapping]] = ..., identity_token_file: _Optional[_Union[_wrappers_pb2.StringValue, _Mapping]] = ..., credentials_file: _Optional[_Union[_wrappers_pb2.StringValue, _Mapping]] = ..., insecure_disable_ssl: _Optional[_Union[_wrappers_pb2.BoolValue, _Mapping]] = ..., _offline: _Optional[_Union[_wrappers_pb2.BoolValue, _Mapping]] = ..., x_sync: _Optional[_Union[_wrappers_pb2.BoolValue, _Mapping]] = ..., sync_file: _Optional[_Union[_wrappers_pb2.StringValue, _Mapping]] = ..., _shared: _Optional[_Union[_wrappers_pb2.BoolValue, _Mapping]] = ..., run_id: _Optional[_Union[_wrappers_pb2.StringValue, _Mapping]] = ..., run_url: _Optional[_Union[_wrappers_pb2.StringValue, _Mapping]] = ..., project: _Optional[_Union[_wrappers_pb2.StringValue, _Mapping]] = ..., entity: _Optional[_Union[_wrappers_pb2.StringValue, _Mapping]] = ..., organization: _Optional[_Union[_wrappers_pb2.StringValue, _Mapping]] = ..., finish_timeout: _Optional[_Union[_wrappers_pb2.DoubleValue, _Mapping]] = ..., x_start_time: _Optional[_Union[_wrappers_pb2.DoubleValue, _Mapping]] = ..., root_dir: _Optional[_Union[_wrappers_pb2.StringValue, _Mapping]] = ..., wandb_dir: _Optional[_Union[_wrappers_pb2.StringValue, _Mapping]] = ..., log_dir: _Optional[_Union[_wrappers_pb2.StringValue, _Mapping]] = ..., log_internal: _Optional[_Union[_wrappers_pb2.StringValue, _Mapping]] = ..., ignore_globs: _Optional[_Union[ListStringValue, _Mapping]] = ..., app_url: _Optional[_Union[_wrappers_pb2.StringValue, _Mapping]] = ..., base_url: _Optional[_Union[_wrappers_pb2.StringValue, _Mapping]] = ..., x_file_stream_max_bytes: _Optional[_Union[_wrappers_pb2.Int32Value, _Mapping]] = ..., x_file_stream_transmit_interval: _Optional[_Union[_wrappers_pb2.DoubleValue, _Mapping]] = ..., x_file_stream_no_gzip: _Optional[_Union[_wrappers_pb2.BoolValue, _Mapping]] = ..., x_extra_http_headers: _Optional[_Union[MapStringKeyStringValue, _Mapping]] = ..., x_file_stream_retry_max: _Optional[_Union[_wrappers_pb2.Int32Value, _Mapping]] = ..., x_file_stream_retry_wait_min_seconds: _Optional[_Union[_wrappers_pb2.DoubleValue, _Mapping]] = ..., x_file_stream_retry_wait_max_seconds: _Optional[_Union[_wrappers_pb2.DoubleValue, _Mapping]] = ..., x_file_stream_timeout_seconds: _Optional[_Union[_wrappers_pb2.DoubleValue, _Mapping]] = ..., x_file_stream_max_line_bytes: _Optional[_Union[_wrappers_pb2.Int32Value, _Mapping]] = ..., x_file_transfer_retry_max: _Optional[_Union[_wrappers_pb2.Int32Value, _Mapping]] = ..., x_file_transfer_retry_wait_min_seconds: _Optional[_Union[_wrappers_pb2.DoubleValue, _Mapping]] = ..., x_file_transfer_retry_wait_max_seconds: _Optional[_Union[_wrappers_pb2.DoubleValue, _Mapping]] = ..., x_file_transfer_timeout_seconds: _Optional[_Union[_wrappers_pb2.DoubleValue, _Mapping]] = ..., x_graphql_retry_max: _Optional[_Union[_wrappers_pb2.Int32Value, _Mapping]] = ..., x_graphql_retry_wait_min_seconds: _Optional[_Union[_wrappers_pb2.DoubleValue, _Mapping]] = ..., x_graphql_retry_wait_max_seconds: _Optional[_Union[_wrappers_pb2.DoubleValue, _Mapping]] = ..., x_graphql_timeout_seconds: _Optional[_Union[_wrappers_pb2.DoubleValue, _Mapping]] = ..., http_proxy: _Optional[_Union[_wrappers_pb2.StringValue, _Mapping]] = ..., https_proxy: _Optional[_Union[_wrappers_pb2.StringValue, _Mapping]] = ..., x_proxies: _Optional[_Union[MapStringKeyStringValue, _Mapping]] = ..., program: _Optional[_Union[_wrappers_pb2.StringValue, _Mapping]] = ..., program_relpath: _Optional[_Union[_wrappers_pb2.StringValue, _Mapping]] = ..., _code_path_local: _Optional[_Union[_wrappers_pb2.StringValue, _Mapping]] = ..., program_abspath: _Optional[_Union[_wrappers_pb2.StringValue, _Mapping]] = ..., _args: _Optional[_Union[ListStringValue, _Mapping]] = ..., _os: _Optional[_Union[_wrappers_pb2.StringValue, _Mapping]] = ..., docker: _Optional[_Union[_wrappers_pb2.StringValue, _Mapping]] = ..., x_executable: _Optional[_Union[_wrappers_pb2.StringValue, _Mapping]] = ..., _python: _Optional[_Union[_wrappers_pb2.StringValue, _Mapping]] = ..., colab_url: _Optional[_Union[_wrappers_pb2.StringValue, _Mapping]] = ..., host: _Optional[_Union[_wrappers_pb2.StringValue, _Mapping]] = ..., username: _Optional[_Union[_wrappers_pb2.StringValue, _Mapping]] = ..., email: _Optional[_Union[_wrappers_pb2.StringValue, _Mapping]] = ..., resume: _Optional[_Union[_wrappers_pb2.StringValue, _Mapping]] = ..., resume_from: _Optional[_Union[RunMoment, _Mapping]] = ..., fork_from: _Optional[_Union[RunMoment, _Mapping]] = ..., disable_job_creation: _Optional[_Union[_wrappers_pb2.BoolValue, _Mapping]] = ..., sweep_url: _Optional[_Union[_wrappers_pb2.StringValue, _Mapping]] = ..., x_disable_update_check: _Optional[_Union[_wrappers_pb2.BoolValue, _Mapping]] = ..., x_disable_meta: _Optional[_Union[_wrappers_pb2.BoolValue, _Mapping]] = ..., save_code: _Optional[_Union[_wrappers_pb2.BoolValue, _Mapping]] = ..., stop_on_fatal_error: _Optional[_Union[_wrappers_pb2.BoolValue, _Mapping]] = ..., disable_git: _Optional[_Union[_wrappers_pb2.BoolValue, _Mapping]] = ..., disable_git_fork_point: _Optional[_Union[_wrappers_pb2.BoolValue, _Mapping]] = ..., x_disable_machine_info: _Optional[_Union[_wrappers_pb2.BoolValue, _Mapping]] = ..., x_disable_stats: _Optional[_Union[_wrappers_pb2.BoolValue, _Mapping]] = ..., x_stats_buffer_size: _Optional[_Union[_wrappers_pb2.Int32Value, _Mapping]] = ..., x_stats_sampling_interval: _Optional[_Union[_wrappers_pb2.DoubleValue, _Mapping]] = ..., x_stats_pid: _Optional[_Union[_wrappers_pb2.Int32Value, _Mapping]] = ..., x_stats_disk_paths: _Optional[_Union[ListStringValue, _Mapping]] = ..., x_stats_neuron_monitor_config_path: _Optional[_Union[_wrappers_pb2.StringValue, _Mapping]] = ..., x_stats_dcgm_exporter: _Optional[_Union[_wrappers_pb2.StringValue, _Mapping]] = ..., x_stats_open_metrics_endpoints: _Optional[_Union[MapStringKeyStringValue, _Mapping]] = ..., x_stats_open_metrics_filters: _Optional[_Union[OpenMetricsFilters, _Mapping]] = ..., x_stats_open_metrics_http_headers: _Optional[_Union[MapStringKeyStringValue, _Mapping]] = ..., x_stats_gpu_device_ids: _Optional[_Union[ListIntValue, _Mapping]] = ..., x_stats_cpu_count: _Optional[_Union[_wrappers_pb2.Int32Value, _Mapping]] = ..., x_stats_cpu_logical_count: _Optional[_Union[_wrappers_pb2.Int32Value, _Mapping]] = ..., x_stats_gpu_count: _Optional[_Union[_wrappers_pb2.Int32Value, _Mapping]] = ..., x_stats_gpu_type: _Optional[_Union[_wrappers_pb2.StringValue, _Mapping]] = ..., x_stats_track_process_tree: _Optional[_Union[_wrappers_pb2.BoolValue, _Mapping]] = ..., x_stats_no_cgroup: _Optional[_Union[_wrappers_pb2.BoolValue, _Mapping]] = ..., x_label: _Optional[_Union[_wrappers_pb2.StringValue, _Mapping]] = ..., x_primary: _Optional[_Union[_wrappers_pb2.BoolValue, _Mapping]] = ..., x_update_finish_state: _Optional[_Union[_wrappers_pb2.BoolValue, _Mapping]] = ..., allow_offline_artifacts: _Optional[_Union[_wrappers_pb2.BoolValue, _Mapping]] = ..., console: _Optional[_Union[_wrappers_pb2.StringValue, _Mapping]] = ..., console_multipart: _Optional[_Union[_wrappers_pb2.BoolValue, _Mapping]] = ..., console_chunk_max_bytes: _Optional[_Union[_wrappers_pb2.Int32Value, _Mapping]] = ..., console_chunk_max_seconds: _Optional[_Union[_wrappers_pb2.Int32Value, _Mapping]] = ..., sync_tensorboard: _Optional[_Union[_wrappers_pb2.BoolValue, _Mapping]] = ..., x_server_side_derived_summary: _Optional[_Union[_wrappers_pb2.BoolValue, _Mapping]] = ..., x_server_side_expand_glob_metrics: _Optional[_Union[_wrappers_pb2.BoolValue, _Mapping]] = ..., x_skip_transaction_log: _Optional[_Union[_wrappers_pb2.BoolValue, _Mapping]] = ..., x_stats_coreweave_metadata_base_url: _Optional[_Union[_wrappers_pb2.StringValue, _Mapping]] = ..., x_stats_coreweave_metadata_endpoint: _Optional[_Union[_wrappers_pb2.StringValue, _Mapping]] = ..., _aws_lambda: _Optional[_Union[_wrappers_pb2.BoolValue, _Mapping]] = ..., x_cli_only_mode: _Optional[_Union[_wrappers_pb2.BoolValue, _Mapping]] = ..., _colab: _Optional[_Union[_wrappers_pb2.BoolValue, _Mapping]] = ..., x_disable_viewer: _Optional[_Union[_wrappers_pb2.BoolValue, _Mapping]] = ..., x_flow_control_custom: _Optional[_Union[_wrappers_pb2.BoolValue, _Mapping]] = ..., x_flow_control_disabled: _Optional[_Union[_wrappers_pb2.BoolValue, _Mapping]] = ..., x_internal_check_process: _Optional[_Union[_wrappers_pb2.DoubleValue, _Mapping]] = ..., _ipython: _Optional[_Union[_wrappers_pb2.BoolValue, _Mapping]] = ..., _jupyter: _Optional[_Union[_wrappers_pb2.BoolValue, _Mapping]] = ..., x_jupyter_root: _Optional[_Union[_wrappers_pb2.StringValue, _Mapping]] = ..., _kaggle: _Optional[_Union[_wrappers_pb2.BoolValue, _Mapping]] = ..., x_live_policy_rate_limit: _Optional[_Union[_wrappers_pb2.Int32Value, _Mapping]] = ..., x_live_policy_wait_time: _Optional[_Union[_wrappers_pb2.Int32Value, _Mapping]] = ..., x_log_level: _Optional[_Union[_wrappers_pb2.Int32Value, _Mapping]] = ..., x_network_buffer: _Optional[_Union[_wrappers_pb2.Int32Value, _Mapping]] = ..., _noop: _Optional[_Union[_wrappers_pb2.BoolValue, _Mapping]] = ..., _notebook: _Optional[_Union[_wrappers_pb2.BoolValue, _Mapping]] = ..., _platform: _Optional[_Union[_wrappers_pb2.StringValue, _Mapping]] = ..., x_runqueue_item_id: _Optional[_Union[_wrappers_pb2.StringValue, _Mapping]] = ..., x_save_requirements: _Optional[_Union[_wrappers_pb2.BoolValue, _Mapping]] = ..., x_service_transport: _Optional[_Union[_wrappers_pb2.StringValue, _Mapping]] = ..., x_service_wait: _Optional[_Union[_wrappers_pb2.DoubleValue, _Mapping]] = ..., _start_datetime: _Optional[_Union[_wrappers_pb2.StringValue, _Mapping]] = ..., _tmp_code_dir: _Optional[_Union[_wrappers_pb2.StringValue, _Mapping]] = ..., _windows: _Optional[_Union[_wrappers_pb2.BoolValue, _Mapping]] = ..., allow_media_symlink: _Optional[_Union[_wrappers_pb2.BoolValue, _Mapping]] = ..., allow_val_change: _Optional[_Union[_wrappers_pb2.BoolValue, _Mapping]] = ..., azure_account_url_to_access_key: _Optional[_Union[MapStringKeyStringValue, _Mapping]] = ..., code_dir: _Optional[_Union[_wrappers_pb2.StringValue, _Mapping]] = ..., config_paths: _Optional[_Union[ListStringValue, _Mapping]] = ..., deployment: _Optional[_Union[_wrappers_pb2.StringValue, _Mapping]] = ..., disable_code: _Optional[_Union[_wrappers_pb2.BoolValue, _Mapping]] = ..., disable_hints: _Optional[_Union[_wrappers_pb2.BoolValue, _Mapping]] = ..., disabled: _Optional[_Union[_wrappers_pb2.BoolValue, _Mapping]] = ..., force: _Optional[_Union[_wrappers_pb2.BoolValue, _Mapping]] = ..., git_commit: _Optional[_Union[_wrappers_pb2.StringValue, _Mapping]] = ..., git_remote: _Optional[_Union[_wrappers_pb2.StringValue, _Mapping]] = ..., git_remote_url: _Optional[_Union[_wrappers_pb2.StringValue, _Mapping]] = ..., git_root: _Optional[_Union[_wrappers_pb2.StringValue, _Mapping]] = ..., heartbeat_seconds: _Optional[_Union[_wrappers_pb2.Int32Value, _Mapping]] = ..., init_timeout: _Optional[_Union[_wrappers_pb2.DoubleValue, _Mapping]] = ..., is_local: _Optional[_Union[_wrappers_pb2.BoolValue, _Mapping]] = ..., job_source: _Optional[_Union[_wrappers_pb2.StringValue, _Mapping]] = ..., label_disable: _Optional[_Union[_wrappers_pb2.BoolValue, _Mapping]] = ..., launch: _Optional[_Union[_wrappers_pb2.BoolValue, _Mapping]] = ..., launch_config_path: _Optional[_Union[_wrappers_pb2.StringValue, _Mapping]] = ..., log_symlink_internal: _Optional[_Union[_wrappers_pb2.StringValue, _Mapping]] = ..., log_symlink_user: _Optional[_Union[_wrappers_pb2.StringValue, _Mapping]] = ..., log_user: _Optional[_Union[_wrappers_pb2.StringValue, _Mapping]] = ..., login_timeout: _Optional[_Union[_wrappers_pb2.DoubleValue, _Mapping]] = ..., mode: _Optional[_Union[_wrappers_pb2.StringValue, _Mapping]] = ..., notebook_name: _Optional[_Union[_wrappers_pb2.StringValue, _Mapping]] = ..., project_url: _Optional[_Union[_wrappers_pb2.StringValue, _Mapping]] = ..., quiet: _Optional[_Union[_wrappers_pb2.BoolValue, _Mapping]] = ..., relogin: _Optional[_Union[_wrappers_pb2.BoolValue, _Mapping]] = ..., resume_fname: _Optional[_Union[_wrappers_pb2.StringValue, _Mapping]] = ..., resumed: _Optional[_Union[_wrappers_pb2.BoolValue, _Mapping]] = ..., run_group: _Optional[_Union[_wrappers_pb2.StringValue, _Mapping]] = ..., run_job_type: _Optional[_Union[_wrappers_pb2.StringValue, _Mapping]] = ..., run_mode: _Optional[_Union[_wrappers_pb2.StringValue, _Mapping]] = ..., run_name: _Optional[_Union[_wrappers_pb2.StringValue, _Mapping]] = ..., run_notes: _Optional[_Union[_wrappers_pb2.StringValue, _Mapping]] = ..., run_tags: _Optional[_Union[ListStringValue, _Mapping]] = ..., sagemaker_disable: _Optional[_Union[_wrappers_pb2.BoolValue, _Mapping]] = ..., settings_system: _Optional[_Union[_wrappers_pb2.StringValue, _Mapping]] = ..., settings_workspace: _Optional[_Union[_wrappers_pb2.StringValue, _Mapping]] = ..., show_colors: _Optional[_Union[_wrappers_pb2.BoolValue, _Mapping]] = ..., show_emoji: _Optional[_Union[_wrappers_pb2.BoolValue, _Mapping]] = ..., show_errors: _Optional[_Union[_wrappers_pb2.BoolValue, _Mapping]] = ..., show_info: _Optional[_Union[_wrappers_pb2.BoolValue, _Mapping]] = ..., show_warnings: _Optional[_Union[_wrappers_pb2.BoolValue, _Mapping]] = ..., silent: _Optional[_Union[_wrappers_pb2.BoolValue, _Mapping]] = ..., start_method: _Optional[_Union[_wrappers_pb2.StringValue, _Mapping]] = ..., strict: _Optional[_Union[_wrappers_pb2.BoolValue, _Mapping]] = ..., summary_errors: _Optional[_Union[_wrappers_pb2.Int32Value, _Mapping]] = ..., summary_timeout: _Optional[_Union[_wrappers_pb2.Int32Value, _Mapping]] = ..., summary_warnings: _Optional[_Union[_wrappers_pb2.Int32Value, _Mapping]] = ..., sweep_id: _Optional[_Union[_wrappers_pb2.StringValue, _Mapping]] = ..., sweep_param_path: _Optional[_Union[_wrappers_pb2.StringValue, _Mapping]] = ..., symlink: _Optional[_Union[_wrappers_pb2.BoolValue, _Mapping]] = ..., sync_dir: _Optional[_Union[_wrappers_pb2.StringValue, _Mapping]] = ..., sync_symlink_latest: _Optional[_Union[_wrappers_pb2.StringValue, _Mapping]] = ..., table_raise_on_max_row_limit_exceeded: _Optional[_Union[_wrappers_pb2.BoolValue, _Mapping]] = ..., timespec: _Optional[_Union[_wrappers_pb2.StringValue, _Mapping]] = ..., tmp_dir: _Optional[_Union[_wrappers_pb2.StringValue, _Mapping]] = ..., x_jupyter_name: _Optional[_Union[_wrappers_pb2.StringValue, _Mapping]] = ..., x_jupyter_path: _Optional[_Union[_wrappers_pb2.StringValue, _Mapping]] = ..., job_name: _Optional[_Union[_wrappers_pb2.StringValue, _Mapping]] = ...) -> None: ...
+    def __init__(self, api_key: _Optional[_Union[_wrappers_pb2.StringValue, _Mapping]] = ..., identity_token_file: _Optional[_Union[_wrappers_pb2.StringValue, _Mapping]] = ..., credentials_file: _Optional[_Union[_wrappers_pb2.StringValue, _Mapping]] = ..., insecure_disable_ssl: _Optional[_Union[_wrappers_pb2.BoolValue, _Mapping]] = ..., _offline: _Optional[_Union[_wrappers_pb2.BoolValue, _Mapping]] = ..., x_sync: _Optional[_Union[_wrappers_pb2.BoolValue, _Mapping]] = ..., sync_file: _Optional[_Union[_wrappers_pb2.StringValue, _Mapping]] = ..., _shared: _Optional[_Union[_wrappers_pb2.BoolValue, _Mapping]] = ..., run_id: _Optional[_Union[_wrappers_pb2.StringValue, _Mapping]] = ..., run_url: _Optional[_Union[_wrappers_pb2.StringValue, _Mapping]] = ..., project: _Optional[_Union[_wrappers_pb2.StringValue, _Mapping]] = ..., entity: _Optional[_Union[_wrappers_pb2.StringValue, _Mapping]] = ..., organization: _Optional[_Union[_wrappers_pb2.StringValue, _Mapping]] = ..., finish_timeout: _Optional[_Union[_wrappers_pb2.DoubleValue, _Mapping]] = ..., offline_fallback_timeout: _Optional[_Union[_wrappers_pb2.DoubleValue, _Mapping]] = ..., x_start_time: _Optional[_Union[_wrappers_pb2.DoubleValue, _Mapping]] = ..., root_dir: _Optional[_Union[_wrappers_pb2.StringValue, _Mapping]] = ..., wandb_dir: _Optional[_Union[_wrappers_pb2.StringValue, _Mapping]] = ..., log_dir: _Optional[_Union[_wrappers_pb2.StringValue, _Mapping]] = ..., log_internal: _Optional[_Union[_wrappers_pb2.StringValue, _Mapping]] = ..., ignore_globs: _Optional[_Union[ListStringValue, _Mapping]] = ..., redact_drop: ListStringValue = ..., redact_hash: ListStringValue = ..., redact_keep: ListStringValue = ..., app_url: _Optional[_Union[_wrappers_pb2.StringValue, _Mapping]] = ..., base_url: _Optional[_Union[_wrappers_pb2.StringValue, _Mapping]] = ..., x_file_stream_max_bytes: _Optional[_Union[_wrappers_pb2.Int32Value, _Mapping]] = ..., x_file_stream_transmit_interval: _Optional[_Union[_wrappers_pb2.DoubleValue, _Mapping]] = ..., x_file_stream_no_gzip: _Optional[_Union[_wrappers_pb2.BoolValue, _Mapping]] = ..., x_extra_http_headers: _Optional[_Union[MapStringKeyStringValue, _Mapping]] = ..., x_file_stream_retry_max: _Optional[_Union[_wrappers_pb2.Int32Value, _Mapping]] = ..., x_file_stream_retry_wait_min_seconds: _Optional[_Union[_wrappers_pb2.DoubleValue, _Mapping]] = ..., x_file_stream_retry_wait_max_seconds: _Optional[_Union[_wrappers_pb2.DoubleValue, _Mapping]] = ..., x_file_stream_timeout_seconds: _Optional[_Union[_wrappers_pb2.DoubleValue, _Mapping]] = ..., x_file_stream_max_line_bytes: _Optional[_Union[_wrappers_pb2.Int32Value, _Mapping]] = ..., x_history_upload_budget: _Optional[_Union[_wrappers_pb2.Int32Value, _Mapping]] = ..., x_history_upload_throttle: _Optional[_Union[_wrappers_pb2.StringValue, _Mapping]] = ..., x_file_transfer_retry_max: _Optional[_Union[_wrappers_pb2.Int32Value, _Mapping]] = ..., x_file_transfer_retry_wait_min_seconds: _Optional[_Union[_wrappers_pb2.DoubleValue, _Mapping]] = ..., x_file_transfer_retry_wait_max_seconds: _Optional[_Union[_wrappers_pb2.DoubleValue, _Mapping]] = ..., x_file_transfer_timeout_seconds: _Optional[_Union[_wrappers_pb2.DoubleValue, _Mapping]] = ..., x_graphql_retry_max: _Optional[_Union[_wrappers_pb2.Int32Value, _Mapping]] = ..., x_graphql_retry_wait_min_seconds: _Optional[_Union[_wrappers_pb2.DoubleValue, _Mapping]] = ..., x_graphql_retry_wait_max_seconds: _Optional[_Union[_wrappers_pb2.DoubleValue, _Mapping]] = ..., x_graphql_timeout_seconds: _Optional[_Union[_wrappers_pb2.DoubleValue, _Mapping]] = ..., http_proxy: _Optional[_Union[_wrappers_pb2.StringValue, _Mapping]] = ..., https_proxy: _Optional[_Union[_wrappers_pb2.StringValue, _Mapping]] = ..., x_proxies: _Optional[_Union[MapStringKeyStringValue, _Mapping]] = ..., program: _Optional[_Union[_wrappers_pb2.StringValue, _Mapping]] = ..., program_relpath: _Optional[_Union[_wrappers_pb2.StringValue, _Mapping]] = ..., _code_path_local: _Optional[_Union[_wrappers_pb2.StringValue, _Mapping]] = ..., program_abspath: _Optional[_Union[_wrappers_pb2.StringValue, _Mapping]] = ..., _args: _Optional[_Union[ListStringValue, _Mapping]] = ..., _os: _Optional[_Union[_wrappers_pb2.StringValue, _Mapping]] = ..., docker: _Optional[_Union[_wrappers_pb2.StringValue, _Mapping]] = ..., x_executable: _Optional[_Union[_wrappers_pb2.StringValue, _Mapping]] = ..., _python: _Optional[_Union[_wrappers_pb2.StringValue, _Mapping]] = ..., colab_url: _Optional[_Union[_wrappers_pb2.StringValue, _Mapping]] = ..., host: _Optional[_Union[_wrappers_pb2.StringValue, _Mapping]] = ..., username: _Optional[_Union[_wrappers_pb2.StringValue, _Mapping]] = ..., email: _Optional[_Union[_wrappers_pb2.StringValue, _Mapping]] = ..., resume: _Optional[_Union[_wrappers_pb2.StringValue, _Mapping]] = ..., resume_from: _Optional[_Union[RunMoment, _Mapping]] = ..., fork_from: _Optional[_Union[RunMoment, _Mapping]] = ..., disable_job_creation: _Optional[_Union[_wrappers_pb2.BoolValue, _Mapping]] = ..., sweep_url: _Optional[_Union[_wrappers_pb2.StringValue, _Mapping]] = ..., x_disable_update_check: _Optional[_Union[_wrappers_pb2.BoolValue, _Mapping]] = ..., x_disable_meta: _Optional[_Union[_wrappers_pb2.BoolValue, _Mapping]] = ..., save_code: _Optional[_Union[_wrappers_pb2.BoolValue, _Mapping]] = ..., save_code_snapshot: _Optional[_Union[_wrappers_pb2.BoolValue, _Mapping]] = ..., save_code_snapshot_max_bytes: _Optional[_Union[_wrappers_pb2.Int64Value, _Mapping]] = ..., stop_on_fatal_error: _Optional[_Union[_wrappers_pb2.BoolValue, _Mapping]] = ..., disable_git: _Optional[_Union[_wrappers_pb2.BoolValue, _Mapping]] = ..., disable_git_fork_point: _Optional[_Union[_wrappers_pb2.BoolValue, _Mapping]] = ..., x_disable_machine_info: _Optional[_Union[_wrappers_pb2.BoolValue, _Mapping]] = ..., x_disable_stats: _Optional[_Union[_wrappers_pb2.BoolValue, _Mapping]] = ..., x_stats_buffer_size: _Optional[_Union[_wrappers_pb2.Int32Value, _Mapping]] = ..., x_stats_sampling_interval: _Optional[_Union[_wrappers_pb2.DoubleValue, _Mapping]] = ..., x_stats_pid: _Optional[_Union[_wrappers_pb2.Int32Value, _Mapping]] = ..., x_stats_disk_paths: _Optional[_Union[ListStringValue, _Mapping]] = ..., x_stats_neuron_monitor_config_path: _Optional[_Union[_wrappers_pb2.StringValue, _Mapping]] = ..., x_stats_dcgm_exporter: _Optional[_Union[_wrappers_pb2.StringValue, _Mapping]] = ..., x_stats_open_metrics_endpoints: _Optional[_Union[MapStringKeyStringValue, _Mapping]] = ..., x_stats_open_metrics_filters: _Optional[_Union[OpenMetricsFilters, _Mapping]] = ..., x_stats_open_metrics_http_headers: _Optional[_Union[MapStringKeyStringValue, _Mapping]] = ..., x_stats_gpu_device_ids: _Optional[_Union[ListIntValue, _Mapping]] = ..., x_stats_cpu_count: _Optional[_Union[_wrappers_pb2.Int32Value, _Mapping]] = ..., x_stats_cpu_logical_count: _Optional[_Union[_wrappers_pb2.Int32Value, _Mapping]] = ..., x_stats_gpu_count: _Optional[_Union[_wrappers_pb2.Int32Value, _Mapping]] = ..., x_stats_gpu_type: _Optional[_Union[_wrappers_pb2.StringValue, _Mapping]] = ..., x_stats_track_process_tree: _Optional[_Union[_wrappers_pb2.BoolValue, _Mapping]] = ..., x_stats_no_cgroup: _Optional[_Union[_wrappers_pb2.BoolValue, _Mapping]] = ..., x_stats_network_interfaces: _Optional[_Union[ListStringValue, _Mapping]] = ..., x_stats_network_interfaces_exclude: _Optional[_Union[ListStringValue, _Mapping]] = ..., x_stats_disk_devices: _Optional[_Union[ListStringValue, _Mapping]] = ..., x_stats_disk_devices_exclude: _Optional[_Union[ListStringValue, _Mapping]] = ..., x_stats_top_processes: _Optional[_Union[_wrappers_pb2.Int32Value, _Mapping]] = ..., x_stats_core_metrics: _Optional[_Union[_wrappers_pb2.BoolValue, _Mapping]] = ..., x_label: _Optional[_Union[_wrappers_pb2.StringValue, _Mapping]] = ..., x_primary: _Optional[_Union[_wrappers_pb2.BoolValue, _Mapping]] = ..., x_update_finish_state: _Optional[_Union[_wrappers_pb2.BoolValue, _Mapping]] = ..., allow_offline_artifacts: _Optional[_Union[_wrappers_pb2.BoolValue, _Mapping]] = ..., console: _Optional[_Union[_wrappers_pb2.StringValue, _Mapping]] = ..., console_multipart: _Optional[_Union[_wrappers_pb2.BoolValue, _Mapping]] = ..., console_chunk_max_bytes: _Optional[_Union[_wrappers_pb2.Int32Value, _Mapping]] = ..., console_chunk_max_seconds: _Optional[_Union[_wrappers_pb2.Int32Value, _Mapping]] = ..., console_log_parsers: ListStringValue = ..., console_redact: _wrappers_pb2.BoolValue = ..., console_redact_patterns: ListStringValue = ..., sync_tensorboard: _Optional[_Union[_wrappers_pb2.BoolValue, _Mapping]] = ..., x_server_side_derived_summary: _Optional[_Union[_wrappers_pb2.BoolValue, _Mapping]] = ..., x_server_side_expand_glob_metrics: _Optional[_Union[_wrappers_pb2.BoolValue, _Mapping]] = ..., x_skip_transaction_log: _Optional[_Union[_wrappers_pb2.BoolValue, _Mapping]] = ..., x_stats_coreweave_metadata_base_url: _Optional[_Union[_wrappers_pb2.StringValue, _Mapping]] = ..., x_stats_coreweave_metadata_endpoint: _Optional[_Union[_wrappers_pb2.StringValue, _Mapping]] = ..., x_client_prints_footer: _Optional[_Union[_wrappers_pb2.BoolValue, _Mapping]] = ..., _aws_lambda: _Optional[_Union[_wrappers_pb2.BoolValue, _Mapping]] = ..., x_cli_only_mode: _Optional[_Union[_wrappers_pb2.BoolValue, _Mapping]] = ..., _colab: _Optional[_Union[_wrappers_pb2.BoolValue, _Mapping]] = ..., x_disable_viewer: _Optional[_Union[_wrappers_pb2.BoolValue, _Mapping]] = ..., x_flow_control_custom: _Optional[_Union[_wrappers_pb2.BoolValue, _Mapping]] = ..., x_flow_control_disabled: _Optional[_Union[_wrappers_pb2.BoolValue, _Mapping]] = ..., x_internal_check_process: _Optional[_Union[_wrappers_pb2.DoubleValue, _Mapping]] = ..., _ipython: _Optional[_Union[_wrappers_pb2.BoolValue, _Mapping]] = ..., _jupyter: _Optional[_Union[_wrappers_pb2.BoolValue, _Mapping]] = ..., x_jupyter_root: _Optional[_Union[_wrappers_pb2.StringValue, _Mapping]] = ..., _kaggle: _Optional[_Union[_wrappers_pb2.BoolValue, _Mapping]] = ..., x_live_policy_rate_limit: _Optional[_Union[_wrappers_pb2.Int32Value, _Mapping]] = ..., x_live_policy_wait_time: _Optional[_Union[_wrappers_pb2.Int32Value, _Mapping]] = ..., x_log_level: _Optional[_Union[_wrappers_pb2.Int32Value, _Mapping]] = ..., x_network_buffer: _Optional[_Union[_wrappers_pb2.Int32Value, _Mapping]] = ..., _noop: _Optional[_Union[_wrappers_pb2.BoolValue, _Mapping]] = ..., _notebook: _Optional[_Union[_wrappers_pb2.BoolValue, _Mapping]] = ..., _platform: _Optional[_Union[_wrappers_pb2.StringValue, _Mapping]] = ..., x_runqueue_item_id: _Optional[_Union[_wrappers_pb2.StringValue, _Mapping]] = ..., x_save_requirements: _Optional[_Union[_wrappers_pb2.BoolValue, _Mapping]] = ..., x_service_transport: _Optional[_Union[_wrappers_pb2.StringValue, _Mapping]] = ..., x_service_wait: _Optional[_Union[_wrappers_pb2.DoubleValue, _Mapping]] = ..., _start_datetime: _Optional[_Union[_wrappers_pb2.StringValue, _Mapping]] = ..., _tmp_code_dir: _Optional[_Union[_wrappers_pb2.StringValue, _Mapping]] = ..., _windows: _Optional[_Union[_wrappers_pb2.BoolValue, _Mapping]] = ..., allow_media_symlink: _Optional[_Union[_wrappers_pb2.BoolValue, _Mapping]] = ..., allow_val_change: _Optional[_Union[_wrappers_pb2.BoolValue, _Mapping]] = ..., azure_account_url_to_access_key: _Optional[_Union[MapStringKeyStringValue, _Mapping]] = ..., code_dir: _Optional[_Union[_wrappers_pb2.StringValue, _Mapping]] = ..., config_paths: _Optional[_Union[ListStringValue, _Mapping]] = ..., deployment: _Optional[_Union[_wrappers_pb2.StringValue, _Mapping]] = ..., disable_code: _Optional[_Union[_wrappers_pb2.BoolValue, _Mapping]] = ..., disable_hints: _Optional[_Union[_wrappers_pb2.BoolValue, _Mapping]] = ..., disabled: _Optional[_Union[_wrappers_pb2.BoolValue, _Mapping]] = ..., force: _Optional[_Union[_wrappers_pb2.BoolValue, _Mapping]] = ..., git_commit: _Optional[_Union[_wrappers_pb2.StringValue, _Mapping]] = ..., git_remote: _Optional[_Union[_wrappers_pb2.StringValue, _Mapping]] = ..., git_remote_url: _Optional[_Union[_wrappers_pb2.StringValue, _Mapping]] = ..., git_root: _Optional[_Union[_wrappers_pb2.StringValue, _Mapping]] = ..., heartbeat_seconds: _Optional[_Union[_wrappers_pb2.Int32Value, _Mapping]] = ..., init_timeout: _Optional[_Union[_wrappers_pb2.DoubleValue, _Mapping]] = ..., is_local: _Optional[_Union[_wrappers_pb2.BoolValue, _Mapping]] = ..., job_source: _Optional[_Union[_wrappers_pb2.StringValue, _Mapping]] = ..., label_disable: _Optional[_Union[_wrappers_pb2.BoolValue, _Mapping]] = ..., launch: _Optional[_Union[_wrappers_pb2.BoolValue, _Mapping]] = ..., launch_config_path: _Optional[_Union[_wrappers_pb2.StringValue, _Mapping]] = ..., log_symlink_internal: _Optional[_Union[_wrappers_pb2.StringValue, _Mapping]] = ..., log_symlink_user: _Optional[_Union[_wrappers_pb2.StringValue, _Mapping]] = ..., log_user: _Optional[_Union[_wrappers_pb2.StringValue, _Mapping]] = ..., login_timeout: _Optional[_Union[_wrappers_pb2.DoubleValue, _Mapping]] = ..., max_end_of_run_history_metrics: _Optional[_Union[_wrappers_pb2.Int32Value, _Mapping]] = ..., max_end_of_run_summary_metrics: _Optional[_Union[_wrappers_pb2.Int32Value, _Mapping]] = ..., mode: _Optional[_Union[_wrappers_pb2.StringValue, _Mapping]] = ..., notebook_name: _Optional[_Union[_wrappers_pb2.StringValue, _Mapping]] = ..., project_url: _Optional[_Union[_wrappers_pb2.StringValue, _Mapping]] = ..., quiet: _Optional[_Union[_wrappers_pb2.BoolValue, _Mapping]] = ..., relogin: _Optional[_Union[_wrappers_pb2.BoolValue, _Mapping]] = ..., resume_fname: _Optional[_Union[_wrappers_pb2.StringValue, _Mapping]] = ..., resumed: _Optional[_Union[_wrappers_pb2.BoolValue, _Mapping]] = ..., run_group: _Optional[_Union[_wrappers_pb2.StringValue, _Mapping]] = ..., run_job_type: _Optional[_Union[_wrappers_pb2.StringValue, _Mapping]] = ..., run_mode: _Optional[_Union[_wrappers_pb2.StringValue, _Mapping]] = ..., run_name: _Optional[_Union[_wrappers_pb2.StringValue, _Mapping]] = ..., run_notes: _Optional[_Union[_wrappers_pb2.StringValue, _Mapping]] = ..., run_tags: _Optional[_Union[ListStringValue, _Mapping]] = ..., sagemaker_disable: _Optional[_Union[_wrappers_pb2.BoolValue, _Mapping]] = ..., settings_system: _Optional[_Union[_wrappers_pb2.StringValue, _Mapping]] = ..., settings_workspace: _Optional[_Union[_wrappers_pb2.StringValue, _Mapping]] = ..., show_colors: _Optional[_Union[_wrappers_pb2.BoolValue, _Mapping]] = ..., show_emoji: _Optional[_Union[_wrappers_pb2.BoolValue, _Mapping]] = ..., show_errors: _Optional[_Union[_wrappers_pb2.BoolValue, _Mapping]] = ..., show_info: _Optional[_Union[_wrappers_pb2.BoolValue, _Mapping]] = ..., show_warnings: _Optional[_Union[_wrappers_pb2.BoolValue, _Mapping]] = ..., silent: _Optional[_Union[_wrappers_pb2.BoolValue, _Mapping]] = ..., start_method: _Optional[_Union[_wrappers_pb2.StringValue, _Mapping]] = ..., strict: _Optional[_Union[_wrappers_pb2.BoolValue, _Mapping]] = ..., summary_errors: _Optional[_Union[_wrappers_pb2.Int32Value, _Mapping]] = ..., summary_timeout: _Optional[_Union[_wrappers_pb2.Int32Value, _Mapping]] = ..., summary_warnings: _Optional[_Union[_wrappers_pb2.Int32Value, _Mapping]] = ..., sweep_id: _Optional[_Union[_wrappers_pb2.StringValue, _Mapping]] = ..., sweep_param_path: _Optional[_Union[_wrappers_pb2.StringValue, _Mapping]] = ..., symlink: _Optional[_Union[_wrappers_pb2.BoolValue, _Mapping]] = ..., sync_dir: _Optional[_Union[_wrappers_pb2.StringValue, _Mapping]] = ..., sync_symlink_latest: _Optional[_Union[_wrappers_pb2.StringValue, _Mapping]] = ..., table_raise_on_max_row_limit_exceeded: _Optional[_Union[_wrappers_pb2.BoolValue, _Mapping]] = ..., timespec: _Optional[_Union[_wrappers_pb2.StringValue, _Mapping]] = ..., tmp_dir: _Optional[_Union[_wrappers_pb2.StringValue, _Mapping]] = ..., x_jupyter_name: _Optional[_Union[_wrappers_pb2.StringValue, _Mapping]] = ..., x_jupyter_path: _Optional[_Union[_wrappers_pb2.StringValue, _Mapping]] = ..., job_name: _Optional[_Union[_wrappers_pb2.StringValue, _Mapping]] = ...) -> None: ...
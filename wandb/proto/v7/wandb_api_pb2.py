@@ -27,7 +27,7 @@ from wandb.proto import wandb_otel_pb2 as wandb_dot_proto_dot_wandb__otel__pb2
 from wandb.proto import wandb_settings_pb2 as wandb_dot_proto_dot_wandb__settings__pb2
 
 
-DESCRIPTOR = _descriptor_pool.Default().AddSerializedFile(b'\n\x1bwandb/proto/wandb_api.proto\x12\x0ewandb_internal\x1a wandb/proto/wandb_internal.proto\x1a\x1cwandb/proto/wandb_otel.proto\x1a wandb/proto/wandb_settings.proto\"X\n\x14ServerApiInitRequest\x12*\n\x08settings\x18\x01 \x01(\x0b\x32\x18.wandb_internal.Settings\x12\x14\n\x0cservice_name\x18\x02 \x01(\t\">\n\x15ServerApiInitResponse\x12\x15\n\rerror_message\x18\x01 \x01(\t\x12\x0e\n\x06\x61pi_id\x18\x02 \x01(\t\"\xf9\x06\n\nApiRequest\x12\x0e\n\x06\x61pi_id\x18\x01 \x01(\t\x12I\n\x18read_run_history_request\x18\x02 \x01(\x0b\x32%.wandb_internal.ReadRunHistoryRequestH\x00\x12;\n\x10\x66\x65\x61tures_request\x18\x03 \x01(\x0b\x32\x1f.wandb_internal.FeaturesRequestH\x00\x12\x39\n\x0fgraphql_request\x18\x04 \x01(\x0b\x32\x1e.wandb_internal.GraphQLRequestH\x00\x12\x44\n\x15\x64ownload_file_request\x18\x05 \x01(\x0b\x32#.wandb_internal.DownloadFileRequestH\x00\x12@\n\x13upload_file_request\x18\x06 \x01(\x0b\x32!.wandb_internal.UploadFileRequestH\x00\x12V\n\x1fmark_run_files_uploaded_request\x18\x07 \x01(\x0b\x32+.wandb_internal.MarkRunFilesUploadedRequestH\x00\x12:\n\x10stop_run_request\x18\x08 \x01(\x0b\x32\x1e.wandb_internal.StopRunRequestH\x00\x12\x33\n\x0c\x61uth_request\x18\t \x01(\x0b\x32\x1b.wandb_internal.AuthRequestH\x00\x12O\n\x1b\x63reate_custom_chart_request\x18\n \x01(\x0b\x32(.wandb_internal.CreateCustomChartRequestH\x00\x12O\n\x1brun_queue_operation_request\x18\x0b \x01(\x0b\x32(.wandb_internal.RunQueueOperationRequestH\x00\x12\x46\n\x16open_telemetry_request\x18\x0c \x01(\x0b\x32$.wandb_internal.OpenTelemetryRequestH\x00\x12R\n\x1dread_run_console_logs_request\x18\r \x01(\x0b\x32).wandb_internal.ReadRunConsoleLogsRequestH\x00\x42\t\n\x07request\"\xf9\x06\n\x0b\x41piResponse\x12K\n\x19read_run_history_response\x18\x01 \x01(\x0b\x32&.wandb_internal.ReadRunHistoryResponseH\x00\x12=\n\x11\x66\x65\x61tures_response\x18\x03 \x01(\x0b\x32 .wandb_internal.FeaturesResponseH\x00\x12;\n\x10graphql_response\x18\x04 \x01(\x0b\x32\x1f.wandb_internal.GraphQLResponseH\x00\x12\x46\n\x16\x64ownload_file_response\x18\x05 \x01(\x0b\x32$.wandb_internal.DownloadFileResponseH\x00\x12\x42\n\x14upload_file_response\x18\x06 \x01(\x0b\x32\".wandb_internal.UploadFileResponseH\x00\x12X\n mark_run_files_uploaded_response\x18\x07 \x01(\x0b\x32,.wandb_internal.MarkRunFilesUploadedResponseH\x00\x12<\n\x11stop_run_response\x18\x08 \x01(\x0b\x32\x1f.wandb_internal.StopRunResponseH\x00\x12\x35\n\rauth_response\x18\t \x01(\x0b\x32\x1c.wandb_internal.AuthResponseH\x00\x12Q\n\x1c\x63reate_custom_chart_response\x18\n \x01(\x0b\x32).wandb_internal.CreateCustomChartResponseH\x00\x12Q\n\x1crun_queue_operation_response\x18\x0b \x01(\x0b\x32).wandb_internal.RunQueueOperationResponseH\x00\x12T\n\x1eread_run_console_logs_response\x18\x0c \x01(\x0b\x32*.wandb_internal.ReadRunConsoleLogsResponseH\x00\x12>\n\x12\x61pi_error_response\x18\x02 \x01(\x0b\x32 .wandb_internal.ApiErrorResponseH\x00\x42\n\n\x08response\"{\n\x10\x41piErrorResponse\x12\x0f\n\x07message\x18\x01 \x01(\t\x12\x32\n\nerror_type\x18\x02 \x01(\x0e\x32\x19.wandb_internal.ErrorTypeH\x00\x88\x01\x01\x12\x13\n\x0bhttp_status\x18\x03 \x01(\x05\x42\r\n\x0b_error_type\")\n\x17ServerApiCleanupRequest\x12\x0e\n\x06\x61pi_id\x18\x01 \x01(\t\"\x88\x01\n\x0f\x46\x65\x61turesRequest\x12\x37\n\x06server\x18\x01 \x01(\x0b\x32%.wandb_internal.ServerFeaturesRequestH\x00\x12\x31\n\x03org\x18\x02 \x01(\x0b\x32\".wandb_internal.OrgFeaturesRequestH\x00\x42\t\n\x07request\"\x8c\x01\n\x10\x46\x65\x61turesResponse\x12\x38\n\x06server\x18\x01 \x01(\x0b\x32&.wandb_internal.ServerFeaturesResponseH\x00\x12\x32\n\x03org\x18\x02 \x01(\x0b\x32#.wandb_internal.OrgFeaturesResponseH\x00\x42\n\n\x08response\"H\n\x15ServerFeaturesRequest\x12/\n\x08\x66\x65\x61tures\x18\x01 \x03(\x0e\x32\x1d.wandb_internal.ServerFeature\"H\n\x16ServerFeaturesResponse\x12.\n\x07\x65nabled\x18\x01 \x03(\x0e\x32\x1d.wandb_internal.ServerFeature\"3\n\x12OrgFeaturesRequest\x12\x0b\n\x03org\x18\x01 \x01(\t\x12\x10\n\x08\x66\x65\x61tures\x18\x02 \x03(\t\"\x8b\x01\n\x13OrgFeaturesResponse\x12\x43\n\x08\x66\x65\x61tures\x18\x01 \x03(\x0b\x32\x31.wandb_internal.OrgFeaturesResponse.FeaturesEntry\x1a/\n\rFeaturesEntry\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\r\n\x05value\x18\x02 \x01(\x08:\x02\x38\x01\"\xfa\x01\n\x0eGraphQLRequest\x12\r\n\x05query\x18\x01 \x01(\t\x12\x16\n\x0evariables_json\x18\x02 \x01(\t\x12\x16\n\x0eomit_variables\x18\x03 \x03(\t\x12\x16\n\x0eomit_fragments\x18\x04 \x03(\t\x12\x13\n\x0bomit_fields\x18\x05 \x03(\t\x12G\n\rrename_fields\x18\x06 \x03(\x0b\x32\x30.wandb_internal.GraphQLRequest.RenameFieldsEntry\x1a\x33\n\x11RenameFieldsEntry\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\r\n\x05value\x18\x02 \x01(\t:\x02\x38\x01\"$\n\x0fGraphQLResponse\x12\x11\n\tdata_json\x18\x01 \x01(\t\">\n\x13\x44ownloadFileRequest\x12\x0c\n\x04path\x18\x01 \x01(\t\x12\x0b\n\x03url\x18\x02 \x01(\t\x12\x0c\n\x04size\x18\x03 \x01(\x03\"\x16\n\x14\x44ownloadFileResponse\"\x9f\x01\n\x11UploadFileRequest\x12\x0c\n\x04path\x18\x01 \x01(\t\x12\x0b\n\x03url\x18\x02 \x01(\t\x12?\n\x07headers\x18\x03 \x03(\x0b\x32..wandb_internal.UploadFileRequest.HeadersEntry\x1a.\n\x0cHeadersEntry\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\r\n\x05value\x18\x02 \x01(\t:\x02\x38\x01\"\x14\n\x12UploadFileResponse\"]\n\x1bMarkRunFilesUploadedRequest\x12\x0e\n\x06\x65ntity\x18\x01 \x01(\t\x12\x0f\n\x07project\x18\x02 \x01(\t\x12\x0e\n\x06run_id\x18\x03 \x01(\t\x12\r\n\x05\x66iles\x18\x04 \x03(\t\"\x1e\n\x1cMarkRunFilesUploadedResponse\"\xa8\x01\n\x0b\x41uthRequest\x12\x43\n\x14\x61uthenticate_request\x18\x01 \x01(\x0b\x32#.wandb_internal.AuthenticateRequestH\x00\x12I\n\x18get_access_token_request\x18\x02 \x01(\x0b\x32%.wandb_internal.GetAccessTokenRequestH\x00\x42\t\n\x07request\"\xae\x01\n\x0c\x41uthResponse\x12\x45\n\x15\x61uthenticate_response\x18\x01 \x01(\x0b\x32$.wandb_internal.AuthenticateResponseH\x00\x12K\n\x19get_access_token_response\x18\x02 \x01(\x0b\x32&.wandb_internal.GetAccessTokenResponseH\x00\x42\n\n\x08response\"\x15\n\x13\x41uthenticateRequest\"r\n\x14\x41uthenticateResponse\x12\x16\n\x0e\x64\x65\x66\x61ult_entity\x18\x01 \x01(\t\x12\x10\n\x08username\x18\x02 \x01(\t\x12\r\n\x05\x65mail\x18\x03 \x01(\t\x12\r\n\x05teams\x18\x04 \x03(\t\x12\x12\n\nflags_json\x18\x05 \x01(\t\"\x17\n\x15GetAccessTokenRequest\".\n\x16GetAccessTokenResponse\x12\x14\n\x0c\x61\x63\x63\x65ss_token\x18\x01 \x01(\t\"$\n\x0eStopRunRequest\x12\x12\n\nstorage_id\x18\x01 \x01(\t\"\x11\n\x0fStopRunResponse\"\xa4\x01\n\x19ReadRunConsoleLogsRequest\x12\x0e\n\x06\x65ntity\x18\x01 \x01(\t\x12\x0f\n\x07project\x18\x02 \x01(\t\x12\x0e\n\x06run_id\x18\x03 \x01(\t\x12\x12\n\x05\x66irst\x18\x04 \x01(\x05H\x00\x88\x01\x01\x12\x12\n\x05\x61\x66ter\x18\x05 \x01(\tH\x01\x88\x01\x01\x12\x11\n\x04last\x18\x06 \x01(\x05H\x02\x88\x01\x01\x42\x08\n\x06_firstB\x08\n\x06_afterB\x07\n\x05_last\"\x8e\x01\n\x1aReadRunConsoleLogsResponse\x12\x30\n\x05lines\x18\x01 \x03(\x0b\x32!.wandb_internal.RunConsoleLogLine\x12\x12\n\nend_cursor\x18\x02 \x01(\t\x12\x15\n\rhas_next_page\x18\x03 \x01(\x08\x12\x13\n\x0btotal_lines\x18\x04 \x01(\x03\"e\n\x11RunConsoleLogLine\x12\x0e\n\x06number\x18\x01 \x01(\x03\x12\x11\n\ttimestamp\x18\x02 \x01(\t\x12\r\n\x05level\x18\x03 \x01(\t\x12\r\n\x05label\x18\x04 \x01(\t\x12\x0f\n\x07\x63ontent\x18\x05 \x01(\t\"\x7f\n\x18\x43reateCustomChartRequest\x12\x0e\n\x06\x65ntity\x18\x01 \x01(\t\x12\x0c\n\x04name\x18\x02 \x01(\t\x12\x14\n\x0c\x64isplay_name\x18\x03 \x01(\t\x12\x11\n\tspec_type\x18\x04 \x01(\t\x12\x0e\n\x06\x61\x63\x63\x65ss\x18\x05 \x01(\t\x12\x0c\n\x04spec\x18\x06 \x01(\t\"-\n\x19\x43reateCustomChartResponse\x12\x10\n\x08\x63hart_id\x18\x01 \x01(\t\"\xa3\x02\n\x18RunQueueOperationRequest\x12\x64\n&create_default_resource_config_request\x18\x01 \x01(\x0b\x32\x32.wandb_internal.CreateDefaultResourceConfigRequestH\x00\x12I\n\x18\x63reate_run_queue_request\x18\x02 \x01(\x0b\x32%.wandb_internal.CreateRunQueueRequestH\x00\x12I\n\x18upsert_run_queue_request\x18\x03 \x01(\x0b\x32%.wandb_internal.UpsertRunQueueRequestH\x00\x42\x0b\n\toperation\"\xaa\x02\n\x19RunQueueOperationResponse\x12\x66\n\'create_default_resource_config_response\x18\x01 \x01(\x0b\x32\x33.wandb_internal.CreateDefaultResourceConfigResponseH\x00\x12K\n\x19\x63reate_run_queue_response\x18\x02 \x01(\x0b\x32&.wandb_internal.CreateRunQueueResponseH\x00\x12K\n\x19upsert_run_queue_response\x18\x03 \x01(\x0b\x32&.wandb_internal.UpsertRunQueueResponseH\x00\x42\x0b\n\toperation\"\x93\x01\n\"CreateDefaultResourceConfigRequest\x12\x13\n\x0b\x65ntity_name\x18\x01 \x01(\t\x12\x10\n\x08resource\x18\x02 \x01(\t\x12\x0e\n\x06\x63onfig\x18\x03 \x01(\t\x12\x1f\n\x12template_variables\x18\x04 \x01(\tH\x00\x88\x01\x01\x42\x15\n\x13_template_variables\"Z\n#CreateDefaultResourceConfigResponse\x12\x0f\n\x07success\x18\x01 \x01(\x08\x12\"\n\x1a\x64\x65\x66\x61ult_resource_config_id\x18\x02 \x01(\t\"\xde\x01\n\x15\x43reateRunQueueRequest\x12\x0e\n\x06\x65ntity\x18\x01 \x01(\t\x12\x0f\n\x07project\x18\x02 \x01(\t\x12\x12\n\nqueue_name\x18\x03 \x01(\t\x12\x0e\n\x06\x61\x63\x63\x65ss\x18\x04 \x01(\t\x12 \n\x13prioritization_mode\x18\x05 \x01(\tH\x00\x88\x01\x01\x12\'\n\x1a\x64\x65\x66\x61ult_resource_config_id\x18\x06 \x01(\tH\x01\x88\x01\x01\x42\x16\n\x14_prioritization_modeB\x1d\n\x1b_default_resource_config_id\";\n\x16\x43reateRunQueueResponse\x12\x0f\n\x07success\x18\x01 \x01(\x08\x12\x10\n\x08queue_id\x18\x02 \x01(\t\"\xe0\x02\n\x15UpsertRunQueueRequest\x12\x13\n\x0b\x65ntity_name\x18\x01 \x01(\t\x12\x14\n\x0cproject_name\x18\x02 \x01(\t\x12\x12\n\nqueue_name\x18\x03 \x01(\t\x12\x15\n\rresource_type\x18\x04 \x01(\t\x12\x17\n\x0fresource_config\x18\x05 \x01(\t\x12\x1f\n\x12template_variables\x18\x06 \x01(\tH\x00\x88\x01\x01\x12 \n\x13prioritization_mode\x18\x07 \x01(\tH\x01\x88\x01\x01\x12\x1b\n\x0e\x65xternal_links\x18\x08 \x01(\tH\x02\x88\x01\x01\x12\x1f\n\x12\x63lient_mutation_id\x18\t \x01(\tH\x03\x88\x01\x01\x42\x15\n\x13_template_variablesB\x16\n\x14_prioritization_modeB\x11\n\x0f_external_linksB\x15\n\x13_client_mutation_id\"R\n\x16UpsertRunQueueResponse\x12\x0f\n\x07success\x18\x01 \x01(\x08\x12\'\n\x1f\x63onfig_schema_validation_errors\x18\x02 \x03(\t\"\xd0\x03\n\x15ReadRunHistoryRequest\x12\x43\n\x15scan_run_history_init\x18\x01 \x01(\x0b\x32\".wandb_internal.ScanRunHistoryInitH\x00\x12:\n\x10scan_run_history\x18\x02 \x01(\x0b\x32\x1e.wandb_internal.ScanRunHistoryH\x00\x12I\n\x18scan_run_history_cleanup\x18\x03 \x01(\x0b\x32%.wandb_internal.ScanRunHistoryCleanupH\x00\x12K\n\x19\x64ownload_run_history_init\x18\x04 \x01(\x0b\x32&.wandb_internal.DownloadRunHistoryInitH\x00\x12\x42\n\x14\x64ownload_run_history\x18\x05 \x01(\x0b\x32\".wandb_internal.DownloadRunHistoryH\x00\x12O\n\x1b\x64ownload_run_history_status\x18\x06 \x01(\x0b\x32(.wandb_internal.DownloadRunHistoryStatusH\x00\x42\t\n\x07request\"\xf9\x03\n\x16ReadRunHistoryResponse\x12K\n\x15scan_run_history_init\x18\x01 \x01(\x0b\x32*.wandb_internal.ScanRunHistoryInitResponseH\x00\x12\x39\n\x0brun_history\x18\x02 \x01(\x0b\x32\".wandb_internal.RunHistoryResponseH\x00\x12Q\n\x18scan_run_history_cleanup\x18\x03 \x01(\x0b\x32-.wandb_internal.ScanRunHistoryCleanupResponseH\x00\x12S\n\x19\x64ownload_run_history_init\x18\x04 \x01(\x0b\x32..wandb_internal.DownloadRunHistoryInitResponseH\x00\x12J\n\x14\x64ownload_run_history\x18\x05 \x01(\x0b\x32*.wandb_internal.DownloadRunHistoryResponseH\x00\x12W\n\x1b\x64ownload_run_history_status\x18\x06 \x01(\x0b\x32\x30.wandb_internal.DownloadRunHistoryStatusResponseH\x00\x42\n\n\x08response\"f\n\x12ScanRunHistoryInit\x12\x0e\n\x06\x65ntity\x18\x01 \x01(\t\x12\x0f\n\x07project\x18\x02 \x01(\t\x12\x0e\n\x06run_id\x18\x03 \x01(\t\x12\x0c\n\x04keys\x18\x04 \x03(\t\x12\x11\n\tuse_cache\x18\x05 \x01(\x08\"0\n\x1aScanRunHistoryInitResponse\x12\x12\n\nrequest_id\x18\x01 \x01(\x05\"H\n\x0eScanRunHistory\x12\x10\n\x08min_step\x18\x01 \x01(\x03\x12\x10\n\x08max_step\x18\x02 \x01(\x03\x12\x12\n\nrequest_id\x18\x03 \x01(\x05\"F\n\x12RunHistoryResponse\x12\x30\n\x0chistory_rows\x18\x01 \x03(\x0b\x32\x1a.wandb_internal.HistoryRow\"G\n\nHistoryRow\x12\x39\n\rhistory_items\x18\x01 \x03(\x0b\x32\".wandb_internal.ParquetHistoryItem\"5\n\x12ParquetHistoryItem\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\x12\n\nvalue_json\x18\x10 \x01(\t\"+\n\x15ScanRunHistoryCleanup\x12\x12\n\nrequest_id\x18\x01 \x01(\x05\"\x1f\n\x1dScanRunHistoryCleanupResponse\"\x81\x01\n\x16\x44ownloadRunHistoryInit\x12\x0e\n\x06\x65ntity\x18\x01 \x01(\t\x12\x0f\n\x07project\x18\x02 \x01(\t\x12\x0e\n\x06run_id\x18\x03 \x01(\t\x12\x14\n\x0c\x64ownload_dir\x18\x04 \x01(\t\x12 \n\x18require_complete_history\x18\x05 \x01(\x08\"P\n\x1e\x44ownloadRunHistoryInitResponse\x12\x12\n\nrequest_id\x18\x01 \x01(\x05\x12\x1a\n\x12\x63ontains_live_data\x18\x02 \x01(\x08\"(\n\x12\x44ownloadRunHistory\x12\x12\n\nrequest_id\x18\x01 \x01(\x05\"\xad\x01\n\x1a\x44ownloadRunHistoryResponse\x12\x18\n\x10\x64ownloaded_files\x18\x01 \x03(\t\x12\x46\n\x06\x65rrors\x18\x02 \x03(\x0b\x32\x36.wandb_internal.DownloadRunHistoryResponse.ErrorsEntry\x1a-\n\x0b\x45rrorsEntry\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\r\n\x05value\x18\x02 \x01(\t:\x02\x38\x01\"\x1b\n\x19IncompleteRunHistoryError\".\n\x18\x44ownloadRunHistoryStatus\x12\x12\n\nrequest_id\x18\x01 \x01(\x05\"[\n DownloadRunHistoryStatusResponse\x12\x37\n\x0foperation_stats\x18\x01 \x01(\x0b\x32\x1e.wandb_internal.OperationStats*@\n\tErrorType\x12\x11\n\rUNKNOWN_ERROR\x10\x00\x12 \n\x1cINCOMPLETE_RUN_HISTORY_ERROR\x10\x01\x42\x1bZ\x19\x63ore/pkg/service_go_protob\x06proto3')
+DESCRIPTOR = _descriptor_pool.Default().AddSerializedFile(b'\n\x1bwandb/proto/wandb_api.proto\x12\x0ewandb_internal\x1a wandb/proto/wandb_internal.proto\x1a\x1cwandb/proto/wandb_otel.proto\x1a wandb/proto/wandb_settings.proto\"X\n\x14ServerApiInitRequest\x12*\n\x08settings\x18\x01 \x01(\x0b\x32\x18.wandb_internal.Settings\x12\x14\n\x0cservice_name\x18\x02 \x01(\t\">\n\x15ServerApiInitResponse\x12\x15\n\rerror_message\x18\x01 \x01(\t\x12\x0e\n\x06\x61pi_id\x18\x02 \x01(\t\"\xf9\x06\n\nApiRequest\x12\x0e\n\x06\x61pi_id\x18\x01 \x01(\t\x12I\n\x18read_run_history_request\x18\x02 \x01(\x0b\x32%.wandb_internal.ReadRunHistoryRequestH\x00\x12;\n\x10\x66\x65\x61tures_request\x18\x03 \x01(\x0b\x32\x1f.wandb_internal.FeaturesRequestH\x00\x12\x39\n\x0fgraphql_request\x18\x04 \x01(\x0b\x32\x1e.wandb_internal.GraphQLRequestH\x00\x12\x44\n\x15\x64ownload_file_request\x18\x05 \x01(\x0b\x32#.wandb_internal.DownloadFileRequestH\x00\x12@\n\x13upload_file_request\x18\x06 \x01(\x0b\x32!.wandb_internal.UploadFileRequestH\x00\x12V\n\x1fmark_run_files_uploaded_request\x18\x07 \x01(\x0b\x32+.wandb_internal.MarkRunFilesUploadedRequestH\x00\x12:\n\x10stop_run_request\x18\x08 \x01(\x0b\x32\x1e.wandb_internal.StopRunRequestH\x00\x12\x33\n\x0c\x61uth_request\x18\t \x01(\x0b\x32\x1b.wandb_internal.AuthRequestH\x00\x12O\n\x1b\x63reate_custom_chart_request\x18\n \x01(\x0b\x32(.wandb_internal.CreateCustomChartRequestH\x00\x12O\n\x1brun_queue_operation_request\x18\x0b \x01(\x0b\x32(.wandb_internal.RunQueueOperationRequestH\x00\x12\x46\n\x16open_telemetry_request\x18\x0c \x01(\x0b\x32$.wandb_internal.OpenTelemetryRequestH\x00\x12R\n\x1dread_run_console_logs_request\x18\r \x01(\x0b\x32).wandb_internal.ReadRunConsoleLogsRequestH\x00\x42\t\n\x07request\"\xf9\x06\n\x0b\x41piResponse\x12K\n\x19read_run_history_response\x18\x01 \x01(\x0b\x32&.wandb_internal.ReadRunHistoryResponseH\x00\x12=\n\x11\x66\x65\x61tures_response\x18\x03 \x01(\x0b\x32 .wandb_internal.FeaturesResponseH\x00\x12;\n\x10graphql_response\x18\x04 \x01(\x0b\x32\x1f.wandb_internal.GraphQLResponseH\x00\x12\x46\n\x16\x64ownload_file_response\x18\x05 \x01(\x0b\x32$.wandb_internal.DownloadFileResponseH\x00\x12\x42\n\x14upload_file_response\x18\x06 \x01(\x0b\x32\".wandb_internal.UploadFileResponseH\x00\x12X\n mark_run_files_uploaded_response\x18\x07 \x01(\x0b\x32,.wandb_internal.MarkRunFilesUploadedResponseH\x00\x12<\n\x11stop_run_response\x18\x08 \x01(\x0b\x32\x1f.wandb_internal.StopRunResponseH\x00\x12\x35\n\rauth_response\x18\t \x01(\x0b\x32\x1c.wandb_internal.AuthResponseH\x00\x12Q\n\x1c\x63reate_custom_chart_response\x18\n \x01(\x0b\x32).wandb_internal.CreateCustomChartResponseH\x00\x12Q\n\x1crun_queue_operation_response\x18\x0b \x01(\x0b\x32).wandb_internal.RunQueueOperationResponseH\x00\x12T\n\x1eread_run_console_logs_response\x18\x0c \x01(\x0b\x32*.wandb_internal.ReadRunConsoleLogsResponseH\x00\x12>\n\x12\x61pi_error_response\x18\x02 \x01(\x0b\x32 .wandb_internal.ApiErrorResponseH\x00\x42\n\n\x08response\"{\n\x10\x41piErrorResponse\x12\x0f\n\x07message\x18\x01 \x01(\t\x12\x32\n\nerror_type\x18\x02 \x01(\x0e\x32\x19.wandb_internal.ErrorTypeH\x00\x88\x01\x01\x12\x13\n\x0bhttp_status\x18\x03 \x01(\x05\x42\r\n\x0b_error_type\")\n\x17ServerApiCleanupRequest\x12\x0e\n\x06\x61pi_id\x18\x01 \x01(\t\"\x88\x01\n\x0f\x46\x65\x61turesRequest\x12\x37\n\x06server\x18\x01 \x01(\x0b\x32%.wandb_internal.ServerFeaturesRequestH\x00\x12\x31\n\x03org\x18\x02 \x01(\x0b\x32\".wandb_internal.OrgFeaturesRequestH\x00\x42\t\n\x07request\"\x8c\x01\n\x10\x46\x65\x61turesResponse\x12\x38\n\x06server\x18\x01 \x01(\x0b\x32&.wandb_internal.ServerFeaturesResponseH\x00\x12\x32\n\x03org\x18\x02 \x01(\x0b\x32#.wandb_internal.OrgFeaturesResponseH\x00\x42\n\n\x08response\"H\n\x15ServerFeaturesRequest\x12/\n\x08\x66\x65\x61tures\x18\x01 \x03(\x0e\x32\x1d.wandb_internal.ServerFeature\"H\n\x16ServerFeaturesResponse\x12.\n\x07\x65nabled\x18\x01 \x03(\x0e\x32\x1d.wandb_internal.ServerFeature\"3\n\x12OrgFeaturesRequest\x12\x0b\n\x03org\x18\x01 \x01(\t\x12\x10\n\x08\x66\x65\x61tures\x18\x02 \x03(\t\"\x8b\x01\n\x13OrgFeaturesResponse\x12\x43\n\x08\x66\x65\x61tures\x18\x01 \x03(\x0b\x32\x31.wandb_internal.OrgFeaturesResponse.FeaturesEntry\x1a/\n\rFeaturesEntry\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\r\n\x05value\x18\x02 \x01(\x08:\x02\x38\x01\"\xfa\x01\n\x0eGraphQLRequest\x12\r\n\x05query\x18\x01 \x01(\t\x12\x16\n\x0evariables_json\x18\x02 \x01(\t\x12\x16\n\x0eomit_variables\x18\x03 \x03(\t\x12\x16\n\x0eomit_fragments\x18\x04 \x03(\t\x12\x13\n\x0bomit_fields\x18\x05 \x03(\t\x12G\n\rrename_fields\x18\x06 \x03(\x0b\x32\x30.wandb_internal.GraphQLRequest.RenameFieldsEntry\x1a\x33\n\x11RenameFieldsEntry\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\r\n\x05value\x18\x02 \x01(\t:\x02\x38\x01\"$\n\x0fGraphQLResponse\x12\x11\n\tdata_json\x18\x01 \x01(\t\">\n\x13\x44ownloadFileRequest\x12\x0c\n\x04path\x18\x01 \x01(\t\x12\x0b\n\x03url\x18\x02 \x01(\t\x12\x0c\n\x04size\x18\x03 \x01(\x03\"\x16\n\x14\x44ownloadFileResponse\"\x9f\x01\n\x11UploadFileRequest\x12\x0c\n\x04path\x18\x01 \x01(\t\x12\x0b\n\x03url\x18\x02 \x01(\t\x12?\n\x07headers\x18\x03 \x03(\x0b\x32..wandb_internal.UploadFileRequest.HeadersEntry\x1a.\n\x0cHeadersEntry\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\r\n\x05value\x18\x02 \x01(\t:\x02\x38\x01\"\x14\n\x12UploadFileResponse\"]\n\x1bMarkRunFilesUploadedRequest\x12\x0e\n\x06\x65ntity\x18\x01 \x01(\t\x12\x0f\n\x07project\x18\x02 \x01(\t\x12\x0e\n\x06run_id\x18\x03 \x01(\t\x12\r\n\x05\x66iles\x18\x04 \x03(\t\"\x1e\n\x1cMarkRunFilesUploadedResponse\"\xa8\x01\n\x0b\x41uthRequest\x12\x43\n\x14\x61uthenticate_request\x18\x01 \x01(\x0b\x32#.wandb_internal.AuthenticateRequestH\x00\x12I\n\x18get_access_token_request\x18\x02 \x01(\x0b\x32%.wandb_internal.GetAccessTokenRequestH\x00\x42\t\n\x07request\"\xae\x01\n\x0c\x41uthResponse\x12\x45\n\x15\x61uthenticate_response\x18\x01 \x01(\x0b\x32$.wandb_internal.AuthenticateResponseH\x00\x12K\n\x19get_access_token_response\x18\x02 \x01(\x0b\x32&.wandb_internal.GetAccessTokenResponseH\x00\x42\n\n\x08response\"\x15\n\x13\x41uthenticateRequest\"r\n\x14\x41uthenticateResponse\x12\x16\n\x0e\x64\x65\x66\x61ult_entity\x18\x01 \x01(\t\x12\x10\n\x08username\x18\x02 \x01(\t\x12\r\n\x05\x65mail\x18\x03 \x01(\t\x12\r\n\x05teams\x18\x04 \x03(\t\x12\x12\n\nflags_json\x18\x05 \x01(\t\"\x17\n\x15GetAccessTokenRequest\".\n\x16GetAccessTokenResponse\x12\x14\n\x0c\x61\x63\x63\x65ss_token\x18\x01 \x01(\t\"$\n\x0eStopRunRequest\x12\x12\n\nstorage_id\x18\x01 \x01(\t\"\x11\n\x0fStopRunResponse\"\xa4\x01\n\x19ReadRunConsoleLogsRequest\x12\x0e\n\x06\x65ntity\x18\x01 \x01(\t\x12\x0f\n\x07project\x18\x02 \x01(\t\x12\x0e\n\x06run_id\x18\x03 \x01(\t\x12\x12\n\x05\x66irst\x18\x04 \x01(\x05H\x00\x88\x01\x01\x12\x12\n\x05\x61\x66ter\x18\x05 \x01(\tH\x01\x88\x01\x01\x12\x11\n\x04last\x18\x06 \x01(\x05H\x02\x88\x01\x01\x42\x08\n\x06_firstB\x08\n\x06_afterB\x07\n\x05_last\"\x8e\x01\n\x1aReadRunConsoleLogsResponse\x12\x30\n\x05lines\x18\x01 \x03(\x0b\x32!.wandb_internal.RunConsoleLogLine\x12\x12\n\nend_cursor\x18\x02 \x01(\t\x12\x15\n\rhas_next_page\x18\x03 \x01(\x08\x12\x13\n\x0btotal_lines\x18\x04 \x01(\x03\"e\n\x11RunConsoleLogLine\x12\x0e\n\x06number\x18\x01 \x01(\x03\x12\x11\n\ttimestamp\x18\x02 \x01(\t\x12\r\n\x05level\x18\x03 \x01(\t\x12\r\n\x05label\x18\x04 \x01(\t\x12\x0f\n\x07\x63ontent\x18\x05 \x01(\t\"\x7f\n\x18\x43reateCustomChartRequest\x12\x0e\n\x06\x65ntity\x18\x01 \x01(\t\x12\x0c\n\x04name\x18\x02 \x01(\t\x12\x14\n\x0c\x64isplay_name\x18\x03 \x01(\t\x12\x11\n\tspec_type\x18\x04 \x01(\t\x12\x0e\n\x06\x61\x63\x63\x65ss\x18\x05 \x01(\t\x12\x0c\n\x04spec\x18\x06 \x01(\t\"-\n\x19\x43reateCustomChartResponse\x12\x10\n\x08\x63hart_id\x18\x01 \x01(\t\"\xa3\x02\n\x18RunQueueOperationRequest\x12\x64\n&create_default_resource_config_request\x18\x01 \x01(\x0b\x32\x32.wandb_internal.CreateDefaultResourceConfigRequestH\x00\x12I\n\x18\x63reate_run_queue_request\x18\x02 \x01(\x0b\x32%.wandb_internal.CreateRunQueueRequestH\x00\x12I\n\x18upsert_run_queue_request\x18\x03 \x01(\x0b\x32%.wandb_internal.UpsertRunQueueRequestH\x00\x42\x0b\n\toperation\"\xaa\x02\n\x19RunQueueOperationResponse\x12\x66\n\'create_default_resource_config_response\x18\x01 \x01(\x0b\x32\x33.wandb_internal.CreateDefaultResourceConfigResponseH\x00\x12K\n\x19\x63reate_run_queue_response\x18\x02 \x01(\x0b\x32&.wandb_internal.CreateRunQueueResponseH\x00\x12K\n\x19upsert_run_queue_response\x18\x03 \x01(\x0b\x32&.wandb_internal.UpsertRunQueueResponseH\x00\x42\x0b\n\toperation\"\x93\x01\n\"CreateDefaultResourceConfigRequest\x12\x13\n\x0b\x65ntity_name\x18\x01 \x01(\t\x12\x10\n\x08resource\x18\x02 \x01(\t\x12\x0e\n\x06\x63onfig\x18\x03 \x01(\t\x12\x1f\n\x12template_variables\x18\x04 \x01(\tH\x00\x88\x01\x01\x42\x15\n\x13_template_variables\"Z\n#CreateDefaultResourceConfigResponse\x12\x0f\n\x07success\x18\x01 \x01(\x08\x12\"\n\x1a\x64\x65\x66\x61ult_resource_config_id\x18\x02 \x01(\t\"\xde\x01\n\x15\x43reateRunQueueRequest\x12\x0e\n\x06\x65ntity\x18\x01 \x01(\t\x12\x0f\n\x07project\x18\x02 \x01(\t\x12\x12\n\nqueue_name\x18\x03 \x01(\t\x12\x0e\n\x06\x61\x63\x63\x65ss\x18\x04 \x01(\t\x12 \n\x13prioritization_mode\x18\x05 \x01(\tH\x00\x88\x01\x01\x12\'\n\x1a\x64\x65\x66\x61ult_resource_config_id\x18\x06 \x01(\tH\x01\x88\x01\x01\x42\x16\n\x14_prioritization_modeB\x1d\n\x1b_default_resource_config_id\";\n\x16\x43reateRunQueueResponse\x12\x0f\n\x07success\x18\x01 \x01(\x08\x12\x10\n\x08queue_id\x18\x02 \x01(\t\"\xe0\x02\n\x15UpsertRunQueueRequest\x12\x13\n\x0b\x65ntity_name\x18\x01 \x01(\t\x12\x14\n\x0cproject_name\x18\x02 \x01(\t\x12\x12\n\nqueue_name\x18\x03 \x01(\t\x12\x15\n\rresource_type\x18\x04 \x01(\t\x12\x17\n\x0fresource_config\x18\x05 \x01(\t\x12\x1f\n\x12template_variables\x18\x06 \x01(\tH\x00\x88\x01\x01\x12 \n\x13prioritization_mode\x18\x07 \x01(\tH\x01\x88\x01\x01\x12\x1b\n\x0e\x65xternal_links\x18\x08 \x01(\tH\x02\x88\x01\x01\x12\x1f\n\x12\x63lient_mutation_id\x18\t \x01(\tH\x03\x88\x01\x01\x42\x15\n\x13_template_variablesB\x16\n\x14_prioritization_modeB\x11\n\x0f_external_linksB\x15\n\x13_client_mutation_id\"R\n\x16UpsertRunQueueResponse\x12\x0f\n\x07success\x18\x01 \x01(\x08\x12\'\n\x1f\x63onfig_schema_validation_errors\x18\x02 \x03(\t\"\xab\x05\n\x15ReadRunHistoryRequest\x12\x43\n\x15scan_run_history_init\x18\x01 \x01(\x0b\x32\".wandb_internal.ScanRunHistoryInitH\x00\x12:\n\x10scan_run_history\x18\x02 \x01(\x0b\x32\x1e.wandb_internal.ScanRunHistoryH\x00\x12I\n\x18scan_run_history_cleanup\x18\x03 \x01(\x0b\x32%.wandb_internal.ScanRunHistoryCleanupH\x00\x12K\n\x19\x64ownload_run_history_init\x18\x04 \x01(\x0b\x32&.wandb_internal.DownloadRunHistoryInitH\x00\x12\x42\n\x14\x64ownload_run_history\x18\x05 \x01(\x0b\x32\".wandb_internal.DownloadRunHistoryH\x00\x12O\n\x1b\x64ownload_run_history_status\x18\x06 \x01(\x0b\x32(.wandb_internal.DownloadRunHistoryStatusH\x00\x12\x45\n\x16\x62\x61tch_run_history_init\x18\x07 \x01(\x0b\x32#.wandb_internal.BatchRunHistoryInitH\x00\x12\x45\n\x16\x62\x61tch_run_history_next\x18\x08 \x01(\x0b\x32#.wandb_internal.BatchRunHistoryNextH\x00\x12K\n\x19\x62\x61tch_run_history_cleanup\x18\t \x01(\x0b\x32&.wandb_internal.BatchRunHistoryCleanupH\x00\x42\t\n\x07request\"\xe3\x05\n\x16ReadRunHistoryResponse\x12K\n\x15scan_run_history_init\x18\x01 \x01(\x0b\x32*.wandb_internal.ScanRunHistoryInitResponseH\x00\x12\x39\n\x0brun_history\x18\x02 \x01(\x0b\x32\".wandb_internal.RunHistoryResponseH\x00\x12Q\n\x18scan_run_history_cleanup\x18\x03 \x01(\x0b\x32-.wandb_internal.ScanRunHistoryCleanupResponseH\x00\x12S\n\x19\x64ownload_run_history_init\x18\x04 \x01(\x0b\x32..wandb_internal.DownloadRunHistoryInitResponseH\x00\x12J\n\x14\x64ownload_run_history\x18\x05 \x01(\x0b\x32*.wandb_internal.DownloadRunHistoryResponseH\x00\x12W\n\x1b\x64ownload_run_history_status\x18\x06 \x01(\x0b\x32\x30.wandb_internal.DownloadRunHistoryStatusResponseH\x00\x12M\n\x16\x62\x61tch_run_history_init\x18\x07 \x01(\x0b\x32+.wandb_internal.BatchRunHistoryInitResponseH\x00\x12\x44\n\x11\x62\x61tch_run_history\x18\x08 \x01(\x0b\x32\'.wandb_internal.BatchRunHistoryResponseH\x00\x12S\n\x19\x62\x61tch_run_history_cleanup\x18\t \x01(\x0b\x32..wandb_internal.BatchRunHistoryCleanupResponseH\x00\x42\n\n\x08response\"f\n\x12ScanRunHistoryInit\x12\x0e\n\x06\x65ntity\x18\x01 \x01(\t\x12\x0f\n\x07project\x18\x02 \x01(\t\x12\x0e\n\x06run_id\x18\x03 \x01(\t\x12\x0c\n\x04keys\x18\x04 \x03(\t\x12\x11\n\tuse_cache\x18\x05 \x01(\x08\"0\n\x1aScanRunHistoryInitResponse\x12\x12\n\nrequest_id\x18\x01 \x01(\x05\"\xf1\x01\n\x0eScanRunHistory\x12\x10\n\x08min_step\x18\x01 \x01(\x03\x12\x10\n\x08max_step\x18\x02 \x01(\x03\x12\x12\n\nrequest_id\x18\x03 \x01(\x05\x12\x31\n\x08sampling\x18\x04 \x01(\x0b\x32\x1f.wandb_internal.HistorySampling\x12\x35\n\x06\x66ormat\x18\x05 \x01(\x0e\x32%.wandb_internal.ScanRunHistory.Format\"=\n\x06\x46ormat\x12\x10\n\x0cHISTORY_ROWS\x10\x00\x12\r\n\tARROW_IPC\x10\x01\x12\x12\n\x0e\x41RROW_IPC_FILE\x10\x02\"\xa9\x01\n\x0fHistorySampling\x12\x15\n\rtarget_points\x18\x01 \x01(\x05\x12@\n\x0b\x61ggregation\x18\x02 \x01(\x0e\x32+.wandb_internal.HistorySampling.Aggregation\"=\n\x0b\x41ggregation\x12\r\n\tEVERY_NTH\x10\x00\x12\x0b\n\x07MIN_MAX\x10\x01\x12\x08\n\x04MEAN\x10\x02\x12\x08\n\x04LTTB\x10\x03\"z\n\x12RunHistoryResponse\x12\x30\n\x0chistory_rows\x18\x01 \x03(\x0b\x32\x1a.wandb_internal.HistoryRow\x12\x19\n\x11\x61rrow_ipc_streams\x18\x02 \x03(\x0c\x12\x17\n\x0f\x61rrow_ipc_files\x18\x03 \x03(\t\"G\n\nHistoryRow\x12\x39\n\rhistory_items\x18\x01 \x03(\x0b\x32\".wandb_internal.ParquetHistoryItem\"5\n\x12ParquetHistoryItem\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\x12\n\nvalue_json\x18\x10 \x01(\t\"+\n\x15ScanRunHistoryCleanup\x12\x12\n\nrequest_id\x18\x01 \x01(\x05\"\x1f\n\x1dScanRunHistoryCleanupResponse\"A\n\x0eHistoryRunPath\x12\x0e\n\x06\x65ntity\x18\x01 \x01(\t\x12\x0f\n\x07project\x18\x02 \x01(\t\x12\x0e\n\x06run_id\x18\x03 \x01(\t\"\xbb\x01\n\x13\x42\x61tchRunHistoryInit\x12,\n\x04runs\x18\x01 \x03(\x0b\x32\x1e.wandb_internal.HistoryRunPath\x12\x0c\n\x04keys\x18\x02 \x03(\t\x12\x10\n\x08min_step\x18\x03 \x01(\x03\x12\x10\n\x08max_step\x18\x04 \x01(\x03\x12\x11\n\tuse_cache\x18\x05 \x01(\x08\x12\x31\n\x08sampling\x18\x06 \x01(\x0b\x32\x1f.wandb_internal.HistorySampling\"1\n\x1b\x42\x61tchRunHistoryInitResponse\x12\x12\n\nrequest_id\x18\x01 \x01(\x05\")\n\x13\x42\x61tchRunHistoryNext\x12\x12\n\nrequest_id\x18\x01 \x01(\x05\"Z\n\x17\x42\x61tchRunHistoryResponse\x12\x31\n\x07results\x18\x01 \x03(\x0b\x32 .wandb_internal.RunHistoryResult\x12\x0c\n\x04\x64one\x18\x02 \x01(\x08\"\x80\x01\n\x10RunHistoryResult\x12+\n\x03run\x18\x01 \x01(\x0b\x32\x1e.wandb_internal.HistoryRunPath\x12\x30\n\x0chistory_rows\x18\x02 \x03(\x0b\x32\x1a.wandb_internal.HistoryRow\x12\r\n\x05\x65rror\x18\x03 \x01(\t\",\n\x16\x42\x61tchRunHistoryCleanup\x12\x12\n\nrequest_id\x18\x01 \x01(\x05\" \n\x1e\x42\x61tchRunHistoryCleanupResponse\"\x81\x01\n\x16\x44ownloadRunHistoryInit\x12\x0e\n\x06\x65ntity\x18\x01 \x01(\t\x12\x0f\n\x07project\x18\x02 \x01(\t\x12\x0e\n\x06run_id\x18\x03 \x01(\t\x12\x14\n\x0c\x64ownload_dir\x18\x04 \x01(\t\x12 \n\x18require_complete_history\x18\x05 \x01(\x08\"P\n\x1e\x44ownloadRunHistoryInitResponse\x12\x12\n\nrequest_id\x18\x01 \x01(\x05\x12\x1a\n\x12\x63ontains_live_data\x18\x02 \x01(\x08\"(\n\x12\x44ownloadRunHistory\x12\x12\n\nrequest_id\x18\x01 \x01(\x05\"\xad\x01\n\x1a\x44ownloadRunHistoryResponse\x12\x18\n\x10\x64ownloaded_files\x18\x01 \x03(\t\x12\x46\n\x06\x65rrors\x18\x02 \x03(\x0b\x32\x36.wandb_internal.DownloadRunHistoryResponse.ErrorsEntry\x1a-\n\x0b\x45rrorsEntry\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\r\n\x05value\x18\x02 \x01(\t:\x02\x38\x01\"\x1b\n\x19IncompleteRunHistoryError\".\n\x18\x44ownloadRunHistoryStatus\x12\x12\n\nrequest_id\x18\x01 \x01(\x05\"[\n DownloadRunHistoryStatusResponse\x12\x37\n\x0foperation_stats\x18\x01 \x01(\x0b\x32\x1e.wandb_internal.OperationStats*@\n\tErrorType\x12\x11\n\rUNKNOWN_ERROR\x10\x00\x12 \n\x1cINCOMPLETE_RUN_HISTORY_ERROR\x10\x01\x42\x1bZ\x19\x63ore/pkg/service_go_protob\x06proto3')
 
 _globals = globals()
 _builder.BuildMessageAndEnumDescriptors(DESCRIPTOR, _globals)
@@ -43,8 +43,8 @@ if not _descriptor._USE_C_DESCRIPTORS:
   _globals['_UPLOADFILEREQUEST_HEADERSENTRY']._serialized_options = b'8\001'
   _globals['_DOWNLOADRUNHISTORYRESPONSE_ERRORSENTRY']._loaded_options = None
   _globals['_DOWNLOADRUNHISTORYRESPONSE_ERRORSENTRY']._serialized_options = b'8\001'
-  _globals['_ERRORTYPE']._serialized_start=8419
-  _globals['_ERRORTYPE']._serialized_end=8483
+  _globals['_ERRORTYPE']._serialized_start=9920
+  _globals['_ERRORTYPE']._serialized_end=9984
   _globals['_SERVERAPIINITREQUEST']._serialized_start=145
   _globals['_SERVERAPIINITREQUEST']._serialized_end=233
   _globals['_SERVERAPIINITRESPONSE']._serialized_start=235
@@ -134,39 +134,61 @@ if not _descriptor._USE_C_DESCRIPTORS:
   _globals['_UPSERTRUNQUEUERESPONSE']._serialized_start=6252
   _globals['_UPSERTRUNQUEUERESPONSE']._serialized_end=6334
   _globals['_READRUNHISTORYREQUEST']._serialized_start=6337
-  _globals['_READRUNHISTORYREQUEST']._serialized_end=6801
-  _globals['_READRUNHISTORYRESPONSE']._serialized_start=6804
-  _globals['_READRUNHISTORYRESPONSE']._serialized_end=7309
-  _globals['_SCANRUNHISTORYINIT']._serialized_start=7311
-  _globals['_SCANRUNHISTORYINIT']._serialized_end=7413
-  _globals['_SCANRUNHISTORYINITRESPONSE']._serialized_start=7415
-  _globals['_SCANRUNHISTORYINITRESPONSE']._serialized_end=7463
-  _globals['_SCANRUNHISTORY']._serialized_start=7465
-  _globals['_SCANRUNHISTORY']._serialized_end=7537
-  _globals['_RUNHISTORYRESPONSE']._serialized_start=7539
-  _globals['_RUNHISTORYRESPONSE']._serialized_end=7609
-  _globals['_HISTORYROW']._serialized_start=7611
-  _globals['_HISTORYROW']._serialized_end=7682
-  _globals['_PARQUETHISTORYITEM']._serialized_start=7684
-  _globals['_PARQUETHISTORYITEM']._serialized_end=7737
-  _globals['_SCANRUNHISTORYCLEANUP']._serialized_start=7739
-  _globals['_SCANRUNHISTORYCLEANUP']._serialized_end=7782
-  _globals['_SCANRUNHISTORYCLEANUPRESPONSE']._serialized_start=7784
-  _globals['_SCANRUNHISTORYCLEANUPRESPONSE']._serialized_end=7815
-  _globals['_DOWNLOADRUNHISTORYINIT']._serialized_start=7818
-  _globals['_DOWNLOADRUNHISTORYINIT']._serialized_end=7947
-  _globals['_DOWNLOADRUNHISTORYINITRESPONSE']._serialized_start=7949
-  _globals['_DOWNLOADRUNHISTORYINITRESPONSE']._serialized_end=8029
-  _globals['_DOWNLOADRUNHISTORY']._serialized_start=8031
-  _globals['_DOWNLOADRUNHISTORY']._serialized_end=8071
-  _globals['_DOWNLOADRUNHISTORYRESPONSE']._serialized_start=8074
-  _globals['_DOWNLOADRUNHISTORYRESPONSE']._serialized_end=8247
-  _globals['_DOWNLOADRUNHISTORYRESPONSE_ERRORSENTRY']._serialized_start=8202
-  _globals['_DOWNLOADRUNHISTORYRESPONSE_ERRORSENTRY']._serialized_end=8247
-  _globals['_INCOMPLETERUNHISTORYERROR']._serialized_start=8249
-  _globals['_INCOMPLETERUNHISTORYERROR']._serialized_end=8276
-  _globals['_DOWNLOADRUNHISTORYSTATUS']._serialized_start=8278
-  _globals['_DOWNLOADRUNHISTORYSTATUS']._serialized_end=8324
-  _globals['_DOWNLOADRUNHISTORYSTATUSRESPONSE']._serialized_start=8326
-  _globals['_DOWNLOADRUNHISTORYSTATUSRESPONSE']._serialized_end=8417
+  _globals['_READRUNHISTORYREQUEST']._serialized_end=7020
+  _globals['_READRUNHISTORYRESPONSE']._serialized_start=7023
+  _globals['_READRUNHISTORYRESPONSE']._serialized_end=7762
+  _globals['_SCANRUNHISTORYINIT']._serialized_start=7764
+  _globals['_SCANRUNHISTORYINIT']._serialized_end=7866
+  _globals['_SCANRUNHISTORYINITRESPONSE']._serialized_start=7868
+  _globals['_SCANRUNHISTORYINITRESPONSE']._serialized_end=7916
+  _globals['_SCANRUNHISTORY']._serialized_start=7919
+  _globals['_SCANRUNHISTORY']._serialized_end=8160
+  _globals['_SCANRUNHISTORY_FORMAT']._serialized_start=8099
+  _globals['_SCANRUNHISTORY_FORMAT']._serialized_end=8160
+  _globals['_HISTORYSAMPLING']._serialized_start=8163
+  _globals['_HISTORYSAMPLING']._serialized_end=8332
+  _globals['_HISTORYSAMPLING_AGGREGATION']._serialized_start=8271
+  _globals['_HISTORYSAMPLING_AGGREGATION']._serialized_end=8332
+  _globals['_RUNHISTORYRESPONSE']._serialized_start=8334
+  _globals['_RUNHISTORYRESPONSE']._serialized_end=8456
+  _globals['_HISTORYROW']._serialized_start=8458
+  _globals['_HISTORYROW']._serialized_end=8529
+  _globals['_PARQUETHISTORYITEM']._serialized_start=8531
+  _globals['_PARQUETHISTORYITEM']._serialized_end=8584
+  _globals['_SCANRUNHISTORYCLEANUP']._serialized_start=8586
+  _globals['_SCANRUNHISTORYCLEANUP']._serialized_end=8629
+  _globals['_SCANRUNHISTORYCLEANUPRESPONSE']._serialized_start=8631
+  _globals['_SCANRUNHISTORYCLEANUPRESPONSE']._serialized_end=8662
+  _globals['_HISTORYRUNPATH']._serialized_start=8664
+  _globals['_HISTORYRUNPATH']._serialized_end=8729
+  _globals['_BATCHRUNHISTORYINIT']._serialized_start=8732
+  _globals['_BATCHRUNHISTORYINIT']._serialized_end=8919
+  _globals['_BATCHRUNHISTORYINITRESPONSE']._serialized_start=8921
+  _globals['_BATCHRUNHISTORYINITRESPONSE']._serialized_end=8970
+  _globals['_BATCHRUNHISTORYNEXT']._serialized_start=8972
+  _globals['_BATCHRUNHISTORYNEXT']._serialized_end=9013
+  _globals['_BATCHRUNHISTORYRESPONSE']._serialized_start=9015
+  _globals['_BATCHRUNHISTORYRESPONSE']._serialized_end=9105
+  _globals['_RUNHISTORYRESULT']._serialized_start=9108
+  _globals['_RUNHISTORYRESULT']._serialized_end=9236
+  _globals['_BATCHRUNHISTORYCLEANUP']._serialized_start=9238
+  _globals['_BATCHRUNHISTORYCLEANUP']._serialized_end=9282
+  _globals['_BATCHRUNHISTORYCLEANUPRESPONSE']._serialized_start=9284
+  _globals['_BATCHRUNHISTORYCLEANUPRESPONSE']._serialized_end=9316
+  _globals['_DOWNLOADRUNHISTORYINIT']._serialized_start=9319
+  _globals['_DOWNLOADRUNHISTORYINIT']._serialized_end=9448
+  _globals['_DOWNLOADRUNHISTORYINITRESPONSE']._serialized_start=9450
+  _globals['_DOWNLOADRUNHISTORYINITRESPONSE']._serialized_end=9530
+  _globals['_DOWNLOADRUNHISTORY']._serialized_start=9532
+  _globals['_DOWNLOADRUNHISTORY']._serialized_end=9572
+  _globals['_DOWNLOADRUNHISTORYRESPONSE']._serialized_start=9575
+  _globals['_DOWNLOADRUNHISTORYRESPONSE']._serialized_end=9748
+  _globals['_DOWNLOADRUNHISTORYRESPONSE_ERRORSENTRY']._serialized_start=9703
+  _globals['_DOWNLOADRUNHISTORYRESPONSE_ERRORSENTRY']._serialized_end=9748
+  _globals['_INCOMPLETERUNHISTORYERROR']._serialized_start=9750
+  _globals['_INCOMPLETERUNHISTORYERROR']._serialized_end=9777
+  _globals['_DOWNLOADRUNHISTORYSTATUS']._serialized_start=9779
+  _globals['_DOWNLOADRUNHISTORYSTATUS']._serialized_end=9825
+  _globals['_DOWNLOADRUNHISTORYSTATUSRESPONSE']._serialized_start=9827
+  _globals['_DOWNLOADRUNHISTORYSTATUSRESPONSE']._serialized_end=9918
 # @@protoc_insertion_point(module_scope)
@@ -932,6 +932,23 @@ class InterfaceBase(abc.ABC):
     ) -> None:
         raise NotImplementedError
 
+    def publish_capture_profile(
+        self,
+        duration_seconds: float = 0,
+        label: str = "",
+    ) -> None:
+        capture_profile = pb.CaptureProfileRequest(
+            duration_seconds=duration_seconds,
+            label=label,
+        )
+        self._publish_capture_profile(capture_profile)
+
+    @abc.abstractmethod
+    def _publish_capture_profile(
+        self, capture_profile: pb.CaptureProfileRequest
+    ) -> None:
+        raise NotImplementedError
+
     def join(self) -> None:
         # Drop indicates that the internal process has already been shutdown
         if self._drop:
@@ -172,6 +172,7 @@ class InterfaceShared(InterfaceBase, abc.ABC):
         python_packages: pb.PythonPackagesRequest | None = None,
         job_input: pb.JobInputRequest | None = None,
         probe_system_info: pb.ProbeSystemInfoRequest | None = None,
+        capture_profile: pb.CaptureProfileRequest | None = None,
     ) -> pb.Record:
         request = pb.Request()
         if get_summary:
@@ -232,6 +233,8 @@ class InterfaceShared(InterfaceBase, abc.ABC):
             request.job_input.CopyFrom(job_input)
         elif probe_system_info:
             request.probe_system_info.CopyFrom(probe_system_info)
+        elif capture_profile:
+            request.capture_profile.CopyFrom(capture_profile)
         else:
             raise Exception("Invalid request")
         record = self._make_record(request=request)
@@ -384,6 +387,12 @@ class InterfaceShared(InterfaceBase, abc.ABC):
         record = self._make_request(probe_system_info=probe_system_info)
         self._publish(record)
 
+    def _publish_capture_profile(
+        self, capture_profile: pb.CaptureProfileRequest
+    ) -> None:
+        record = self._make_request(capture_profile=capture_profile)
+        self._publish(record)
+
     def _deliver_artifact(
         self,
         log_artifact: pb.LogArtifactRequest,
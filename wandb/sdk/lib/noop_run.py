@@ -88,6 +88,7 @@ class NoopRun(wandb_run.Run):
         "log_model",
         "use_model",
         "mark_preempting",
+        "capture_profile",
         "restore",
         "status",
         "watch",
@@ -3816,6 +3816,41 @@ class Run:
         if self._interface:
             self._interface.publish_preempting()
 
+    @_log_to_run
+    @_raise_if_finished
+    @_attach
+    def capture_profile(
+        self,
+        duration_seconds: float = 30,
+        label: str | None = None,
+    ) -> None:
+        """Capture a performance profile of this run in the background.
+
+        For `duration_seconds`, the internal process records a burst of
+        high-frequency system metrics, `perf` and `py-spy` samples of this
+        process if those tools are installed, and profiles of the internal
+        process itself. The results are logged as an artifact of type
+        "profile" with a timestamped index, so that profiles of different
+        runs can be compared.
+
+        This returns immediately. Only one profile is captured at a time;
+        requests made while a capture is in progress are ignored, and a
+        capture still in progress when the run finishes is discarded.
+
+        Args:
+            duration_seconds: How long to capture for, in seconds.
+            label: An optional label to store in the profile's index,
+                such as the training phase being profiled.
+        """
+        if duration_seconds <= 0:
+            raise ValueError("duration_seconds must be positive")
+
+        if self._interface:
+            self._interface.publish_capture_profile(
+                duration_seconds=duration_seconds,
+                label=label or "",
+            )
+
     @property
     @_log_to_run
     @_raise_if_finished
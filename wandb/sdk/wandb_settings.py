@@ -15,7 +15,7 @@ from datetime import datetime
 from typing import Any, Literal
 from urllib.parse import quote, unquote
 
-from google.protobuf.wrappers_pb2 import BoolValue, DoubleValue, Int32Value, StringValue
+from google.protobuf.wrappers_pb2 import BoolValue, DoubleValue, StringValue
 from pydantic import BaseModel, ConfigDict, Field
 from typing_extensions import Self
 
@@ -41,14 +41,27 @@ def _path_convert(*args: str) -> str:
     return os.path.expanduser(os.path.join(*args))
 
 
+# Python regular expression syntax that Go's RE2 syntax doesn't support:
+# backreferences, \Z, lookarounds, atomic groups, conditionals and
+# possessive quantifiers. Other escapes are matched to skip them.
+_NON_RE2_SYNTAX = re.compile(r"\\[1-9Z]|\\.|\(\?(?:[=!>(]|<[=!]|P=)|[*+?}]\+")
+
+
+def _find_non_re2_syntax(pattern: str) -> str | None:
+    """Returns the first construct in the pattern that RE2 doesn't support."""
+    for match in _NON_RE2_SYNTAX.finditer(pattern):
+        text = match.group()
+        if not text.startswith("\\") or text[1] in "123456789Z":
+            return text
+    return None
+
+
 CLIENT_ONLY_SETTINGS = (
     "anonymous",
     "app_url_override",
     "capture_loggers",
     "files_dir",
     "finish_timeout_raises",
-    "max_end_of_run_history_metrics",
-    "max_end_of_run_summary_metrics",
     "reinit",
     "stop_fn",
     "use_dot_wandb",
@@ -208,6 +221,38 @@ class Settings(BaseModel, validate_assignment=True):
     limit.
     """
 
+    console_log_parsers: Sequence[Literal["python", "json", "glog"]] = ()
+    """Log formats to recognize in console output.
+
+    Lines written by Python's `logging` module ("python"), by structured
+    loggers that print JSON objects with a level field ("json"), or by
+    glog and klog ("glog") are parsed into their timestamp, level, logger
+    and message. Parsed lines are saved to a JSON Lines file next to
+    `output.log`, and the number of lines at each level is recorded in
+    the run summary, for example as `_console/errors`.
+
+    Formats are tried in the given order. Parsing is off by default.
+    """
+
+    console_redact: bool = False
+    """Whether to mask secrets in console output before it is saved or uploaded.
+
+    Built-in detectors find AWS access keys, bearer tokens, W&B API keys and
+    signatures in pre-signed S3, GCS and Azure URLs. Each match is replaced
+    with `[REDACTED]` in `output.log` and in the live console logs, and the
+    number of redactions is recorded in the run summary as
+    `_console/redactions`.
+    """
+
+    console_redact_patterns: Sequence[str] = ()
+    """Additional regular expressions to redact from console output.
+
+    Patterns use Go's RE2 syntax. If a pattern has capture groups, only the
+    text matched by the groups is redacted, so `password=(\\S+)` keeps the
+    `password=` prefix. Setting this enables redaction even if
+    `console_redact` is `False`.
+    """
+
     capture_loggers: dict[str, str] | None = None
     """Names of Python loggers to capture into the run's Logs tab.
 
@@ -394,6 +439,20 @@ class Settings(BaseModel, validate_assignment=True):
     notebook_name: str | None = None
     """Name of the notebook if running in a Jupyter-like environment."""
 
+    offline_fallback_timeout: float | None = None
+    """Time in seconds the W&B server can be unreachable before a run switches
+    to offline mode.
+
+    Off by default.
+
+    While offline, the run's data is only written to its local `.wandb` file.
+    W&B keeps checking whether the server is reachable and, once it is,
+    uploads the data saved in the meantime. If the run finishes while offline,
+    the remaining data can be uploaded with `wandb sync`.
+
+    If unset, zero or negative, requests are retried instead.
+    """
+
     program: str | None = None
     """Path to the script that created the run, if available."""
 
@@ -414,6 +473,34 @@ class Settings(BaseModel, validate_assignment=True):
     quiet: bool = False
     """Flag to suppress non-essential output."""
 
+    redact_drop: Sequence[str] = ()
+    """Config, summary and metadata values to remove before uploading.
+
+    Each entry is a dotted path such as `"config.db.password"`,
+    `"summary.eval.samples"` or `"metadata.git.remote"`, where metadata paths
+    use the field names of `wandb-metadata.json`. Each component may contain
+    glob wildcards, and `**` matches any number of components. An entry that
+    doesn't start with `config`, `summary` or `metadata` matches keys at any
+    depth, so `"*token*"` removes every value whose key contains "token".
+
+    Useful in a system-wide settings file to enforce a policy for all runs.
+    """
+
+    redact_hash: Sequence[str] = ()
+    """Config, summary and metadata values to replace with their hash.
+
+    Uses the same patterns as `redact_drop`. Matching values are replaced by
+    a `"sha256:"`-prefixed hex digest, so runs can still be grouped by them.
+    """
+
+    redact_keep: Sequence[str] = ()
+    """Values to upload unchanged even if they match `redact_drop` or
+    `redact_hash`.
+
+    Uses the same patterns as `redact_drop`. For example, `"config.tokenizer"`
+    exempts that key from a `"*token*"` rule.
+    """
+
     reinit: (
         Literal["default", "return_previous", "finish_previous", "create_new"] | bool
     ) = "default"
@@ -499,6 +586,23 @@ class Settings(BaseModel, validate_assignment=True):
     save_code: bool | None = None
     """Whether to save the code associated with the run."""
 
+    save_code_snapshot: bool = False
+    """Whether to snapshot the full source tree into a code artifact.
+
+    The snapshot covers the git root, or the program's directory when the
+    program is not in a git repository. Files matched by `.gitignore` are
+    skipped, untracked files that are not ignored are included, and the
+    commit of each submodule is recorded in the artifact's metadata.
+    Has an effect only when `save_code` is `True`.
+    """
+
+    save_code_snapshot_max_bytes: int = 100 * 1024 * 1024
+    """Maximum total size of a source snapshot, in bytes.
+
+    Files that would push the snapshot past this limit are left out and
+    listed in the artifact's metadata.
+    """
+
     settings_system: str | None = None
     """Path to the system-wide settings file."""
 
@@ -614,6 +718,14 @@ class Settings(BaseModel, validate_assignment=True):
     <!-- lazydoc-ignore -->
     """
 
+    x_client_prints_footer: bool = True
+    """Whether the SDK prints the end-of-run footer itself.
+
+    If False, wandb-core prints it when the run finishes.
+
+    <!-- lazydoc-ignore -->
+    """
+
     x_disable_meta: bool = False
     """Flag to disable the collection of system metadata."""
 
@@ -769,6 +881,28 @@ class Settings(BaseModel, validate_assignment=True):
     <!-- lazydoc-ignore -->
     """
 
+    x_history_upload_budget: int | None = None
+    """Number of history values per second above which uploads are thinned.
+
+    A value is one key in one logged row. When a run logs faster than this,
+    only a downsampled history is uploaded, while the local transaction log
+    keeps every row. The policy is recorded in the run config under
+    `_wandb.history_throttle`. Disabled if unset or zero.
+
+    <!-- lazydoc-ignore -->
+    """
+
+    x_history_upload_throttle: Literal["stride", "buckets"] = "stride"
+    """How history is thinned when over `x_history_upload_budget`.
+
+    With "stride", every Nth value of each key is uploaded, where N grows
+    with the logging rate. With "buckets", rows are grouped into short time
+    buckets and the rows holding each key's minimum, maximum and last value
+    are uploaded.
+
+    <!-- lazydoc-ignore -->
+    """
+
     x_internal_check_process: float = 8.0
     """Interval for internal process health checks in seconds.
 
@@ -1002,7 +1136,55 @@ class Settings(BaseModel, validate_assignment=True):
     """
 
     x_stats_no_cgroup: bool = False
-    """Disable cgroup v2 CPU and memory limits for system metric percentages."""
+    """Disable cgroup v2 metrics.
+
+    Without cgroup v2 CPU and memory limits, system metric percentages are
+    relative to the whole machine, and cgroup pressure, CPU throttling and
+    OOM metrics are not collected.
+    """
+
+    x_stats_network_interfaces: Sequence[str] | None = None
+    """Glob patterns of network interfaces to report send and receive rates for.
+
+    For example, `("ib*", "eth0")`, or `"ib*,eth0"` in the
+    `WANDB_X_STATS_NETWORK_INTERFACES` environment variable. No per-interface
+    rates are reported if unset.
+    """
+
+    x_stats_network_interfaces_exclude: Sequence[str] | None = None
+    """Glob patterns of network interfaces to leave out of per-interface rates."""
+
+    x_stats_disk_devices: Sequence[str] | None = None
+    """Glob patterns of disk devices to report read and write rates for.
+
+    For example, `("nvme*",)`. If unset, the devices backing
+    `x_stats_disk_paths` are used.
+    """
+
+    x_stats_disk_devices_exclude: Sequence[str] | None = None
+    """Glob patterns of disk devices to leave out of per-device rates."""
+
+    x_stats_top_processes: int = 0
+    """Number of processes to include in periodic process table snapshots.
+
+    If positive, the pid, command line, memory, CPU, thread and open file
+    counts of the processes using the most CPU in the tree rooted at
+    `x_stats_pid` are appended to the `wandb-processes.jsonl` run file
+    every sampling interval. Secrets in command lines are masked with the
+    built-in console redaction patterns and `console_redact_patterns`,
+    and snapshots stop once the file reaches 64 MiB.
+    """
+
+    x_stats_core_metrics: bool = False
+    """Log the internal state of the wandb-core process as system metrics.
+
+    When `True`, metrics prefixed with `_wandb/core.` describe the backlog of
+    data waiting to be uploaded, filestream request latency and retries, the
+    estimated server rate limit, file upload progress and wandb-core's own
+    goroutine count and heap size. They help tell whether a run that lags
+    behind in the UI is held back by the network, by rate limiting or by
+    wandb-core itself.
+    """
 
     x_sync: bool = False
     """Flag to indicate whether we are syncing a run from the transaction log.
@@ -1189,6 +1371,17 @@ class Settings(BaseModel, validate_assignment=True):
             raise ValueError("File stream max line bytes must be greater than 0")
         return value
 
+    @field_validator("x_history_upload_budget", mode="after")
+    @classmethod
+    def validate_x_history_upload_budget(cls, value):
+        """Validate the history upload budget.
+
+        <!-- lazydoc-ignore -->
+        """
+        if value is not None and value < 0:
+            raise ValueError("History upload budget must not be negative")
+        return value
+
     @field_validator("x_files_dir", mode="before")
     @classmethod
     def validate_x_files_dir(cls, value):
@@ -1257,6 +1450,46 @@ class Settings(BaseModel, validate_assignment=True):
         """
         return tuple(value) if not isinstance(value, tuple) else value
 
+    @field_validator("console_log_parsers", mode="after")
+    @classmethod
+    def validate_console_log_parsers(cls, value):
+        """Validate the console log parsers.
+
+        <!-- lazydoc-ignore -->
+        """
+        return tuple(value) if not isinstance(value, tuple) else value
+
+    @field_validator("console_redact_patterns", mode="after")
+    @classmethod
+    def validate_console_redact_patterns(cls, value):
+        """Validate the console redaction patterns.
+
+        <!-- lazydoc-ignore -->
+        """
+        for pattern in value:
+            try:
+                re.compile(pattern)
+            except re.error as e:
+                raise UsageError(
+                    f"Invalid console redaction pattern {pattern!r}: {e}"
+                ) from e
+
+            if unsupported := _find_non_re2_syntax(pattern):
+                raise UsageError(
+                    f"Invalid console redaction pattern {pattern!r}:"
+                    f" {unsupported!r} is not supported in Go's RE2 syntax"
+                )
+        return tuple(value) if not isinstance(value, tuple) else value
+
+    @field_validator("redact_drop", "redact_hash", "redact_keep", mode="after")
+    @classmethod
+    def validate_redact_patterns(cls, value):
+        """Validate the redaction patterns.
+
+        <!-- lazydoc-ignore -->
+        """
+        return tuple(value) if not isinstance(value, tuple) else value
+
     @field_validator("program", mode="before")
     @classmethod
     def validate_program(cls, value):
@@ -1441,6 +1674,26 @@ class Settings(BaseModel, validate_assignment=True):
             return json.loads(value)
         return value
 
+    @field_validator(
+        "x_stats_network_interfaces",
+        "x_stats_network_interfaces_exclude",
+        "x_stats_disk_devices",
+        "x_stats_disk_devices_exclude",
+        mode="before",
+    )
+    @classmethod
+    def validate_x_stats_device_patterns(cls, value):
+        """Validate the network interface and disk device patterns.
+
+        Comma-separated strings, as set in environment variables and
+        settings files, are split into patterns.
+
+        <!-- lazydoc-ignore -->
+        """
+        if isinstance(value, str):
+            return [pattern.strip() for pattern in value.split(",") if pattern.strip()]
+        return value
+
     @field_validator("x_stats_neuron_monitor_config_path", mode="before")
     @classmethod
     def validate_x_stats_neuron_monitor_config_path(cls, value):
@@ -1977,7 +2230,14 @@ class Settings(BaseModel, validate_assignment=True):
             if key not in self.__dict__:
                 continue
 
-            if key in ("ignore_globs", "run_tags"):
+            if key in (
+                "ignore_globs",
+                "run_tags",
+                "console_log_parsers",
+                "redact_drop",
+                "redact_hash",
+                "redact_keep",
+            ):
                 value = value.split(",")
 
             if value is None:
@@ -2136,7 +2396,9 @@ class Settings(BaseModel, validate_assignment=True):
             if isinstance(v, bool):
                 getattr(settings_proto, k).CopyFrom(BoolValue(value=v))
             elif isinstance(v, int):
-                getattr(settings_proto, k).CopyFrom(Int32Value(value=v))
+                # Either an Int32Value or an Int64Value.
+                wrapper = getattr(settings_proto, k)
+                wrapper.CopyFrom(type(wrapper)(value=v))
             elif isinstance(v, float):
                 getattr(settings_proto, k).CopyFrom(DoubleValue(value=v))
             elif isinstance(v, str):
@@ -2303,7 +2565,7 @@ def _parse_system_settings(
 
     value: object  # Can be transformed arbitrarily.
     for key, value in system_settings.all().items():
-        if key == "ignore_globs":
+        if key in ("ignore_globs", "redact_drop", "redact_hash", "redact_keep"):
             fields[key] = value.split(",")
 
         elif key == "anonymous":